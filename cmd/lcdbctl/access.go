@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+// parseRole 把 viewer / editor / builder / owner 转成 Role。
+func parseRole(name string) (lowcodev1.Role, error) {
+	role, ok := lowcodev1.Role_value["ROLE_"+strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown role %q", name)
+	}
+	return lowcodev1.Role(role), nil
+}
+
+func membersCommand() *cobra.Command {
+	return groupCommand("members", "Manage tenant members and their roles",
+		&cobra.Command{
+			Use:   "list",
+			Short: "List members",
+			Args:  cobra.NoArgs,
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ListMembers(ctx, &lowcodev1.ListMembersRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "set <user_id> <viewer|editor|builder|owner>",
+			Short: "Add a member or change their role",
+			Args:  cobra.ExactArgs(2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				role, err := parseRole(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return c.SetMember(ctx, &lowcodev1.SetMemberRequest{UserId: args[0], Role: role})
+			}),
+		},
+		&cobra.Command{
+			Use:   "delete <user_id>",
+			Short: "Remove a member",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.DeleteMember(ctx, &lowcodev1.DeleteMemberRequest{UserId: args[0]})
+			}),
+		},
+	)
+}
+
+func permissionsCommand() *cobra.Command {
+	return groupCommand("permissions", "Manage table and column permission rules",
+		&cobra.Command{
+			Use:   "list <table_id>",
+			Short: "List the rules of a table",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ListPermissions(ctx, &lowcodev1.ListPermissionsRequest{TableId: args[0]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "set <table_id> <column_id|-> <role:NAME|user:ID> <read|write|unmask> <allow|deny>",
+			Short: "Add a rule or change its effect",
+			Args:  cobra.ExactArgs(5),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				req := &lowcodev1.SetPermissionRequest{TableId: args[0]}
+				if args[1] != "-" {
+					req.ColumnId = args[1]
+				}
+				kind, who, _ := strings.Cut(args[2], ":")
+				switch kind {
+				case "role":
+					role, err := parseRole(who)
+					if err != nil {
+						return nil, err
+					}
+					req.Role = role
+				case "user":
+					req.UserId = who
+				default:
+					return nil, fmt.Errorf("subject must be role:NAME or user:ID, got %q", args[2])
+				}
+				access, ok := lowcodev1.PermissionAccess_value["PERMISSION_ACCESS_"+strings.ToUpper(args[3])]
+				if !ok {
+					return nil, fmt.Errorf("unknown access %q", args[3])
+				}
+				effect, ok := lowcodev1.PermissionEffect_value["PERMISSION_EFFECT_"+strings.ToUpper(args[4])]
+				if !ok {
+					return nil, fmt.Errorf("unknown effect %q", args[4])
+				}
+				req.Access = lowcodev1.PermissionAccess(access)
+				req.Effect = lowcodev1.PermissionEffect(effect)
+				return c.SetPermission(ctx, req)
+			}),
+		},
+		&cobra.Command{
+			Use:   "delete <id>",
+			Short: "Delete a rule",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.DeletePermission(ctx, &lowcodev1.DeletePermissionRequest{Id: args[0]})
+			}),
+		},
+	)
+}
+
+// auditFilterFlags 注册 audit list / tail 共用的过滤条件。
+func auditFilterFlags(cmd *cobra.Command, req *lowcodev1.ListAuditEventsRequest) {
+	cmd.Flags().StringVar(&req.UserId, "user", "", "only events by this user")
+	cmd.Flags().StringVar(&req.Method, "method", "", "only events of this RPC, e.g. UpdateRow")
+	cmd.Flags().StringVar(&req.TableId, "table", "", "only events on this table")
+	cmd.Flags().StringVar(&req.ResourceId, "resource", "", "only events affecting this id")
+}
+
+func auditCommand() *cobra.Command {
+	return groupCommand("audit", "Read the audit log of --tenant", auditListCommand(), auditTailCommand())
+}
+
+func auditListCommand() *cobra.Command {
+	req := &lowcodev1.ListAuditEventsRequest{}
+	var since time.Duration
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recent audit events, newest first",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			if since > 0 {
+				req.StartTime = timestamppb.New(time.Now().Add(-since))
+			}
+			req.PageSize = limit
+			return c.ListAuditEvents(ctx, req)
+		}),
+	}
+	auditFilterFlags(cmd, req)
+	cmd.Flags().DurationVar(&since, "since", 0, "only events newer than this")
+	cmd.Flags().Int32Var(&limit, "limit", 50, "maximum number of events")
+	return cmd
+}
+
+func auditTailCommand() *cobra.Command {
+	req := &lowcodev1.ListAuditEventsRequest{PageSize: 1000}
+	var since, interval time.Duration
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Follow new audit events, one JSON object per line",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(true, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			if interval <= 0 {
+				return nil, fmt.Errorf("--interval must be positive")
+			}
+			return nil, tail(ctx, time.Now().Add(-since), interval, func(ctx context.Context, from time.Time, pageToken string) ([]*lowcodev1.AuditEvent, string, error) {
+				req.StartTime, req.PageToken = timestamppb.New(from), pageToken
+				res, err := c.ListAuditEvents(ctx, req)
+				return res.GetEvents(), res.GetNextPageToken(), err
+			})
+		}),
+	}
+	auditFilterFlags(cmd, req)
+	cmd.Flags().DurationVar(&since, "since", 0, "also print events newer than this before following")
+	cmd.Flags().DurationVar(&interval, "interval", 2*time.Second, "polling interval")
+	return cmd
+}
@@ -0,0 +1,183 @@
+// lcdbctl 是 lowcode-database 的管理命令行，通过 gRPC API 完成常见运维操作。
+//
+//	lcdbctl [--addr localhost:9090] [--tenant id] <group> <command> [args]
+//
+// 每个子命令对应一个 RPC，响应以 JSON 输出；tail 类命令持续输出到 Ctrl-C。
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+// globalFlags 是所有子命令共用的连接参数。
+var globalFlags struct {
+	addr     string
+	tenantID string
+	token    string
+	timeout  time.Duration
+}
+
+// rpcFunc 调用一个 RPC，返回要输出的响应；自己输出结果的命令（如 tail）返回 nil。
+type rpcFunc func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error)
+
+// runRPC 把 rpcFunc 包装成 cobra 的 RunE：连接服务，附带 tenant 和 token，以多行 JSON 输出响应。
+// follow 的命令持续运行到 Ctrl-C，不受 --timeout 限制。
+func runRPC(follow bool, run rpcFunc) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// 参数已通过校验，之后的错误不再打印用法
+		cmd.SilenceUsage = true
+		conn, err := grpc.NewClient(globalFlags.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("dial %s: %w", globalFlags.addr, err)
+		}
+		defer conn.Close()
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+		if !follow {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, globalFlags.timeout)
+			defer cancel()
+		}
+		if globalFlags.tenantID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-tenant-id", globalFlags.tenantID)
+		}
+		if globalFlags.token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+globalFlags.token)
+		}
+
+		res, err := run(ctx, lowcodev1.NewLowcodeServiceClient(conn), args)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", cmd.Parent().Name(), cmd.Name(), err)
+		}
+		if res == nil {
+			return nil
+		}
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(res)
+		if err != nil {
+			return fmt.Errorf("encode response: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(out))
+		return nil
+	}
+}
+
+// readJSON 读取 path 并按 protojson 解析到 m。
+func readJSON(path string, m proto.Message) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// tailRecord 是 tail 输出的记录（审计事件、webhook 投递）。
+type tailRecord interface {
+	proto.Message
+	GetId() string
+	GetCreatedAt() *timestamppb.Timestamp
+}
+
+// tail 每隔 every 调用 list 取 from 之后的全部记录，按时间顺序逐行输出 JSON，直到 ctx 结束。
+// list 返回一页按时间倒序排列的记录和下一页的 token。start_time 包含边界，与上次最后一条同一时刻的记录按 id 去重。
+func tail[T tailRecord](ctx context.Context, from time.Time, every time.Duration, list func(ctx context.Context, from time.Time, pageToken string) ([]T, string, error)) error {
+	seen := map[string]bool{}
+	for {
+		var records []T
+		for pageToken := ""; ; {
+			page, next, err := list(ctx, from, pageToken)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			records = append(records, page...)
+			if pageToken = next; pageToken == "" {
+				break
+			}
+		}
+		for i := len(records) - 1; i >= 0; i-- {
+			r := records[i]
+			if seen[r.GetId()] {
+				continue
+			}
+			if t := r.GetCreatedAt().AsTime(); t.After(from) {
+				from, seen = t, map[string]bool{}
+			}
+			seen[r.GetId()] = true
+			out, err := protojson.Marshal(r)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(every):
+		}
+	}
+}
+
+// groupCommand 返回只用来归类子命令的命令，如 tenant、schema。
+func groupCommand(use, short string, cmds ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{Use: use, Short: short}
+	cmd.AddCommand(cmds...)
+	return cmd
+}
+
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "lcdbctl",
+		Short:         "Manage a lowcode-database server through its gRPC API",
+		SilenceErrors: true,
+	}
+	f := root.PersistentFlags()
+	f.StringVar(&globalFlags.addr, "addr", getenvDefault("LCDB_ADDR", "localhost:9090"), "gRPC server address")
+	f.StringVar(&globalFlags.tenantID, "tenant", os.Getenv("LCDB_TENANT"), "tenant id (multi-tenant and pooled modes)")
+	f.StringVar(&globalFlags.token, "token", os.Getenv("LCDB_TOKEN"), "bearer token (when the server has OIDC_ISSUER set)")
+	f.DurationVar(&globalFlags.timeout, "timeout", 30*time.Second, "request timeout")
+	root.AddCommand(
+		tenantCommand(),
+		membersCommand(),
+		permissionsCommand(),
+		auditCommand(),
+		webhooksCommand(),
+		schemaCommand(),
+		tablesCommand(),
+		typesCommand(),
+	)
+	return root
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "lcdbctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func getenvDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
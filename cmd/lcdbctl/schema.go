@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+func schemaCommand() *cobra.Command {
+	return groupCommand("schema", "Export, import and apply schema documents",
+		schemaExportCommand(),
+		schemaImportCommand(),
+		schemaApplyCommand(),
+	)
+}
+
+func schemaExportCommand() *cobra.Command {
+	req := &lowcodev1.ExportSchemaRequest{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Print the tables and custom types of --tenant as a schema bundle",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			return c.ExportSchema(ctx, req)
+		}),
+	}
+	cmd.Flags().BoolVar(&req.IncludeBuiltinTypes, "builtin", false, "include builtin types")
+	return cmd
+}
+
+func schemaImportCommand() *cobra.Command {
+	var overwrite bool
+	cmd := &cobra.Command{
+		Use:   "import <bundle.json>",
+		Short: "Create the tables and types of a schema bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			// 文件格式即 schema export 的输出。
+			var doc lowcodev1.ExportSchemaResponse
+			if err := readJSON(args[0], &doc); err != nil {
+				return nil, err
+			}
+			return c.ImportSchema(ctx, &lowcodev1.ImportSchemaRequest{Bundle: doc.GetBundle(), OverwriteTypes: overwrite})
+		}),
+	}
+	cmd.Flags().BoolVar(&overwrite, "overwrite-types", false, "overwrite existing types with different definitions")
+	return cmd
+}
+
+func schemaApplyCommand() *cobra.Command {
+	var prune, dryRun bool
+	cmd := &cobra.Command{
+		Use:   "apply <table.json>",
+		Short: "Bring a table in line with a declarative schema document",
+		Args:  cobra.ExactArgs(1),
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			// 文件是 ApplyTableSchemaRequest 的 JSON（name / columns / indexes ...），命令行参数覆盖 prune / dry_run。
+			req := &lowcodev1.ApplyTableSchemaRequest{}
+			if err := readJSON(args[0], req); err != nil {
+				return nil, err
+			}
+			req.Prune = req.Prune || prune
+			req.DryRun = req.DryRun || dryRun
+			return c.ApplyTableSchema(ctx, req)
+		}),
+	}
+	cmd.Flags().BoolVar(&prune, "prune", false, "drop columns and indexes missing from the file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the planned changes")
+	return cmd
+}
+
+func tablesCommand() *cobra.Command {
+	return groupCommand("tables", "List, export and import tables",
+		&cobra.Command{
+			Use:   "list",
+			Short: "List tables",
+			Args:  cobra.NoArgs,
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ListTables(ctx, &lowcodev1.ListTablesRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "export <table_id>",
+			Short: "Print the schema document of a table",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.GetTableSchema(ctx, &lowcodev1.GetTableSchemaRequest{TableId: args[0]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "import <airtable|notion> <name>=<file.csv|file.json>...",
+			Short: "Create tables from Airtable or Notion exports",
+			Args:  cobra.MinimumNArgs(2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				req := &lowcodev1.ImportExternalTablesRequest{Source: args[0]}
+				for _, arg := range args[1:] {
+					name, path, ok := strings.Cut(arg, "=")
+					if !ok {
+						return nil, fmt.Errorf("expected <name>=<file>, got %q", arg)
+					}
+					data, err := os.ReadFile(path)
+					if err != nil {
+						return nil, err
+					}
+					format := "csv"
+					if strings.HasSuffix(strings.ToLower(path), ".json") {
+						format = "json"
+					}
+					req.Tables = append(req.Tables, &lowcodev1.ExternalTableExport{Name: name, Format: format, Data: data})
+				}
+				return c.ImportExternalTables(ctx, req)
+			}),
+		},
+		&cobra.Command{
+			Use:   "adopt [schema...]",
+			Short: "Register existing Postgres tables of the given schemas",
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ImportDatabaseSchema(ctx, &lowcodev1.ImportDatabaseSchemaRequest{Schemas: args})
+			}),
+		},
+	)
+}
+
+func typesCommand() *cobra.Command {
+	return groupCommand("types", "Export and import column types", typesExportCommand(), typesImportCommand())
+}
+
+func typesExportCommand() *cobra.Command {
+	req := &lowcodev1.ExportTypesRequest{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Print the custom column types",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			return c.ExportTypes(ctx, req)
+		}),
+	}
+	cmd.Flags().BoolVar(&req.IncludeBuiltin, "builtin", false, "include builtin types")
+	return cmd
+}
+
+func typesImportCommand() *cobra.Command {
+	var overwrite bool
+	cmd := &cobra.Command{
+		Use:   "import <file.json>",
+		Short: "Create the types of a types export",
+		Args:  cobra.ExactArgs(1),
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			// 文件格式即 ExportTypes 的输出。
+			var doc lowcodev1.ExportTypesResponse
+			if err := readJSON(args[0], &doc); err != nil {
+				return nil, err
+			}
+			return c.ImportTypes(ctx, &lowcodev1.ImportTypesRequest{Types: doc.GetTypes(), Overwrite: overwrite})
+		}),
+	}
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false, "overwrite existing types with different definitions")
+	return cmd
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+func tenantCommand() *cobra.Command {
+	return groupCommand("tenant", "Manage tenants (multi-tenant mode)",
+		tenantCreateCommand(),
+		&cobra.Command{
+			Use:   "list",
+			Short: "List tenants",
+			Args:  cobra.NoArgs,
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ListTenants(ctx, &lowcodev1.ListTenantsRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "get <id>",
+			Short: "Show a tenant",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.GetTenant(ctx, &lowcodev1.GetTenantRequest{Id: args[0]})
+			}),
+		},
+		tenantCloneCommand(),
+		tenantExportCommand(),
+		tenantImportCommand(),
+		&cobra.Command{
+			Use:   "backup <id>",
+			Short: "Start a backup of a tenant",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.TriggerBackup(ctx, &lowcodev1.TriggerBackupRequest{TenantId: args[0]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "backups [id]",
+			Short: "List backups, optionally of one tenant",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				req := &lowcodev1.ListBackupsRequest{}
+				if len(args) == 1 {
+					req.TenantId = args[0]
+				}
+				return c.ListBackups(ctx, req)
+			}),
+		},
+		&cobra.Command{
+			Use:   "migrate [id...]",
+			Short: "Apply metadata migrations to the given tenants, or all tenants",
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.MigrateAllTenants(ctx, &lowcodev1.MigrateAllTenantsRequest{TenantIds: args})
+			}),
+		},
+		tenantHealthCommand(),
+		&cobra.Command{
+			Use:   "set-max-conns <id> <n>",
+			Short: "Set the connection pool size of a tenant, 0 for the default",
+			Args:  cobra.ExactArgs(2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				n, err := strconv.ParseInt(args[1], 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid connection count %q", args[1])
+				}
+				maxConns := int32(n)
+				return c.UpdateTenant(ctx, &lowcodev1.UpdateTenantRequest{Id: args[0], MaxConns: &maxConns})
+			}),
+		},
+		&cobra.Command{
+			Use:   "set-dsn <id> <dsn>",
+			Short: `Set the dedicated DSN of a tenant, "" for TENANT_DSN_TEMPLATE`,
+			Args:  cobra.ExactArgs(2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.UpdateTenant(ctx, &lowcodev1.UpdateTenantRequest{Id: args[0], Dsn: &args[1]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "set-replica <id> <dsn>",
+			Short: `Set the read replica DSN of a tenant, "" for READ_REPLICA_DSN`,
+			Args:  cobra.ExactArgs(2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.UpdateTenant(ctx, &lowcodev1.UpdateTenantRequest{Id: args[0], ReplicaDsn: &args[1]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "suspend <id> [reason]",
+			Short: "Suspend a tenant",
+			Args:  cobra.RangeArgs(1, 2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				req := &lowcodev1.SuspendTenantRequest{Id: args[0]}
+				if len(args) == 2 {
+					req.Reason = args[1]
+				}
+				return c.SuspendTenant(ctx, req)
+			}),
+		},
+		&cobra.Command{
+			Use:   "resume <id>",
+			Short: "Resume a suspended tenant",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ResumeTenant(ctx, &lowcodev1.ResumeTenantRequest{Id: args[0]})
+			}),
+		},
+		&cobra.Command{
+			Use:   "rotate-keys",
+			Short: "Rotate the data key of --tenant and re-encrypt its DSN and encrypted columns",
+			Args:  cobra.NoArgs,
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.RotateTenantKeys(ctx, &lowcodev1.RotateTenantKeysRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "delete <id> <id again to confirm>",
+			Short: "Delete a tenant and its database",
+			Args:  cobra.ExactArgs(2),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.DeleteTenant(ctx, &lowcodev1.DeleteTenantRequest{Id: args[0], Confirm: args[1]})
+			}),
+		},
+	)
+}
+
+func tenantCreateCommand() *cobra.Command {
+	var bootstrap string
+	var empty bool
+	cmd := &cobra.Command{
+		Use:   "create <id> [dsn]",
+		Short: "Create a tenant",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			req := &lowcodev1.CreateTenantRequest{Id: args[0], SkipBootstrap: empty}
+			if len(args) == 2 {
+				req.Dsn = args[1]
+			}
+			if bootstrap != "" {
+				data, err := os.ReadFile(bootstrap)
+				if err != nil {
+					return nil, err
+				}
+				req.Bootstrap = &lowcodev1.TenantBootstrap{}
+				if err := protojson.Unmarshal(data, req.Bootstrap); err != nil {
+					return nil, fmt.Errorf("parse %s: %w", bootstrap, err)
+				}
+			}
+			return c.CreateTenant(ctx, req)
+		}),
+	}
+	cmd.Flags().StringVar(&bootstrap, "bootstrap", "", "TenantBootstrap JSON written into the new tenant instead of the server default")
+	cmd.Flags().BoolVar(&empty, "empty", false, "create an empty tenant without the server default bootstrap")
+	cmd.MarkFlagsMutuallyExclusive("bootstrap", "empty")
+	return cmd
+}
+
+func tenantCloneCommand() *cobra.Command {
+	req := &lowcodev1.CloneTenantRequest{}
+	cmd := &cobra.Command{
+		Use:   "clone <source> <target>",
+		Short: "Create a tenant from a copy of another tenant's database",
+		Args:  cobra.ExactArgs(2),
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			req.SourceId, req.TargetId = args[0], args[1]
+			return c.CloneTenant(ctx, req)
+		}),
+	}
+	cmd.Flags().BoolVar(&req.IncludeData, "data", false, "copy rows as well as the schema")
+	cmd.Flags().BoolVar(&req.DisconnectSource, "disconnect", false, "disconnect other sessions of the source database while copying")
+	return cmd
+}
+
+func tenantExportCommand() *cobra.Command {
+	var toStorage bool
+	cmd := &cobra.Command{
+		Use:   "export <id> [file.tar.gz]",
+		Short: "Export a tenant archive to a file or to object storage",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if toStorage {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		},
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			stream, err := c.ExportTenant(ctx, &lowcodev1.ExportTenantRequest{TenantId: args[0], ToStorage: toStorage})
+			if err != nil {
+				return nil, err
+			}
+			var f *os.File
+			if !toStorage {
+				path := args[0] + ".tar.gz"
+				if len(args) == 2 {
+					path = args[1]
+				}
+				if f, err = os.Create(path); err != nil {
+					return nil, err
+				}
+				defer f.Close()
+			}
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					return nil, err
+				}
+				if info := msg.GetInfo(); info != nil {
+					return info, nil
+				}
+				if f == nil {
+					return nil, fmt.Errorf("unexpected archive chunk")
+				}
+				if _, err := f.Write(msg.GetChunk()); err != nil {
+					return nil, err
+				}
+			}
+		}),
+	}
+	cmd.Flags().BoolVar(&toStorage, "to-storage", false, "write the archive to object storage")
+	return cmd
+}
+
+func tenantImportCommand() *cobra.Command {
+	var storageKey string
+	cmd := &cobra.Command{
+		Use:   "import <id> [file.tar.gz]",
+		Short: "Create a tenant from an archive file or an archive in object storage",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if storageKey != "" {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			stream, err := c.ImportTenant(ctx)
+			if err != nil {
+				return nil, err
+			}
+			info := &lowcodev1.ImportTenantInfo{TenantId: args[0], StorageKey: storageKey}
+			if err := stream.Send(&lowcodev1.ImportTenantRequest{Payload: &lowcodev1.ImportTenantRequest_Info{Info: info}}); err != nil {
+				return nil, err
+			}
+			if storageKey == "" {
+				f, err := os.Open(args[1])
+				if err != nil {
+					return nil, err
+				}
+				defer f.Close()
+				buf := make([]byte, 256<<10)
+				for {
+					n, err := f.Read(buf)
+					if n > 0 {
+						if err := stream.Send(&lowcodev1.ImportTenantRequest{Payload: &lowcodev1.ImportTenantRequest_Chunk{Chunk: buf[:n]}}); err == io.EOF {
+							// 服务端已经结束（出错），错误由 CloseAndRecv 返回
+							return stream.CloseAndRecv()
+						} else if err != nil {
+							return nil, err
+						}
+					}
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+			return stream.CloseAndRecv()
+		}),
+	}
+	cmd.Flags().StringVar(&storageKey, "storage-key", "", "import the archive stored under this object storage key")
+	return cmd
+}
+
+func tenantHealthCommand() *cobra.Command {
+	req := &lowcodev1.ListTenantHealthRequest{}
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Show the health of open tenant pools",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			return c.ListTenantHealth(ctx, req)
+		}),
+	}
+	cmd.Flags().BoolVar(&req.Refresh, "refresh", false, "check the open pools now instead of returning the last periodic check")
+	return cmd
+}
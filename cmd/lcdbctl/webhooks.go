@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+func webhooksCommand() *cobra.Command {
+	return groupCommand("webhooks", "Manage the webhooks of --tenant and read their delivery log",
+		webhooksCreateCommand(),
+		&cobra.Command{
+			Use:   "list",
+			Short: "List webhooks",
+			Args:  cobra.NoArgs,
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.ListWebhooks(ctx, &lowcodev1.ListWebhooksRequest{})
+			}),
+		},
+		&cobra.Command{
+			Use:   "delete <id>",
+			Short: "Delete a webhook and its delivery log",
+			Args:  cobra.ExactArgs(1),
+			RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+				return c.DeleteWebhook(ctx, &lowcodev1.DeleteWebhookRequest{Id: args[0]})
+			}),
+		},
+		webhooksDeliveriesCommand(),
+		webhooksTailCommand(),
+	)
+}
+
+func webhooksCreateCommand() *cobra.Command {
+	req := &lowcodev1.CreateWebhookRequest{}
+	cmd := &cobra.Command{
+		Use:   "create <url>",
+		Short: "POST the audit event of every successful change to url",
+		Args:  cobra.ExactArgs(1),
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			req.Url = args[0]
+			return c.CreateWebhook(ctx, req)
+		}),
+	}
+	cmd.Flags().StringVar(&req.TableId, "table", "", "only changes to this table")
+	cmd.Flags().StringSliceVar(&req.Methods, "method", nil, "only these RPCs, e.g. CreateRow,UpdateRow (repeatable)")
+	cmd.Flags().StringVar(&req.Secret, "secret", "", "sign deliveries with HMAC-SHA256 in X-Lowcode-Signature")
+	return cmd
+}
+
+// deliveryFilterFlags 注册 webhooks deliveries / tail 共用的过滤条件。
+func deliveryFilterFlags(cmd *cobra.Command, req *lowcodev1.ListWebhookDeliveriesRequest) {
+	cmd.Flags().StringVar(&req.WebhookId, "webhook", "", "only deliveries of this webhook")
+	cmd.Flags().BoolVar(&req.FailedOnly, "failed", false, "only failed deliveries (non-2xx or connection errors)")
+}
+
+func webhooksDeliveriesCommand() *cobra.Command {
+	req := &lowcodev1.ListWebhookDeliveriesRequest{}
+	var since time.Duration
+	cmd := &cobra.Command{
+		Use:   "deliveries",
+		Short: "List recent webhook deliveries, newest first",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(false, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			if since > 0 {
+				req.StartTime = timestamppb.New(time.Now().Add(-since))
+			}
+			return c.ListWebhookDeliveries(ctx, req)
+		}),
+	}
+	deliveryFilterFlags(cmd, req)
+	cmd.Flags().DurationVar(&since, "since", 0, "only deliveries newer than this")
+	cmd.Flags().Int32Var(&req.PageSize, "limit", 50, "maximum number of deliveries")
+	return cmd
+}
+
+func webhooksTailCommand() *cobra.Command {
+	req := &lowcodev1.ListWebhookDeliveriesRequest{PageSize: 1000}
+	var since, interval time.Duration
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Follow new webhook deliveries, one JSON object per line",
+		Args:  cobra.NoArgs,
+		RunE: runRPC(true, func(ctx context.Context, c lowcodev1.LowcodeServiceClient, args []string) (proto.Message, error) {
+			if interval <= 0 {
+				return nil, fmt.Errorf("--interval must be positive")
+			}
+			return nil, tail(ctx, time.Now().Add(-since), interval, func(ctx context.Context, from time.Time, pageToken string) ([]*lowcodev1.WebhookDelivery, string, error) {
+				req.StartTime, req.PageToken = timestamppb.New(from), pageToken
+				res, err := c.ListWebhookDeliveries(ctx, req)
+				return res.GetDeliveries(), res.GetNextPageToken(), err
+			})
+		}),
+	}
+	deliveryFilterFlags(cmd, req)
+	cmd.Flags().DurationVar(&since, "since", 0, "also print deliveries newer than this before following")
+	cmd.Flags().DurationVar(&interval, "interval", 2*time.Second, "polling interval")
+	return cmd
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+	"github.com/solat/lowcode-database/internal/service"
+)
+
+// cellContentPath 是 bytes / 附件单元格的流式上传下载路由（grpc-gateway 不支持原始 body 的流式传输）。
+const cellContentPath = "/v1/tables/{table_id}/rows/{row_id}/cells/{column_id}/content"
+
+// registerCellContentRoutes 注册 PUT（上传）和 GET（下载）两个路由，body 为原始字节，不经过 JSON 编码。
+func registerCellContentRoutes(mux *http.ServeMux, svc *service.LowcodeService, verifier *auth.Verifier) {
+	mux.HandleFunc("PUT "+cellContentPath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := httpRequestContext(r, verifier)
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		info := &lowcodev1.CellContentInfo{
+			TableId:     r.PathValue("table_id"),
+			RowId:       r.PathValue("row_id"),
+			ColumnId:    r.PathValue("column_id"),
+			ContentType: r.Header.Get("Content-Type"),
+			Filename:    r.URL.Query().Get("filename"),
+		}
+		ctx, finish := svc.BeginAudit(ctx, lowcodev1.LowcodeService_UploadCellContent_FullMethodName, info)
+		defer func() { finish(err) }()
+		if err = svc.Authorize(ctx, lowcodev1.LowcodeService_UploadCellContent_FullMethodName, nil); err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		buf := make([]byte, 256<<10)
+		res, err := svc.WriteCellContent(ctx, info, func() ([]byte, error) {
+			n, err := io.ReadFull(r.Body, buf)
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				err = nil
+			}
+			if n == 0 && err == nil {
+				err = io.EOF
+			}
+			return buf[:n], err
+		})
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		writeHTTPJSON(w, res)
+	})
+
+	mux.HandleFunc("GET "+cellContentPath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := httpRequestContext(r, verifier)
+		if err == nil {
+			err = svc.Authorize(ctx, lowcodev1.LowcodeService_DownloadCellContent_FullMethodName, nil)
+		}
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		req := &lowcodev1.DownloadCellContentRequest{
+			TableId:  r.PathValue("table_id"),
+			RowId:    r.PathValue("row_id"),
+			ColumnId: r.PathValue("column_id"),
+		}
+		wroteHeader := false
+		err = svc.ReadCellContent(ctx, req,
+			func(info *lowcodev1.CellContentInfo) error {
+				ct := info.GetContentType()
+				if ct == "" {
+					ct = "application/octet-stream"
+				}
+				w.Header().Set("Content-Type", ct)
+				w.Header().Set("Content-Length", strconv.FormatInt(info.GetSize(), 10))
+				if info.GetFilename() != "" {
+					w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": info.GetFilename()}))
+				}
+				w.WriteHeader(http.StatusOK)
+				wroteHeader = true
+				return nil
+			},
+			func(chunk []byte) error {
+				_, err := w.Write(chunk)
+				return err
+			},
+		)
+		if err != nil && !wroteHeader {
+			writeHTTPError(w, err)
+		}
+	})
+}
+
+var jsonMarshaler = &runtime.JSONPb{}
+
+// writeHTTPJSON 以 grpc-gateway 的 JSON 编码写出响应。
+func writeHTTPJSON(w http.ResponseWriter, m proto.Message) {
+	b, err := jsonMarshaler.Marshal(m)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+// writeHTTPError 按 gRPC status 写出与 grpc-gateway 一致的 JSON 错误（含错误码 details）。
+func writeHTTPError(w http.ResponseWriter, err error) {
+	st := status.Convert(apierr.Annotate(err))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	b, mErr := jsonMarshaler.Marshal(st.Proto())
+	if mErr != nil {
+		w.Write([]byte(`{"message":"internal error"}`))
+		return
+	}
+	w.Write(b)
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log"
+	"math"
 	"net"
 	"net/http"
 	"os"
@@ -15,11 +17,17 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
 	"github.com/solat/lowcode-database/internal/config"
 	"github.com/solat/lowcode-database/internal/db"
 	"github.com/solat/lowcode-database/internal/service"
+	"github.com/solat/lowcode-database/internal/storage"
 	"github.com/solat/lowcode-database/internal/tenant"
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
 )
@@ -28,7 +36,7 @@ func withCORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-Tenant-Id, X-Tenant-ID, X-Requested-With")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Tenant-Id, X-Tenant-ID, X-User-Id, X-Read-Consistency, Grpc-Timeout, X-Requested-With")
 
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusNoContent)
@@ -39,6 +47,133 @@ func withCORS(next http.Handler) http.Handler {
 	})
 }
 
+// limitRequestBody 限制经过 grpc-gateway 的请求体大小：Content-Length 超过 max 时直接返回 PAYLOAD_TOO_LARGE，
+// 没有声明长度的请求在读取超过 max 时中止。max <= 0 不限制。
+func limitRequestBody(next http.Handler, max int) http.Handler {
+	if max <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > int64(max) {
+			writeHTTPError(w, apierr.NewPayloadTooLarge("body", max, int(r.ContentLength),
+				"request body of %d bytes exceeds the limit of %d", r.ContentLength, max))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, int64(max))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// tenantServerStream 替换 stream 的 context，使 streaming RPC 也能拿到 tenant id。
+type tenantServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantServerStream) Context() context.Context { return s.ctx }
+
+// requestContext 从 gRPC metadata 中取出 tenant id、调用方 user id（x-user-id，用于 created_by / updated_by）
+// 和读一致性要求（x-read-consistency: strong 时行查询读主库而不是只读副本）。
+// verifier 非空时 tenant id 和 user id 改为取自 bearer token，见 authenticate。
+func requestContext(ctx context.Context, verifier *auth.Verifier) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get("x-read-consistency"); len(vals) > 0 && vals[0] == "strong" {
+		ctx = db.WithStrongReads(ctx)
+	}
+	if verifier != nil {
+		return authenticate(ctx, md, verifier)
+	}
+	if vals := md.Get("x-tenant-id"); len(vals) > 0 {
+		ctx = tenant.WithTenantID(ctx, vals[0])
+	}
+	if vals := md.Get("x-user-id"); len(vals) > 0 {
+		ctx = auth.WithUserID(ctx, vals[0])
+	}
+	return ctx, nil
+}
+
+// authenticate 校验 authorization: Bearer <jwt>。token 只允许一个 tenant 时使用它；允许多个（或 "*"）时
+// 由 x-tenant-id 选择，选择 token 不允许的 tenant 返回 PermissionDenied。x-user-id 被忽略。
+func authenticate(ctx context.Context, md metadata.MD, verifier *auth.Verifier) (context.Context, error) {
+	var token string
+	if vals := md.Get("authorization"); len(vals) > 0 {
+		if scheme, rest, ok := strings.Cut(vals[0], " "); ok && strings.EqualFold(scheme, "bearer") {
+			token = strings.TrimSpace(rest)
+		}
+	}
+	if token == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_UNAUTHENTICATED, codes.Unauthenticated, "bearer token is required")
+	}
+	id, err := verifier.Verify(ctx, token)
+	if errors.Is(err, auth.ErrInvalidToken) {
+		return nil, apierr.New(lowcodev1.ErrorCode_UNAUTHENTICATED, codes.Unauthenticated, "%v", err)
+	}
+	if err != nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_INTERNAL, codes.Unavailable, "verify token: %v", err)
+	}
+
+	tenantID := ""
+	if vals := md.Get("x-tenant-id"); len(vals) > 0 && vals[0] != "" {
+		tenantID = vals[0]
+		if !id.AllowsTenant(tenantID) {
+			return nil, apierr.New(lowcodev1.ErrorCode_PERMISSION_DENIED, codes.PermissionDenied, "token does not grant access to tenant %s", tenantID)
+		}
+	} else if len(id.Tenants) == 1 && id.Tenants[0] != "*" {
+		tenantID = id.Tenants[0]
+	}
+	if tenantID != "" {
+		ctx = tenant.WithTenantID(ctx, tenantID)
+	}
+	if id.UserID != "" {
+		ctx = auth.WithUserID(ctx, id.UserID)
+	}
+	return auth.WithIdentity(ctx, id), nil
+}
+
+// httpRequestContext 为不经过 grpc-gateway 的 HTTP 路由（单元格内容、tenant 归档）做与 gRPC 拦截器相同的处理。
+func httpRequestContext(r *http.Request, verifier *auth.Verifier) (context.Context, error) {
+	md := metadata.MD{}
+	for _, k := range []string{"authorization", "x-tenant-id", "x-user-id", "x-read-consistency"} {
+		if v := r.Header.Get(k); v != "" {
+			md.Set(k, v)
+		}
+	}
+	return requestContext(metadata.NewIncomingContext(r.Context(), md), verifier)
+}
+
+// skipAuth 报告 method 是否不需要认证：grpc.health.v1 供负载均衡器和编排系统探活，不带 token。
+func skipAuth(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/")
+}
+
+// watchHealth 定期 ping 数据库并更新 health 状态，直到 ctx 结束。
+func watchHealth(ctx context.Context, hs *health.Server, tenantMgr *db.TenantManager) {
+	const lcService = "lowcode.v1.LowcodeService"
+	check := func() {
+		pingCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := tenantMgr.Ping(pingCtx); err != nil {
+			log.Printf("health: tenant manager not ready: %v", err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		hs.SetServingStatus("", status)
+		hs.SetServingStatus(lcService, status)
+	}
+
+	check()
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			check()
+		}
+	}
+}
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -59,20 +194,93 @@ func main() {
 		log.Fatalf("init tenant manager: %v", err)
 	}
 
+	store, err := storage.New(cfg)
+	if err != nil {
+		log.Fatalf("init attachment storage: %v", err)
+	}
+
+	var verifier *auth.Verifier
+	if cfg.OIDCIssuer != "" {
+		verifier, err = auth.NewVerifier(ctx, auth.OIDCConfig{
+			Issuer:      cfg.OIDCIssuer,
+			Audience:    cfg.OIDCAudience,
+			JWKSURL:     cfg.OIDCJWKSURL,
+			TenantClaim: cfg.OIDCTenantClaim,
+			UserClaim:   cfg.OIDCUserClaim,
+		})
+		if err != nil {
+			log.Fatalf("init oidc verifier: %v", err)
+		}
+		log.Printf("authenticating requests with OIDC issuer %s", cfg.OIDCIssuer)
+	}
+
 	// gRPC server
-	unary := grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if vals := md.Get("x-tenant-id"); len(vals) > 0 {
-				ctx = tenant.WithTenantID(ctx, vals[0])
-			}
+	tenantUnary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if skipAuth(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := requestContext(ctx, verifier)
+		if err != nil {
+			return nil, err
 		}
 		return handler(ctx, req)
-	})
+	}
 
-	grpcServer := grpc.NewServer(unary)
-	lcSvc := service.NewLowcodeService(tenantMgr, cfg.MaxRow)
+	tenantStream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if skipAuth(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := requestContext(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &tenantServerStream{ServerStream: ss, ctx: ctx})
+	}
+
+	lcSvc := service.NewLowcodeService(tenantMgr, cfg.MaxRow, cfg.MaxCellBytes, store)
+	if cfg.TenantBootstrapFile != "" {
+		if err := lcSvc.LoadTenantBootstrap(cfg.TenantBootstrapFile); err != nil {
+			log.Fatalf("load tenant bootstrap: %v", err)
+		}
+	}
+	lcSvc.SetLimits(service.Limits{
+		MaxBulkItems:   cfg.MaxBulkItems,
+		MaxCellsPerRow: cfg.MaxCellsPerRow,
+		MaxValueBytes:  cfg.MaxValueBytes,
+	})
+	maxMessageBytes := cfg.MaxMessageBytes
+	if maxMessageBytes <= 0 {
+		maxMessageBytes = math.MaxInt32
+	}
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageBytes),
+		// apierr 在最外层，保证所有错误（包括 tenant 解析失败）都带上错误码；角色检查需要先解析出 tenant 和调用方。
+		// PG 错误转换需要 tenant 的连接池来解析表 id / 列 id。超过大小限制的请求在审计之前拒绝；
+		// 审计在角色检查之前，被拒绝的修改也会记录。
+		grpc.ChainUnaryInterceptor(apierr.UnaryServerInterceptor, tenantUnary, lcSvc.UnaryErrorTranslator, lcSvc.UnaryLimiter, lcSvc.UnaryAuditor, lcSvc.UnaryAuthorizer),
+		grpc.ChainStreamInterceptor(apierr.StreamServerInterceptor, tenantStream, lcSvc.StreamErrorTranslator, lcSvc.StreamAuditor, lcSvc.StreamAuthorizer),
+	)
 	lowcodev1.RegisterLowcodeServiceServer(grpcServer, lcSvc)
 
+	// grpc.health.v1：""=整体状态，LowcodeService 的状态跟随 TenantManager 的数据库连通性。
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthSrv)
+	go watchHealth(ctx, healthSrv, tenantMgr)
+	// 分区表的 RANGE 分区由后台任务提前创建。
+	go lcSvc.RunPartitionMaintenance(ctx, time.Hour)
+	// 归档的表超过保留期后由后台任务清除。
+	if cfg.ArchiveRetention > 0 {
+		go lcSvc.RunTableRetention(ctx, time.Hour, time.Duration(cfg.ArchiveRetention)*24*time.Hour)
+	}
+	// 定期备份 tenant 到对象存储，并清除超过保留期的备份。
+	if tenantMgr.MultiTenant() && store != nil && (cfg.BackupInterval > 0 || cfg.BackupRetentionDays > 0) {
+		go lcSvc.RunBackups(ctx, 10*time.Minute, service.BackupPolicy{
+			Interval:  time.Duration(cfg.BackupInterval) * time.Hour,
+			Retention: time.Duration(cfg.BackupRetentionDays) * 24 * time.Hour,
+			KeepLast:  cfg.BackupKeepLast,
+		})
+	}
+
 	go func() {
 		lis, err := net.Listen("tcp", *grpcAddr)
 		if err != nil {
@@ -87,8 +295,9 @@ func main() {
 	// HTTP gateway + static
 	gwMux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
-			if strings.ToLower(key) == "x-tenant-id" {
-				return "x-tenant-id", true
+			switch k := strings.ToLower(key); k {
+			case "x-tenant-id", "x-user-id", "x-read-consistency":
+				return k, true
 			}
 			return runtime.DefaultHeaderMatcher(key)
 		}),
@@ -102,7 +311,14 @@ func main() {
 
 	mux := http.NewServeMux()
 	// API
-	mux.Handle("/v1/", gwMux)
+	mux.Handle("/v1/", limitRequestBody(gwMux, cfg.MaxMessageBytes))
+	registerCellContentRoutes(mux, lcSvc, verifier)
+	registerTenantArchiveRoutes(mux, lcSvc, verifier)
+	registerRowExportRoutes(mux, lcSvc, verifier, cfg.MaxMessageBytes)
+	// 本地存储的预签名 URL 由本服务处理
+	if local, ok := store.(*storage.LocalStore); ok {
+		mux.Handle(storage.LocalPathPrefix, local.Handler())
+	}
 
 	// Static files (index.html)
 	cwd, _ := os.Getwd()
@@ -125,10 +341,33 @@ func main() {
 
 	<-ctx.Done()
 	log.Println("shutting down...")
-	grpcServer.GracefulStop()
+	healthSrv.Shutdown()
 
-	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+	// 先关闭 HTTP：gateway 上进行中的请求还要经过 gRPC 服务处理。两者共用 SHUTDOWN_TIMEOUT_SECONDS，
+	// 超时后强制关闭，长时间的 streaming 调用不会一直阻塞退出
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
 	defer cancelShutdown()
-	_ = httpServer.Shutdown(shutdownCtx)
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("shutdown HTTP server: %v", err)
+		httpServer.Close()
+	}
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		log.Printf("gRPC requests still running after %ds, stopping", cfg.ShutdownTimeout)
+		grpcServer.Stop()
+		<-stopped
+	}
+	// 请求都已结束，等借出的连接归还后关闭全部连接池
+	closeCtx, cancelClose := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelClose()
+	if err := tenantMgr.Close(closeCtx); err != nil {
+		log.Printf("close tenant manager: %v", err)
+	}
 }
 
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+	"github.com/solat/lowcode-database/internal/service"
+)
+
+// rowExportPath 是表的导出路由（ExportRows），响应为文件本身（同 cellContentPath，不经过 grpc-gateway）。
+const rowExportPath = "/v1/tables/{table_id}/export"
+
+// registerRowExportRoutes 注册 GET 和 POST。GET 的查询参数为 format=csv|xlsx|jsonl|parquet、view_id、column_ids（可重复）
+// 和 include_hidden；需要 filter / sorts 时用 POST，body 为 ExportRowsRequest 的 JSON，路径中的 table_id 优先。
+// POST 的 body 同经过 grpc-gateway 的请求一样受 maxBody（MAX_MESSAGE_BYTES）限制。
+func registerRowExportRoutes(mux *http.ServeMux, svc *service.LowcodeService, verifier *auth.Verifier, maxBody int) {
+	mux.HandleFunc("GET "+rowExportPath, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		req := &lowcodev1.ExportRowsRequest{
+			ViewId:        q.Get("view_id"),
+			ColumnIds:     q["column_ids"],
+			IncludeHidden: q.Get("include_hidden") == "true",
+		}
+		format, err := parseExportFormat(q.Get("format"))
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		req.Format = format
+		serveRowExport(w, r, svc, verifier, req)
+	})
+
+	mux.Handle("POST "+rowExportPath, limitRequestBody(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			// 没有声明长度的 body 读到超过上限为止，实际大小未知，size 记为上限 + 1
+			writeHTTPError(w, apierr.NewPayloadTooLarge("body", int(tooLarge.Limit), int(tooLarge.Limit)+1,
+				"request body exceeds the limit of %d", tooLarge.Limit))
+			return
+		}
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		req := &lowcodev1.ExportRowsRequest{}
+		if len(body) > 0 {
+			if err := protojson.Unmarshal(body, req); err != nil {
+				writeHTTPError(w, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid request body: %v", err))
+				return
+			}
+		}
+		serveRowExport(w, r, svc, verifier, req)
+	}), maxBody))
+}
+
+// parseExportFormat 解析 format 查询参数，为空时导出 CSV。
+func parseExportFormat(s string) (lowcodev1.ExportFormat, error) {
+	switch strings.ToLower(s) {
+	case "", "csv":
+		return lowcodev1.ExportFormat_EXPORT_FORMAT_CSV, nil
+	case "xlsx":
+		return lowcodev1.ExportFormat_EXPORT_FORMAT_XLSX, nil
+	case "jsonl":
+		return lowcodev1.ExportFormat_EXPORT_FORMAT_JSONL, nil
+	case "parquet":
+		return lowcodev1.ExportFormat_EXPORT_FORMAT_PARQUET, nil
+	}
+	return 0, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "format must be csv, xlsx, jsonl or parquet")
+}
+
+func serveRowExport(w http.ResponseWriter, r *http.Request, svc *service.LowcodeService, verifier *auth.Verifier, req *lowcodev1.ExportRowsRequest) {
+	req.TableId = r.PathValue("table_id")
+	ctx, err := httpRequestContext(r, verifier)
+	if err == nil {
+		err = svc.Authorize(ctx, lowcodev1.LowcodeService_ExportRows_FullMethodName, nil)
+	}
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	contentType, ext := service.ExportContentType(req.GetFormat())
+	wroteHeader := false
+	err = svc.ExportRowsTo(ctx, req, func(chunk []byte) error {
+		if !wroteHeader {
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": req.GetTableId() + ext}))
+			w.WriteHeader(http.StatusOK)
+			wroteHeader = true
+		}
+		_, err := w.Write(chunk)
+		return err
+	})
+	if err == nil {
+		return
+	}
+	if !wroteHeader {
+		writeHTTPError(w, err)
+		return
+	}
+	// 已经开始发送时中断连接，避免客户端把不完整的文件当作成功的导出
+	panic(http.ErrAbortHandler)
+}
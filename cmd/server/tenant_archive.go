@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/auth"
+	"github.com/solat/lowcode-database/internal/service"
+)
+
+// tenantArchivePath 是 tenant 归档的导出 / 导入路由，body 为原始 tar.gz（同 cellContentPath，不经过 grpc-gateway）。
+const tenantArchivePath = "/v1/tenants/{tenant_id}/archive"
+
+// registerTenantArchiveRoutes 注册 GET（ExportTenant）和 PUT（ImportTenant，tenant_id 为新 tenant）。
+// 查询参数 to_storage=true / storage_key=... 时归档经由对象存储，响应为 JSON。
+func registerTenantArchiveRoutes(mux *http.ServeMux, svc *service.LowcodeService, verifier *auth.Verifier) {
+	mux.HandleFunc("GET "+tenantArchivePath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := httpRequestContext(r, verifier)
+		if err == nil {
+			err = svc.Authorize(ctx, lowcodev1.LowcodeService_ExportTenant_FullMethodName, nil)
+		}
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		req := &lowcodev1.ExportTenantRequest{
+			TenantId:  r.PathValue("tenant_id"),
+			ToStorage: r.URL.Query().Get("to_storage") == "true",
+		}
+		wroteHeader := false
+		info, err := svc.ExportTenantArchive(ctx, req, func(chunk []byte) error {
+			if !wroteHeader {
+				w.Header().Set("Content-Type", "application/gzip")
+				w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": req.GetTenantId() + ".tar.gz"}))
+				w.WriteHeader(http.StatusOK)
+				wroteHeader = true
+			}
+			_, err := w.Write(chunk)
+			return err
+		})
+		if err != nil {
+			if !wroteHeader {
+				writeHTTPError(w, err)
+			}
+			return
+		}
+		if req.GetToStorage() {
+			writeHTTPJSON(w, &lowcodev1.ExportTenantResponse{Payload: &lowcodev1.ExportTenantResponse_Info{Info: info}})
+		}
+	})
+
+	mux.HandleFunc("PUT "+tenantArchivePath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := httpRequestContext(r, verifier)
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		info := &lowcodev1.ImportTenantInfo{
+			TenantId:   r.PathValue("tenant_id"),
+			StorageKey: r.URL.Query().Get("storage_key"),
+		}
+		ctx, finish := svc.BeginAudit(ctx, lowcodev1.LowcodeService_ImportTenant_FullMethodName, info)
+		defer func() { finish(err) }()
+		if err = svc.Authorize(ctx, lowcodev1.LowcodeService_ImportTenant_FullMethodName, nil); err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		buf := make([]byte, 256<<10)
+		res, err := svc.ImportTenantArchive(ctx, info, func() ([]byte, error) {
+			n, err := io.ReadFull(r.Body, buf)
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				err = nil
+			}
+			if n == 0 && err == nil {
+				err = io.EOF
+			}
+			return buf[:n], err
+		})
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		writeHTTPJSON(w, res)
+	})
+}
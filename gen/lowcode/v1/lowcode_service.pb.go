@@ -24,34 +24,14461 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type IndexMethod int32
+
+const (
+	// CreateIndex 中表示自动选择：数组列用 GIN，其它用 BTREE
+	IndexMethod_INDEX_METHOD_UNSPECIFIED IndexMethod = 0
+	IndexMethod_INDEX_METHOD_BTREE       IndexMethod = 1
+	// 只支持单列等值查询，不能是唯一索引
+	IndexMethod_INDEX_METHOD_HASH IndexMethod = 2
+	// 数组、jsonb 列
+	IndexMethod_INDEX_METHOD_GIN IndexMethod = 3
+	// 地理列
+	IndexMethod_INDEX_METHOD_GIST IndexMethod = 4
+	// 数值、时间等可排序的列，适合按写入顺序增长的大表
+	IndexMethod_INDEX_METHOD_BRIN IndexMethod = 5
+)
+
+// Enum value maps for IndexMethod.
+var (
+	IndexMethod_name = map[int32]string{
+		0: "INDEX_METHOD_UNSPECIFIED",
+		1: "INDEX_METHOD_BTREE",
+		2: "INDEX_METHOD_HASH",
+		3: "INDEX_METHOD_GIN",
+		4: "INDEX_METHOD_GIST",
+		5: "INDEX_METHOD_BRIN",
+	}
+	IndexMethod_value = map[string]int32{
+		"INDEX_METHOD_UNSPECIFIED": 0,
+		"INDEX_METHOD_BTREE":       1,
+		"INDEX_METHOD_HASH":        2,
+		"INDEX_METHOD_GIN":         3,
+		"INDEX_METHOD_GIST":        4,
+		"INDEX_METHOD_BRIN":        5,
+	}
+)
+
+func (x IndexMethod) Enum() *IndexMethod {
+	p := new(IndexMethod)
+	*p = x
+	return p
+}
+
+func (x IndexMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[0].Descriptor()
+}
+
+func (IndexMethod) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[0]
+}
+
+func (x IndexMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexMethod.Descriptor instead.
+func (IndexMethod) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{0}
+}
+
+type IndexFunction int32
+
+const (
+	IndexFunction_INDEX_FUNCTION_UNSPECIFIED IndexFunction = 0
+	// lower(col)，文本列；配合不区分大小写的唯一约束
+	IndexFunction_INDEX_FUNCTION_LOWER IndexFunction = 1
+	// upper(col)，文本列
+	IndexFunction_INDEX_FUNCTION_UPPER IndexFunction = 2
+	// col ->> json_key，json / jsonb 列
+	IndexFunction_INDEX_FUNCTION_JSON_FIELD IndexFunction = 3
+	// 按 UTC 取日期，时间列
+	IndexFunction_INDEX_FUNCTION_DATE IndexFunction = 4
+)
+
+// Enum value maps for IndexFunction.
+var (
+	IndexFunction_name = map[int32]string{
+		0: "INDEX_FUNCTION_UNSPECIFIED",
+		1: "INDEX_FUNCTION_LOWER",
+		2: "INDEX_FUNCTION_UPPER",
+		3: "INDEX_FUNCTION_JSON_FIELD",
+		4: "INDEX_FUNCTION_DATE",
+	}
+	IndexFunction_value = map[string]int32{
+		"INDEX_FUNCTION_UNSPECIFIED": 0,
+		"INDEX_FUNCTION_LOWER":       1,
+		"INDEX_FUNCTION_UPPER":       2,
+		"INDEX_FUNCTION_JSON_FIELD":  3,
+		"INDEX_FUNCTION_DATE":        4,
+	}
+)
+
+func (x IndexFunction) Enum() *IndexFunction {
+	p := new(IndexFunction)
+	*p = x
+	return p
+}
+
+func (x IndexFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[1].Descriptor()
+}
+
+func (IndexFunction) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[1]
+}
+
+func (x IndexFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexFunction.Descriptor instead.
+func (IndexFunction) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{1}
+}
+
+// 稳定的机器可读错误码，通过 google.rpc.ErrorInfo 附加在每个 RPC 的错误上：
+// ErrorInfo.reason = 枚举名（如 "TABLE_NOT_FOUND"），ErrorInfo.domain = "lowcode.v1"
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	ErrorCode_INTERNAL               ErrorCode = 1
+	ErrorCode_VALIDATION_FAILED      ErrorCode = 2
+	ErrorCode_NOT_FOUND              ErrorCode = 3
+	ErrorCode_TABLE_NOT_FOUND        ErrorCode = 4
+	ErrorCode_COLUMN_NOT_FOUND       ErrorCode = 5
+	ErrorCode_ROW_NOT_FOUND          ErrorCode = 6
+	ErrorCode_TYPE_NOT_FOUND         ErrorCode = 7
+	ErrorCode_INDEX_NOT_FOUND        ErrorCode = 8
+	ErrorCode_ALREADY_EXISTS         ErrorCode = 9
+	ErrorCode_UNIQUE_VIOLATION       ErrorCode = 10
+	ErrorCode_NOT_NULL_VIOLATION     ErrorCode = 11
+	ErrorCode_FOREIGN_KEY_VIOLATION  ErrorCode = 12
+	ErrorCode_QUOTA_EXCEEDED         ErrorCode = 13
+	ErrorCode_TENANT_REQUIRED        ErrorCode = 14
+	ErrorCode_TENANT_NOT_FOUND       ErrorCode = 15
+	ErrorCode_TENANT_SUSPENDED       ErrorCode = 16
+	ErrorCode_PERMISSION_DENIED      ErrorCode = 17
+	ErrorCode_UNAUTHENTICATED        ErrorCode = 18
+	// 语句超过 STATEMENT_TIMEOUT_SECONDS 或请求的 deadline 被数据库取消
+	ErrorCode_STATEMENT_TIMEOUT ErrorCode = 19
+	// 请求超过大小限制（MAX_MESSAGE_BYTES、MAX_BULK_ITEMS、MAX_CELLS_PER_ROW、MAX_VALUE_BYTES）
+	ErrorCode_PAYLOAD_TOO_LARGE ErrorCode = 20
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "ERROR_CODE_UNSPECIFIED",
+		1:  "INTERNAL",
+		2:  "VALIDATION_FAILED",
+		3:  "NOT_FOUND",
+		4:  "TABLE_NOT_FOUND",
+		5:  "COLUMN_NOT_FOUND",
+		6:  "ROW_NOT_FOUND",
+		7:  "TYPE_NOT_FOUND",
+		8:  "INDEX_NOT_FOUND",
+		9:  "ALREADY_EXISTS",
+		10: "UNIQUE_VIOLATION",
+		11: "NOT_NULL_VIOLATION",
+		12: "FOREIGN_KEY_VIOLATION",
+		13: "QUOTA_EXCEEDED",
+		14: "TENANT_REQUIRED",
+		15: "TENANT_NOT_FOUND",
+		16: "TENANT_SUSPENDED",
+		17: "PERMISSION_DENIED",
+		18: "UNAUTHENTICATED",
+		19: "STATEMENT_TIMEOUT",
+		20: "PAYLOAD_TOO_LARGE",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED": 0,
+		"INTERNAL":               1,
+		"VALIDATION_FAILED":      2,
+		"NOT_FOUND":              3,
+		"TABLE_NOT_FOUND":        4,
+		"COLUMN_NOT_FOUND":       5,
+		"ROW_NOT_FOUND":          6,
+		"TYPE_NOT_FOUND":         7,
+		"INDEX_NOT_FOUND":        8,
+		"ALREADY_EXISTS":         9,
+		"UNIQUE_VIOLATION":       10,
+		"NOT_NULL_VIOLATION":     11,
+		"FOREIGN_KEY_VIOLATION":  12,
+		"QUOTA_EXCEEDED":         13,
+		"TENANT_REQUIRED":        14,
+		"TENANT_NOT_FOUND":       15,
+		"TENANT_SUSPENDED":       16,
+		"PERMISSION_DENIED":      17,
+		"UNAUTHENTICATED":        18,
+		"STATEMENT_TIMEOUT":      19,
+		"PAYLOAD_TOO_LARGE":      20,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[2]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{2}
+}
+
+// tenant 的生命周期状态，记录在 admin 库的 lc_tenants 中；只有 ACTIVE 的 tenant 可以访问
+type TenantState int32
+
+const (
+	TenantState_TENANT_STATE_UNSPECIFIED TenantState = 0
+	// CreateTenant 正在建库 / 迁移；失败时保持该状态，可以重试 CreateTenant
+	TenantState_TENANT_STATE_PROVISIONING TenantState = 1
+	TenantState_TENANT_STATE_ACTIVE       TenantState = 2
+	TenantState_TENANT_STATE_SUSPENDED    TenantState = 3
+	// DeleteTenant 正在删除；失败时保持该状态，可以重试 DeleteTenant
+	TenantState_TENANT_STATE_DELETING TenantState = 4
+)
+
+// Enum value maps for TenantState.
+var (
+	TenantState_name = map[int32]string{
+		0: "TENANT_STATE_UNSPECIFIED",
+		1: "TENANT_STATE_PROVISIONING",
+		2: "TENANT_STATE_ACTIVE",
+		3: "TENANT_STATE_SUSPENDED",
+		4: "TENANT_STATE_DELETING",
+	}
+	TenantState_value = map[string]int32{
+		"TENANT_STATE_UNSPECIFIED":  0,
+		"TENANT_STATE_PROVISIONING": 1,
+		"TENANT_STATE_ACTIVE":       2,
+		"TENANT_STATE_SUSPENDED":    3,
+		"TENANT_STATE_DELETING":     4,
+	}
+)
+
+func (x TenantState) Enum() *TenantState {
+	p := new(TenantState)
+	*p = x
+	return p
+}
+
+func (x TenantState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TenantState) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[3].Descriptor()
+}
+
+func (TenantState) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[3]
+}
+
+func (x TenantState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TenantState.Descriptor instead.
+func (TenantState) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{3}
+}
+
+// 备份记录在 admin 库的 lc_tenant_backups 中，tenant 删除后仍保留到超过保留期
+type BackupState int32
+
+const (
+	BackupState_BACKUP_STATE_UNSPECIFIED BackupState = 0
+	BackupState_BACKUP_STATE_RUNNING     BackupState = 1
+	BackupState_BACKUP_STATE_SUCCEEDED   BackupState = 2
+	// 失败原因见 error；进程中断遗留的 RUNNING 记录在下一次备份该 tenant 时标记为失败
+	BackupState_BACKUP_STATE_FAILED BackupState = 3
+)
+
+// Enum value maps for BackupState.
+var (
+	BackupState_name = map[int32]string{
+		0: "BACKUP_STATE_UNSPECIFIED",
+		1: "BACKUP_STATE_RUNNING",
+		2: "BACKUP_STATE_SUCCEEDED",
+		3: "BACKUP_STATE_FAILED",
+	}
+	BackupState_value = map[string]int32{
+		"BACKUP_STATE_UNSPECIFIED": 0,
+		"BACKUP_STATE_RUNNING":     1,
+		"BACKUP_STATE_SUCCEEDED":   2,
+		"BACKUP_STATE_FAILED":      3,
+	}
+)
+
+func (x BackupState) Enum() *BackupState {
+	p := new(BackupState)
+	*p = x
+	return p
+}
+
+func (x BackupState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupState) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[4].Descriptor()
+}
+
+func (BackupState) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[4]
+}
+
+func (x BackupState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupState.Descriptor instead.
+func (BackupState) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{4}
+}
+
+type BackupTrigger int32
+
+const (
+	BackupTrigger_BACKUP_TRIGGER_UNSPECIFIED BackupTrigger = 0
+	// 定期备份（BACKUP_INTERVAL_HOURS）
+	BackupTrigger_BACKUP_TRIGGER_SCHEDULED BackupTrigger = 1
+	// TriggerBackup
+	BackupTrigger_BACKUP_TRIGGER_MANUAL BackupTrigger = 2
+)
+
+// Enum value maps for BackupTrigger.
+var (
+	BackupTrigger_name = map[int32]string{
+		0: "BACKUP_TRIGGER_UNSPECIFIED",
+		1: "BACKUP_TRIGGER_SCHEDULED",
+		2: "BACKUP_TRIGGER_MANUAL",
+	}
+	BackupTrigger_value = map[string]int32{
+		"BACKUP_TRIGGER_UNSPECIFIED": 0,
+		"BACKUP_TRIGGER_SCHEDULED":   1,
+		"BACKUP_TRIGGER_MANUAL":      2,
+	}
+)
+
+func (x BackupTrigger) Enum() *BackupTrigger {
+	p := new(BackupTrigger)
+	*p = x
+	return p
+}
+
+func (x BackupTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[5].Descriptor()
+}
+
+func (BackupTrigger) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[5]
+}
+
+func (x BackupTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupTrigger.Descriptor instead.
+func (BackupTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{5}
+}
+
+// ListTables 的排序字段，相同时按表名
+type TableSort int32
+
+const (
+	// 同 TABLE_SORT_CREATED_AT
+	TableSort_TABLE_SORT_UNSPECIFIED    TableSort = 0
+	TableSort_TABLE_SORT_CREATED_AT     TableSort = 1
+	TableSort_TABLE_SORT_UPDATED_AT     TableSort = 2
+	TableSort_TABLE_SORT_NAME           TableSort = 3
+	TableSort_TABLE_SORT_ESTIMATED_ROWS TableSort = 4
+	TableSort_TABLE_SORT_TOTAL_BYTES    TableSort = 5
+)
+
+// Enum value maps for TableSort.
+var (
+	TableSort_name = map[int32]string{
+		0: "TABLE_SORT_UNSPECIFIED",
+		1: "TABLE_SORT_CREATED_AT",
+		2: "TABLE_SORT_UPDATED_AT",
+		3: "TABLE_SORT_NAME",
+		4: "TABLE_SORT_ESTIMATED_ROWS",
+		5: "TABLE_SORT_TOTAL_BYTES",
+	}
+	TableSort_value = map[string]int32{
+		"TABLE_SORT_UNSPECIFIED":    0,
+		"TABLE_SORT_CREATED_AT":     1,
+		"TABLE_SORT_UPDATED_AT":     2,
+		"TABLE_SORT_NAME":           3,
+		"TABLE_SORT_ESTIMATED_ROWS": 4,
+		"TABLE_SORT_TOTAL_BYTES":    5,
+	}
+)
+
+func (x TableSort) Enum() *TableSort {
+	p := new(TableSort)
+	*p = x
+	return p
+}
+
+func (x TableSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TableSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[6].Descriptor()
+}
+
+func (TableSort) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[6]
+}
+
+func (x TableSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TableSort.Descriptor instead.
+func (TableSort) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{6}
+}
+
+// 元数据与物理表之间的差异类型
+type DriftKind int32
+
+const (
+	DriftKind_DRIFT_KIND_UNSPECIFIED DriftKind = 0
+	// 物理表不存在
+	DriftKind_DRIFT_KIND_MISSING_TABLE DriftKind = 1
+	// lc_columns 中的列在物理表上不存在（虚拟列除外）
+	DriftKind_DRIFT_KIND_MISSING_COLUMN DriftKind = 2
+	// 物理列类型与列类型的 pg_type 不属于同一类（varchar(64) 与 text 视为一致）
+	DriftKind_DRIFT_KIND_TYPE_MISMATCH DriftKind = 3
+	// 物理列的 NOT NULL 与 is_nullable 不一致
+	DriftKind_DRIFT_KIND_NULLABILITY_MISMATCH DriftKind = 4
+	// 物理表上有未登记到 lc_columns 的列（id 和系统列除外）
+	DriftKind_DRIFT_KIND_ORPHANED_COLUMN DriftKind = 5
+	// lc_indexes 中登记的索引在物理表上不存在
+	DriftKind_DRIFT_KIND_MISSING_INDEX DriftKind = 6
+	// 物理表上有未登记到 lc_indexes 的索引（主键和约束自带的索引除外）
+	DriftKind_DRIFT_KIND_ORPHANED_INDEX DriftKind = 7
+)
+
+// Enum value maps for DriftKind.
+var (
+	DriftKind_name = map[int32]string{
+		0: "DRIFT_KIND_UNSPECIFIED",
+		1: "DRIFT_KIND_MISSING_TABLE",
+		2: "DRIFT_KIND_MISSING_COLUMN",
+		3: "DRIFT_KIND_TYPE_MISMATCH",
+		4: "DRIFT_KIND_NULLABILITY_MISMATCH",
+		5: "DRIFT_KIND_ORPHANED_COLUMN",
+		6: "DRIFT_KIND_MISSING_INDEX",
+		7: "DRIFT_KIND_ORPHANED_INDEX",
+	}
+	DriftKind_value = map[string]int32{
+		"DRIFT_KIND_UNSPECIFIED":          0,
+		"DRIFT_KIND_MISSING_TABLE":        1,
+		"DRIFT_KIND_MISSING_COLUMN":       2,
+		"DRIFT_KIND_TYPE_MISMATCH":        3,
+		"DRIFT_KIND_NULLABILITY_MISMATCH": 4,
+		"DRIFT_KIND_ORPHANED_COLUMN":      5,
+		"DRIFT_KIND_MISSING_INDEX":        6,
+		"DRIFT_KIND_ORPHANED_INDEX":       7,
+	}
+)
+
+func (x DriftKind) Enum() *DriftKind {
+	p := new(DriftKind)
+	*p = x
+	return p
+}
+
+func (x DriftKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[7].Descriptor()
+}
+
+func (DriftKind) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[7]
+}
+
+func (x DriftKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftKind.Descriptor instead.
+func (DriftKind) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{7}
+}
+
+// 修改列类型时已有数据的转换方式
+type CastStrategy int32
+
+const (
+	// 同 CAST_STRATEGY_CAST
+	CastStrategy_CAST_STRATEGY_UNSPECIFIED CastStrategy = 0
+	// 直接 col::new_type，任一值无法转换时 PG 报错，整体回滚
+	CastStrategy_CAST_STRATEGY_CAST CastStrategy = 1
+	// 无法转换的值写为 NULL；转换为 varchar(n) 等带长度的类型时截断
+	CastStrategy_CAST_STRATEGY_TRUNCATE CastStrategy = 2
+	// 先检查所有值，存在无法转换的值时返回 FailedPrecondition（附带示例行 id），不修改任何数据
+	CastStrategy_CAST_STRATEGY_FAIL_ON_ERROR CastStrategy = 3
+)
+
+// Enum value maps for CastStrategy.
+var (
+	CastStrategy_name = map[int32]string{
+		0: "CAST_STRATEGY_UNSPECIFIED",
+		1: "CAST_STRATEGY_CAST",
+		2: "CAST_STRATEGY_TRUNCATE",
+		3: "CAST_STRATEGY_FAIL_ON_ERROR",
+	}
+	CastStrategy_value = map[string]int32{
+		"CAST_STRATEGY_UNSPECIFIED":   0,
+		"CAST_STRATEGY_CAST":          1,
+		"CAST_STRATEGY_TRUNCATE":      2,
+		"CAST_STRATEGY_FAIL_ON_ERROR": 3,
+	}
+)
+
+func (x CastStrategy) Enum() *CastStrategy {
+	p := new(CastStrategy)
+	*p = x
+	return p
+}
+
+func (x CastStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CastStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[8].Descriptor()
+}
+
+func (CastStrategy) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[8]
+}
+
+func (x CastStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CastStrategy.Descriptor instead.
+func (CastStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{8}
+}
+
+type RowVersionAction int32
+
+const (
+	RowVersionAction_ROW_VERSION_ACTION_UNSPECIFIED RowVersionAction = 0
+	RowVersionAction_ROW_VERSION_ACTION_UPDATE      RowVersionAction = 1
+	RowVersionAction_ROW_VERSION_ACTION_DELETE      RowVersionAction = 2
+	// RestoreRowVersion 覆盖前的行
+	RowVersionAction_ROW_VERSION_ACTION_RESTORE RowVersionAction = 3
+)
+
+// Enum value maps for RowVersionAction.
+var (
+	RowVersionAction_name = map[int32]string{
+		0: "ROW_VERSION_ACTION_UNSPECIFIED",
+		1: "ROW_VERSION_ACTION_UPDATE",
+		2: "ROW_VERSION_ACTION_DELETE",
+		3: "ROW_VERSION_ACTION_RESTORE",
+	}
+	RowVersionAction_value = map[string]int32{
+		"ROW_VERSION_ACTION_UNSPECIFIED": 0,
+		"ROW_VERSION_ACTION_UPDATE":      1,
+		"ROW_VERSION_ACTION_DELETE":      2,
+		"ROW_VERSION_ACTION_RESTORE":     3,
+	}
+)
+
+func (x RowVersionAction) Enum() *RowVersionAction {
+	p := new(RowVersionAction)
+	*p = x
+	return p
+}
+
+func (x RowVersionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RowVersionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[9].Descriptor()
+}
+
+func (RowVersionAction) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[9]
+}
+
+func (x RowVersionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RowVersionAction.Descriptor instead.
+func (RowVersionAction) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{9}
+}
+
+// 过滤运算符；IN / NOT_IN 使用 FilterCondition.values，IS_NULL / IS_NOT_NULL 不需要值
+type FilterOperator int32
+
+const (
+	FilterOperator_FILTER_OPERATOR_UNSPECIFIED    FilterOperator = 0
+	FilterOperator_FILTER_OPERATOR_EQ             FilterOperator = 1
+	FilterOperator_FILTER_OPERATOR_NEQ            FilterOperator = 2
+	FilterOperator_FILTER_OPERATOR_LT             FilterOperator = 3
+	FilterOperator_FILTER_OPERATOR_LTE            FilterOperator = 4
+	FilterOperator_FILTER_OPERATOR_GT             FilterOperator = 5
+	FilterOperator_FILTER_OPERATOR_GTE            FilterOperator = 6
+	FilterOperator_FILTER_OPERATOR_CONTAINS       FilterOperator = 7 // text 列，不区分大小写
+	FilterOperator_FILTER_OPERATOR_STARTS_WITH    FilterOperator = 8 // text 列，不区分大小写
+	FilterOperator_FILTER_OPERATOR_IN             FilterOperator = 9
+	FilterOperator_FILTER_OPERATOR_NOT_IN         FilterOperator = 10
+	FilterOperator_FILTER_OPERATOR_IS_NULL        FilterOperator = 11
+	FilterOperator_FILTER_OPERATOR_IS_NOT_NULL    FilterOperator = 12
+	FilterOperator_FILTER_OPERATOR_WITHIN_RADIUS  FilterOperator = 13 // point / geometry 列，value 为 GeoJSON Point，半径见 radius_meters
+	FilterOperator_FILTER_OPERATOR_ARRAY_CONTAINS FilterOperator = 14 // 数组列包含 values 中的全部元素（@>）
+	FilterOperator_FILTER_OPERATOR_ARRAY_OVERLAPS FilterOperator = 15 // 数组列包含 values 中的任一元素（&&）
+)
+
+// Enum value maps for FilterOperator.
+var (
+	FilterOperator_name = map[int32]string{
+		0:  "FILTER_OPERATOR_UNSPECIFIED",
+		1:  "FILTER_OPERATOR_EQ",
+		2:  "FILTER_OPERATOR_NEQ",
+		3:  "FILTER_OPERATOR_LT",
+		4:  "FILTER_OPERATOR_LTE",
+		5:  "FILTER_OPERATOR_GT",
+		6:  "FILTER_OPERATOR_GTE",
+		7:  "FILTER_OPERATOR_CONTAINS",
+		8:  "FILTER_OPERATOR_STARTS_WITH",
+		9:  "FILTER_OPERATOR_IN",
+		10: "FILTER_OPERATOR_NOT_IN",
+		11: "FILTER_OPERATOR_IS_NULL",
+		12: "FILTER_OPERATOR_IS_NOT_NULL",
+		13: "FILTER_OPERATOR_WITHIN_RADIUS",
+		14: "FILTER_OPERATOR_ARRAY_CONTAINS",
+		15: "FILTER_OPERATOR_ARRAY_OVERLAPS",
+	}
+	FilterOperator_value = map[string]int32{
+		"FILTER_OPERATOR_UNSPECIFIED":    0,
+		"FILTER_OPERATOR_EQ":             1,
+		"FILTER_OPERATOR_NEQ":            2,
+		"FILTER_OPERATOR_LT":             3,
+		"FILTER_OPERATOR_LTE":            4,
+		"FILTER_OPERATOR_GT":             5,
+		"FILTER_OPERATOR_GTE":            6,
+		"FILTER_OPERATOR_CONTAINS":       7,
+		"FILTER_OPERATOR_STARTS_WITH":    8,
+		"FILTER_OPERATOR_IN":             9,
+		"FILTER_OPERATOR_NOT_IN":         10,
+		"FILTER_OPERATOR_IS_NULL":        11,
+		"FILTER_OPERATOR_IS_NOT_NULL":    12,
+		"FILTER_OPERATOR_WITHIN_RADIUS":  13,
+		"FILTER_OPERATOR_ARRAY_CONTAINS": 14,
+		"FILTER_OPERATOR_ARRAY_OVERLAPS": 15,
+	}
+)
+
+func (x FilterOperator) Enum() *FilterOperator {
+	p := new(FilterOperator)
+	*p = x
+	return p
+}
+
+func (x FilterOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[10].Descriptor()
+}
+
+func (FilterOperator) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[10]
+}
+
+func (x FilterOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterOperator.Descriptor instead.
+func (FilterOperator) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{10}
+}
+
+type ExportFormat int32
+
+const (
+	// 按 CSV 处理
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_XLSX        ExportFormat = 2
+	// 每行一个 JSON 对象，key 为 id 和列名，值保留 JSON 类型
+	ExportFormat_EXPORT_FORMAT_JSONL ExportFormat = 3
+	// 列类型按 pg_type 映射为 Parquet 类型，不压缩
+	ExportFormat_EXPORT_FORMAT_PARQUET ExportFormat = 4
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_XLSX",
+		3: "EXPORT_FORMAT_JSONL",
+		4: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_XLSX":        2,
+		"EXPORT_FORMAT_JSONL":       3,
+		"EXPORT_FORMAT_PARQUET":     4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[11].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[11]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{11}
+}
+
+type AggregateFunction int32
+
+const (
+	AggregateFunction_AGGREGATE_FUNCTION_UNSPECIFIED    AggregateFunction = 0
+	AggregateFunction_AGGREGATE_FUNCTION_COUNT          AggregateFunction = 1 // column_id 为空时为 COUNT(*)，否则统计非 NULL 值
+	AggregateFunction_AGGREGATE_FUNCTION_SUM            AggregateFunction = 2 // 仅数值列
+	AggregateFunction_AGGREGATE_FUNCTION_AVG            AggregateFunction = 3 // 仅数值列
+	AggregateFunction_AGGREGATE_FUNCTION_MIN            AggregateFunction = 4
+	AggregateFunction_AGGREGATE_FUNCTION_MAX            AggregateFunction = 5
+	AggregateFunction_AGGREGATE_FUNCTION_COUNT_DISTINCT AggregateFunction = 6
+)
+
+// Enum value maps for AggregateFunction.
+var (
+	AggregateFunction_name = map[int32]string{
+		0: "AGGREGATE_FUNCTION_UNSPECIFIED",
+		1: "AGGREGATE_FUNCTION_COUNT",
+		2: "AGGREGATE_FUNCTION_SUM",
+		3: "AGGREGATE_FUNCTION_AVG",
+		4: "AGGREGATE_FUNCTION_MIN",
+		5: "AGGREGATE_FUNCTION_MAX",
+		6: "AGGREGATE_FUNCTION_COUNT_DISTINCT",
+	}
+	AggregateFunction_value = map[string]int32{
+		"AGGREGATE_FUNCTION_UNSPECIFIED":    0,
+		"AGGREGATE_FUNCTION_COUNT":          1,
+		"AGGREGATE_FUNCTION_SUM":            2,
+		"AGGREGATE_FUNCTION_AVG":            3,
+		"AGGREGATE_FUNCTION_MIN":            4,
+		"AGGREGATE_FUNCTION_MAX":            5,
+		"AGGREGATE_FUNCTION_COUNT_DISTINCT": 6,
+	}
+)
+
+func (x AggregateFunction) Enum() *AggregateFunction {
+	p := new(AggregateFunction)
+	*p = x
+	return p
+}
+
+func (x AggregateFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[12].Descriptor()
+}
+
+func (AggregateFunction) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[12]
+}
+
+func (x AggregateFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateFunction.Descriptor instead.
+func (AggregateFunction) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{12}
+}
+
+type OperationStatus int32
+
+const (
+	OperationStatus_OPERATION_STATUS_UNSPECIFIED OperationStatus = 0
+	OperationStatus_OPERATION_STATUS_RUNNING     OperationStatus = 1
+	OperationStatus_OPERATION_STATUS_SUCCEEDED   OperationStatus = 2
+	OperationStatus_OPERATION_STATUS_FAILED      OperationStatus = 3
+	OperationStatus_OPERATION_STATUS_CANCELLED   OperationStatus = 4
+)
+
+// Enum value maps for OperationStatus.
+var (
+	OperationStatus_name = map[int32]string{
+		0: "OPERATION_STATUS_UNSPECIFIED",
+		1: "OPERATION_STATUS_RUNNING",
+		2: "OPERATION_STATUS_SUCCEEDED",
+		3: "OPERATION_STATUS_FAILED",
+		4: "OPERATION_STATUS_CANCELLED",
+	}
+	OperationStatus_value = map[string]int32{
+		"OPERATION_STATUS_UNSPECIFIED": 0,
+		"OPERATION_STATUS_RUNNING":     1,
+		"OPERATION_STATUS_SUCCEEDED":   2,
+		"OPERATION_STATUS_FAILED":      3,
+		"OPERATION_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x OperationStatus) Enum() *OperationStatus {
+	p := new(OperationStatus)
+	*p = x
+	return p
+}
+
+func (x OperationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[13].Descriptor()
+}
+
+func (OperationStatus) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[13]
+}
+
+func (x OperationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationStatus.Descriptor instead.
+func (OperationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{13}
+}
+
+// 租户内的角色，高的角色包含低的角色的全部权限
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	// 查询表结构和行
+	Role_ROLE_VIEWER Role = 1
+	// 写入行（包括附件、单元格内容和视图），不能修改表结构
+	Role_ROLE_EDITOR Role = 2
+	// 修改表结构：表、列、索引、类型、workspace、导入
+	Role_ROLE_BUILDER Role = 3
+	// 管理成员，查看和修改本租户的配置（连接串等）
+	Role_ROLE_OWNER Role = 4
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_VIEWER",
+		2: "ROLE_EDITOR",
+		3: "ROLE_BUILDER",
+		4: "ROLE_OWNER",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_VIEWER":      1,
+		"ROLE_EDITOR":      2,
+		"ROLE_BUILDER":     3,
+		"ROLE_OWNER":       4,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[14].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[14]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{14}
+}
+
+type PermissionAccess int32
+
+const (
+	PermissionAccess_PERMISSION_ACCESS_UNSPECIFIED PermissionAccess = 0
+	// 读取行 / 单元格，以及用于过滤、排序、聚合
+	PermissionAccess_PERMISSION_ACCESS_READ PermissionAccess = 1
+	// 写入单元格；表级规则还决定能否删除、恢复行
+	PermissionAccess_PERMISSION_ACCESS_WRITE PermissionAccess = 2
+	// 读取配置了 config.mask 的列的原值；没有时返回脱敏后的值
+	PermissionAccess_PERMISSION_ACCESS_UNMASK PermissionAccess = 3
+)
+
+// Enum value maps for PermissionAccess.
+var (
+	PermissionAccess_name = map[int32]string{
+		0: "PERMISSION_ACCESS_UNSPECIFIED",
+		1: "PERMISSION_ACCESS_READ",
+		2: "PERMISSION_ACCESS_WRITE",
+		3: "PERMISSION_ACCESS_UNMASK",
+	}
+	PermissionAccess_value = map[string]int32{
+		"PERMISSION_ACCESS_UNSPECIFIED": 0,
+		"PERMISSION_ACCESS_READ":        1,
+		"PERMISSION_ACCESS_WRITE":       2,
+		"PERMISSION_ACCESS_UNMASK":      3,
+	}
+)
+
+func (x PermissionAccess) Enum() *PermissionAccess {
+	p := new(PermissionAccess)
+	*p = x
+	return p
+}
+
+func (x PermissionAccess) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionAccess) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[15].Descriptor()
+}
+
+func (PermissionAccess) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[15]
+}
+
+func (x PermissionAccess) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionAccess.Descriptor instead.
+func (PermissionAccess) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{15}
+}
+
+type PermissionEffect int32
+
+const (
+	PermissionEffect_PERMISSION_EFFECT_UNSPECIFIED PermissionEffect = 0
+	PermissionEffect_PERMISSION_EFFECT_ALLOW       PermissionEffect = 1
+	PermissionEffect_PERMISSION_EFFECT_DENY        PermissionEffect = 2
+)
+
+// Enum value maps for PermissionEffect.
+var (
+	PermissionEffect_name = map[int32]string{
+		0: "PERMISSION_EFFECT_UNSPECIFIED",
+		1: "PERMISSION_EFFECT_ALLOW",
+		2: "PERMISSION_EFFECT_DENY",
+	}
+	PermissionEffect_value = map[string]int32{
+		"PERMISSION_EFFECT_UNSPECIFIED": 0,
+		"PERMISSION_EFFECT_ALLOW":       1,
+		"PERMISSION_EFFECT_DENY":        2,
+	}
+)
+
+func (x PermissionEffect) Enum() *PermissionEffect {
+	p := new(PermissionEffect)
+	*p = x
+	return p
+}
+
+func (x PermissionEffect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionEffect) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[16].Descriptor()
+}
+
+func (PermissionEffect) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[16]
+}
+
+func (x PermissionEffect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionEffect.Descriptor instead.
+func (PermissionEffect) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{16}
+}
+
+type FilterGroup_Combinator int32
+
+const (
+	FilterGroup_COMBINATOR_UNSPECIFIED FilterGroup_Combinator = 0 // 按 AND 处理
+	FilterGroup_AND                    FilterGroup_Combinator = 1
+	FilterGroup_OR                     FilterGroup_Combinator = 2
+)
+
+// Enum value maps for FilterGroup_Combinator.
+var (
+	FilterGroup_Combinator_name = map[int32]string{
+		0: "COMBINATOR_UNSPECIFIED",
+		1: "AND",
+		2: "OR",
+	}
+	FilterGroup_Combinator_value = map[string]int32{
+		"COMBINATOR_UNSPECIFIED": 0,
+		"AND":                    1,
+		"OR":                     2,
+	}
+)
+
+func (x FilterGroup_Combinator) Enum() *FilterGroup_Combinator {
+	p := new(FilterGroup_Combinator)
+	*p = x
+	return p
+}
+
+func (x FilterGroup_Combinator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterGroup_Combinator) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[17].Descriptor()
+}
+
+func (FilterGroup_Combinator) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[17]
+}
+
+func (x FilterGroup_Combinator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterGroup_Combinator.Descriptor instead.
+func (FilterGroup_Combinator) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{163, 0}
+}
+
+type SortSpec_Direction int32
+
+const (
+	SortSpec_DIRECTION_UNSPECIFIED SortSpec_Direction = 0 // 按 ASC 处理
+	SortSpec_ASC                   SortSpec_Direction = 1
+	SortSpec_DESC                  SortSpec_Direction = 2
+)
+
+// Enum value maps for SortSpec_Direction.
+var (
+	SortSpec_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "ASC",
+		2: "DESC",
+	}
+	SortSpec_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"ASC":                   1,
+		"DESC":                  2,
+	}
+)
+
+func (x SortSpec_Direction) Enum() *SortSpec_Direction {
+	p := new(SortSpec_Direction)
+	*p = x
+	return p
+}
+
+func (x SortSpec_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortSpec_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[18].Descriptor()
+}
+
+func (SortSpec_Direction) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[18]
+}
+
+func (x SortSpec_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortSpec_Direction.Descriptor instead.
+func (SortSpec_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{165, 0}
+}
+
+type SortSpec_Nulls int32
+
+const (
+	SortSpec_NULLS_UNSPECIFIED SortSpec_Nulls = 0 // PG 默认：ASC 时 NULL 在后，DESC 时 NULL 在前
+	SortSpec_NULLS_FIRST       SortSpec_Nulls = 1
+	SortSpec_NULLS_LAST        SortSpec_Nulls = 2
+)
+
+// Enum value maps for SortSpec_Nulls.
+var (
+	SortSpec_Nulls_name = map[int32]string{
+		0: "NULLS_UNSPECIFIED",
+		1: "NULLS_FIRST",
+		2: "NULLS_LAST",
+	}
+	SortSpec_Nulls_value = map[string]int32{
+		"NULLS_UNSPECIFIED": 0,
+		"NULLS_FIRST":       1,
+		"NULLS_LAST":        2,
+	}
+)
+
+func (x SortSpec_Nulls) Enum() *SortSpec_Nulls {
+	p := new(SortSpec_Nulls)
+	*p = x
+	return p
+}
+
+func (x SortSpec_Nulls) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortSpec_Nulls) Descriptor() protoreflect.EnumDescriptor {
+	return file_lowcode_v1_lowcode_service_proto_enumTypes[19].Descriptor()
+}
+
+func (SortSpec_Nulls) Type() protoreflect.EnumType {
+	return &file_lowcode_v1_lowcode_service_proto_enumTypes[19]
+}
+
+func (x SortSpec_Nulls) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortSpec_Nulls.Descriptor instead.
+func (SortSpec_Nulls) EnumDescriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{165, 1}
+}
+
 // 基础类型定义，用于列类型（text/number/json 等）
 type Type struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PgType    string                 `protobuf:"bytes,3,opt,name=pg_type,json=pgType,proto3" json:"pg_type,omitempty"`
+	Config    *structpb.Struct       `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 每次修改 pg_type / config 递增，历史定义保存在 lc_type_versions
+	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// 内置类型由服务代码定义，只读，不能删除、修改或被同名类型覆盖
+	Builtin       bool `protobuf:"varint,8,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Type) Reset() {
+	*x = Type{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Type) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Type) ProtoMessage() {}
+
+func (x *Type) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Type.ProtoReflect.Descriptor instead.
+func (*Type) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Type) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Type) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Type) GetPgType() string {
+	if x != nil {
+		return x.PgType
+	}
+	return ""
+}
+
+func (x *Type) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Type) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Type) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Type) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Type) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+type Table struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SchemaName string                 `protobuf:"bytes,3,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName  string                 `protobuf:"bytes,4,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 非空表示物理表是分区表
+	Partition   *PartitionSpec `protobuf:"bytes,7,opt,name=partition,proto3" json:"partition,omitempty"`
+	Description string         `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Icon        string         `protobuf:"bytes,9,opt,name=icon,proto3" json:"icon,omitempty"`
+	// 非空表示已归档，id 为归档名
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// 归档前的表名
+	OriginalName string `protobuf:"bytes,11,opt,name=original_name,json=originalName,proto3" json:"original_name,omitempty"`
+	// 仅 ListTables / GetTable 返回
+	Stats *TableStats `protobuf:"bytes,12,opt,name=stats,proto3" json:"stats,omitempty"`
+	// 所属 workspace，为空表示未分组
+	WorkspaceId string `protobuf:"bytes,13,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// 非空表示这是 CreateSQLView 创建的只读表
+	SqlView       *SQLViewSpec `protobuf:"bytes,14,opt,name=sql_view,json=sqlView,proto3" json:"sql_view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Table) Reset() {
+	*x = Table{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table) ProtoMessage() {}
+
+func (x *Table) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table.ProtoReflect.Descriptor instead.
+func (*Table) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Table) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Table) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Table) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *Table) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *Table) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Table) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Table) GetPartition() *PartitionSpec {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+func (x *Table) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Table) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Table) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Table) GetOriginalName() string {
+	if x != nil {
+		return x.OriginalName
+	}
+	return ""
+}
+
+func (x *Table) GetStats() *TableStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Table) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Table) GetSqlView() *SQLViewSpec {
+	if x != nil {
+		return x.SqlView
+	}
+	return nil
+}
+
+// SQL 视图的定义
+type SQLViewSpec struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Sql          string                 `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	Materialized bool                   `protobuf:"varint,2,opt,name=materialized,proto3" json:"materialized,omitempty"`
+	// 物化视图最近一次创建或刷新的时间
+	RefreshedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SQLViewSpec) Reset() {
+	*x = SQLViewSpec{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SQLViewSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SQLViewSpec) ProtoMessage() {}
+
+func (x *SQLViewSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SQLViewSpec.ProtoReflect.Descriptor instead.
+func (*SQLViewSpec) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SQLViewSpec) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *SQLViewSpec) GetMaterialized() bool {
+	if x != nil {
+		return x.Materialized
+	}
+	return false
+}
+
+func (x *SQLViewSpec) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+// workspace：把表分组管理的目录，只是元数据，不影响物理表
+type Workspace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workspace) Reset() {
+	*x = Workspace{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workspace) ProtoMessage() {}
+
+func (x *Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
+func (*Workspace) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Workspace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Workspace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Workspace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Workspace) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Workspace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 来自 pg_class 统计信息的估算值（ANALYZE / autovacuum 后更新），分区表为各分区之和
+type TableStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EstimatedRows int64                  `protobuf:"varint,1,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	// 含索引和 TOAST 的总大小
+	TotalBytes    int64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableStats) Reset() {
+	*x = TableStats{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableStats) ProtoMessage() {}
+
+func (x *TableStats) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableStats.ProtoReflect.Descriptor instead.
+func (*TableStats) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TableStats) GetEstimatedRows() int64 {
+	if x != nil {
+		return x.EstimatedRows
+	}
+	return 0
+}
+
+func (x *TableStats) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+// 物理表分区配置：timestamp 类列按时间范围（RANGE）分区，其它列按值（LIST）分区。
+// 分区列在建表时一并创建（NOT NULL，与 id 组成主键），超出已建分区的行落入 default 分区。
+type PartitionSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分区列 id；CreateTable 时为输出，UpdateTable 时指定已有的列（可以是 created_at 等系统列）
+	ColumnId string `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	// 建表时创建的分区列名
+	ColumnName string `protobuf:"bytes,2,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	// 分区列类型，默认 timestamp；UpdateTable 时忽略，取已有列的类型
+	TypeId string `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// range | list（输出）
+	Strategy string `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// RANGE 分区粒度：day | week | month，默认 month
+	Interval string `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// RANGE 分区提前创建的未来分区数，默认 3；后台任务会持续补齐
+	Premake int32 `protobuf:"varint,6,opt,name=premake,proto3" json:"premake,omitempty"`
+	// LIST 分区：预先创建分区的取值
+	Values        []string `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartitionSpec) Reset() {
+	*x = PartitionSpec{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartitionSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionSpec) ProtoMessage() {}
+
+func (x *PartitionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionSpec.ProtoReflect.Descriptor instead.
+func (*PartitionSpec) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PartitionSpec) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *PartitionSpec) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *PartitionSpec) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+func (x *PartitionSpec) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *PartitionSpec) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *PartitionSpec) GetPremake() int32 {
+	if x != nil {
+		return x.Premake
+	}
+	return 0
+}
+
+func (x *PartitionSpec) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Column struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TableId    string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TypeId     string                 `protobuf:"bytes,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	PgColumn   string                 `protobuf:"bytes,5,opt,name=pg_column,json=pgColumn,proto3" json:"pg_column,omitempty"`
+	IsNullable bool                   `protobuf:"varint,6,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
+	Position   int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	Config     *structpb.Struct       `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 隐藏列：ListRows / GetRow / StreamRows 默认不返回该列的单元格
+	IsHidden bool `protobuf:"varint,11,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	// 只读列：行接口拒绝写入
+	IsReadonly bool `protobuf:"varint,12,opt,name=is_readonly,json=isReadonly,proto3" json:"is_readonly,omitempty"`
+	// 列说明
+	Description string `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	// 表单等界面中显示的标题，为空时使用 name
+	Label string `protobuf:"bytes,14,opt,name=label,proto3" json:"label,omitempty"`
+	// 界面提示（如 placeholder、help_text、width、widget），服务端只保存不解释
+	UiHints       *structpb.Struct `protobuf:"bytes,15,opt,name=ui_hints,json=uiHints,proto3" json:"ui_hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Column) Reset() {
+	*x = Column{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Column) ProtoMessage() {}
+
+func (x *Column) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Column.ProtoReflect.Descriptor instead.
+func (*Column) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Column) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Column) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *Column) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Column) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+func (x *Column) GetPgColumn() string {
+	if x != nil {
+		return x.PgColumn
+	}
+	return ""
+}
+
+func (x *Column) GetIsNullable() bool {
+	if x != nil {
+		return x.IsNullable
+	}
+	return false
+}
+
+func (x *Column) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Column) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Column) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Column) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Column) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *Column) GetIsReadonly() bool {
+	if x != nil {
+		return x.IsReadonly
+	}
+	return false
+}
+
+func (x *Column) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Column) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Column) GetUiHints() *structpb.Struct {
+	if x != nil {
+		return x.UiHints
+	}
+	return nil
+}
+
+type Index struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TableId   string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PgIndex   string                 `protobuf:"bytes,4,opt,name=pg_index,json=pgIndex,proto3" json:"pg_index,omitempty"`
+	ColumnIds []string               `protobuf:"bytes,5,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	IsUnique  bool                   `protobuf:"varint,6,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 非空表示表达式索引，column_ids 为表达式引用的列
+	Expression *IndexExpression `protobuf:"bytes,9,opt,name=expression,proto3" json:"expression,omitempty"`
+	// 非空表示部分索引，只包含满足条件的行
+	Where *RowFilter `protobuf:"bytes,10,opt,name=where,proto3" json:"where,omitempty"`
+	// 索引方法；早期创建、未记录方法的索引为 UNSPECIFIED
+	IndexMethod IndexMethod `protobuf:"varint,11,opt,name=index_method,json=indexMethod,proto3,enum=lowcode.v1.IndexMethod" json:"index_method,omitempty"`
+	// 由 SyncIndexes 登记的外部索引：不能 rebuild，DeleteIndex 只删除登记、保留物理索引
+	External bool `protobuf:"varint,12,opt,name=external,proto3" json:"external,omitempty"`
+	// 外部索引的定义（pg_get_indexdef）
+	Definition    string `protobuf:"bytes,13,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Index) Reset() {
+	*x = Index{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Index) ProtoMessage() {}
+
+func (x *Index) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Index.ProtoReflect.Descriptor instead.
+func (*Index) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Index) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Index) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *Index) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Index) GetPgIndex() string {
+	if x != nil {
+		return x.PgIndex
+	}
+	return ""
+}
+
+func (x *Index) GetColumnIds() []string {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+func (x *Index) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *Index) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Index) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Index) GetExpression() *IndexExpression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *Index) GetWhere() *RowFilter {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+func (x *Index) GetIndexMethod() IndexMethod {
+	if x != nil {
+		return x.IndexMethod
+	}
+	return IndexMethod_INDEX_METHOD_UNSPECIFIED
+}
+
+func (x *Index) GetExternal() bool {
+	if x != nil {
+		return x.External
+	}
+	return false
+}
+
+func (x *Index) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+// 表达式索引的键：对一列应用函数
+type IndexExpression struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Function IndexFunction          `protobuf:"varint,2,opt,name=function,proto3,enum=lowcode.v1.IndexFunction" json:"function,omitempty"`
+	// INDEX_FUNCTION_JSON_FIELD 时取的顶层字段名
+	JsonKey       string `protobuf:"bytes,3,opt,name=json_key,json=jsonKey,proto3" json:"json_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexExpression) Reset() {
+	*x = IndexExpression{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexExpression) ProtoMessage() {}
+
+func (x *IndexExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexExpression.ProtoReflect.Descriptor instead.
+func (*IndexExpression) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IndexExpression) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *IndexExpression) GetFunction() IndexFunction {
+	if x != nil {
+		return x.Function
+	}
+	return IndexFunction_INDEX_FUNCTION_UNSPECIFIED
+}
+
+func (x *IndexExpression) GetJsonKey() string {
+	if x != nil {
+		return x.JsonKey
+	}
+	return ""
+}
+
+// 保存的视图
+type View struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TableId string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 表内唯一
+	Name   string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Filter *RowFilter  `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sorts  []*SortSpec `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// 返回的列，为空表示全部列
+	VisibleColumnIds []string `protobuf:"bytes,6,rep,name=visible_column_ids,json=visibleColumnIds,proto3" json:"visible_column_ids,omitempty"`
+	// 为 0 时使用 ListRows 的默认值
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *View) Reset() {
+	*x = View{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *View) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*View) ProtoMessage() {}
+
+func (x *View) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use View.ProtoReflect.Descriptor instead.
+func (*View) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *View) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *View) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *View) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *View) GetFilter() *RowFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *View) GetSorts() []*SortSpec {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *View) GetVisibleColumnIds() []string {
+	if x != nil {
+		return x.VisibleColumnIds
+	}
+	return nil
+}
+
+func (x *View) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *View) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *View) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 通用值类型
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Value_StringValue
+	//	*Value_NumberValue
+	//	*Value_BoolValue
+	//	*Value_TimestampValue
+	//	*Value_BytesValue
+	//	*Value_JsonValue
+	//	*Value_NullValue
+	//	*Value_ListValue
+	Kind          isValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Value) GetKind() isValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetNumberValue() float64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_NumberValue); ok {
+			return x.NumberValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *Value) GetTimestampValue() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_TimestampValue); ok {
+			return x.TimestampValue
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetBytesValue() []byte {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BytesValue); ok {
+			return x.BytesValue
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetJsonValue() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_JsonValue); ok {
+			return x.JsonValue
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetNullValue() structpb.NullValue {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_NullValue); ok {
+			return x.NullValue
+		}
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *Value) GetListValue() *ValueList {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_ListValue); ok {
+			return x.ListValue
+		}
+	}
+	return nil
+}
+
+type isValue_Kind interface {
+	isValue_Kind()
+}
+
+type Value_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,2,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type Value_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Value_TimestampValue struct {
+	TimestampValue *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp_value,json=timestampValue,proto3,oneof"`
+}
+
+type Value_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type Value_JsonValue struct {
+	JsonValue *structpb.Struct `protobuf:"bytes,6,opt,name=json_value,json=jsonValue,proto3,oneof"`
+}
+
+type Value_NullValue struct {
+	// 显式写入 NULL（清空单元格）
+	NullValue structpb.NullValue `protobuf:"varint,7,opt,name=null_value,json=nullValue,proto3,enum=google.protobuf.NullValue,oneof"`
+}
+
+type Value_ListValue struct {
+	// 数组列（如 numeric[]）或顶层为数组的 JSON 值
+	ListValue *ValueList `protobuf:"bytes,8,opt,name=list_value,json=listValue,proto3,oneof"`
+}
+
+func (*Value_StringValue) isValue_Kind() {}
+
+func (*Value_NumberValue) isValue_Kind() {}
+
+func (*Value_BoolValue) isValue_Kind() {}
+
+func (*Value_TimestampValue) isValue_Kind() {}
+
+func (*Value_BytesValue) isValue_Kind() {}
+
+func (*Value_JsonValue) isValue_Kind() {}
+
+func (*Value_NullValue) isValue_Kind() {}
+
+func (*Value_ListValue) isValue_Kind() {}
+
+type ValueList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*Value               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueList) Reset() {
+	*x = ValueList{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueList) ProtoMessage() {}
+
+func (x *ValueList) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueList.ProtoReflect.Descriptor instead.
+func (*ValueList) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValueList) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 一行数据，cells 的 key = column_id
+// 当 ListRows 指定了 expand_column_ids 时，对应 relationship 列的 cell 值为 json_value：{ "rows": [ { "id", "cells" }, ... ] }，一对多为多项，一对一为一项
+type Row struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cells         map[string]*Value      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Row) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Row) GetCells() map[string]*Value {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// -------- Tenant --------
+type CreateTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新 tenant 的唯一标识，用于拼接数据库名（由后端配置决定具体格式）
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 专属的连接串（如独立的 Postgres 实例），为空时按 TENANT_DSN_TEMPLATE 生成。
+	// 以 KMS 加密后保存在注册表中，需要配置 KMS_PROVIDER
+	Dsn string `protobuf:"bytes,2,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// 新库中预先建立的表和初始行；未设置时使用 TENANT_BOOTSTRAP_FILE 中的默认内容
+	Bootstrap *TenantBootstrap `protobuf:"bytes,3,opt,name=bootstrap,proto3" json:"bootstrap,omitempty"`
+	// 建一个空库，不使用默认内容
+	SkipBootstrap bool `protobuf:"varint,4,opt,name=skip_bootstrap,json=skipBootstrap,proto3" json:"skip_bootstrap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetDsn() string {
+	if x != nil {
+		return x.Dsn
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetBootstrap() *TenantBootstrap {
+	if x != nil {
+		return x.Bootstrap
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetSkipBootstrap() bool {
+	if x != nil {
+		return x.SkipBootstrap
+	}
+	return false
+}
+
+// CreateTenant 在 tenant 变为 active 之前写入新库的内容，全部在一个事务中完成。
+// 依次导入 schema、按模板建表、写入 rows
+type TenantBootstrap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 同 ImportSchema 的文档
+	Schema *SchemaBundle `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// 按内置模板建表（见 ListTemplates），表名为模板 id
+	TemplateIds []string `protobuf:"bytes,2,rep,name=template_ids,json=templateIds,proto3" json:"template_ids,omitempty"`
+	// 不写入模板的示例行
+	SkipSampleRows bool              `protobuf:"varint,3,opt,name=skip_sample_rows,json=skipSampleRows,proto3" json:"skip_sample_rows,omitempty"`
+	Rows           []*TenantSeedRows `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TenantBootstrap) Reset() {
+	*x = TenantBootstrap{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantBootstrap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantBootstrap) ProtoMessage() {}
+
+func (x *TenantBootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantBootstrap.ProtoReflect.Descriptor instead.
+func (*TenantBootstrap) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TenantBootstrap) GetSchema() *SchemaBundle {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *TenantBootstrap) GetTemplateIds() []string {
+	if x != nil {
+		return x.TemplateIds
+	}
+	return nil
+}
+
+func (x *TenantBootstrap) GetSkipSampleRows() bool {
+	if x != nil {
+		return x.SkipSampleRows
+	}
+	return false
+}
+
+func (x *TenantBootstrap) GetRows() []*TenantSeedRows {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// 写入一张表的初始行
+type TenantSeedRows struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Table string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// 每行以列名为 key
+	Rows          []*structpb.Struct `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantSeedRows) Reset() {
+	*x = TenantSeedRows{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSeedRows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSeedRows) ProtoMessage() {}
+
+func (x *TenantSeedRows) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSeedRows.ProtoReflect.Descriptor instead.
+func (*TenantSeedRows) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TenantSeedRows) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *TenantSeedRows) GetRows() []*structpb.Struct {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateTenantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Tenant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 数据库大小（pg_database_size）
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// 当前连接数（pg_stat_activity，含其它服务实例）
+	Connections int32 `protobuf:"varint,3,opt,name=connections,proto3" json:"connections,omitempty"`
+	// 数据库是否允许连接（pg_database.datallowconn）
+	AllowConnections bool `protobuf:"varint,4,opt,name=allow_connections,json=allowConnections,proto3" json:"allow_connections,omitempty"`
+	// 本服务实例是否已为其打开连接池
+	PoolOpen bool        `protobuf:"varint,5,opt,name=pool_open,json=poolOpen,proto3" json:"pool_open,omitempty"`
+	State    TenantState `protobuf:"varint,6,opt,name=state,proto3,enum=lowcode.v1.TenantState" json:"state,omitempty"`
+	// SuspendTenant 时给出的原因
+	StateReason string                 `protobuf:"bytes,7,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 每个服务实例为该 tenant 打开的最大连接数，0 表示使用 TENANT_POOL_MAX_CONNS
+	MaxConns int32 `protobuf:"varint,10,opt,name=max_conns,json=maxConns,proto3" json:"max_conns,omitempty"`
+	// 使用专属连接串时为其 host:port/database（不含凭据），否则为空。
+	// 此时 size_bytes / connections / allow_connections 不可用，为零值
+	DsnHost string `protobuf:"bytes,11,opt,name=dsn_host,json=dsnHost,proto3" json:"dsn_host,omitempty"`
+	// 专属只读副本的 host:port/database（不含凭据），使用 READ_REPLICA_DSN 或没有副本时为空
+	ReplicaHost   string `protobuf:"bytes,12,opt,name=replica_host,json=replicaHost,proto3" json:"replica_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Tenant) GetConnections() int32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *Tenant) GetAllowConnections() bool {
+	if x != nil {
+		return x.AllowConnections
+	}
+	return false
+}
+
+func (x *Tenant) GetPoolOpen() bool {
+	if x != nil {
+		return x.PoolOpen
+	}
+	return false
+}
+
+func (x *Tenant) GetState() TenantState {
+	if x != nil {
+		return x.State
+	}
+	return TenantState_TENANT_STATE_UNSPECIFIED
+}
+
+func (x *Tenant) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetMaxConns() int32 {
+	if x != nil {
+		return x.MaxConns
+	}
+	return 0
+}
+
+func (x *Tenant) GetDsnHost() string {
+	if x != nil {
+		return x.DsnHost
+	}
+	return ""
+}
+
+func (x *Tenant) GetReplicaHost() string {
+	if x != nil {
+		return x.ReplicaHost
+	}
+	return ""
+}
+
+type ListTenantsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只返回该状态的 tenant
+	State         TenantState `protobuf:"varint,1,opt,name=state,proto3,enum=lowcode.v1.TenantState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTenantsRequest) GetState() TenantState {
+	if x != nil {
+		return x.State
+	}
+	return TenantState_TENANT_STATE_UNSPECIFIED
+}
+
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantResponse) ProtoMessage() {}
+
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type DeleteTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 必须与 id 相同，防止误删
+	Confirm       string `protobuf:"bytes,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetConfirm() string {
+	if x != nil {
+		return x.Confirm
+	}
+	return ""
+}
+
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{23}
+}
+
+type CloneTenantRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SourceId string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// 新 tenant 的 id，不能已存在
+	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// 为 false 时只复制表结构和元数据，清空数据表
+	IncludeData bool `protobuf:"varint,3,opt,name=include_data,json=includeData,proto3" json:"include_data,omitempty"`
+	// 复制期间源库不能有其它连接。为 true 时暂时禁止连接并断开源库的全部会话（进行中的请求会失败），
+	// 否则源库在其它实例上有连接时返回 FailedPrecondition
+	DisconnectSource bool `protobuf:"varint,4,opt,name=disconnect_source,json=disconnectSource,proto3" json:"disconnect_source,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CloneTenantRequest) Reset() {
+	*x = CloneTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTenantRequest) ProtoMessage() {}
+
+func (x *CloneTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTenantRequest.ProtoReflect.Descriptor instead.
+func (*CloneTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CloneTenantRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CloneTenantRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *CloneTenantRequest) GetIncludeData() bool {
+	if x != nil {
+		return x.IncludeData
+	}
+	return false
+}
+
+func (x *CloneTenantRequest) GetDisconnectSource() bool {
+	if x != nil {
+		return x.DisconnectSource
+	}
+	return false
+}
+
+type CloneTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneTenantResponse) Reset() {
+	*x = CloneTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTenantResponse) ProtoMessage() {}
+
+func (x *CloneTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTenantResponse.ProtoReflect.Descriptor instead.
+func (*CloneTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CloneTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// ExportTenant 生成的归档的概要
+type TenantArchiveInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 导出的 tenant
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 归档格式版本，当前为 1
+	Version    int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ExportedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	// 归档字节数
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// 写入对象存储时的 key
+	StorageKey    string `protobuf:"bytes,5,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
+	TableCount    int32  `protobuf:"varint,6,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	RowCount      int64  `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantArchiveInfo) Reset() {
+	*x = TenantArchiveInfo{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantArchiveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantArchiveInfo) ProtoMessage() {}
+
+func (x *TenantArchiveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantArchiveInfo.ProtoReflect.Descriptor instead.
+func (*TenantArchiveInfo) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TenantArchiveInfo) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantArchiveInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TenantArchiveInfo) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *TenantArchiveInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TenantArchiveInfo) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *TenantArchiveInfo) GetTableCount() int32 {
+	if x != nil {
+		return x.TableCount
+	}
+	return 0
+}
+
+func (x *TenantArchiveInfo) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+type ExportTenantRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 写入对象存储（STORAGE_PROVIDER）而不是返回给客户端
+	ToStorage     bool `protobuf:"varint,2,opt,name=to_storage,json=toStorage,proto3" json:"to_storage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTenantRequest) Reset() {
+	*x = ExportTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantRequest) ProtoMessage() {}
+
+func (x *ExportTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantRequest.ProtoReflect.Descriptor instead.
+func (*ExportTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ExportTenantRequest) GetToStorage() bool {
+	if x != nil {
+		return x.ToStorage
+	}
+	return false
+}
+
+type ExportTenantResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExportTenantResponse_Info
+	//	*ExportTenantResponse_Chunk
+	Payload       isExportTenantResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTenantResponse) Reset() {
+	*x = ExportTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantResponse) ProtoMessage() {}
+
+func (x *ExportTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantResponse.ProtoReflect.Descriptor instead.
+func (*ExportTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportTenantResponse) GetPayload() isExportTenantResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExportTenantResponse) GetInfo() *TenantArchiveInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportTenantResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *ExportTenantResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportTenantResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isExportTenantResponse_Payload interface {
+	isExportTenantResponse_Payload()
+}
+
+type ExportTenantResponse_Info struct {
+	Info *TenantArchiveInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type ExportTenantResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ExportTenantResponse_Info) isExportTenantResponse_Payload() {}
+
+func (*ExportTenantResponse_Chunk) isExportTenantResponse_Payload() {}
+
+type ImportTenantInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新 tenant 的 id，不能已存在
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 从对象存储中读取的归档 key（ExportTenant 返回的 storage_key）
+	StorageKey    string `protobuf:"bytes,2,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTenantInfo) Reset() {
+	*x = ImportTenantInfo{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantInfo) ProtoMessage() {}
+
+func (x *ImportTenantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantInfo.ProtoReflect.Descriptor instead.
+func (*ImportTenantInfo) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportTenantInfo) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ImportTenantInfo) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+type ImportTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportTenantRequest_Info
+	//	*ImportTenantRequest_Chunk
+	Payload       isImportTenantRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTenantRequest) Reset() {
+	*x = ImportTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantRequest) ProtoMessage() {}
+
+func (x *ImportTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantRequest.ProtoReflect.Descriptor instead.
+func (*ImportTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ImportTenantRequest) GetPayload() isImportTenantRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportTenantRequest) GetInfo() *ImportTenantInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTenantRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *ImportTenantRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTenantRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportTenantRequest_Payload interface {
+	isImportTenantRequest_Payload()
+}
+
+type ImportTenantRequest_Info struct {
+	Info *ImportTenantInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type ImportTenantRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportTenantRequest_Info) isImportTenantRequest_Payload() {}
+
+func (*ImportTenantRequest_Chunk) isImportTenantRequest_Payload() {}
+
+type ImportTenantResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tenant *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// 归档中的源 tenant
+	SourceTenantId string `protobuf:"bytes,2,opt,name=source_tenant_id,json=sourceTenantId,proto3" json:"source_tenant_id,omitempty"`
+	TableCount     int32  `protobuf:"varint,3,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	RowCount       int64  `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportTenantResponse) Reset() {
+	*x = ImportTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantResponse) ProtoMessage() {}
+
+func (x *ImportTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantResponse.ProtoReflect.Descriptor instead.
+func (*ImportTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *ImportTenantResponse) GetSourceTenantId() string {
+	if x != nil {
+		return x.SourceTenantId
+	}
+	return ""
+}
+
+func (x *ImportTenantResponse) GetTableCount() int32 {
+	if x != nil {
+		return x.TableCount
+	}
+	return 0
+}
+
+func (x *ImportTenantResponse) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+type MigrateAllTenantsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只迁移这些 tenant；为空时迁移全部 active / suspended 的 tenant
+	TenantIds []string `protobuf:"bytes,1,rep,name=tenant_ids,json=tenantIds,proto3" json:"tenant_ids,omitempty"`
+	// 同时迁移的 tenant 数，默认 4
+	Concurrency   int32 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateAllTenantsRequest) Reset() {
+	*x = MigrateAllTenantsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateAllTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateAllTenantsRequest) ProtoMessage() {}
+
+func (x *MigrateAllTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateAllTenantsRequest.ProtoReflect.Descriptor instead.
+func (*MigrateAllTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MigrateAllTenantsRequest) GetTenantIds() []string {
+	if x != nil {
+		return x.TenantIds
+	}
+	return nil
+}
+
+func (x *MigrateAllTenantsRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type TenantMigrationResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TenantId    string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FromVersion int32                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   int32                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// 迁移失败时的错误，成功时为空
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantMigrationResult) Reset() {
+	*x = TenantMigrationResult{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantMigrationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantMigrationResult) ProtoMessage() {}
+
+func (x *TenantMigrationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantMigrationResult.ProtoReflect.Descriptor instead.
+func (*TenantMigrationResult) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TenantMigrationResult) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantMigrationResult) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *TenantMigrationResult) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *TenantMigrationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MigrateAllTenantsResponse struct {
+	state   protoimpl.MessageState   `protogen:"open.v1"`
+	Results []*TenantMigrationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// 最新迁移版本
+	LatestVersion int32 `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateAllTenantsResponse) Reset() {
+	*x = MigrateAllTenantsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateAllTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateAllTenantsResponse) ProtoMessage() {}
+
+func (x *MigrateAllTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateAllTenantsResponse.ProtoReflect.Descriptor instead.
+func (*MigrateAllTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MigrateAllTenantsResponse) GetResults() []*TenantMigrationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *MigrateAllTenantsResponse) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+type TenantBackup struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	State    BackupState            `protobuf:"varint,3,opt,name=state,proto3,enum=lowcode.v1.BackupState" json:"state,omitempty"`
+	Trigger  BackupTrigger          `protobuf:"varint,4,opt,name=trigger,proto3,enum=lowcode.v1.BackupTrigger" json:"trigger,omitempty"`
+	// 归档在对象存储中的 key，可以作为 ImportTenant 的 storage_key 恢复；成功前为空
+	StorageKey    string                 `protobuf:"bytes,5,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	TableCount    int32                  `protobuf:"varint,7,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	RowCount      int64                  `protobuf:"varint,8,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantBackup) Reset() {
+	*x = TenantBackup{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantBackup) ProtoMessage() {}
+
+func (x *TenantBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantBackup.ProtoReflect.Descriptor instead.
+func (*TenantBackup) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TenantBackup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantBackup) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantBackup) GetState() BackupState {
+	if x != nil {
+		return x.State
+	}
+	return BackupState_BACKUP_STATE_UNSPECIFIED
+}
+
+func (x *TenantBackup) GetTrigger() BackupTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return BackupTrigger_BACKUP_TRIGGER_UNSPECIFIED
+}
+
+func (x *TenantBackup) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *TenantBackup) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *TenantBackup) GetTableCount() int32 {
+	if x != nil {
+		return x.TableCount
+	}
+	return 0
+}
+
+func (x *TenantBackup) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *TenantBackup) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TenantBackup) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TenantBackup) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type TriggerBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerBackupRequest) Reset() {
+	*x = TriggerBackupRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerBackupRequest) ProtoMessage() {}
+
+func (x *TriggerBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerBackupRequest.ProtoReflect.Descriptor instead.
+func (*TriggerBackupRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TriggerBackupRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type TriggerBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backup        *TenantBackup          `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerBackupResponse) Reset() {
+	*x = TriggerBackupResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerBackupResponse) ProtoMessage() {}
+
+func (x *TriggerBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerBackupResponse.ProtoReflect.Descriptor instead.
+func (*TriggerBackupResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TriggerBackupResponse) GetBackup() *TenantBackup {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+type ListBackupsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// 只返回该状态的备份
+	State BackupState `protobuf:"varint,2,opt,name=state,proto3,enum=lowcode.v1.BackupState" json:"state,omitempty"`
+	// 默认 50，最大 500
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackupsRequest) Reset() {
+	*x = ListBackupsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackupsRequest) ProtoMessage() {}
+
+func (x *ListBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListBackupsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListBackupsRequest) GetState() BackupState {
+	if x != nil {
+		return x.State
+	}
+	return BackupState_BACKUP_STATE_UNSPECIFIED
+}
+
+func (x *ListBackupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBackupsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBackupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backups       []*TenantBackup        `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackupsResponse) Reset() {
+	*x = ListBackupsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackupsResponse) ProtoMessage() {}
+
+func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListBackupsResponse) GetBackups() []*TenantBackup {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+func (x *ListBackupsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTenantHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 先同步检查一遍已打开的连接池，而不是返回上一轮定期检查的结果
+	Refresh       bool `protobuf:"varint,1,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantHealthRequest) Reset() {
+	*x = ListTenantHealthRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantHealthRequest) ProtoMessage() {}
+
+func (x *ListTenantHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantHealthRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantHealthRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTenantHealthRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+// 本服务实例对一个 tenant 连接池最近一次检查的结果
+type TenantHealth struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Healthy  bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// 检查失败的池被关闭，重建成功前为 false
+	PoolOpen bool `protobuf:"varint,3,opt,name=pool_open,json=poolOpen,proto3" json:"pool_open,omitempty"`
+	// 未检查过时为空
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	LatencyMs int64                  `protobuf:"varint,5,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// 最近一次失败的错误，恢复后保留
+	LastError           string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	ConsecutiveFailures int32  `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// 检查失败后自动重建连接池的次数
+	Reconnects    int32 `protobuf:"varint,8,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantHealth) Reset() {
+	*x = TenantHealth{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantHealth) ProtoMessage() {}
+
+func (x *TenantHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantHealth.ProtoReflect.Descriptor instead.
+func (*TenantHealth) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TenantHealth) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *TenantHealth) GetPoolOpen() bool {
+	if x != nil {
+		return x.PoolOpen
+	}
+	return false
+}
+
+func (x *TenantHealth) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *TenantHealth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *TenantHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *TenantHealth) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *TenantHealth) GetReconnects() int32 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+type ListTenantHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已打开连接池、或最近检查失败的 tenant，按 id 排序
+	Tenants       []*TenantHealth `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantHealthResponse) Reset() {
+	*x = ListTenantHealthResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantHealthResponse) ProtoMessage() {}
+
+func (x *ListTenantHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantHealthResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantHealthResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTenantHealthResponse) GetTenants() []*TenantHealth {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type UpdateTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 未设置时保持不变，0 表示恢复默认值
+	MaxConns *int32 `protobuf:"varint,2,opt,name=max_conns,json=maxConns,proto3,oneof" json:"max_conns,omitempty"`
+	// 未设置时保持不变，空字符串表示改回 TENANT_DSN_TEMPLATE。只修改连接位置，不迁移数据
+	Dsn *string `protobuf:"bytes,3,opt,name=dsn,proto3,oneof" json:"dsn,omitempty"`
+	// 只读副本的连接串，ListRows / GetRow / AggregateRows 从副本读取。未设置时保持不变，
+	// 空字符串表示改回 READ_REPLICA_DSN（专属 dsn 的 tenant 则不使用副本）。同 dsn 加密保存
+	ReplicaDsn    *string `protobuf:"bytes,4,opt,name=replica_dsn,json=replicaDsn,proto3,oneof" json:"replica_dsn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetMaxConns() int32 {
+	if x != nil && x.MaxConns != nil {
+		return *x.MaxConns
+	}
+	return 0
+}
+
+func (x *UpdateTenantRequest) GetDsn() string {
+	if x != nil && x.Dsn != nil {
+		return *x.Dsn
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetReplicaDsn() string {
+	if x != nil && x.ReplicaDsn != nil {
+		return *x.ReplicaDsn
+	}
+	return ""
+}
+
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type SuspendTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantRequest) Reset() {
+	*x = SuspendTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantRequest) ProtoMessage() {}
+
+func (x *SuspendTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantRequest.ProtoReflect.Descriptor instead.
+func (*SuspendTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SuspendTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuspendTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantResponse) Reset() {
+	*x = SuspendTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantResponse) ProtoMessage() {}
+
+func (x *SuspendTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantResponse.ProtoReflect.Descriptor instead.
+func (*SuspendTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SuspendTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type ResumeTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTenantRequest) Reset() {
+	*x = ResumeTenantRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTenantRequest) ProtoMessage() {}
+
+func (x *ResumeTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTenantRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTenantRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResumeTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTenantResponse) Reset() {
+	*x = ResumeTenantResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTenantResponse) ProtoMessage() {}
+
+func (x *ResumeTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTenantResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTenantResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResumeTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type RotateTenantKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTenantKeysRequest) Reset() {
+	*x = RotateTenantKeysRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTenantKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTenantKeysRequest) ProtoMessage() {}
+
+func (x *RotateTenantKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTenantKeysRequest.ProtoReflect.Descriptor instead.
+func (*RotateTenantKeysRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{49}
+}
+
+type RotateTenantKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 开始轮换时返回，其它字段在 operation 完成后的 response 中
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// 重新加密的注册表连接串个数（专属 DSN 和只读副本 DSN，仅 multi 模式）
+	ResealedDsns int32 `protobuf:"varint,2,opt,name=resealed_dsns,json=resealedDsns,proto3" json:"resealed_dsns,omitempty"`
+	// 重新加密的加密列单元格数（含回收站中的行和已归档的表）
+	ReencryptedCells int64 `protobuf:"varint,3,opt,name=reencrypted_cells,json=reencryptedCells,proto3" json:"reencrypted_cells,omitempty"`
+	// 重新加密的行历史版本数
+	ReencryptedVersions int64 `protobuf:"varint,4,opt,name=reencrypted_versions,json=reencryptedVersions,proto3" json:"reencrypted_versions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RotateTenantKeysResponse) Reset() {
+	*x = RotateTenantKeysResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTenantKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTenantKeysResponse) ProtoMessage() {}
+
+func (x *RotateTenantKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTenantKeysResponse.ProtoReflect.Descriptor instead.
+func (*RotateTenantKeysResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RotateTenantKeysResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *RotateTenantKeysResponse) GetResealedDsns() int32 {
+	if x != nil {
+		return x.ResealedDsns
+	}
+	return 0
+}
+
+func (x *RotateTenantKeysResponse) GetReencryptedCells() int64 {
+	if x != nil {
+		return x.ReencryptedCells
+	}
+	return 0
+}
+
+func (x *RotateTenantKeysResponse) GetReencryptedVersions() int64 {
+	if x != nil {
+		return x.ReencryptedVersions
+	}
+	return 0
+}
+
+// -------- Type --------
+type CreateTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PgType        string                 `protobuf:"bytes,2,opt,name=pg_type,json=pgType,proto3" json:"pg_type,omitempty"`
+	Config        *structpb.Struct       `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTypeRequest) Reset() {
+	*x = CreateTypeRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTypeRequest) ProtoMessage() {}
+
+func (x *CreateTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTypeRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTypeRequest) GetPgType() string {
+	if x != nil {
+		return x.PgType
+	}
+	return ""
+}
+
+func (x *CreateTypeRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CreateTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *Type                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTypeResponse) Reset() {
+	*x = CreateTypeResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTypeResponse) ProtoMessage() {}
+
+func (x *CreateTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTypeResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateTypeResponse) GetType() *Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+type ListTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTypesRequest) Reset() {
+	*x = ListTypesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTypesRequest) ProtoMessage() {}
+
+func (x *ListTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListTypesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{53}
+}
+
+type ListTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []*Type                `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTypesResponse) Reset() {
+	*x = ListTypesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTypesResponse) ProtoMessage() {}
+
+func (x *ListTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListTypesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListTypesResponse) GetTypes() []*Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type GetTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 类型 id 或 name
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTypeRequest) Reset() {
+	*x = GetTypeRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTypeRequest) ProtoMessage() {}
+
+func (x *GetTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetTypeRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *Type                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTypeResponse) Reset() {
+	*x = GetTypeResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTypeResponse) ProtoMessage() {}
+
+func (x *GetTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetTypeResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTypeResponse) GetType() *Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+type UpdateTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 类型 id 或 name
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 为空表示不修改
+	PgType string `protobuf:"bytes,2,opt,name=pg_type,json=pgType,proto3" json:"pg_type,omitempty"`
+	// 不传表示不修改；传入时整体替换
+	Config *structpb.Struct `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// pg_type 变化且已有列使用该类型时，用 ALTER COLUMN ... TYPE ... USING col::<pg_type> 转换这些列；
+	// 为 false 时拒绝修改
+	CastColumns   bool `protobuf:"varint,4,opt,name=cast_columns,json=castColumns,proto3" json:"cast_columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTypeRequest) Reset() {
+	*x = UpdateTypeRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTypeRequest) ProtoMessage() {}
+
+func (x *UpdateTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTypeRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdateTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTypeRequest) GetPgType() string {
+	if x != nil {
+		return x.PgType
+	}
+	return ""
+}
+
+func (x *UpdateTypeRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateTypeRequest) GetCastColumns() bool {
+	if x != nil {
+		return x.CastColumns
+	}
+	return false
+}
+
+type UpdateTypeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  *Type                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 被转换类型的物理列数
+	AlteredColumns int32 `protobuf:"varint,2,opt,name=altered_columns,json=alteredColumns,proto3" json:"altered_columns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTypeResponse) Reset() {
+	*x = UpdateTypeResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTypeResponse) ProtoMessage() {}
+
+func (x *UpdateTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTypeResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateTypeResponse) GetType() *Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *UpdateTypeResponse) GetAlteredColumns() int32 {
+	if x != nil {
+		return x.AlteredColumns
+	}
+	return 0
+}
+
+type DeleteTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTypeRequest) Reset() {
+	*x = DeleteTypeRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTypeRequest) ProtoMessage() {}
+
+func (x *DeleteTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTypeRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTypeResponse) Reset() {
+	*x = DeleteTypeResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTypeResponse) ProtoMessage() {}
+
+func (x *DeleteTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTypeResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{60}
+}
+
+// 类型目录文档中的一项，config 中可包含校验 schema 等
+type TypeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PgType        string                 `protobuf:"bytes,2,opt,name=pg_type,json=pgType,proto3" json:"pg_type,omitempty"`
+	Config        *structpb.Struct       `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeDefinition) Reset() {
+	*x = TypeDefinition{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeDefinition) ProtoMessage() {}
+
+func (x *TypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeDefinition.ProtoReflect.Descriptor instead.
+func (*TypeDefinition) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TypeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypeDefinition) GetPgType() string {
+	if x != nil {
+		return x.PgType
+	}
+	return ""
+}
+
+func (x *TypeDefinition) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ExportTypesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否包含内置类型（text/number/bool/timestamp/json/formula/relationship/rollup/lookup/single_select/multi_select/attachment/currency/percent/duration/rating/point/geometry/text_list/number_list/email/url/phone）
+	IncludeBuiltin bool `protobuf:"varint,1,opt,name=include_builtin,json=includeBuiltin,proto3" json:"include_builtin,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportTypesRequest) Reset() {
+	*x = ExportTypesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTypesRequest) ProtoMessage() {}
+
+func (x *ExportTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTypesRequest.ProtoReflect.Descriptor instead.
+func (*ExportTypesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExportTypesRequest) GetIncludeBuiltin() bool {
+	if x != nil {
+		return x.IncludeBuiltin
+	}
+	return false
+}
+
+type ExportTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []*TypeDefinition      `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTypesResponse) Reset() {
+	*x = ExportTypesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTypesResponse) ProtoMessage() {}
+
+func (x *ExportTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTypesResponse.ProtoReflect.Descriptor instead.
+func (*ExportTypesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ExportTypesResponse) GetTypes() []*TypeDefinition {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ImportTypesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Types []*TypeDefinition      `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// 已存在同名 type 且定义不同时是否覆盖
+	Overwrite     bool `protobuf:"varint,2,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTypesRequest) Reset() {
+	*x = ImportTypesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTypesRequest) ProtoMessage() {}
+
+func (x *ImportTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTypesRequest.ProtoReflect.Descriptor instead.
+func (*ImportTypesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ImportTypesRequest) GetTypes() []*TypeDefinition {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ImportTypesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type ImportTypesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Created []*Type                `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Updated []*Type                `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	// 已存在且未修改的 type name
+	Unchanged     []string `protobuf:"bytes,3,rep,name=unchanged,proto3" json:"unchanged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTypesResponse) Reset() {
+	*x = ImportTypesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTypesResponse) ProtoMessage() {}
+
+func (x *ImportTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTypesResponse.ProtoReflect.Descriptor instead.
+func (*ImportTypesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ImportTypesResponse) GetCreated() []*Type {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ImportTypesResponse) GetUpdated() []*Type {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ImportTypesResponse) GetUnchanged() []string {
+	if x != nil {
+		return x.Unchanged
+	}
+	return nil
+}
+
+// -------- Table --------
+type CreateTableRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SchemaName string                 `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// 可选：按列分区
+	Partition *PartitionSpec `protobuf:"bytes,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	// name 已被占用时自动追加 _2、_3… 后缀，实际使用的名字见返回的 table.name；默认返回 ALREADY_EXISTS
+	AutoSuffix bool `protobuf:"varint,4,opt,name=auto_suffix,json=autoSuffix,proto3" json:"auto_suffix,omitempty"`
+	// 可选：放入的 workspace
+	WorkspaceId   string `protobuf:"bytes,5,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableRequest) Reset() {
+	*x = CreateTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableRequest) ProtoMessage() {}
+
+func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTableRequest) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *CreateTableRequest) GetPartition() *PartitionSpec {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+func (x *CreateTableRequest) GetAutoSuffix() bool {
+	if x != nil {
+		return x.AutoSuffix
+	}
+	return false
+}
+
+func (x *CreateTableRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type CreateTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableResponse) Reset() {
+	*x = CreateTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableResponse) ProtoMessage() {}
+
+func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableResponse.ProtoReflect.Descriptor instead.
+func (*CreateTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateTableResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+// 列定义：按 name 标识，不含 id
+type ColumnDefinition struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TypeId     string                 `protobuf:"bytes,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	IsNullable bool                   `protobuf:"varint,3,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
+	Position   int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Config     *structpb.Struct       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	IsUnique   bool                   `protobuf:"varint,6,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	// 源列 id（ExportSchema 输出）。ImportSchema 据此把其它列 config 中的引用改写为新列 id，其它接口忽略
+	Id            string           `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	IsHidden      bool             `protobuf:"varint,8,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	IsReadonly    bool             `protobuf:"varint,9,opt,name=is_readonly,json=isReadonly,proto3" json:"is_readonly,omitempty"`
+	Description   string           `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Label         string           `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+	UiHints       *structpb.Struct `protobuf:"bytes,12,opt,name=ui_hints,json=uiHints,proto3" json:"ui_hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnDefinition) Reset() {
+	*x = ColumnDefinition{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnDefinition) ProtoMessage() {}
+
+func (x *ColumnDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnDefinition.ProtoReflect.Descriptor instead.
+func (*ColumnDefinition) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ColumnDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColumnDefinition) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+func (x *ColumnDefinition) GetIsNullable() bool {
+	if x != nil {
+		return x.IsNullable
+	}
+	return false
+}
+
+func (x *ColumnDefinition) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ColumnDefinition) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ColumnDefinition) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *ColumnDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ColumnDefinition) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *ColumnDefinition) GetIsReadonly() bool {
+	if x != nil {
+		return x.IsReadonly
+	}
+	return false
+}
+
+func (x *ColumnDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ColumnDefinition) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ColumnDefinition) GetUiHints() *structpb.Struct {
+	if x != nil {
+		return x.UiHints
+	}
+	return nil
+}
+
+// 索引定义：按列名引用列
+type IndexDefinition struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ColumnNames []string               `protobuf:"bytes,2,rep,name=column_names,json=columnNames,proto3" json:"column_names,omitempty"`
+	IsUnique    bool                   `protobuf:"varint,3,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	// 同 CreateIndexRequest.index_method；UNSPECIFIED 时 ApplyTableSchema 不比较索引方法
+	IndexMethod   IndexMethod `protobuf:"varint,4,opt,name=index_method,json=indexMethod,proto3,enum=lowcode.v1.IndexMethod" json:"index_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexDefinition) Reset() {
+	*x = IndexDefinition{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexDefinition) ProtoMessage() {}
+
+func (x *IndexDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexDefinition.ProtoReflect.Descriptor instead.
+func (*IndexDefinition) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *IndexDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IndexDefinition) GetColumnNames() []string {
+	if x != nil {
+		return x.ColumnNames
+	}
+	return nil
+}
+
+func (x *IndexDefinition) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *IndexDefinition) GetIndexMethod() IndexMethod {
+	if x != nil {
+		return x.IndexMethod
+	}
+	return IndexMethod_INDEX_METHOD_UNSPECIFIED
+}
+
+type CreateTableWithSchemaRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SchemaName string                 `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	Partition  *PartitionSpec         `protobuf:"bytes,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Columns    []*ColumnDefinition    `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes    []*IndexDefinition     `protobuf:"bytes,5,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// 同 CreateTableRequest.auto_suffix
+	AutoSuffix    bool   `protobuf:"varint,6,opt,name=auto_suffix,json=autoSuffix,proto3" json:"auto_suffix,omitempty"`
+	WorkspaceId   string `protobuf:"bytes,7,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableWithSchemaRequest) Reset() {
+	*x = CreateTableWithSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableWithSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableWithSchemaRequest) ProtoMessage() {}
+
+func (x *CreateTableWithSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableWithSchemaRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableWithSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateTableWithSchemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTableWithSchemaRequest) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *CreateTableWithSchemaRequest) GetPartition() *PartitionSpec {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+func (x *CreateTableWithSchemaRequest) GetColumns() []*ColumnDefinition {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CreateTableWithSchemaRequest) GetIndexes() []*IndexDefinition {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *CreateTableWithSchemaRequest) GetAutoSuffix() bool {
+	if x != nil {
+		return x.AutoSuffix
+	}
+	return false
+}
+
+func (x *CreateTableWithSchemaRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type CreateTableWithSchemaResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Table   *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// 包括 is_unique 列的唯一索引
+	Indexes       []*Index `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableWithSchemaResponse) Reset() {
+	*x = CreateTableWithSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableWithSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableWithSchemaResponse) ProtoMessage() {}
+
+func (x *CreateTableWithSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableWithSchemaResponse.ProtoReflect.Descriptor instead.
+func (*CreateTableWithSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateTableWithSchemaResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *CreateTableWithSchemaResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CreateTableWithSchemaResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type ApplyTableSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 表名（table id），不存在时创建
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 仅建表时使用
+	SchemaName string         `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	Partition  *PartitionSpec `protobuf:"bytes,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	// 列按 name 对应；config 只比较给出的字段，服务端补充的字段（如选项 id）不算差异
+	Columns []*ColumnDefinition `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	// 索引按 name 对应；is_unique 列的唯一索引由列定义管理
+	Indexes []*IndexDefinition `protobuf:"bytes,5,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// 删除定义中没有的列和索引；默认保留
+	Prune         bool `protobuf:"varint,6,opt,name=prune,proto3" json:"prune,omitempty"`
+	DryRun        bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTableSchemaRequest) Reset() {
+	*x = ApplyTableSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTableSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTableSchemaRequest) ProtoMessage() {}
+
+func (x *ApplyTableSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTableSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ApplyTableSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ApplyTableSchemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyTableSchemaRequest) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *ApplyTableSchemaRequest) GetPartition() *PartitionSpec {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaRequest) GetColumns() []*ColumnDefinition {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaRequest) GetIndexes() []*IndexDefinition {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *ApplyTableSchemaRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ApplyTableSchema 计划中的一步
+type SchemaChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// create_table | add_column | change_column_type | update_column | drop_column | create_index | drop_index
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// 表名、列名或索引名
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// 仅 dry_run 时对破坏性的步骤返回：change_column_type / drop_column 同 ChangeColumnType / DeleteColumn 的 dry_run，
+	// drop_index 为被删除的索引和 DROP INDEX 语句
+	Impact        *SchemaImpact `protobuf:"bytes,4,opt,name=impact,proto3" json:"impact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaChange) Reset() {
+	*x = SchemaChange{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaChange) ProtoMessage() {}
+
+func (x *SchemaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaChange.ProtoReflect.Descriptor instead.
+func (*SchemaChange) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SchemaChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetImpact() *SchemaImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+type ApplyTableSchemaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按执行顺序排列；为空表示当前结构已与定义一致
+	Changes []*SchemaChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// 执行后的表结构，dry_run 时为空
+	Table   *Table    `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Columns []*Column `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes []*Index  `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// 仅 dry_run 时返回，为各步 impact 的合计：affected_rows 相加，依赖的列 / 索引 / 视图去重，statements 按步骤顺序排列
+	Impact        *SchemaImpact `protobuf:"bytes,5,opt,name=impact,proto3" json:"impact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyTableSchemaResponse) Reset() {
+	*x = ApplyTableSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyTableSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyTableSchemaResponse) ProtoMessage() {}
+
+func (x *ApplyTableSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyTableSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ApplyTableSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ApplyTableSchemaResponse) GetChanges() []*SchemaChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *ApplyTableSchemaResponse) GetImpact() *SchemaImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+// -------- Schema bundle --------
+type TableDefinition struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SchemaName  string                 `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon        string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Partition   *PartitionSpec         `protobuf:"bytes,5,opt,name=partition,proto3" json:"partition,omitempty"`
+	// 不含分区列（随建表创建）
+	Columns []*ColumnDefinition `protobuf:"bytes,6,rep,name=columns,proto3" json:"columns,omitempty"`
+	// 不含 is_unique 列的唯一索引
+	Indexes       []*IndexDefinition `protobuf:"bytes,7,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableDefinition) Reset() {
+	*x = TableDefinition{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableDefinition) ProtoMessage() {}
+
+func (x *TableDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableDefinition.ProtoReflect.Descriptor instead.
+func (*TableDefinition) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TableDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableDefinition) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *TableDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TableDefinition) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *TableDefinition) GetPartition() *PartitionSpec {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+func (x *TableDefinition) GetColumns() []*ColumnDefinition {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableDefinition) GetIndexes() []*IndexDefinition {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type SchemaBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文档格式版本，当前为 1
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Types         []*TypeDefinition      `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	Tables        []*TableDefinition     `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaBundle) Reset() {
+	*x = SchemaBundle{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaBundle) ProtoMessage() {}
+
+func (x *SchemaBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaBundle.ProtoReflect.Descriptor instead.
+func (*SchemaBundle) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SchemaBundle) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SchemaBundle) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *SchemaBundle) GetTypes() []*TypeDefinition {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SchemaBundle) GetTables() []*TableDefinition {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type ExportSchemaRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IncludeBuiltinTypes bool                   `protobuf:"varint,1,opt,name=include_builtin_types,json=includeBuiltinTypes,proto3" json:"include_builtin_types,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExportSchemaRequest) Reset() {
+	*x = ExportSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSchemaRequest) ProtoMessage() {}
+
+func (x *ExportSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ExportSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExportSchemaRequest) GetIncludeBuiltinTypes() bool {
+	if x != nil {
+		return x.IncludeBuiltinTypes
+	}
+	return false
+}
+
+type ExportSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *SchemaBundle          `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSchemaResponse) Reset() {
+	*x = ExportSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSchemaResponse) ProtoMessage() {}
+
+func (x *ExportSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ExportSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ExportSchemaResponse) GetBundle() *SchemaBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ImportSchemaRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bundle *SchemaBundle          `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// 已存在同名 type 且定义不同时是否覆盖，同 ImportTypes.overwrite
+	OverwriteTypes bool `protobuf:"varint,2,opt,name=overwrite_types,json=overwriteTypes,proto3" json:"overwrite_types,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportSchemaRequest) Reset() {
+	*x = ImportSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSchemaRequest) ProtoMessage() {}
+
+func (x *ImportSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ImportSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ImportSchemaRequest) GetBundle() *SchemaBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportSchemaRequest) GetOverwriteTypes() bool {
+	if x != nil {
+		return x.OverwriteTypes
+	}
+	return false
+}
+
+type ImportSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         *ImportTypesResponse   `protobuf:"bytes,1,opt,name=types,proto3" json:"types,omitempty"`
+	Tables        []*Table               `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes       []*Index               `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSchemaResponse) Reset() {
+	*x = ImportSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSchemaResponse) ProtoMessage() {}
+
+func (x *ImportSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ImportSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ImportSchemaResponse) GetTypes() *ImportTypesResponse {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ImportSchemaResponse) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ImportSchemaResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportSchemaResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// -------- Template --------
+type Template struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Columns        []*ColumnDefinition    `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes        []*IndexDefinition     `protobuf:"bytes,5,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	SampleRowCount int32                  `protobuf:"varint,6,opt,name=sample_row_count,json=sampleRowCount,proto3" json:"sample_row_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetColumns() []*ColumnDefinition {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Template) GetIndexes() []*IndexDefinition {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *Template) GetSampleRowCount() int32 {
+	if x != nil {
+		return x.SampleRowCount
+	}
+	return 0
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{82}
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateTableFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 表名，默认为模板 id
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SchemaName string `protobuf:"bytes,3,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// 不写入示例行
+	SkipSampleRows bool `protobuf:"varint,4,opt,name=skip_sample_rows,json=skipSampleRows,proto3" json:"skip_sample_rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTableFromTemplateRequest) Reset() {
+	*x = CreateTableFromTemplateRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTableFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateTableFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateTableFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTableFromTemplateRequest) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *CreateTableFromTemplateRequest) GetSkipSampleRows() bool {
+	if x != nil {
+		return x.SkipSampleRows
+	}
+	return false
+}
+
+type CreateTableFromTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes       []*Index               `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Rows          []*Row                 `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableFromTemplateResponse) Reset() {
+	*x = CreateTableFromTemplateResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTableFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTableFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateTableFromTemplateResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *CreateTableFromTemplateResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CreateTableFromTemplateResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *CreateTableFromTemplateResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type UpdateTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 新的逻辑名（也是新的 table id），为空表示不改名。物理表是 CreateTable 生成的 lc_t_<name>
+	// 且不是分区表时一并重命名为 lc_t_<新名>，否则物理表名保持不变
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 未设置时保持不变
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Icon        *string `protobuf:"bytes,4,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	// 移动到另一个 workspace，空字符串表示移出 workspace；未设置时保持不变
+	WorkspaceId *string `protobuf:"bytes,5,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"`
+	// 把未分区的表改为分区表：partition.column_id 指定分区键，interval / premake / values 同 CreateTable。
+	// 已有数据在同一个事务中搬进新的分区父表，期间表被 ACCESS EXCLUSIVE 锁住；限制见 README「分区表」
+	Partition *PartitionSpec `protobuf:"bytes,6,opt,name=partition,proto3" json:"partition,omitempty"`
+	// 与 partition 一起使用：在后台执行，立即返回 operation
+	Async         bool `protobuf:"varint,7,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTableRequest) Reset() {
+	*x = UpdateTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTableRequest) ProtoMessage() {}
+
+func (x *UpdateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTableRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetWorkspaceId() string {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetPartition() *PartitionSpec {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+func (x *UpdateTableRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type UpdateTableResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Table *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// 仅 async 时返回，table 在 operation 完成后的 response 中
+	Operation     *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTableResponse) Reset() {
+	*x = UpdateTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTableResponse) ProtoMessage() {}
+
+func (x *UpdateTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTableResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateTableResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *UpdateTableResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type DuplicateTableRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 新表的逻辑名
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 同时复制行（不含回收站中的行）和多对多关联
+	IncludeData   bool `protobuf:"varint,3,opt,name=include_data,json=includeData,proto3" json:"include_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateTableRequest) Reset() {
+	*x = DuplicateTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateTableRequest) ProtoMessage() {}
+
+func (x *DuplicateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateTableRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DuplicateTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *DuplicateTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DuplicateTableRequest) GetIncludeData() bool {
+	if x != nil {
+		return x.IncludeData
+	}
+	return false
+}
+
+type DuplicateTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes       []*Index               `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	CopiedRows    int64                  `protobuf:"varint,4,opt,name=copied_rows,json=copiedRows,proto3" json:"copied_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateTableResponse) Reset() {
+	*x = DuplicateTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateTableResponse) ProtoMessage() {}
+
+func (x *DuplicateTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateTableResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DuplicateTableResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *DuplicateTableResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DuplicateTableResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *DuplicateTableResponse) GetCopiedRows() int64 {
+	if x != nil {
+		return x.CopiedRows
+	}
+	return 0
+}
+
+type DeleteTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 只返回影响分析，不执行删除
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// 其它表中有指向该表的 relationship / formula 列时，一并删除这些列；
+	// 否则存在依赖时返回 FailedPrecondition
+	Cascade bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	// 立即删除物理表和元数据，不经过归档
+	Purge         bool `protobuf:"varint,4,opt,name=purge,proto3" json:"purge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableRequest) Reset() {
+	*x = DeleteTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableRequest) ProtoMessage() {}
+
+func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTableRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteTableRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *DeleteTableRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+type DeleteTableResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 仅 dry_run 时返回
+	Impact *SchemaImpact `protobuf:"bytes,1,opt,name=impact,proto3" json:"impact,omitempty"`
+	// 归档后的表（id 为归档名），purge 时为空
+	Archived      *Table `protobuf:"bytes,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableResponse) Reset() {
+	*x = DeleteTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableResponse) ProtoMessage() {}
+
+func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteTableResponse) GetImpact() *SchemaImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+func (x *DeleteTableResponse) GetArchived() *Table {
+	if x != nil {
+		return x.Archived
+	}
+	return nil
+}
+
+type RestoreTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 归档名
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 恢复后的表名，默认为原表名
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTableRequest) Reset() {
+	*x = RestoreTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTableRequest) ProtoMessage() {}
+
+func (x *RestoreTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTableRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RestoreTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTableResponse) Reset() {
+	*x = RestoreTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTableResponse) ProtoMessage() {}
+
+func (x *RestoreTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTableResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RestoreTableResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+type PurgeTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 归档名
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTableRequest) Reset() {
+	*x = PurgeTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTableRequest) ProtoMessage() {}
+
+func (x *PurgeTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTableRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PurgeTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTableResponse) Reset() {
+	*x = PurgeTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTableResponse) ProtoMessage() {}
+
+func (x *PurgeTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTableResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{95}
+}
+
+type ListTablesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否包含已归档的表
+	IncludeArchived bool `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// 为 0 时返回全部表，否则每页最多 page_size 张（上限 1000）
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 只返回表名以此开头的表
+	NamePrefix string    `protobuf:"bytes,4,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	Sort       TableSort `protobuf:"varint,5,opt,name=sort,proto3,enum=lowcode.v1.TableSort" json:"sort,omitempty"`
+	Descending bool      `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	// 只返回该 workspace 中的表
+	WorkspaceId string `protobuf:"bytes,7,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// 只返回不属于任何 workspace 的表，与 workspace_id 互斥
+	Unassigned    bool `protobuf:"varint,8,opt,name=unassigned,proto3" json:"unassigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesRequest) ProtoMessage() {}
+
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListTablesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListTablesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTablesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTablesRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListTablesRequest) GetSort() TableSort {
+	if x != nil {
+		return x.Sort
+	}
+	return TableSort_TABLE_SORT_UNSPECIFIED
+}
+
+func (x *ListTablesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListTablesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListTablesRequest) GetUnassigned() bool {
+	if x != nil {
+		return x.Unassigned
+	}
+	return false
+}
+
+type ListTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*Table               `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesResponse) ProtoMessage() {}
+
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListTablesResponse) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ListTablesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateWorkspaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant 内唯一
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Icon          string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceRequest) Reset() {
+	*x = CreateWorkspaceRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CreateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+type CreateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceResponse) Reset() {
+	*x = CreateWorkspaceResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceResponse) ProtoMessage() {}
+
+func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type ListWorkspacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesRequest) Reset() {
+	*x = ListWorkspacesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesRequest) ProtoMessage() {}
+
+func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{100}
+}
+
+type ListWorkspacesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按 name 排序
+	Workspaces    []*Workspace `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesResponse) Reset() {
+	*x = ListWorkspacesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesResponse) ProtoMessage() {}
+
+func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+type GetWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceRequest) Reset() {
+	*x = GetWorkspaceRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetWorkspaceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceResponse) Reset() {
+	*x = GetWorkspaceResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type UpdateWorkspaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 未设置时保持不变
+	Name          *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Icon          *string `protobuf:"bytes,4,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceRequest) Reset() {
+	*x = UpdateWorkspaceRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateWorkspaceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+type UpdateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceResponse) Reset() {
+	*x = UpdateWorkspaceResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UpdateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type DeleteWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkspaceRequest) Reset() {
+	*x = DeleteWorkspaceRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceRequest) ProtoMessage() {}
+
+func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteWorkspaceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkspaceResponse) Reset() {
+	*x = DeleteWorkspaceResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceResponse) ProtoMessage() {}
+
+func (x *DeleteWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{107}
+}
+
+type GetTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 表 id；归档的表用归档名
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableRequest) Reset() {
+	*x = GetTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableRequest) ProtoMessage() {}
+
+func (x *GetTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableRequest.ProtoReflect.Descriptor instead.
+func (*GetTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableResponse) Reset() {
+	*x = GetTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableResponse) ProtoMessage() {}
+
+func (x *GetTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableResponse.ProtoReflect.Descriptor instead.
+func (*GetTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetTableResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+// 获取 table + columns + indexes
+type GetTableSchemaRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 对照 pg_catalog 检查物理表与 lc_columns / lc_indexes 是否一致，结果在 drift 中
+	Verify        bool `protobuf:"varint,2,opt,name=verify,proto3" json:"verify,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableSchemaRequest) Reset() {
+	*x = GetTableSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableSchemaRequest) ProtoMessage() {}
+
+func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetTableSchemaRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *GetTableSchemaRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+type GetTableSchemaResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Table   *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes []*Index               `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// 仅 verify 时返回；为空表示一致
+	Drift         []*SchemaDrift `protobuf:"bytes,4,rep,name=drift,proto3" json:"drift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableSchemaResponse) Reset() {
+	*x = GetTableSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableSchemaResponse) ProtoMessage() {}
+
+func (x *GetTableSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetTableSchemaResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *GetTableSchemaResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *GetTableSchemaResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *GetTableSchemaResponse) GetDrift() []*SchemaDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+type SchemaDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  DriftKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=lowcode.v1.DriftKind" json:"kind,omitempty"`
+	// 列相关的差异：登记的列 id（ORPHANED_COLUMN 为空）
+	ColumnId string `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	// 物理列名
+	PgColumn string `protobuf:"bytes,3,opt,name=pg_column,json=pgColumn,proto3" json:"pg_column,omitempty"`
+	// 索引相关的差异：登记的索引 id（ORPHANED_INDEX 为空）
+	IndexId string `protobuf:"bytes,4,opt,name=index_id,json=indexId,proto3" json:"index_id,omitempty"`
+	// 物理索引名
+	PgIndex string `protobuf:"bytes,5,opt,name=pg_index,json=pgIndex,proto3" json:"pg_index,omitempty"`
+	// 元数据中的值，如 pg_type、NOT NULL
+	Expected string `protobuf:"bytes,6,opt,name=expected,proto3" json:"expected,omitempty"`
+	// 物理表上的实际值
+	Actual        string `protobuf:"bytes,7,opt,name=actual,proto3" json:"actual,omitempty"`
+	Message       string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaDrift) Reset() {
+	*x = SchemaDrift{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaDrift) ProtoMessage() {}
+
+func (x *SchemaDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaDrift.ProtoReflect.Descriptor instead.
+func (*SchemaDrift) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SchemaDrift) GetKind() DriftKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DriftKind_DRIFT_KIND_UNSPECIFIED
+}
+
+func (x *SchemaDrift) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetPgColumn() string {
+	if x != nil {
+		return x.PgColumn
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetIndexId() string {
+	if x != nil {
+		return x.IndexId
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetPgIndex() string {
+	if x != nil {
+		return x.PgIndex
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *SchemaDrift) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RepairTableSchemaRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 删除未登记到 lc_columns 的物理列（及其数据）；默认保留
+	DropOrphanedColumns bool `protobuf:"varint,2,opt,name=drop_orphaned_columns,json=dropOrphanedColumns,proto3" json:"drop_orphaned_columns,omitempty"`
+	// 只返回计划的 changes，不做修改
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairTableSchemaRequest) Reset() {
+	*x = RepairTableSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairTableSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairTableSchemaRequest) ProtoMessage() {}
+
+func (x *RepairTableSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairTableSchemaRequest.ProtoReflect.Descriptor instead.
+func (*RepairTableSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RepairTableSchemaRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *RepairTableSchemaRequest) GetDropOrphanedColumns() bool {
+	if x != nil {
+		return x.DropOrphanedColumns
+	}
+	return false
+}
+
+func (x *RepairTableSchemaRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RepairTableSchemaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按执行顺序排列，action 为 add_physical_column | drop_physical_column | register_index | unregister_index
+	Changes []*SchemaChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// 修复后仍存在的差异（类型、可空性不一致等需要人工处理）；dry_run 时为修复前的差异
+	Drift         []*SchemaDrift `protobuf:"bytes,2,rep,name=drift,proto3" json:"drift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairTableSchemaResponse) Reset() {
+	*x = RepairTableSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairTableSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairTableSchemaResponse) ProtoMessage() {}
+
+func (x *RepairTableSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairTableSchemaResponse.ProtoReflect.Descriptor instead.
+func (*RepairTableSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *RepairTableSchemaResponse) GetChanges() []*SchemaChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *RepairTableSchemaResponse) GetDrift() []*SchemaDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+type GetWorkspaceSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只返回该 workspace 中的表（及其列、索引和关联关系）
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// 只返回不属于任何 workspace 的表，与 workspace_id 互斥
+	Unassigned    bool `protobuf:"varint,2,opt,name=unassigned,proto3" json:"unassigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSchemaRequest) Reset() {
+	*x = GetWorkspaceSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSchemaRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetWorkspaceSchemaRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *GetWorkspaceSchemaRequest) GetUnassigned() bool {
+	if x != nil {
+		return x.Unassigned
+	}
+	return false
+}
+
+type TableSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Indexes       []*Index               `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableSchema) Reset() {
+	*x = TableSchema{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSchema) ProtoMessage() {}
+
+func (x *TableSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSchema.ProtoReflect.Descriptor instead.
+func (*TableSchema) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TableSchema) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *TableSchema) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableSchema) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// relationship 列解析后的关联关系
+type Relationship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TargetTableId string                 `protobuf:"bytes,3,opt,name=target_table_id,json=targetTableId,proto3" json:"target_table_id,omitempty"`
+	// 一对多：子表中指向当前行 id 的列
+	LinkColumnId string `protobuf:"bytes,4,opt,name=link_column_id,json=linkColumnId,proto3" json:"link_column_id,omitempty"`
+	// 多对一 / 一对一：本表中存目标行 id 的列
+	TargetColumnId string `protobuf:"bytes,5,opt,name=target_column_id,json=targetColumnId,proto3" json:"target_column_id,omitempty"`
+	// 多对多：服务管理的隐藏关联表（schema.table）
+	JoinTable     string `protobuf:"bytes,6,opt,name=join_table,json=joinTable,proto3" json:"join_table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Relationship) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *Relationship) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetTableId() string {
+	if x != nil {
+		return x.TargetTableId
+	}
+	return ""
+}
+
+func (x *Relationship) GetLinkColumnId() string {
+	if x != nil {
+		return x.LinkColumnId
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetColumnId() string {
+	if x != nil {
+		return x.TargetColumnId
+	}
+	return ""
+}
+
+func (x *Relationship) GetJoinTable() string {
+	if x != nil {
+		return x.JoinTable
+	}
+	return ""
+}
+
+type GetWorkspaceSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*TableSchema         `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Relationships []*Relationship        `protobuf:"bytes,2,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSchemaResponse) Reset() {
+	*x = GetWorkspaceSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSchemaResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetWorkspaceSchemaResponse) GetTables() []*TableSchema {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetWorkspaceSchemaResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// 破坏性 schema 操作的影响分析，用于 UI 确认弹窗
+type SchemaImpact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 受影响的行数：删除表时为表的行数，删除/修改列时为该列非空的行数
+	AffectedRows int64 `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	// 会失效的 formula / relationship 列（其它列的 config 引用了被删除的表或列）
+	DependentColumns []*Column `protobuf:"bytes,2,rep,name=dependent_columns,json=dependentColumns,proto3" json:"dependent_columns,omitempty"`
+	// 会被一起删除的索引
+	DependentIndexes []*Index `protobuf:"bytes,3,rep,name=dependent_indexes,json=dependentIndexes,proto3" json:"dependent_indexes,omitempty"`
+	// 依赖被删除对象的 PG 视图（schema.view）
+	DependentViews []string `protobuf:"bytes,4,rep,name=dependent_views,json=dependentViews,proto3" json:"dependent_views,omitempty"`
+	// 将要执行的 DDL
+	Statements    []string `protobuf:"bytes,5,rep,name=statements,proto3" json:"statements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaImpact) Reset() {
+	*x = SchemaImpact{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaImpact) ProtoMessage() {}
+
+func (x *SchemaImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaImpact.ProtoReflect.Descriptor instead.
+func (*SchemaImpact) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SchemaImpact) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *SchemaImpact) GetDependentColumns() []*Column {
+	if x != nil {
+		return x.DependentColumns
+	}
+	return nil
+}
+
+func (x *SchemaImpact) GetDependentIndexes() []*Index {
+	if x != nil {
+		return x.DependentIndexes
+	}
+	return nil
+}
+
+func (x *SchemaImpact) GetDependentViews() []string {
+	if x != nil {
+		return x.DependentViews
+	}
+	return nil
+}
+
+func (x *SchemaImpact) GetStatements() []string {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+// -------- Column --------
+type AddColumnRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TableId    string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TypeId     string                 `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	IsNullable bool                   `protobuf:"varint,4,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
+	// 从 1 开始；为 0 时追加到末尾，否则插入到该位置，原有列依次后移
+	Position int32            `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Config   *structpb.Struct `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
+	// 在物理列上创建唯一索引并登记到 lc_indexes（名为 <name>_unique），随列一起删除
+	IsUnique bool `protobuf:"varint,7,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	// 见 Column.is_hidden / Column.is_readonly
+	IsHidden   bool `protobuf:"varint,8,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	IsReadonly bool `protobuf:"varint,9,opt,name=is_readonly,json=isReadonly,proto3" json:"is_readonly,omitempty"`
+	// 见 Column.description / Column.label / Column.ui_hints
+	Description   string           `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Label         string           `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+	UiHints       *structpb.Struct `protobuf:"bytes,12,opt,name=ui_hints,json=uiHints,proto3" json:"ui_hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddColumnRequest) Reset() {
+	*x = AddColumnRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddColumnRequest) ProtoMessage() {}
+
+func (x *AddColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddColumnRequest.ProtoReflect.Descriptor instead.
+func (*AddColumnRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AddColumnRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *AddColumnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddColumnRequest) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+func (x *AddColumnRequest) GetIsNullable() bool {
+	if x != nil {
+		return x.IsNullable
+	}
+	return false
+}
+
+func (x *AddColumnRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *AddColumnRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AddColumnRequest) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *AddColumnRequest) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *AddColumnRequest) GetIsReadonly() bool {
+	if x != nil {
+		return x.IsReadonly
+	}
+	return false
+}
+
+func (x *AddColumnRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddColumnRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddColumnRequest) GetUiHints() *structpb.Struct {
+	if x != nil {
+		return x.UiHints
+	}
+	return nil
+}
+
+type AddColumnResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Column *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	// 仅 is_unique 时返回
+	UniqueIndex   *Index `protobuf:"bytes,2,opt,name=unique_index,json=uniqueIndex,proto3" json:"unique_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddColumnResponse) Reset() {
+	*x = AddColumnResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddColumnResponse) ProtoMessage() {}
+
+func (x *AddColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddColumnResponse.ProtoReflect.Descriptor instead.
+func (*AddColumnResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AddColumnResponse) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *AddColumnResponse) GetUniqueIndex() *Index {
+	if x != nil {
+		return x.UniqueIndex
+	}
+	return nil
+}
+
+// single_select / multi_select 列 config.options 中的一项；单元格保存 label
+type SelectOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectOption) Reset() {
+	*x = SelectOption{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectOption) ProtoMessage() {}
+
+func (x *SelectOption) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectOption.ProtoReflect.Descriptor instead.
+func (*SelectOption) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SelectOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SelectOption) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SelectOption) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type AddSelectOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSelectOptionRequest) Reset() {
+	*x = AddSelectOptionRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSelectOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSelectOptionRequest) ProtoMessage() {}
+
+func (x *AddSelectOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSelectOptionRequest.ProtoReflect.Descriptor instead.
+func (*AddSelectOptionRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AddSelectOptionRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *AddSelectOptionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddSelectOptionRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type AddSelectOptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Option        *SelectOption          `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSelectOptionResponse) Reset() {
+	*x = AddSelectOptionResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSelectOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSelectOptionResponse) ProtoMessage() {}
+
+func (x *AddSelectOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSelectOptionResponse.ProtoReflect.Descriptor instead.
+func (*AddSelectOptionResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *AddSelectOptionResponse) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *AddSelectOptionResponse) GetOption() *SelectOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+type UpdateSelectOptionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	OptionId string                 `protobuf:"bytes,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// 新 label，为空表示不改；已有单元格中的旧 label 会被替换
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// 新颜色，为空表示不改
+	Color         string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSelectOptionRequest) Reset() {
+	*x = UpdateSelectOptionRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSelectOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSelectOptionRequest) ProtoMessage() {}
+
+func (x *UpdateSelectOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSelectOptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSelectOptionRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UpdateSelectOptionRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *UpdateSelectOptionRequest) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *UpdateSelectOptionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpdateSelectOptionRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type UpdateSelectOptionResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Column *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	// 被改写的单元格（行）数
+	MigratedRows  int64 `protobuf:"varint,2,opt,name=migrated_rows,json=migratedRows,proto3" json:"migrated_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSelectOptionResponse) Reset() {
+	*x = UpdateSelectOptionResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSelectOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSelectOptionResponse) ProtoMessage() {}
+
+func (x *UpdateSelectOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSelectOptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSelectOptionResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UpdateSelectOptionResponse) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *UpdateSelectOptionResponse) GetMigratedRows() int64 {
+	if x != nil {
+		return x.MigratedRows
+	}
+	return 0
+}
+
+type RemoveSelectOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	OptionId      string                 `protobuf:"bytes,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSelectOptionRequest) Reset() {
+	*x = RemoveSelectOptionRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSelectOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSelectOptionRequest) ProtoMessage() {}
+
+func (x *RemoveSelectOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSelectOptionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSelectOptionRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *RemoveSelectOptionRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *RemoveSelectOptionRequest) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+type RemoveSelectOptionResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Column *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	// 被清除该选项的单元格（行）数
+	MigratedRows  int64 `protobuf:"varint,2,opt,name=migrated_rows,json=migratedRows,proto3" json:"migrated_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSelectOptionResponse) Reset() {
+	*x = RemoveSelectOptionResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSelectOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSelectOptionResponse) ProtoMessage() {}
+
+func (x *RemoveSelectOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSelectOptionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSelectOptionResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *RemoveSelectOptionResponse) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *RemoveSelectOptionResponse) GetMigratedRows() int64 {
+	if x != nil {
+		return x.MigratedRows
+	}
+	return 0
+}
+
+type ReorderColumnsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 列出的列按顺序排在最前，未列出的列保持原有相对顺序排在后面
+	ColumnIds     []string `protobuf:"bytes,2,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderColumnsRequest) Reset() {
+	*x = ReorderColumnsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderColumnsRequest) ProtoMessage() {}
+
+func (x *ReorderColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReorderColumnsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ReorderColumnsRequest) GetColumnIds() []string {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+type ReorderColumnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*Column              `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderColumnsResponse) Reset() {
+	*x = ReorderColumnsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderColumnsResponse) ProtoMessage() {}
+
+func (x *ReorderColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ReorderColumnsResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type UpdateColumnRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 未设置时保持不变；改为 false 时物理列 SET NOT NULL，已有 NULL 值则拒绝
+	IsNullable *bool `protobuf:"varint,3,opt,name=is_nullable,json=isNullable,proto3,oneof" json:"is_nullable,omitempty"`
+	// 非 0 时把列移到该位置，其它列依次调整
+	Position int32            `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Config   *structpb.Struct `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// 新的物理列名（可选），在同一事务内 RENAME COLUMN
+	PgColumn string `protobuf:"bytes,6,opt,name=pg_column,json=pgColumn,proto3" json:"pg_column,omitempty"`
+	// 未设置时保持不变
+	IsHidden    *bool   `protobuf:"varint,7,opt,name=is_hidden,json=isHidden,proto3,oneof" json:"is_hidden,omitempty"`
+	IsReadonly  *bool   `protobuf:"varint,8,opt,name=is_readonly,json=isReadonly,proto3,oneof" json:"is_readonly,omitempty"`
+	Description *string `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Label       *string `protobuf:"bytes,10,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// 未传时保持不变，传入时整体替换
+	UiHints       *structpb.Struct `protobuf:"bytes,11,opt,name=ui_hints,json=uiHints,proto3" json:"ui_hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateColumnRequest) Reset() {
+	*x = UpdateColumnRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateColumnRequest) ProtoMessage() {}
+
+func (x *UpdateColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateColumnRequest.ProtoReflect.Descriptor instead.
+func (*UpdateColumnRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *UpdateColumnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetIsNullable() bool {
+	if x != nil && x.IsNullable != nil {
+		return *x.IsNullable
+	}
+	return false
+}
+
+func (x *UpdateColumnRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *UpdateColumnRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateColumnRequest) GetPgColumn() string {
+	if x != nil {
+		return x.PgColumn
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetIsHidden() bool {
+	if x != nil && x.IsHidden != nil {
+		return *x.IsHidden
+	}
+	return false
+}
+
+func (x *UpdateColumnRequest) GetIsReadonly() bool {
+	if x != nil && x.IsReadonly != nil {
+		return *x.IsReadonly
+	}
+	return false
+}
+
+func (x *UpdateColumnRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetUiHints() *structpb.Struct {
+	if x != nil {
+		return x.UiHints
+	}
+	return nil
+}
+
+type UpdateColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateColumnResponse) Reset() {
+	*x = UpdateColumnResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateColumnResponse) ProtoMessage() {}
+
+func (x *UpdateColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateColumnResponse.ProtoReflect.Descriptor instead.
+func (*UpdateColumnResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UpdateColumnResponse) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+type ChangeColumnTypeRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId     string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	NewTypeId    string                 `protobuf:"bytes,2,opt,name=new_type_id,json=newTypeId,proto3" json:"new_type_id,omitempty"`
+	CastStrategy CastStrategy           `protobuf:"varint,3,opt,name=cast_strategy,json=castStrategy,proto3,enum=lowcode.v1.CastStrategy" json:"cast_strategy,omitempty"`
+	// 只返回影响分析和无法转换的行数，不执行修改
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// 在后台执行，立即返回 operation；不能与 dry_run 同时使用
+	Async         bool `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeColumnTypeRequest) Reset() {
+	*x = ChangeColumnTypeRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeColumnTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeColumnTypeRequest) ProtoMessage() {}
+
+func (x *ChangeColumnTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeColumnTypeRequest.ProtoReflect.Descriptor instead.
+func (*ChangeColumnTypeRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ChangeColumnTypeRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *ChangeColumnTypeRequest) GetNewTypeId() string {
+	if x != nil {
+		return x.NewTypeId
+	}
+	return ""
+}
+
+func (x *ChangeColumnTypeRequest) GetCastStrategy() CastStrategy {
+	if x != nil {
+		return x.CastStrategy
+	}
+	return CastStrategy_CAST_STRATEGY_UNSPECIFIED
+}
+
+func (x *ChangeColumnTypeRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ChangeColumnTypeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type ChangeColumnTypeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Column *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	// 无法转换的非 NULL 值个数（TRUNCATE 时这些值被写为 NULL）
+	FailedRows int64 `protobuf:"varint,2,opt,name=failed_rows,json=failedRows,proto3" json:"failed_rows,omitempty"`
+	// 仅 dry_run 时返回
+	Impact *SchemaImpact `protobuf:"bytes,3,opt,name=impact,proto3" json:"impact,omitempty"`
+	// 仅 async 时返回，其它字段在 operation 完成后的 response 中
+	Operation     *Operation `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeColumnTypeResponse) Reset() {
+	*x = ChangeColumnTypeResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeColumnTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeColumnTypeResponse) ProtoMessage() {}
+
+func (x *ChangeColumnTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeColumnTypeResponse.ProtoReflect.Descriptor instead.
+func (*ChangeColumnTypeResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ChangeColumnTypeResponse) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *ChangeColumnTypeResponse) GetFailedRows() int64 {
+	if x != nil {
+		return x.FailedRows
+	}
+	return 0
+}
+
+func (x *ChangeColumnTypeResponse) GetImpact() *SchemaImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+func (x *ChangeColumnTypeResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type DeleteColumnRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 只返回影响分析，不执行删除
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteColumnRequest) Reset() {
+	*x = DeleteColumnRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnRequest) ProtoMessage() {}
+
+func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnRequest.ProtoReflect.Descriptor instead.
+func (*DeleteColumnRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *DeleteColumnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteColumnRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DeleteColumnResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 仅 dry_run 时返回
+	Impact        *SchemaImpact `protobuf:"bytes,1,opt,name=impact,proto3" json:"impact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteColumnResponse) Reset() {
+	*x = DeleteColumnResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnResponse) ProtoMessage() {}
+
+func (x *DeleteColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnResponse.ProtoReflect.Descriptor instead.
+func (*DeleteColumnResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *DeleteColumnResponse) GetImpact() *SchemaImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+type ListColumnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListColumnsRequest) Reset() {
+	*x = ListColumnsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListColumnsRequest) ProtoMessage() {}
+
+func (x *ListColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ListColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListColumnsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ListColumnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*Column              `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListColumnsResponse) Reset() {
+	*x = ListColumnsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListColumnsResponse) ProtoMessage() {}
+
+func (x *ListColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ListColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListColumnsResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// -------- Row / Cell --------
+type CreateRowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Cells         map[string]*Value      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRowRequest) Reset() {
+	*x = CreateRowRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRowRequest) ProtoMessage() {}
+
+func (x *CreateRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRowRequest.ProtoReflect.Descriptor instead.
+func (*CreateRowRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *CreateRowRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CreateRowRequest) GetCells() map[string]*Value {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type CreateRowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRowResponse) Reset() {
+	*x = CreateRowResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRowResponse) ProtoMessage() {}
+
+func (x *CreateRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRowResponse.ProtoReflect.Descriptor instead.
+func (*CreateRowResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *CreateRowResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type UpdateRowRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId   string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	// 只更新出现的列，未出现的列保持不变
+	Cells map[string]*Value `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 要清空（置为 NULL）的列，等价于 cells 中对应列传 null_value
+	ClearColumnIds []string `protobuf:"bytes,4,rep,name=clear_column_ids,json=clearColumnIds,proto3" json:"clear_column_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateRowRequest) Reset() {
+	*x = UpdateRowRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRowRequest) ProtoMessage() {}
+
+func (x *UpdateRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRowRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *UpdateRowRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *UpdateRowRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *UpdateRowRequest) GetCells() map[string]*Value {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *UpdateRowRequest) GetClearColumnIds() []string {
+	if x != nil {
+		return x.ClearColumnIds
+	}
+	return nil
+}
+
+type UpdateRowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRowResponse) Reset() {
+	*x = UpdateRowResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRowResponse) ProtoMessage() {}
+
+func (x *UpdateRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRowResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRowResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *UpdateRowResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type DeleteRowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRowRequest) Reset() {
+	*x = DeleteRowRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRowRequest) ProtoMessage() {}
+
+func (x *DeleteRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRowRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DeleteRowRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *DeleteRowRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+type DeleteRowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRowResponse) Reset() {
+	*x = DeleteRowResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRowResponse) ProtoMessage() {}
+
+func (x *DeleteRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRowResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{144}
+}
+
+type RestoreRowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRowRequest) Reset() {
+	*x = RestoreRowRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRowRequest) ProtoMessage() {}
+
+func (x *RestoreRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRowRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRowRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *RestoreRowRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *RestoreRowRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+type RestoreRowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRowResponse) Reset() {
+	*x = RestoreRowResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRowResponse) ProtoMessage() {}
+
+func (x *RestoreRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRowResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRowResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RestoreRowResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+// RowVersion 是一次修改之前的行
+type RowVersion struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action RowVersionAction       `protobuf:"varint,2,opt,name=action,proto3,enum=lowcode.v1.RowVersionAction" json:"action,omitempty"`
+	// 修改前的完整行（含系统列）
+	Row *Row `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	// 执行修改的用户
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 修改时间，row 是该时间之前的值
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowVersion) Reset() {
+	*x = RowVersion{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowVersion) ProtoMessage() {}
+
+func (x *RowVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowVersion.ProtoReflect.Descriptor instead.
+func (*RowVersion) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *RowVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RowVersion) GetAction() RowVersionAction {
+	if x != nil {
+		return x.Action
+	}
+	return RowVersionAction_ROW_VERSION_ACTION_UNSPECIFIED
+}
+
+func (x *RowVersion) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+func (x *RowVersion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RowVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetRowHistoryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId   string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	// 默认 50，上限 1000；按时间倒序
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 设置时在 row 中返回该时刻的行；该时刻行还不存在或已被物理删除时返回 ROW_NOT_FOUND
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRowHistoryRequest) Reset() {
+	*x = GetRowHistoryRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRowHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRowHistoryRequest) ProtoMessage() {}
+
+func (x *GetRowHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRowHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRowHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetRowHistoryRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *GetRowHistoryRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *GetRowHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRowHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetRowHistoryRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GetRowHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*RowVersion          `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// as_of 时刻的行
+	Row           *Row `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRowHistoryResponse) Reset() {
+	*x = GetRowHistoryResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRowHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRowHistoryResponse) ProtoMessage() {}
+
+func (x *GetRowHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRowHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRowHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetRowHistoryResponse) GetVersions() []*RowVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *GetRowHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetRowHistoryResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type RestoreRowVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRowVersionRequest) Reset() {
+	*x = RestoreRowVersionRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRowVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRowVersionRequest) ProtoMessage() {}
+
+func (x *RestoreRowVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRowVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRowVersionRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RestoreRowVersionRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *RestoreRowVersionRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *RestoreRowVersionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type RestoreRowVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRowVersionResponse) Reset() {
+	*x = RestoreRowVersionResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRowVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRowVersionResponse) ProtoMessage() {}
+
+func (x *RestoreRowVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRowVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRowVersionResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *RestoreRowVersionResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type LinkRowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId   string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	// config.kind 为 many_to_many 的 relationship 列
+	ColumnId      string   `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	TargetRowIds  []string `protobuf:"bytes,4,rep,name=target_row_ids,json=targetRowIds,proto3" json:"target_row_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkRowsRequest) Reset() {
+	*x = LinkRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRowsRequest) ProtoMessage() {}
+
+func (x *LinkRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRowsRequest.ProtoReflect.Descriptor instead.
+func (*LinkRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *LinkRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *LinkRowsRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *LinkRowsRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *LinkRowsRequest) GetTargetRowIds() []string {
+	if x != nil {
+		return x.TargetRowIds
+	}
+	return nil
+}
+
+type LinkRowsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新增的关联数，已存在的关联不计
+	Linked        int64 `protobuf:"varint,1,opt,name=linked,proto3" json:"linked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkRowsResponse) Reset() {
+	*x = LinkRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRowsResponse) ProtoMessage() {}
+
+func (x *LinkRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRowsResponse.ProtoReflect.Descriptor instead.
+func (*LinkRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *LinkRowsResponse) GetLinked() int64 {
+	if x != nil {
+		return x.Linked
+	}
+	return 0
+}
+
+type UnlinkRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	TargetRowIds  []string               `protobuf:"bytes,4,rep,name=target_row_ids,json=targetRowIds,proto3" json:"target_row_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkRowsRequest) Reset() {
+	*x = UnlinkRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkRowsRequest) ProtoMessage() {}
+
+func (x *UnlinkRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkRowsRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *UnlinkRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *UnlinkRowsRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *UnlinkRowsRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *UnlinkRowsRequest) GetTargetRowIds() []string {
+	if x != nil {
+		return x.TargetRowIds
+	}
+	return nil
+}
+
+type UnlinkRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unlinked      int64                  `protobuf:"varint,1,opt,name=unlinked,proto3" json:"unlinked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkRowsResponse) Reset() {
+	*x = UnlinkRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkRowsResponse) ProtoMessage() {}
+
+func (x *UnlinkRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkRowsResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *UnlinkRowsResponse) GetUnlinked() int64 {
+	if x != nil {
+		return x.Unlinked
+	}
+	return 0
+}
+
+type PurgeRowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 为空时清空整个回收站；只会删除已软删除的行
+	RowIds []string `protobuf:"bytes,2,rep,name=row_ids,json=rowIds,proto3" json:"row_ids,omitempty"`
+	// 只删除在此时间之前被软删除的行，为空表示不限
+	DeletedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_before,json=deletedBefore,proto3" json:"deleted_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeRowsRequest) Reset() {
+	*x = PurgeRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRowsRequest) ProtoMessage() {}
+
+func (x *PurgeRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRowsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PurgeRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *PurgeRowsRequest) GetRowIds() []string {
+	if x != nil {
+		return x.RowIds
+	}
+	return nil
+}
+
+func (x *PurgeRowsRequest) GetDeletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedBefore
+	}
+	return nil
+}
+
+type PurgeRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int64                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeRowsResponse) Reset() {
+	*x = PurgeRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRowsResponse) ProtoMessage() {}
+
+func (x *PurgeRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRowsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *PurgeRowsResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type GetRowRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId   string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	// 同 ListRowsRequest.expand_column_ids
+	ExpandColumnIds []string `protobuf:"bytes,3,rep,name=expand_column_ids,json=expandColumnIds,proto3" json:"expand_column_ids,omitempty"`
+	// 同 ListRowsRequest.include_deleted
+	IncludeDeleted bool `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// 同 ListRowsRequest.include_hidden
+	IncludeHidden bool `protobuf:"varint,5,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRowRequest) Reset() {
+	*x = GetRowRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRowRequest) ProtoMessage() {}
+
+func (x *GetRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRowRequest.ProtoReflect.Descriptor instead.
+func (*GetRowRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *GetRowRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *GetRowRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *GetRowRequest) GetExpandColumnIds() []string {
+	if x != nil {
+		return x.ExpandColumnIds
+	}
+	return nil
+}
+
+func (x *GetRowRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetRowRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type GetRowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRowResponse) Reset() {
+	*x = GetRowResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRowResponse) ProtoMessage() {}
+
+func (x *GetRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRowResponse.ProtoReflect.Descriptor instead.
+func (*GetRowResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetRowResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type FindRowByColumnRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TableId         string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	ColumnId        string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Value           *Value                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpandColumnIds []string               `protobuf:"bytes,4,rep,name=expand_column_ids,json=expandColumnIds,proto3" json:"expand_column_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindRowByColumnRequest) Reset() {
+	*x = FindRowByColumnRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindRowByColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRowByColumnRequest) ProtoMessage() {}
+
+func (x *FindRowByColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRowByColumnRequest.ProtoReflect.Descriptor instead.
+func (*FindRowByColumnRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *FindRowByColumnRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *FindRowByColumnRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *FindRowByColumnRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FindRowByColumnRequest) GetExpandColumnIds() []string {
+	if x != nil {
+		return x.ExpandColumnIds
+	}
+	return nil
+}
+
+type FindRowByColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindRowByColumnResponse) Reset() {
+	*x = FindRowByColumnResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindRowByColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRowByColumnResponse) ProtoMessage() {}
+
+func (x *FindRowByColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRowByColumnResponse.ProtoReflect.Descriptor instead.
+func (*FindRowByColumnResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *FindRowByColumnResponse) GetRow() *Row {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+type FilterCondition struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Operator FilterOperator         `protobuf:"varint,2,opt,name=operator,proto3,enum=lowcode.v1.FilterOperator" json:"operator,omitempty"`
+	Value    *Value                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Values   []*Value               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	// WITHIN_RADIUS 的半径（米）
+	RadiusMeters  float64 `protobuf:"fixed64,5,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterCondition) Reset() {
+	*x = FilterCondition{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterCondition) ProtoMessage() {}
+
+func (x *FilterCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterCondition.ProtoReflect.Descriptor instead.
+func (*FilterCondition) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *FilterCondition) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *FilterCondition) GetOperator() FilterOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return FilterOperator_FILTER_OPERATOR_UNSPECIFIED
+}
+
+func (x *FilterCondition) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FilterCondition) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *FilterCondition) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type FilterGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Combinator    FilterGroup_Combinator `protobuf:"varint,1,opt,name=combinator,proto3,enum=lowcode.v1.FilterGroup_Combinator" json:"combinator,omitempty"`
+	Filters       []*RowFilter           `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterGroup) Reset() {
+	*x = FilterGroup{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterGroup) ProtoMessage() {}
+
+func (x *FilterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterGroup.ProtoReflect.Descriptor instead.
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *FilterGroup) GetCombinator() FilterGroup_Combinator {
+	if x != nil {
+		return x.Combinator
+	}
+	return FilterGroup_COMBINATOR_UNSPECIFIED
+}
+
+func (x *FilterGroup) GetFilters() []*RowFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// RowFilter 是单个条件或一组条件（可以嵌套）
+type RowFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*RowFilter_Condition
+	//	*RowFilter_Group
+	Kind          isRowFilter_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilter) Reset() {
+	*x = RowFilter{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilter) ProtoMessage() {}
+
+func (x *RowFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilter.ProtoReflect.Descriptor instead.
+func (*RowFilter) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *RowFilter) GetKind() isRowFilter_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *RowFilter) GetCondition() *FilterCondition {
+	if x != nil {
+		if x, ok := x.Kind.(*RowFilter_Condition); ok {
+			return x.Condition
+		}
+	}
+	return nil
+}
+
+func (x *RowFilter) GetGroup() *FilterGroup {
+	if x != nil {
+		if x, ok := x.Kind.(*RowFilter_Group); ok {
+			return x.Group
+		}
+	}
+	return nil
+}
+
+type isRowFilter_Kind interface {
+	isRowFilter_Kind()
+}
+
+type RowFilter_Condition struct {
+	Condition *FilterCondition `protobuf:"bytes,1,opt,name=condition,proto3,oneof"`
+}
+
+type RowFilter_Group struct {
+	Group *FilterGroup `protobuf:"bytes,2,opt,name=group,proto3,oneof"`
+}
+
+func (*RowFilter_Condition) isRowFilter_Kind() {}
+
+func (*RowFilter_Group) isRowFilter_Kind() {}
+
+type SortSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 列 id，"id" 表示行 id
+	ColumnId      string             `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Direction     SortSpec_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=lowcode.v1.SortSpec_Direction" json:"direction,omitempty"`
+	Nulls         SortSpec_Nulls     `protobuf:"varint,3,opt,name=nulls,proto3,enum=lowcode.v1.SortSpec_Nulls" json:"nulls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortSpec) Reset() {
+	*x = SortSpec{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortSpec) ProtoMessage() {}
+
+func (x *SortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortSpec.ProtoReflect.Descriptor instead.
+func (*SortSpec) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SortSpec) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *SortSpec) GetDirection() SortSpec_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return SortSpec_DIRECTION_UNSPECIFIED
+}
+
+func (x *SortSpec) GetNulls() SortSpec_Nulls {
+	if x != nil {
+		return x.Nulls
+	}
+	return SortSpec_NULLS_UNSPECIFIED
+}
+
+type ListRowsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TableId   string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 要展开的 relationship 列 id 列表，返回时每行会带对应子表/关联表数据（一对多=多行，一对一=单行）
+	// 可用 "." 连接多级路径（如 "<order 列>.<customer 列>"）继续展开关联行，最多 3 级
+	ExpandColumnIds []string `protobuf:"bytes,4,rep,name=expand_column_ids,json=expandColumnIds,proto3" json:"expand_column_ids,omitempty"`
+	// 行过滤条件，为空表示不过滤
+	Filter *RowFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 排序，按顺序生效；最后总会追加 id 升序保证分页稳定
+	Sorts []*SortSpec `protobuf:"bytes,6,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// 同时返回回收站中（已软删除）的行，这些行的 deleted_at 不为空
+	IncludeDeleted bool `protobuf:"varint,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// 同时返回隐藏列（Column.is_hidden）的单元格；过滤和排序不受影响，总是可以使用隐藏列
+	IncludeHidden bool `protobuf:"varint,8,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	// 应用保存的视图：视图的 filter 与请求的 filter 取 AND；请求没有 sorts / page_size 时使用视图的；
+	// 只返回视图的 visible_column_ids。视图必须属于 table_id
+	ViewId        string `protobuf:"bytes,9,opt,name=view_id,json=viewId,proto3" json:"view_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRowsRequest) Reset() {
+	*x = ListRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRowsRequest) ProtoMessage() {}
+
+func (x *ListRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRowsRequest.ProtoReflect.Descriptor instead.
+func (*ListRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ListRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ListRowsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRowsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRowsRequest) GetExpandColumnIds() []string {
+	if x != nil {
+		return x.ExpandColumnIds
+	}
+	return nil
+}
+
+func (x *ListRowsRequest) GetFilter() *RowFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListRowsRequest) GetSorts() []*SortSpec {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *ListRowsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListRowsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+func (x *ListRowsRequest) GetViewId() string {
+	if x != nil {
+		return x.ViewId
+	}
+	return ""
+}
+
+type ListRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRowsResponse) Reset() {
+	*x = ListRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRowsResponse) ProtoMessage() {}
+
+func (x *ListRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRowsResponse.ProtoReflect.Descriptor instead.
+func (*ListRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ListRowsResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ListRowsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamRowsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TableId         string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Filter          *RowFilter             `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sorts           []*SortSpec            `protobuf:"bytes,3,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	ExpandColumnIds []string               `protobuf:"bytes,4,rep,name=expand_column_ids,json=expandColumnIds,proto3" json:"expand_column_ids,omitempty"`
+	// 每条消息的行数，默认 500，上限 5000
+	BatchSize int32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// 同 ListRowsRequest.include_hidden
+	IncludeHidden bool `protobuf:"varint,6,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRowsRequest) Reset() {
+	*x = StreamRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRowsRequest) ProtoMessage() {}
+
+func (x *StreamRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRowsRequest.ProtoReflect.Descriptor instead.
+func (*StreamRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *StreamRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *StreamRowsRequest) GetFilter() *RowFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamRowsRequest) GetSorts() []*SortSpec {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *StreamRowsRequest) GetExpandColumnIds() []string {
+	if x != nil {
+		return x.ExpandColumnIds
+	}
+	return nil
+}
+
+func (x *StreamRowsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *StreamRowsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type StreamRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRowsResponse) Reset() {
+	*x = StreamRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRowsResponse) ProtoMessage() {}
+
+func (x *StreamRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRowsResponse.ProtoReflect.Descriptor instead.
+func (*StreamRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *StreamRowsResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ExportRowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Format  ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=lowcode.v1.ExportFormat" json:"format,omitempty"`
+	Filter  *RowFilter             `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sorts   []*SortSpec            `protobuf:"bytes,4,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// 使用视图的过滤、排序和可见列（同 ListRowsRequest.view_id），与 filter / sorts 合并的规则相同
+	ViewId string `protobuf:"bytes,5,opt,name=view_id,json=viewId,proto3" json:"view_id,omitempty"`
+	// 导出的列及顺序；为空时导出视图的可见列，没有视图时导出全部列（隐藏列见 include_hidden）和系统列
+	ColumnIds     []string `protobuf:"bytes,6,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	IncludeHidden bool     `protobuf:"varint,7,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRowsRequest) Reset() {
+	*x = ExportRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRowsRequest) ProtoMessage() {}
+
+func (x *ExportRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRowsRequest.ProtoReflect.Descriptor instead.
+func (*ExportRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *ExportRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ExportRowsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportRowsRequest) GetFilter() *RowFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportRowsRequest) GetSorts() []*SortSpec {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *ExportRowsRequest) GetViewId() string {
+	if x != nil {
+		return x.ViewId
+	}
+	return ""
+}
+
+func (x *ExportRowsRequest) GetColumnIds() []string {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+func (x *ExportRowsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type ExportRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRowsResponse) Reset() {
+	*x = ExportRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRowsResponse) ProtoMessage() {}
+
+func (x *ExportRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRowsResponse.ProtoReflect.Descriptor instead.
+func (*ExportRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ExportRowsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type SearchRowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Query   string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// 限定搜索的列，为空时搜索所有文本列
+	ColumnIds     []string `protobuf:"bytes,3,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	PageSize      int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRowsRequest) Reset() {
+	*x = SearchRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRowsRequest) ProtoMessage() {}
+
+func (x *SearchRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRowsRequest.ProtoReflect.Descriptor instead.
+func (*SearchRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SearchRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *SearchRowsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRowsRequest) GetColumnIds() []string {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+func (x *SearchRowsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRowsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRowsResponse) Reset() {
+	*x = SearchRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRowsResponse) ProtoMessage() {}
+
+func (x *SearchRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRowsResponse.ProtoReflect.Descriptor instead.
+func (*SearchRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SearchRowsResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *SearchRowsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Aggregation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId      string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Function      AggregateFunction      `protobuf:"varint,2,opt,name=function,proto3,enum=lowcode.v1.AggregateFunction" json:"function,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregation) Reset() {
+	*x = Aggregation{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregation) ProtoMessage() {}
+
+func (x *Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregation.ProtoReflect.Descriptor instead.
+func (*Aggregation) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *Aggregation) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *Aggregation) GetFunction() AggregateFunction {
+	if x != nil {
+		return x.Function
+	}
+	return AggregateFunction_AGGREGATE_FUNCTION_UNSPECIFIED
+}
+
+type AggregateRowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 分组列，为空时对整个（过滤后的）表聚合，只返回一组
+	GroupByColumnIds []string       `protobuf:"bytes,2,rep,name=group_by_column_ids,json=groupByColumnIds,proto3" json:"group_by_column_ids,omitempty"`
+	Aggregations     []*Aggregation `protobuf:"bytes,3,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
+	Filter           *RowFilter     `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 最多返回的分组数，默认/上限同 ListRows 的 page_size
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRowsRequest) Reset() {
+	*x = AggregateRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRowsRequest) ProtoMessage() {}
+
+func (x *AggregateRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRowsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *AggregateRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *AggregateRowsRequest) GetGroupByColumnIds() []string {
+	if x != nil {
+		return x.GroupByColumnIds
+	}
+	return nil
+}
+
+func (x *AggregateRowsRequest) GetAggregations() []*Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *AggregateRowsRequest) GetFilter() *RowFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AggregateRowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AggregateGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分组列 id -> 分组取值（NULL 分组不出现在 map 中）
+	Keys map[string]*Value `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 与 AggregateRowsRequest.aggregations 一一对应，没有非 NULL 值时为 null_value
+	Values        []*Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateGroup) Reset() {
+	*x = AggregateGroup{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroup) ProtoMessage() {}
+
+func (x *AggregateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroup.ProtoReflect.Descriptor instead.
+func (*AggregateGroup) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *AggregateGroup) GetKeys() map[string]*Value {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *AggregateGroup) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AggregateRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*AggregateGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRowsResponse) Reset() {
+	*x = AggregateRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRowsResponse) ProtoMessage() {}
+
+func (x *AggregateRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRowsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *AggregateRowsResponse) GetGroups() []*AggregateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListDistinctValuesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TableId  string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	ColumnId string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	// 默认/上限同 ListRows 的 page_size
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 前缀匹配（不区分大小写），为空表示不过滤
+	Search        string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDistinctValuesRequest) Reset() {
+	*x = ListDistinctValuesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDistinctValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDistinctValuesRequest) ProtoMessage() {}
+
+func (x *ListDistinctValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDistinctValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListDistinctValuesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ListDistinctValuesRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ListDistinctValuesRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *ListDistinctValuesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDistinctValuesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListDistinctValuesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按值升序，不包含 NULL
+	Values        []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDistinctValuesResponse) Reset() {
+	*x = ListDistinctValuesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDistinctValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDistinctValuesResponse) ProtoMessage() {}
+
+func (x *ListDistinctValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDistinctValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListDistinctValuesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListDistinctValuesResponse) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BulkUpsertRowItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowId         string                 `protobuf:"bytes,1,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"` // 为空=insert，否则=update
+	Cells         map[string]*Value      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertRowItem) Reset() {
+	*x = BulkUpsertRowItem{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertRowItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertRowItem) ProtoMessage() {}
+
+func (x *BulkUpsertRowItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertRowItem.ProtoReflect.Descriptor instead.
+func (*BulkUpsertRowItem) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *BulkUpsertRowItem) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *BulkUpsertRowItem) GetCells() map[string]*Value {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type BulkUpsertRowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Items   []*BulkUpsertRowItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// 不带 row_id 的 item 按这些列做 INSERT ... ON CONFLICT DO UPDATE（需要这些列上有唯一索引），
+	// 每个 item 都必须包含这些列
+	ConflictColumnIds []string `protobuf:"bytes,3,rep,name=conflict_column_ids,json=conflictColumnIds,proto3" json:"conflict_column_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkUpsertRowsRequest) Reset() {
+	*x = BulkUpsertRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertRowsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertRowsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *BulkUpsertRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *BulkUpsertRowsRequest) GetItems() []*BulkUpsertRowItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BulkUpsertRowsRequest) GetConflictColumnIds() []string {
+	if x != nil {
+		return x.ConflictColumnIds
+	}
+	return nil
+}
+
+type BulkUpsertRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertRowsResponse) Reset() {
+	*x = BulkUpsertRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertRowsResponse) ProtoMessage() {}
+
+func (x *BulkUpsertRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertRowsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpsertRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *BulkUpsertRowsResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type BulkDeleteRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowIds        []string               `protobuf:"bytes,2,rep,name=row_ids,json=rowIds,proto3" json:"row_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteRowsRequest) Reset() {
+	*x = BulkDeleteRowsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteRowsRequest) ProtoMessage() {}
+
+func (x *BulkDeleteRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteRowsRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteRowsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *BulkDeleteRowsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *BulkDeleteRowsRequest) GetRowIds() []string {
+	if x != nil {
+		return x.RowIds
+	}
+	return nil
+}
+
+type BulkDeleteRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteRowsResponse) Reset() {
+	*x = BulkDeleteRowsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteRowsResponse) ProtoMessage() {}
+
+func (x *BulkDeleteRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteRowsResponse.ProtoReflect.Descriptor instead.
+func (*BulkDeleteRowsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{184}
+}
+
+// -------- Cell content (streaming) --------
+type CellContentInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TableId     string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId       string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	ColumnId    string                 `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename    string                 `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	// 下载时为内容总字节数，上传时忽略
+	Size          int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CellContentInfo) Reset() {
+	*x = CellContentInfo{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellContentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellContentInfo) ProtoMessage() {}
+
+func (x *CellContentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellContentInfo.ProtoReflect.Descriptor instead.
+func (*CellContentInfo) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *CellContentInfo) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CellContentInfo) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *CellContentInfo) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *CellContentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CellContentInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CellContentInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type UploadCellContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadCellContentRequest_Info
+	//	*UploadCellContentRequest_Chunk
+	Payload       isUploadCellContentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCellContentRequest) Reset() {
+	*x = UploadCellContentRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCellContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCellContentRequest) ProtoMessage() {}
+
+func (x *UploadCellContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCellContentRequest.ProtoReflect.Descriptor instead.
+func (*UploadCellContentRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *UploadCellContentRequest) GetPayload() isUploadCellContentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadCellContentRequest) GetInfo() *CellContentInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadCellContentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadCellContentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadCellContentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadCellContentRequest_Payload interface {
+	isUploadCellContentRequest_Payload()
+}
+
+type UploadCellContentRequest_Info struct {
+	Info *CellContentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadCellContentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadCellContentRequest_Info) isUploadCellContentRequest_Payload() {}
+
+func (*UploadCellContentRequest_Chunk) isUploadCellContentRequest_Payload() {}
+
+type UploadCellContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 写入单元格后的值：bytea 列为空（内容直接写入列），jsonb 列为引用 { "blob_id", "size", "content_type", "filename" }
+	Value         *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Size          int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCellContentResponse) Reset() {
+	*x = UploadCellContentResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCellContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCellContentResponse) ProtoMessage() {}
+
+func (x *UploadCellContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCellContentResponse.ProtoReflect.Descriptor instead.
+func (*UploadCellContentResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *UploadCellContentResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *UploadCellContentResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type DownloadCellContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadCellContentRequest) Reset() {
+	*x = DownloadCellContentRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadCellContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCellContentRequest) ProtoMessage() {}
+
+func (x *DownloadCellContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCellContentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadCellContentRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *DownloadCellContentRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *DownloadCellContentRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *DownloadCellContentRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+type DownloadCellContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadCellContentResponse_Info
+	//	*DownloadCellContentResponse_Chunk
+	Payload       isDownloadCellContentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadCellContentResponse) Reset() {
+	*x = DownloadCellContentResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadCellContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCellContentResponse) ProtoMessage() {}
+
+func (x *DownloadCellContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCellContentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadCellContentResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *DownloadCellContentResponse) GetPayload() isDownloadCellContentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadCellContentResponse) GetInfo() *CellContentInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadCellContentResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *DownloadCellContentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadCellContentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadCellContentResponse_Payload interface {
+	isDownloadCellContentResponse_Payload()
+}
+
+type DownloadCellContentResponse_Info struct {
+	Info *CellContentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadCellContentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadCellContentResponse_Info) isDownloadCellContentResponse_Payload() {}
+
+func (*DownloadCellContentResponse_Chunk) isDownloadCellContentResponse_Payload() {}
+
+// -------- Attachment --------
+// attachment 列单元格中的一项（文件元数据），文件本身保存在对象存储中
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename    string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// 对象存储中的 key，由服务生成
+	Key           string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 预签名 URL：客户端用 method 请求 url，并带上 headers
+type PresignedUrl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignedUrl) Reset() {
+	*x = PresignedUrl{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignedUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignedUrl) ProtoMessage() {}
+
+func (x *PresignedUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignedUrl.ProtoReflect.Descriptor instead.
+func (*PresignedUrl) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *PresignedUrl) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PresignedUrl) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PresignedUrl) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PresignedUrl) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateAttachmentUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentUploadRequest) Reset() {
+	*x = CreateAttachmentUploadRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *CreateAttachmentUploadRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CreateAttachmentUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Upload        *PresignedUrl          `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentUploadResponse) Reset() {
+	*x = CreateAttachmentUploadResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentUploadResponse) ProtoMessage() {}
+
+func (x *CreateAttachmentUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentUploadResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *CreateAttachmentUploadResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CreateAttachmentUploadResponse) GetUpload() *PresignedUrl {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+type GetAttachmentUrlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	AttachmentId  string                 `protobuf:"bytes,4,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUrlRequest) Reset() {
+	*x = GetAttachmentUrlRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUrlRequest) ProtoMessage() {}
+
+func (x *GetAttachmentUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUrlRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *GetAttachmentUrlRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *GetAttachmentUrlRequest) GetRowId() string {
+	if x != nil {
+		return x.RowId
+	}
+	return ""
+}
+
+func (x *GetAttachmentUrlRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *GetAttachmentUrlRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type GetAttachmentUrlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Download      *PresignedUrl          `protobuf:"bytes,2,opt,name=download,proto3" json:"download,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUrlResponse) Reset() {
+	*x = GetAttachmentUrlResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUrlResponse) ProtoMessage() {}
+
+func (x *GetAttachmentUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUrlResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *GetAttachmentUrlResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *GetAttachmentUrlResponse) GetDownload() *PresignedUrl {
+	if x != nil {
+		return x.Download
+	}
+	return nil
+}
+
+// -------- Index --------
+type CreateIndexRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 与 expression 二选一
+	ColumnIds []string `protobuf:"bytes,3,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	IsUnique  bool     `protobuf:"varint,4,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	// 可选：表达式索引，如 lower(email)
+	Expression *IndexExpression `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// 可选：部分索引的条件，写法同 ListRows 的 filter；条件中只能使用不可变的比较（如不能对时间列做文本匹配）
+	Where *RowFilter `protobuf:"bytes,6,opt,name=where,proto3" json:"where,omitempty"`
+	// 可选：索引方法，与列类型不匹配时返回 VALIDATION_FAILED；只有 BTREE 支持唯一索引
+	IndexMethod IndexMethod `protobuf:"varint,7,opt,name=index_method,json=indexMethod,proto3,enum=lowcode.v1.IndexMethod" json:"index_method,omitempty"`
+	// 在后台执行，立即返回 operation
+	Async         bool `protobuf:"varint,8,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIndexRequest) Reset() {
+	*x = CreateIndexRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIndexRequest) ProtoMessage() {}
+
+func (x *CreateIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIndexRequest.ProtoReflect.Descriptor instead.
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *CreateIndexRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CreateIndexRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIndexRequest) GetColumnIds() []string {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+func (x *CreateIndexRequest) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *CreateIndexRequest) GetExpression() *IndexExpression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *CreateIndexRequest) GetWhere() *RowFilter {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+func (x *CreateIndexRequest) GetIndexMethod() IndexMethod {
+	if x != nil {
+		return x.IndexMethod
+	}
+	return IndexMethod_INDEX_METHOD_UNSPECIFIED
+}
+
+func (x *CreateIndexRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type CreateIndexResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// async 时为空，结果在 operation 完成后的 response 中
+	Index         *Index     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Operation     *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIndexResponse) Reset() {
+	*x = CreateIndexResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIndexResponse) ProtoMessage() {}
+
+func (x *CreateIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIndexResponse.ProtoReflect.Descriptor instead.
+func (*CreateIndexResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *CreateIndexResponse) GetIndex() *Index {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *CreateIndexResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type UpdateIndexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 新的逻辑名，为空时不改名；物理索引名不变
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// REINDEX 物理索引，用于索引膨胀或损坏
+	Rebuild bool `protobuf:"varint,3,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	// 与 rebuild 一起使用：REINDEX CONCURRENTLY，重建期间不阻塞写入
+	Concurrently bool `protobuf:"varint,4,opt,name=concurrently,proto3" json:"concurrently,omitempty"`
+	// 与 rebuild 一起使用：在后台重建，立即返回 operation（改名仍同步完成）
+	Async         bool `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIndexRequest) Reset() {
+	*x = UpdateIndexRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIndexRequest) ProtoMessage() {}
+
+func (x *UpdateIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIndexRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIndexRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *UpdateIndexRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIndexRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateIndexRequest) GetRebuild() bool {
+	if x != nil {
+		return x.Rebuild
+	}
+	return false
+}
+
+func (x *UpdateIndexRequest) GetConcurrently() bool {
+	if x != nil {
+		return x.Concurrently
+	}
+	return false
+}
+
+func (x *UpdateIndexRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type UpdateIndexResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// async 时为改名后、重建前的索引
+	Index         *Index     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Operation     *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIndexResponse) Reset() {
+	*x = UpdateIndexResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIndexResponse) ProtoMessage() {}
+
+func (x *UpdateIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIndexResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIndexResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *UpdateIndexResponse) GetIndex() *Index {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *UpdateIndexResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type SyncIndexesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 只返回计划的 changes，不做修改
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncIndexesRequest) Reset() {
+	*x = SyncIndexesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncIndexesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncIndexesRequest) ProtoMessage() {}
+
+func (x *SyncIndexesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncIndexesRequest.ProtoReflect.Descriptor instead.
+func (*SyncIndexesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *SyncIndexesRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *SyncIndexesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SyncIndexesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action 为 unregister_index | register_index
+	Changes []*SchemaChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// 同步后表上登记的全部索引；dry_run 时为同步前
+	Indexes       []*Index `protobuf:"bytes,2,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncIndexesResponse) Reset() {
+	*x = SyncIndexesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncIndexesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncIndexesResponse) ProtoMessage() {}
+
+func (x *SyncIndexesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncIndexesResponse.ProtoReflect.Descriptor instead.
+func (*SyncIndexesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *SyncIndexesResponse) GetChanges() []*SchemaChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncIndexesResponse) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type DeleteIndexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIndexRequest) Reset() {
+	*x = DeleteIndexRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIndexRequest) ProtoMessage() {}
+
+func (x *DeleteIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIndexRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIndexRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *DeleteIndexRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIndexResponse) Reset() {
+	*x = DeleteIndexResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIndexResponse) ProtoMessage() {}
+
+func (x *DeleteIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIndexResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIndexResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{203}
+}
+
+type CreateViewRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TableId          string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter           *RowFilter             `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sorts            []*SortSpec            `protobuf:"bytes,4,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	VisibleColumnIds []string               `protobuf:"bytes,5,rep,name=visible_column_ids,json=visibleColumnIds,proto3" json:"visible_column_ids,omitempty"`
+	PageSize         int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateViewRequest) Reset() {
+	*x = CreateViewRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateViewRequest) ProtoMessage() {}
+
+func (x *CreateViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateViewRequest.ProtoReflect.Descriptor instead.
+func (*CreateViewRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *CreateViewRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CreateViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateViewRequest) GetFilter() *RowFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateViewRequest) GetSorts() []*SortSpec {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *CreateViewRequest) GetVisibleColumnIds() []string {
+	if x != nil {
+		return x.VisibleColumnIds
+	}
+	return nil
+}
+
+func (x *CreateViewRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CreateViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *View                  `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateViewResponse) Reset() {
+	*x = CreateViewResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateViewResponse) ProtoMessage() {}
+
+func (x *CreateViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateViewResponse.ProtoReflect.Descriptor instead.
+func (*CreateViewResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *CreateViewResponse) GetView() *View {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+type ListViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListViewsRequest) Reset() {
+	*x = ListViewsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListViewsRequest) ProtoMessage() {}
+
+func (x *ListViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListViewsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *ListViewsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ListViewsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按 name 排序
+	Views         []*View `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListViewsResponse) Reset() {
+	*x = ListViewsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListViewsResponse) ProtoMessage() {}
+
+func (x *ListViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListViewsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *ListViewsResponse) GetViews() []*View {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+type GetViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PgType        string                 `protobuf:"bytes,3,opt,name=pg_type,json=pgType,proto3" json:"pg_type,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Type) Reset() {
-	*x = Type{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[0]
+func (x *GetViewRequest) Reset() {
+	*x = GetViewRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Type) String() string {
+func (x *GetViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Type) ProtoMessage() {}
+func (*GetViewRequest) ProtoMessage() {}
 
-func (x *Type) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[0]
+func (x *GetViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetViewRequest.ProtoReflect.Descriptor instead.
+func (*GetViewRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *GetViewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *View                  `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetViewResponse) Reset() {
+	*x = GetViewResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetViewResponse) ProtoMessage() {}
+
+func (x *GetViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetViewResponse.ProtoReflect.Descriptor instead.
+func (*GetViewResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GetViewResponse) GetView() *View {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+type UpdateViewRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter           *RowFilter             `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sorts            []*SortSpec            `protobuf:"bytes,4,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	VisibleColumnIds []string               `protobuf:"bytes,5,rep,name=visible_column_ids,json=visibleColumnIds,proto3" json:"visible_column_ids,omitempty"`
+	PageSize         int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateViewRequest) Reset() {
+	*x = UpdateViewRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateViewRequest) ProtoMessage() {}
+
+func (x *UpdateViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,80 +14489,75 @@ func (x *Type) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Type.ProtoReflect.Descriptor instead.
-func (*Type) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UpdateViewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateViewRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *Type) GetId() string {
+func (x *UpdateViewRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Type) GetName() string {
+func (x *UpdateViewRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Type) GetPgType() string {
+func (x *UpdateViewRequest) GetFilter() *RowFilter {
 	if x != nil {
-		return x.PgType
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *Type) GetConfig() *structpb.Struct {
+func (x *UpdateViewRequest) GetSorts() []*SortSpec {
 	if x != nil {
-		return x.Config
+		return x.Sorts
 	}
 	return nil
 }
 
-func (x *Type) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateViewRequest) GetVisibleColumnIds() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.VisibleColumnIds
 	}
 	return nil
 }
 
-func (x *Type) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *UpdateViewRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type Table struct {
+type UpdateViewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SchemaName    string                 `protobuf:"bytes,3,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TableName     string                 `protobuf:"bytes,4,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	View          *View                  `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Table) Reset() {
-	*x = Table{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[1]
+func (x *UpdateViewResponse) Reset() {
+	*x = UpdateViewResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Table) String() string {
+func (x *UpdateViewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Table) ProtoMessage() {}
+func (*UpdateViewResponse) ProtoMessage() {}
 
-func (x *Table) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[1]
+func (x *UpdateViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,84 +14568,83 @@ func (x *Table) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Table.ProtoReflect.Descriptor instead.
-func (*Table) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateViewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateViewResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *Table) GetId() string {
+func (x *UpdateViewResponse) GetView() *View {
 	if x != nil {
-		return x.Id
+		return x.View
 	}
-	return ""
+	return nil
 }
 
-func (x *Table) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type DeleteViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Table) GetSchemaName() string {
-	if x != nil {
-		return x.SchemaName
-	}
-	return ""
+func (x *DeleteViewRequest) Reset() {
+	*x = DeleteViewRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Table) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
+func (x *DeleteViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Table) GetCreatedAt() *timestamppb.Timestamp {
+func (*DeleteViewRequest) ProtoMessage() {}
+
+func (x *DeleteViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[212]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Table) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use DeleteViewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteViewRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *DeleteViewRequest) GetId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type Column struct {
+type DeleteViewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	TypeId        string                 `protobuf:"bytes,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
-	PgColumn      string                 `protobuf:"bytes,5,opt,name=pg_column,json=pgColumn,proto3" json:"pg_column,omitempty"`
-	IsNullable    bool                   `protobuf:"varint,6,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
-	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Column) Reset() {
-	*x = Column{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[2]
+func (x *DeleteViewResponse) Reset() {
+	*x = DeleteViewResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Column) String() string {
+func (x *DeleteViewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Column) ProtoMessage() {}
+func (*DeleteViewResponse) ProtoMessage() {}
 
-func (x *Column) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[2]
+func (x *DeleteViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,110 +14655,126 @@ func (x *Column) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Column.ProtoReflect.Descriptor instead.
-func (*Column) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeleteViewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteViewResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *Column) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type ListIndexesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Column) GetTableId() string {
-	if x != nil {
-		return x.TableId
-	}
-	return ""
+func (x *ListIndexesRequest) Reset() {
+	*x = ListIndexesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Column) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ListIndexesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Column) GetTypeId() string {
+func (*ListIndexesRequest) ProtoMessage() {}
+
+func (x *ListIndexesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[214]
 	if x != nil {
-		return x.TypeId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Column) GetPgColumn() string {
+// Deprecated: Use ListIndexesRequest.ProtoReflect.Descriptor instead.
+func (*ListIndexesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *ListIndexesRequest) GetTableId() string {
 	if x != nil {
-		return x.PgColumn
+		return x.TableId
 	}
 	return ""
 }
 
-func (x *Column) GetIsNullable() bool {
-	if x != nil {
-		return x.IsNullable
-	}
-	return false
+type ListIndexesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Indexes       []*Index               `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Column) GetPosition() int32 {
-	if x != nil {
-		return x.Position
-	}
-	return 0
+func (x *ListIndexesResponse) Reset() {
+	*x = ListIndexesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Column) GetConfig() *structpb.Struct {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+func (x *ListIndexesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Column) GetCreatedAt() *timestamppb.Timestamp {
+func (*ListIndexesResponse) ProtoMessage() {}
+
+func (x *ListIndexesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[215]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Column) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ListIndexesResponse.ProtoReflect.Descriptor instead.
+func (*ListIndexesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *ListIndexesResponse) GetIndexes() []*Index {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Indexes
 	}
 	return nil
 }
 
-type Index struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PgIndex       string                 `protobuf:"bytes,4,opt,name=pg_index,json=pgIndex,proto3" json:"pg_index,omitempty"`
-	ColumnIds     []string               `protobuf:"bytes,5,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
-	IsUnique      bool                   `protobuf:"varint,6,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+// -------- Import --------
+type ExternalTableExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 导入后的 table name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// csv | json
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Index) Reset() {
-	*x = Index{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[3]
+func (x *ExternalTableExport) Reset() {
+	*x = ExternalTableExport{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Index) String() string {
+func (x *ExternalTableExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Index) ProtoMessage() {}
+func (*ExternalTableExport) ProtoMessage() {}
 
-func (x *Index) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[3]
+func (x *ExternalTableExport) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,98 +14785,109 @@ func (x *Index) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Index.ProtoReflect.Descriptor instead.
-func (*Index) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ExternalTableExport.ProtoReflect.Descriptor instead.
+func (*ExternalTableExport) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *Index) GetId() string {
+func (x *ExternalTableExport) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Index) GetTableId() string {
+func (x *ExternalTableExport) GetFormat() string {
 	if x != nil {
-		return x.TableId
+		return x.Format
 	}
 	return ""
 }
 
-func (x *Index) GetName() string {
+func (x *ExternalTableExport) GetData() []byte {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Index) GetPgIndex() string {
-	if x != nil {
-		return x.PgIndex
-	}
-	return ""
+type ImportExternalTablesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// airtable | notion
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Tables        []*ExternalTableExport `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Index) GetColumnIds() []string {
-	if x != nil {
-		return x.ColumnIds
-	}
-	return nil
+func (x *ImportExternalTablesRequest) Reset() {
+	*x = ImportExternalTablesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Index) GetIsUnique() bool {
-	if x != nil {
-		return x.IsUnique
-	}
-	return false
+func (x *ImportExternalTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Index) GetCreatedAt() *timestamppb.Timestamp {
+func (*ImportExternalTablesRequest) ProtoMessage() {}
+
+func (x *ImportExternalTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[217]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Index) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+// Deprecated: Use ImportExternalTablesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExternalTablesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{217}
 }
 
-// 通用值类型
-type Value struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Kind:
-	//
-	//	*Value_StringValue
-	//	*Value_NumberValue
-	//	*Value_BoolValue
-	//	*Value_TimestampValue
-	//	*Value_BytesValue
-	//	*Value_JsonValue
-	Kind          isValue_Kind `protobuf_oneof:"kind"`
+func (x *ImportExternalTablesRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportExternalTablesRequest) GetTables() []*ExternalTableExport {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type ImportedTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	RowCount      int32                  `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Value) Reset() {
-	*x = Value{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[4]
+func (x *ImportedTable) Reset() {
+	*x = ImportedTable{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Value) String() string {
+func (x *ImportedTable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value) ProtoMessage() {}
+func (*ImportedTable) ProtoMessage() {}
 
-func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[4]
+func (x *ImportedTable) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,137 +14898,144 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value.ProtoReflect.Descriptor instead.
-func (*Value) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ImportedTable.ProtoReflect.Descriptor instead.
+func (*ImportedTable) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *Value) GetKind() isValue_Kind {
+func (x *ImportedTable) GetTable() *Table {
 	if x != nil {
-		return x.Kind
+		return x.Table
 	}
 	return nil
 }
 
-func (x *Value) GetStringValue() string {
+func (x *ImportedTable) GetColumns() []*Column {
 	if x != nil {
-		if x, ok := x.Kind.(*Value_StringValue); ok {
-			return x.StringValue
-		}
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-func (x *Value) GetNumberValue() float64 {
+func (x *ImportedTable) GetRowCount() int32 {
 	if x != nil {
-		if x, ok := x.Kind.(*Value_NumberValue); ok {
-			return x.NumberValue
-		}
+		return x.RowCount
 	}
 	return 0
 }
 
-func (x *Value) GetBoolValue() bool {
-	if x != nil {
-		if x, ok := x.Kind.(*Value_BoolValue); ok {
-			return x.BoolValue
-		}
-	}
-	return false
+type ImportExternalTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*ImportedTable       `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Value) GetTimestampValue() *timestamppb.Timestamp {
-	if x != nil {
-		if x, ok := x.Kind.(*Value_TimestampValue); ok {
-			return x.TimestampValue
-		}
-	}
-	return nil
+func (x *ImportExternalTablesResponse) Reset() {
+	*x = ImportExternalTablesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Value) GetBytesValue() []byte {
+func (x *ImportExternalTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExternalTablesResponse) ProtoMessage() {}
+
+func (x *ImportExternalTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[219]
 	if x != nil {
-		if x, ok := x.Kind.(*Value_BytesValue); ok {
-			return x.BytesValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Value) GetJsonValue() *structpb.Struct {
+// Deprecated: Use ImportExternalTablesResponse.ProtoReflect.Descriptor instead.
+func (*ImportExternalTablesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *ImportExternalTablesResponse) GetTables() []*ImportedTable {
 	if x != nil {
-		if x, ok := x.Kind.(*Value_JsonValue); ok {
-			return x.JsonValue
-		}
+		return x.Tables
 	}
 	return nil
 }
 
-type isValue_Kind interface {
-	isValue_Kind()
+type ImportDatabaseSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 要扫描的 schema，默认 public
+	Schemas       []string `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type Value_StringValue struct {
-	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+func (x *ImportDatabaseSchemaRequest) Reset() {
+	*x = ImportDatabaseSchemaRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type Value_NumberValue struct {
-	NumberValue float64 `protobuf:"fixed64,2,opt,name=number_value,json=numberValue,proto3,oneof"`
+func (x *ImportDatabaseSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Value_BoolValue struct {
-	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
-}
+func (*ImportDatabaseSchemaRequest) ProtoMessage() {}
 
-type Value_TimestampValue struct {
-	TimestampValue *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp_value,json=timestampValue,proto3,oneof"`
+func (x *ImportDatabaseSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Value_BytesValue struct {
-	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+// Deprecated: Use ImportDatabaseSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ImportDatabaseSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{220}
 }
 
-type Value_JsonValue struct {
-	JsonValue *structpb.Struct `protobuf:"bytes,6,opt,name=json_value,json=jsonValue,proto3,oneof"`
+func (x *ImportDatabaseSchemaRequest) GetSchemas() []string {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
 }
 
-func (*Value_StringValue) isValue_Kind() {}
-
-func (*Value_NumberValue) isValue_Kind() {}
-
-func (*Value_BoolValue) isValue_Kind() {}
-
-func (*Value_TimestampValue) isValue_Kind() {}
-
-func (*Value_BytesValue) isValue_Kind() {}
-
-func (*Value_JsonValue) isValue_Kind() {}
-
-// 一行数据，cells 的 key = column_id
-// 当 ListRows 指定了 expand_column_ids 时，对应 relationship 列的 cell 值为 json_value：{ "rows": [ { "id", "cells" }, ... ] }，一对多为多项，一对一为一项
-type Row struct {
+type AdoptedTable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cells         map[string]*Value      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Row) Reset() {
-	*x = Row{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[5]
+func (x *AdoptedTable) Reset() {
+	*x = AdoptedTable{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Row) String() string {
+func (x *AdoptedTable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Row) ProtoMessage() {}
+func (*AdoptedTable) ProtoMessage() {}
 
-func (x *Row) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[5]
+func (x *AdoptedTable) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,49 +15046,50 @@ func (x *Row) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Row.ProtoReflect.Descriptor instead.
-func (*Row) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AdoptedTable.ProtoReflect.Descriptor instead.
+func (*AdoptedTable) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *Row) GetId() string {
+func (x *AdoptedTable) GetTable() *Table {
 	if x != nil {
-		return x.Id
+		return x.Table
 	}
-	return ""
+	return nil
 }
 
-func (x *Row) GetCells() map[string]*Value {
+func (x *AdoptedTable) GetColumns() []*Column {
 	if x != nil {
-		return x.Cells
+		return x.Columns
 	}
 	return nil
 }
 
-// -------- Tenant --------
-type CreateTenantRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 新 tenant 的唯一标识，用于拼接数据库名（由后端配置决定具体格式）
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// 无法接管的表（如主键不是单列 id）
+type SkippedTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaName    string                 `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantRequest) Reset() {
-	*x = CreateTenantRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[6]
+func (x *SkippedTable) Reset() {
+	*x = SkippedTable{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTenantRequest) String() string {
+func (x *SkippedTable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantRequest) ProtoMessage() {}
+func (*SkippedTable) ProtoMessage() {}
 
-func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[6]
+func (x *SkippedTable) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,40 +15100,55 @@ func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
-func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SkippedTable.ProtoReflect.Descriptor instead.
+func (*SkippedTable) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *CreateTenantRequest) GetId() string {
+func (x *SkippedTable) GetSchemaName() string {
 	if x != nil {
-		return x.Id
+		return x.SchemaName
 	}
 	return ""
 }
 
-type CreateTenantResponse struct {
+func (x *SkippedTable) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *SkippedTable) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportDatabaseSchemaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tables        []*AdoptedTable        `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Skipped       []*SkippedTable        `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantResponse) Reset() {
-	*x = CreateTenantResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[7]
+func (x *ImportDatabaseSchemaResponse) Reset() {
+	*x = ImportDatabaseSchemaResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTenantResponse) String() string {
+func (x *ImportDatabaseSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantResponse) ProtoMessage() {}
+func (*ImportDatabaseSchemaResponse) ProtoMessage() {}
 
-func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[7]
+func (x *ImportDatabaseSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,43 +15159,55 @@ func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
-func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportDatabaseSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ImportDatabaseSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *CreateTenantResponse) GetId() string {
+func (x *ImportDatabaseSchemaResponse) GetTables() []*AdoptedTable {
 	if x != nil {
-		return x.Id
+		return x.Tables
 	}
-	return ""
+	return nil
 }
 
-// -------- Type --------
-type CreateTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PgType        string                 `protobuf:"bytes,2,opt,name=pg_type,json=pgType,proto3" json:"pg_type,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+func (x *ImportDatabaseSchemaResponse) GetSkipped() []*SkippedTable {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type CreateSQLViewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 逻辑表名，规则同 CreateTable；物理视图为 lc_v_<name>
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 默认 public
+	SchemaName string `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	// 单条 SELECT（或 WITH ... SELECT），只能读取已注册的表和 SQL 视图；结果必须包含唯一的 id 列（uuid 或 text）
+	Sql string `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+	// 创建物化视图：数据在创建和 RefreshSQLView 时计算
+	Materialized  bool   `protobuf:"varint,4,opt,name=materialized,proto3" json:"materialized,omitempty"`
+	WorkspaceId   string `protobuf:"bytes,5,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTypeRequest) Reset() {
-	*x = CreateTypeRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[8]
+func (x *CreateSQLViewRequest) Reset() {
+	*x = CreateSQLViewRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTypeRequest) String() string {
+func (x *CreateSQLViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTypeRequest) ProtoMessage() {}
+func (*CreateSQLViewRequest) ProtoMessage() {}
 
-func (x *CreateTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[8]
+func (x *CreateSQLViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,54 +15218,70 @@ func (x *CreateTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateTypeRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateSQLViewRequest.ProtoReflect.Descriptor instead.
+func (*CreateSQLViewRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *CreateTypeRequest) GetName() string {
+func (x *CreateSQLViewRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateTypeRequest) GetPgType() string {
+func (x *CreateSQLViewRequest) GetSchemaName() string {
 	if x != nil {
-		return x.PgType
+		return x.SchemaName
 	}
 	return ""
 }
 
-func (x *CreateTypeRequest) GetConfig() *structpb.Struct {
+func (x *CreateSQLViewRequest) GetSql() string {
 	if x != nil {
-		return x.Config
+		return x.Sql
 	}
-	return nil
+	return ""
 }
 
-type CreateTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          *Type                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *CreateSQLViewRequest) GetMaterialized() bool {
+	if x != nil {
+		return x.Materialized
+	}
+	return false
+}
+
+func (x *CreateSQLViewRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type CreateSQLViewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Table *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// 按结果列生成的只读列
+	Columns       []*Column `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTypeResponse) Reset() {
-	*x = CreateTypeResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[9]
+func (x *CreateSQLViewResponse) Reset() {
+	*x = CreateSQLViewResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTypeResponse) String() string {
+func (x *CreateSQLViewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTypeResponse) ProtoMessage() {}
+func (*CreateSQLViewResponse) ProtoMessage() {}
 
-func (x *CreateTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[9]
+func (x *CreateSQLViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,39 +15292,49 @@ func (x *CreateTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTypeResponse.ProtoReflect.Descriptor instead.
-func (*CreateTypeResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateSQLViewResponse.ProtoReflect.Descriptor instead.
+func (*CreateSQLViewResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *CreateTypeResponse) GetType() *Type {
+func (x *CreateSQLViewResponse) GetTable() *Table {
 	if x != nil {
-		return x.Type
+		return x.Table
 	}
 	return nil
 }
 
-type ListTypesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *CreateSQLViewResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type RefreshSQLViewRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// REFRESH MATERIALIZED VIEW CONCURRENTLY：刷新期间不阻塞读取
+	Concurrently  bool `protobuf:"varint,2,opt,name=concurrently,proto3" json:"concurrently,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTypesRequest) Reset() {
-	*x = ListTypesRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[10]
+func (x *RefreshSQLViewRequest) Reset() {
+	*x = RefreshSQLViewRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTypesRequest) String() string {
+func (x *RefreshSQLViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTypesRequest) ProtoMessage() {}
+func (*RefreshSQLViewRequest) ProtoMessage() {}
 
-func (x *ListTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[10]
+func (x *RefreshSQLViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,33 +15345,47 @@ func (x *ListTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListTypesRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RefreshSQLViewRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSQLViewRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{226}
 }
 
-type ListTypesResponse struct {
+func (x *RefreshSQLViewRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *RefreshSQLViewRequest) GetConcurrently() bool {
+	if x != nil {
+		return x.Concurrently
+	}
+	return false
+}
+
+type RefreshSQLViewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Types         []*Type                `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTypesResponse) Reset() {
-	*x = ListTypesResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[11]
+func (x *RefreshSQLViewResponse) Reset() {
+	*x = RefreshSQLViewResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTypesResponse) String() string {
+func (x *RefreshSQLViewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTypesResponse) ProtoMessage() {}
+func (*RefreshSQLViewResponse) ProtoMessage() {}
 
-func (x *ListTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[11]
+func (x *RefreshSQLViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,40 +15396,46 @@ func (x *ListTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListTypesResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RefreshSQLViewResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSQLViewResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *ListTypesResponse) GetTypes() []*Type {
+func (x *RefreshSQLViewResponse) GetTable() *Table {
 	if x != nil {
-		return x.Types
+		return x.Table
 	}
 	return nil
 }
 
-type DeleteTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ImportExistingTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 默认 public
+	SchemaName string `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// 注册后的逻辑 name，默认同 ImportDatabaseSchema：public 下为表名，其它 schema 为 "<schema>_<table>"
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 物理列名 -> type id，覆盖默认的类型映射（如 text 列映射为 email）。类型必须与物理列属于同一类（文本 / 数值 / 布尔 / 时间 / JSON）
+	ColumnTypes   map[string]string `protobuf:"bytes,4,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTypeRequest) Reset() {
-	*x = DeleteTypeRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[12]
+func (x *ImportExistingTableRequest) Reset() {
+	*x = ImportExistingTableRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTypeRequest) String() string {
+func (x *ImportExistingTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTypeRequest) ProtoMessage() {}
+func (*ImportExistingTableRequest) ProtoMessage() {}
 
-func (x *DeleteTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[12]
+func (x *ImportExistingTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,39 +15446,65 @@ func (x *DeleteTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTypeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTypeRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ImportExistingTableRequest.ProtoReflect.Descriptor instead.
+func (*ImportExistingTableRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *DeleteTypeRequest) GetId() string {
+func (x *ImportExistingTableRequest) GetSchemaName() string {
 	if x != nil {
-		return x.Id
+		return x.SchemaName
 	}
 	return ""
 }
 
-type DeleteTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportExistingTableRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
 }
 
-func (x *DeleteTypeResponse) Reset() {
-	*x = DeleteTypeResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[13]
+func (x *ImportExistingTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportExistingTableRequest) GetColumnTypes() map[string]string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+type ImportExistingTableResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Table *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// 含由外键生成的多对一 relationship 列
+	Columns []*Column `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// 被引用的已注册表上新增的一对多 relationship 列
+	RelatedColumns []*Column `protobuf:"bytes,3,rep,name=related_columns,json=relatedColumns,proto3" json:"related_columns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportExistingTableResponse) Reset() {
+	*x = ImportExistingTableResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTypeResponse) String() string {
+func (x *ImportExistingTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTypeResponse) ProtoMessage() {}
+func (*ImportExistingTableResponse) ProtoMessage() {}
 
-func (x *DeleteTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[13]
+func (x *ImportExistingTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,35 +15515,72 @@ func (x *DeleteTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTypeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTypeResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ImportExistingTableResponse.ProtoReflect.Descriptor instead.
+func (*ImportExistingTableResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{229}
 }
 
-// -------- Table --------
-type CreateTableRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	SchemaName    string                 `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportExistingTableResponse) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
 }
 
-func (x *CreateTableRequest) Reset() {
-	*x = CreateTableRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[14]
+func (x *ImportExistingTableResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportExistingTableResponse) GetRelatedColumns() []*Column {
+	if x != nil {
+		return x.RelatedColumns
+	}
+	return nil
+}
+
+// 后台执行的长时间操作，记录在 lc_operations
+type Operation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 发起操作的 RPC，如 CreateIndex
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 操作的对象，如 table id / column id
+	Target string          `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Status OperationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=lowcode.v1.OperationStatus" json:"status,omitempty"`
+	// status 不是 RUNNING 时为 true
+	Done bool `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	// SUCCEEDED 时为原 RPC 的响应（JSON 形式，同 HTTP 接口的返回）
+	Response *structpb.Struct `protobuf:"bytes,6,opt,name=response,proto3" json:"response,omitempty"`
+	// FAILED 时的错误码和信息
+	ErrorCode    ErrorCode `protobuf:"varint,7,opt,name=error_code,json=errorCode,proto3,enum=lowcode.v1.ErrorCode" json:"error_code,omitempty"`
+	ErrorMessage string    `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 已请求取消，尚未停止
+	CancelRequested bool                   `protobuf:"varint,9,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTableRequest) String() string {
+func (x *Operation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTableRequest) ProtoMessage() {}
+func (*Operation) ProtoMessage() {}
 
-func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[14]
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,47 +15591,117 @@ func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
-func (*CreateTableRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *CreateTableRequest) GetName() string {
+func (x *Operation) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateTableRequest) GetSchemaName() string {
+func (x *Operation) GetKind() string {
 	if x != nil {
-		return x.SchemaName
+		return x.Kind
 	}
 	return ""
 }
 
-type CreateTableResponse struct {
+func (x *Operation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Operation) GetStatus() OperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OperationStatus_OPERATION_STATUS_UNSPECIFIED
+}
+
+func (x *Operation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *Operation) GetResponse() *structpb.Struct {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *Operation) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *Operation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Operation) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type GetOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTableResponse) Reset() {
-	*x = CreateTableResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[15]
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTableResponse) String() string {
+func (x *GetOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTableResponse) ProtoMessage() {}
+func (*GetOperationRequest) ProtoMessage() {}
 
-func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[15]
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,40 +15712,40 @@ func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTableResponse.ProtoReflect.Descriptor instead.
-func (*CreateTableResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *CreateTableResponse) GetTable() *Table {
+func (x *GetOperationRequest) GetId() string {
 	if x != nil {
-		return x.Table
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteTableRequest struct {
+type GetOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTableRequest) Reset() {
-	*x = DeleteTableRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[16]
+func (x *GetOperationResponse) Reset() {
+	*x = GetOperationResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTableRequest) String() string {
+func (x *GetOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTableRequest) ProtoMessage() {}
+func (*GetOperationResponse) ProtoMessage() {}
 
-func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[16]
+func (x *GetOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,39 +15756,48 @@ func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *DeleteTableRequest) GetId() string {
+func (x *GetOperationResponse) GetOperation() *Operation {
 	if x != nil {
-		return x.Id
+		return x.Operation
 	}
-	return ""
+	return nil
 }
 
-type DeleteTableResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListOperationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只返回该 kind 的操作
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 只返回该 target 的操作
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// 只返回该状态的操作
+	Status OperationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=lowcode.v1.OperationStatus" json:"status,omitempty"`
+	// 默认 50，上限 1000；按创建时间倒序
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTableResponse) Reset() {
-	*x = DeleteTableResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[17]
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTableResponse) String() string {
+func (x *ListOperationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTableResponse) ProtoMessage() {}
+func (*ListOperationsRequest) ProtoMessage() {}
 
-func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[17]
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,32 +15808,69 @@ func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTableResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTableResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{233}
 }
 
-type ListTablesRequest struct {
+func (x *ListOperationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetStatus() OperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OperationStatus_OPERATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListOperationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOperationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operations    []*Operation           `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTablesRequest) Reset() {
-	*x = ListTablesRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[18]
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTablesRequest) String() string {
+func (x *ListOperationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTablesRequest) ProtoMessage() {}
+func (*ListOperationsResponse) ProtoMessage() {}
 
-func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[18]
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,33 +15881,47 @@ func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
-func (*ListTablesRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{234}
 }
 
-type ListTablesResponse struct {
+func (x *ListOperationsResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *ListOperationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelOperationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tables        []*Table               `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTablesResponse) Reset() {
-	*x = ListTablesResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[19]
+func (x *CancelOperationRequest) Reset() {
+	*x = CancelOperationRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTablesResponse) String() string {
+func (x *CancelOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTablesResponse) ProtoMessage() {}
+func (*CancelOperationRequest) ProtoMessage() {}
 
-func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[19]
+func (x *CancelOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,41 +15932,40 @@ func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
-func (*ListTablesResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CancelOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOperationRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *ListTablesResponse) GetTables() []*Table {
+func (x *CancelOperationRequest) GetId() string {
 	if x != nil {
-		return x.Tables
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// 获取 table + columns + indexes
-type GetTableSchemaRequest struct {
+type CancelOperationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTableSchemaRequest) Reset() {
-	*x = GetTableSchemaRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[20]
+func (x *CancelOperationResponse) Reset() {
+	*x = CancelOperationResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTableSchemaRequest) String() string {
+func (x *CancelOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableSchemaRequest) ProtoMessage() {}
+func (*CancelOperationResponse) ProtoMessage() {}
 
-func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[20]
+func (x *CancelOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,42 +15976,44 @@ func (x *GetTableSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableSchemaRequest.ProtoReflect.Descriptor instead.
-func (*GetTableSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CancelOperationResponse.ProtoReflect.Descriptor instead.
+func (*CancelOperationResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *GetTableSchemaRequest) GetTableId() string {
+func (x *CancelOperationResponse) GetOperation() *Operation {
 	if x != nil {
-		return x.TableId
+		return x.Operation
 	}
-	return ""
+	return nil
 }
 
-type GetTableSchemaResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Table         *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
-	Indexes       []*Index               `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+type Member struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 与 token 中 OIDC_USER_CLAIM 的值相同
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=lowcode.v1.Role" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTableSchemaResponse) Reset() {
-	*x = GetTableSchemaResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[21]
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTableSchemaResponse) String() string {
+func (x *Member) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableSchemaResponse) ProtoMessage() {}
+func (*Member) ProtoMessage() {}
 
-func (x *GetTableSchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[21]
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,60 +16024,60 @@ func (x *GetTableSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableSchemaResponse.ProtoReflect.Descriptor instead.
-func (*GetTableSchemaResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *GetTableSchemaResponse) GetTable() *Table {
+func (x *Member) GetUserId() string {
 	if x != nil {
-		return x.Table
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTableSchemaResponse) GetColumns() []*Column {
+func (x *Member) GetRole() Role {
 	if x != nil {
-		return x.Columns
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *Member) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *GetTableSchemaResponse) GetIndexes() []*Index {
+func (x *Member) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Indexes
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-// -------- Column --------
-type AddColumnRequest struct {
+type ListMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TypeId        string                 `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
-	IsNullable    bool                   `protobuf:"varint,4,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
-	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddColumnRequest) Reset() {
-	*x = AddColumnRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[22]
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddColumnRequest) String() string {
+func (x *ListMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddColumnRequest) ProtoMessage() {}
+func (*ListMembersRequest) ProtoMessage() {}
 
-func (x *AddColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[22]
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,75 +16088,79 @@ func (x *AddColumnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddColumnRequest.ProtoReflect.Descriptor instead.
-func (*AddColumnRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *AddColumnRequest) GetTableId() string {
-	if x != nil {
-		return x.TableId
-	}
-	return ""
+type ListMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按 user_id 排序
+	Members       []*Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddColumnRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddColumnRequest) GetTypeId() string {
-	if x != nil {
-		return x.TypeId
-	}
-	return ""
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddColumnRequest) GetIsNullable() bool {
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[239]
 	if x != nil {
-		return x.IsNullable
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *AddColumnRequest) GetPosition() int32 {
-	if x != nil {
-		return x.Position
-	}
-	return 0
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *AddColumnRequest) GetConfig() *structpb.Struct {
+func (x *ListMembersResponse) GetMembers() []*Member {
 	if x != nil {
-		return x.Config
+		return x.Members
 	}
 	return nil
 }
 
-type AddColumnResponse struct {
+type SetMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Column        *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=lowcode.v1.Role" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddColumnResponse) Reset() {
-	*x = AddColumnResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[23]
+func (x *SetMemberRequest) Reset() {
+	*x = SetMemberRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddColumnResponse) String() string {
+func (x *SetMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddColumnResponse) ProtoMessage() {}
+func (*SetMemberRequest) ProtoMessage() {}
 
-func (x *AddColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[23]
+func (x *SetMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,44 +16171,47 @@ func (x *AddColumnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddColumnResponse.ProtoReflect.Descriptor instead.
-func (*AddColumnResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SetMemberRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *AddColumnResponse) GetColumn() *Column {
+func (x *SetMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Column
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type UpdateColumnRequest struct {
+func (x *SetMemberRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type SetMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsNullable    bool                   `protobuf:"varint,3,opt,name=is_nullable,json=isNullable,proto3" json:"is_nullable,omitempty"`
-	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateColumnRequest) Reset() {
-	*x = UpdateColumnRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[24]
+func (x *SetMemberResponse) Reset() {
+	*x = SetMemberResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateColumnRequest) String() string {
+func (x *SetMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateColumnRequest) ProtoMessage() {}
+func (*SetMemberResponse) ProtoMessage() {}
 
-func (x *UpdateColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[24]
+func (x *SetMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1492,68 +16222,83 @@ func (x *UpdateColumnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateColumnRequest.ProtoReflect.Descriptor instead.
-func (*UpdateColumnRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SetMemberResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *UpdateColumnRequest) GetId() string {
+func (x *SetMemberResponse) GetMember() *Member {
 	if x != nil {
-		return x.Id
+		return x.Member
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateColumnRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type DeleteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateColumnRequest) GetIsNullable() bool {
-	if x != nil {
-		return x.IsNullable
-	}
-	return false
+func (x *DeleteMemberRequest) Reset() {
+	*x = DeleteMemberRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateColumnRequest) GetPosition() int32 {
+func (x *DeleteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberRequest) ProtoMessage() {}
+
+func (x *DeleteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[242]
 	if x != nil {
-		return x.Position
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateColumnRequest) GetConfig() *structpb.Struct {
+// Deprecated: Use DeleteMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *DeleteMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Config
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type UpdateColumnResponse struct {
+type DeleteMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Column        *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateColumnResponse) Reset() {
-	*x = UpdateColumnResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[25]
+func (x *DeleteMemberResponse) Reset() {
+	*x = DeleteMemberResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateColumnResponse) String() string {
+func (x *DeleteMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateColumnResponse) ProtoMessage() {}
+func (*DeleteMemberResponse) ProtoMessage() {}
 
-func (x *UpdateColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[25]
+func (x *DeleteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,40 +16309,45 @@ func (x *UpdateColumnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateColumnResponse.ProtoReflect.Descriptor instead.
-func (*UpdateColumnResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *UpdateColumnResponse) GetColumn() *Column {
-	if x != nil {
-		return x.Column
-	}
-	return nil
-}
-
-type DeleteColumnRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Deprecated: Use DeleteMemberResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{243}
+}
+
+// 表 / 列的读写规则。对一个列，按 用户+列 > 角色+列 > 用户+表 > 角色+表 的顺序取第一条匹配的规则，
+// 都没有时按角色的默认权限（viewer 只读，editor 以上读写）；同级的 allow 和 deny 以 deny 为准。
+// owner 和平台管理员不受规则限制
+type Permission struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TableId string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 为空时作用于整张表
+	ColumnId string `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	// role 和 user_id 二选一：规则作用于持有该角色（不含更高角色）的成员，或单个用户
+	Role          Role                   `protobuf:"varint,4,opt,name=role,proto3,enum=lowcode.v1.Role" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Access        PermissionAccess       `protobuf:"varint,6,opt,name=access,proto3,enum=lowcode.v1.PermissionAccess" json:"access,omitempty"`
+	Effect        PermissionEffect       `protobuf:"varint,7,opt,name=effect,proto3,enum=lowcode.v1.PermissionEffect" json:"effect,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteColumnRequest) Reset() {
-	*x = DeleteColumnRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[26]
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteColumnRequest) String() string {
+func (x *Permission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteColumnRequest) ProtoMessage() {}
+func (*Permission) ProtoMessage() {}
 
-func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[26]
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,76 +16358,89 @@ func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteColumnRequest.ProtoReflect.Descriptor instead.
-func (*DeleteColumnRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *DeleteColumnRequest) GetId() string {
+func (x *Permission) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteColumnResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Permission) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
 }
 
-func (x *DeleteColumnResponse) Reset() {
-	*x = DeleteColumnResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Permission) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
 }
 
-func (x *DeleteColumnResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Permission) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
 }
 
-func (*DeleteColumnResponse) ProtoMessage() {}
+func (x *Permission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
 
-func (x *DeleteColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[27]
+func (x *Permission) GetAccess() PermissionAccess {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Access
 	}
-	return mi.MessageOf(x)
+	return PermissionAccess_PERMISSION_ACCESS_UNSPECIFIED
 }
 
-// Deprecated: Use DeleteColumnResponse.ProtoReflect.Descriptor instead.
-func (*DeleteColumnResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{27}
+func (x *Permission) GetEffect() PermissionEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return PermissionEffect_PERMISSION_EFFECT_UNSPECIFIED
 }
 
-type ListColumnsRequest struct {
+func (x *Permission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListColumnsRequest) Reset() {
-	*x = ListColumnsRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[28]
+func (x *ListPermissionsRequest) Reset() {
+	*x = ListPermissionsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListColumnsRequest) String() string {
+func (x *ListPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListColumnsRequest) ProtoMessage() {}
+func (*ListPermissionsRequest) ProtoMessage() {}
 
-func (x *ListColumnsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[28]
+func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1688,40 +16451,40 @@ func (x *ListColumnsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListColumnsRequest.ProtoReflect.Descriptor instead.
-func (*ListColumnsRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *ListColumnsRequest) GetTableId() string {
+func (x *ListPermissionsRequest) GetTableId() string {
 	if x != nil {
 		return x.TableId
 	}
 	return ""
 }
 
-type ListColumnsResponse struct {
+type ListPermissionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Columns       []*Column              `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListColumnsResponse) Reset() {
-	*x = ListColumnsResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[29]
+func (x *ListPermissionsResponse) Reset() {
+	*x = ListPermissionsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListColumnsResponse) String() string {
+func (x *ListPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListColumnsResponse) ProtoMessage() {}
+func (*ListPermissionsResponse) ProtoMessage() {}
 
-func (x *ListColumnsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[29]
+func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,42 +16495,45 @@ func (x *ListColumnsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListColumnsResponse.ProtoReflect.Descriptor instead.
-func (*ListColumnsResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *ListColumnsResponse) GetColumns() []*Column {
+func (x *ListPermissionsResponse) GetPermissions() []*Permission {
 	if x != nil {
-		return x.Columns
+		return x.Permissions
 	}
 	return nil
 }
 
-// -------- Row / Cell --------
-type CreateRowRequest struct {
+type SetPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Cells         map[string]*Value      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ColumnId      string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=lowcode.v1.Role" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Access        PermissionAccess       `protobuf:"varint,5,opt,name=access,proto3,enum=lowcode.v1.PermissionAccess" json:"access,omitempty"`
+	Effect        PermissionEffect       `protobuf:"varint,6,opt,name=effect,proto3,enum=lowcode.v1.PermissionEffect" json:"effect,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRowRequest) Reset() {
-	*x = CreateRowRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[30]
+func (x *SetPermissionRequest) Reset() {
+	*x = SetPermissionRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRowRequest) String() string {
+func (x *SetPermissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRowRequest) ProtoMessage() {}
+func (*SetPermissionRequest) ProtoMessage() {}
 
-func (x *CreateRowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[30]
+func (x *SetPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,47 +16544,75 @@ func (x *CreateRowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRowRequest.ProtoReflect.Descriptor instead.
-func (*CreateRowRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SetPermissionRequest.ProtoReflect.Descriptor instead.
+func (*SetPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *CreateRowRequest) GetTableId() string {
+func (x *SetPermissionRequest) GetTableId() string {
 	if x != nil {
 		return x.TableId
 	}
 	return ""
 }
 
-func (x *CreateRowRequest) GetCells() map[string]*Value {
+func (x *SetPermissionRequest) GetColumnId() string {
 	if x != nil {
-		return x.Cells
+		return x.ColumnId
 	}
-	return nil
+	return ""
 }
 
-type CreateRowResponse struct {
+func (x *SetPermissionRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *SetPermissionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetPermissionRequest) GetAccess() PermissionAccess {
+	if x != nil {
+		return x.Access
+	}
+	return PermissionAccess_PERMISSION_ACCESS_UNSPECIFIED
+}
+
+func (x *SetPermissionRequest) GetEffect() PermissionEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return PermissionEffect_PERMISSION_EFFECT_UNSPECIFIED
+}
+
+type SetPermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
+	Permission    *Permission            `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRowResponse) Reset() {
-	*x = CreateRowResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[31]
+func (x *SetPermissionResponse) Reset() {
+	*x = SetPermissionResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRowResponse) String() string {
+func (x *SetPermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRowResponse) ProtoMessage() {}
+func (*SetPermissionResponse) ProtoMessage() {}
 
-func (x *CreateRowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[31]
+func (x *SetPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,42 +16623,40 @@ func (x *CreateRowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRowResponse.ProtoReflect.Descriptor instead.
-func (*CreateRowResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SetPermissionResponse.ProtoReflect.Descriptor instead.
+func (*SetPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{248}
 }
 
-func (x *CreateRowResponse) GetRow() *Row {
+func (x *SetPermissionResponse) GetPermission() *Permission {
 	if x != nil {
-		return x.Row
+		return x.Permission
 	}
 	return nil
 }
 
-type UpdateRowRequest struct {
+type DeletePermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
-	Cells         map[string]*Value      `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRowRequest) Reset() {
-	*x = UpdateRowRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[32]
+func (x *DeletePermissionRequest) Reset() {
+	*x = DeletePermissionRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRowRequest) String() string {
+func (x *DeletePermissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRowRequest) ProtoMessage() {}
+func (*DeletePermissionRequest) ProtoMessage() {}
 
-func (x *UpdateRowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[32]
+func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,54 +16667,39 @@ func (x *UpdateRowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRowRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRowRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *UpdateRowRequest) GetTableId() string {
-	if x != nil {
-		return x.TableId
-	}
-	return ""
+// Deprecated: Use DeletePermissionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *UpdateRowRequest) GetRowId() string {
+func (x *DeletePermissionRequest) GetId() string {
 	if x != nil {
-		return x.RowId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateRowRequest) GetCells() map[string]*Value {
-	if x != nil {
-		return x.Cells
-	}
-	return nil
-}
-
-type UpdateRowResponse struct {
+type DeletePermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Row           *Row                   `protobuf:"bytes,1,opt,name=row,proto3" json:"row,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRowResponse) Reset() {
-	*x = UpdateRowResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[33]
+func (x *DeletePermissionResponse) Reset() {
+	*x = DeletePermissionResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRowResponse) String() string {
+func (x *DeletePermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRowResponse) ProtoMessage() {}
+func (*DeletePermissionResponse) ProtoMessage() {}
 
-func (x *UpdateRowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[33]
+func (x *DeletePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1933,41 +16710,48 @@ func (x *UpdateRowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRowResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRowResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *UpdateRowResponse) GetRow() *Row {
-	if x != nil {
-		return x.Row
-	}
-	return nil
+// Deprecated: Use DeletePermissionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{250}
 }
 
-type DeleteRowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	RowId         string                 `protobuf:"bytes,2,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"`
+type AuditEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 调用方的用户 id，未开启认证且没有 X-User-Id 时为空
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// RPC 方法名，如 UpdateRow
+	Method  string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	TableId string `protobuf:"bytes,5,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 受影响的对象 id：请求中的表、行、列等 id，以及服务写入的行 id
+	ResourceIds []string `protobuf:"bytes,6,rep,name=resource_ids,json=resourceIds,proto3" json:"resource_ids,omitempty"`
+	// 结果：成功为 OK，失败时为错误码（ErrorCode 名），如 PERMISSION_DENIED
+	Code  string `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// 请求内容（JSON），超过 64KB 时只记录 {"truncated": true, "bytes": n}
+	Request *structpb.Struct `protobuf:"bytes,9,opt,name=request,proto3" json:"request,omitempty"`
+	// 行数据的变化：{ "<row_id>": { "<column_id>": { "before": ..., "after": ... } } }，只包含写入的列
+	Diff          *structpb.Struct `protobuf:"bytes,10,opt,name=diff,proto3" json:"diff,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRowRequest) Reset() {
-	*x = DeleteRowRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[34]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRowRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRowRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *DeleteRowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[34]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,46 +16762,113 @@ func (x *DeleteRowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRowRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRowRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *DeleteRowRequest) GetTableId() string {
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTableId() string {
 	if x != nil {
 		return x.TableId
 	}
 	return ""
 }
 
-func (x *DeleteRowRequest) GetRowId() string {
+func (x *AuditEvent) GetResourceIds() []string {
 	if x != nil {
-		return x.RowId
+		return x.ResourceIds
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-type DeleteRowResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequest() *structpb.Struct {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetDiff() *structpb.Struct {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Method  string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	TableId string                 `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 只返回 resource_ids 包含该 id 的事件
+	ResourceId string `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// 时间范围 [start_time, end_time)
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 默认 50，上限 1000；按时间倒序
+	PageSize      int32  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRowResponse) Reset() {
-	*x = DeleteRowResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[35]
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRowResponse) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRowResponse) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *DeleteRowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[35]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,103 +16879,90 @@ func (x *DeleteRowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRowResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRowResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{252}
 }
 
-type ListRowsRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	TableId   string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// 要展开的 relationship 列 id 列表，返回时每行会带对应子表/关联表数据（一对多=多行，一对一=单行）
-	ExpandColumnIds []string `protobuf:"bytes,4,rep,name=expand_column_ids,json=expandColumnIds,proto3" json:"expand_column_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ListAuditEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *ListRowsRequest) Reset() {
-	*x = ListRowsRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListAuditEventsRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
 }
 
-func (x *ListRowsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListAuditEventsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
 }
-
-func (*ListRowsRequest) ProtoMessage() {}
-
-func (x *ListRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[36]
+
+func (x *ListAuditEventsRequest) GetResourceId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ResourceId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListRowsRequest.ProtoReflect.Descriptor instead.
-func (*ListRowsRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{36}
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (x *ListRowsRequest) GetTableId() string {
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TableId
+		return x.EndTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ListRowsRequest) GetPageSize() int32 {
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListRowsRequest) GetPageToken() string {
+func (x *ListAuditEventsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListRowsRequest) GetExpandColumnIds() []string {
-	if x != nil {
-		return x.ExpandColumnIds
-	}
-	return nil
-}
-
-type ListRowsResponse struct {
+type ListAuditEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRowsResponse) Reset() {
-	*x = ListRowsResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[37]
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRowsResponse) String() string {
+func (x *ListAuditEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRowsResponse) ProtoMessage() {}
+func (*ListAuditEventsResponse) ProtoMessage() {}
 
-func (x *ListRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[37]
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,48 +16973,56 @@ func (x *ListRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRowsResponse.ProtoReflect.Descriptor instead.
-func (*ListRowsResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{253}
 }
 
-func (x *ListRowsResponse) GetRows() []*Row {
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Rows
+		return x.Events
 	}
 	return nil
 }
 
-func (x *ListRowsResponse) GetNextPageToken() string {
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type BulkUpsertRowItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RowId         string                 `protobuf:"bytes,1,opt,name=row_id,json=rowId,proto3" json:"row_id,omitempty"` // 为空=insert，否则=update
-	Cells         map[string]*Value      `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 接收审计事件的 http / https 地址
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// 只投递该表上的事件，为空时投递所有表
+	TableId string `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 只投递这些 RPC 的事件（如 CreateRow），为空时投递所有修改操作
+	Methods []string `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	// 是否设置了签名密钥（密钥本身不返回）
+	HasSecret     bool                   `protobuf:"varint,5,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpsertRowItem) Reset() {
-	*x = BulkUpsertRowItem{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[38]
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpsertRowItem) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpsertRowItem) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *BulkUpsertRowItem) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[38]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,48 +17033,79 @@ func (x *BulkUpsertRowItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpsertRowItem.ProtoReflect.Descriptor instead.
-func (*BulkUpsertRowItem) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{254}
 }
 
-func (x *BulkUpsertRowItem) GetRowId() string {
+func (x *Webhook) GetId() string {
 	if x != nil {
-		return x.RowId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BulkUpsertRowItem) GetCells() map[string]*Value {
+func (x *Webhook) GetUrl() string {
 	if x != nil {
-		return x.Cells
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *Webhook) GetMethods() []string {
+	if x != nil {
+		return x.Methods
 	}
 	return nil
 }
 
-type BulkUpsertRowsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Items         []*BulkUpsertRowItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+func (x *Webhook) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Url     string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	TableId string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Methods []string               `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	// 设置后每次投递带 X-Lowcode-Signature: sha256=<hex(HMAC-SHA256(secret, body))>
+	Secret        string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpsertRowsRequest) Reset() {
-	*x = BulkUpsertRowsRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[39]
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpsertRowsRequest) String() string {
+func (x *CreateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpsertRowsRequest) ProtoMessage() {}
+func (*CreateWebhookRequest) ProtoMessage() {}
 
-func (x *BulkUpsertRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[39]
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2239,47 +17116,61 @@ func (x *BulkUpsertRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpsertRowsRequest.ProtoReflect.Descriptor instead.
-func (*BulkUpsertRowsRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *BulkUpsertRowsRequest) GetTableId() string {
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetTableId() string {
 	if x != nil {
 		return x.TableId
 	}
 	return ""
 }
 
-func (x *BulkUpsertRowsRequest) GetItems() []*BulkUpsertRowItem {
+func (x *CreateWebhookRequest) GetMethods() []string {
 	if x != nil {
-		return x.Items
+		return x.Methods
 	}
 	return nil
 }
 
-type BulkUpsertRowsResponse struct {
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpsertRowsResponse) Reset() {
-	*x = BulkUpsertRowsResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[40]
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpsertRowsResponse) String() string {
+func (x *CreateWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpsertRowsResponse) ProtoMessage() {}
+func (*CreateWebhookResponse) ProtoMessage() {}
 
-func (x *BulkUpsertRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[40]
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,41 +17181,39 @@ func (x *BulkUpsertRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpsertRowsResponse.ProtoReflect.Descriptor instead.
-func (*BulkUpsertRowsResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{256}
 }
 
-func (x *BulkUpsertRowsResponse) GetRows() []*Row {
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
 	if x != nil {
-		return x.Rows
+		return x.Webhook
 	}
 	return nil
 }
 
-type BulkDeleteRowsRequest struct {
+type ListWebhooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	RowIds        []string               `protobuf:"bytes,2,rep,name=row_ids,json=rowIds,proto3" json:"row_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkDeleteRowsRequest) Reset() {
-	*x = BulkDeleteRowsRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[41]
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkDeleteRowsRequest) String() string {
+func (x *ListWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkDeleteRowsRequest) ProtoMessage() {}
+func (*ListWebhooksRequest) ProtoMessage() {}
 
-func (x *BulkDeleteRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[41]
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,46 +17224,33 @@ func (x *BulkDeleteRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkDeleteRowsRequest.ProtoReflect.Descriptor instead.
-func (*BulkDeleteRowsRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *BulkDeleteRowsRequest) GetTableId() string {
-	if x != nil {
-		return x.TableId
-	}
-	return ""
-}
-
-func (x *BulkDeleteRowsRequest) GetRowIds() []string {
-	if x != nil {
-		return x.RowIds
-	}
-	return nil
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{257}
 }
 
-type BulkDeleteRowsResponse struct {
+type ListWebhooksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkDeleteRowsResponse) Reset() {
-	*x = BulkDeleteRowsResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[42]
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkDeleteRowsResponse) String() string {
+func (x *ListWebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkDeleteRowsResponse) ProtoMessage() {}
+func (*ListWebhooksResponse) ProtoMessage() {}
 
-func (x *BulkDeleteRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[42]
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[258]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,37 +17261,40 @@ func (x *BulkDeleteRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkDeleteRowsResponse.ProtoReflect.Descriptor instead.
-func (*BulkDeleteRowsResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{258}
 }
 
-// -------- Index --------
-type CreateIndexRequest struct {
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ColumnIds     []string               `protobuf:"bytes,3,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
-	IsUnique      bool                   `protobuf:"varint,4,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIndexRequest) Reset() {
-	*x = CreateIndexRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[43]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIndexRequest) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIndexRequest) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *CreateIndexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[43]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,61 +17305,88 @@ func (x *CreateIndexRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIndexRequest.ProtoReflect.Descriptor instead.
-func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{259}
 }
 
-func (x *CreateIndexRequest) GetTableId() string {
+func (x *DeleteWebhookRequest) GetId() string {
 	if x != nil {
-		return x.TableId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateIndexRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIndexRequest) GetColumnIds() []string {
-	if x != nil {
-		return x.ColumnIds
-	}
-	return nil
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIndexRequest) GetIsUnique() bool {
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[260]
 	if x != nil {
-		return x.IsUnique
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type CreateIndexResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Index         *Index                 `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{260}
+}
+
+// 一次投递：POST 的 body 为审计事件（AuditEvent 的 JSON），不重试
+type WebhookDelivery struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WebhookId    string                 `protobuf:"bytes,3,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Url          string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	AuditEventId string                 `protobuf:"bytes,5,opt,name=audit_event_id,json=auditEventId,proto3" json:"audit_event_id,omitempty"`
+	// 审计事件的 RPC 方法名
+	Method string `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	// 接收方返回的 HTTP 状态码，连接失败或超时时为 0
+	StatusCode int32 `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// 连接错误，或非 2xx 时响应 body 的开头
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int32  `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIndexResponse) Reset() {
-	*x = CreateIndexResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[44]
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIndexResponse) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIndexResponse) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *CreateIndexResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[44]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2491,83 +17397,104 @@ func (x *CreateIndexResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIndexResponse.ProtoReflect.Descriptor instead.
-func (*CreateIndexResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{261}
 }
 
-func (x *CreateIndexResponse) GetIndex() *Index {
+func (x *WebhookDelivery) GetId() string {
 	if x != nil {
-		return x.Index
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteIndexRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *DeleteIndexRequest) Reset() {
-	*x = DeleteIndexRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
 }
 
-func (x *DeleteIndexRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-func (*DeleteIndexRequest) ProtoMessage() {}
+func (x *WebhookDelivery) GetAuditEventId() string {
+	if x != nil {
+		return x.AuditEventId
+	}
+	return ""
+}
 
-func (x *DeleteIndexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[45]
+func (x *WebhookDelivery) GetMethod() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Method
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteIndexRequest.ProtoReflect.Descriptor instead.
-func (*DeleteIndexRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{45}
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
 }
 
-func (x *DeleteIndexRequest) GetId() string {
+func (x *WebhookDelivery) GetError() string {
 	if x != nil {
-		return x.Id
+		return x.Error
 	}
 	return ""
 }
 
-type DeleteIndexResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *WebhookDelivery) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// 只返回失败（非 2xx 或连接错误）的投递
+	FailedOnly bool `protobuf:"varint,2,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+	// 时间范围 [start_time, end_time)
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 默认 50，上限 1000；按时间倒序
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteIndexResponse) Reset() {
-	*x = DeleteIndexResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[46]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteIndexResponse) String() string {
+func (x *ListWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteIndexResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *DeleteIndexResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[46]
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,77 +17505,76 @@ func (x *DeleteIndexResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteIndexResponse.ProtoReflect.Descriptor instead.
-func (*DeleteIndexResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{262}
 }
 
-type ListIndexesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
 }
 
-func (x *ListIndexesRequest) Reset() {
-	*x = ListIndexesRequest{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListWebhookDeliveriesRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
 }
 
-func (x *ListIndexesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListWebhookDeliveriesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (*ListIndexesRequest) ProtoMessage() {}
-
-func (x *ListIndexesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[47]
+func (x *ListWebhookDeliveriesRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListIndexesRequest.ProtoReflect.Descriptor instead.
-func (*ListIndexesRequest) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{47}
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *ListIndexesRequest) GetTableId() string {
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
 	if x != nil {
-		return x.TableId
+		return x.PageToken
 	}
 	return ""
 }
 
-type ListIndexesResponse struct {
+type ListWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Indexes       []*Index               `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIndexesResponse) Reset() {
-	*x = ListIndexesResponse{}
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[48]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIndexesResponse) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIndexesResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *ListIndexesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[48]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowcode_v1_lowcode_service_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,24 +17585,31 @@ func (x *ListIndexesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIndexesResponse.ProtoReflect.Descriptor instead.
-func (*ListIndexesResponse) Descriptor() ([]byte, []int) {
-	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_lowcode_v1_lowcode_service_proto_rawDescGZIP(), []int{263}
 }
 
-func (x *ListIndexesResponse) GetIndexes() []*Index {
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Indexes
+		return x.Deliveries
 	}
 	return nil
 }
 
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_lowcode_v1_lowcode_service_proto protoreflect.FileDescriptor
 
 const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\n" +
 	" lowcode/v1/lowcode_service.proto\x12\n" +
-	"lowcode.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xea\x01\n" +
+	"lowcode.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x9e\x02\n" +
 	"\x04Type\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -2685,7 +17618,9 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe1\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\x12\x18\n" +
+	"\abuiltin\x18\b \x01(\bR\abuiltin\"\xb7\x04\n" +
 	"\x05Table\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -2696,7 +17631,44 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe1\x02\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\tpartition\x18\a \x01(\v2\x19.lowcode.v1.PartitionSpecR\tpartition\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\t \x01(\tR\x04icon\x12;\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12#\n" +
+	"\roriginal_name\x18\v \x01(\tR\foriginalName\x12,\n" +
+	"\x05stats\x18\f \x01(\v2\x16.lowcode.v1.TableStatsR\x05stats\x12!\n" +
+	"\fworkspace_id\x18\r \x01(\tR\vworkspaceId\x122\n" +
+	"\bsql_view\x18\x0e \x01(\v2\x17.lowcode.v1.SQLViewSpecR\asqlView\"\x82\x01\n" +
+	"\vSQLViewSpec\x12\x10\n" +
+	"\x03sql\x18\x01 \x01(\tR\x03sql\x12\"\n" +
+	"\fmaterialized\x18\x02 \x01(\bR\fmaterialized\x12=\n" +
+	"\frefreshed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\"\xdb\x01\n" +
+	"\tWorkspace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"T\n" +
+	"\n" +
+	"TableStats\x12%\n" +
+	"\x0eestimated_rows\x18\x01 \x01(\x03R\restimatedRows\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x03R\n" +
+	"totalBytes\"\xd0\x01\n" +
+	"\rPartitionSpec\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x1f\n" +
+	"\vcolumn_name\x18\x02 \x01(\tR\n" +
+	"columnName\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\tR\x06typeId\x12\x1a\n" +
+	"\bstrategy\x18\x04 \x01(\tR\bstrategy\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\tR\binterval\x12\x18\n" +
+	"\apremake\x18\x06 \x01(\x05R\apremake\x12\x16\n" +
+	"\x06values\x18\a \x03(\tR\x06values\"\x8b\x04\n" +
 	"\x06Column\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x12\n" +
@@ -2711,7 +17683,13 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tis_hidden\x18\v \x01(\bR\bisHidden\x12\x1f\n" +
+	"\vis_readonly\x18\f \x01(\bR\n" +
+	"isReadonly\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\x12\x14\n" +
+	"\x05label\x18\x0e \x01(\tR\x05label\x122\n" +
+	"\bui_hints\x18\x0f \x01(\v2\x17.google.protobuf.StructR\auiHints\"\xf5\x03\n" +
 	"\x05Index\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x12\n" +
@@ -2723,7 +17701,33 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9e\x02\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\n" +
+	"expression\x18\t \x01(\v2\x1b.lowcode.v1.IndexExpressionR\n" +
+	"expression\x12+\n" +
+	"\x05where\x18\n" +
+	" \x01(\v2\x15.lowcode.v1.RowFilterR\x05where\x12:\n" +
+	"\findex_method\x18\v \x01(\x0e2\x17.lowcode.v1.IndexMethodR\vindexMethod\x12\x1a\n" +
+	"\bexternal\x18\f \x01(\bR\bexternal\x12\x1e\n" +
+	"\n" +
+	"definition\x18\r \x01(\tR\n" +
+	"definition\"\x80\x01\n" +
+	"\x0fIndexExpression\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x125\n" +
+	"\bfunction\x18\x02 \x01(\x0e2\x19.lowcode.v1.IndexFunctionR\bfunction\x12\x19\n" +
+	"\bjson_key\x18\x03 \x01(\tR\ajsonKey\"\xe1\x02\n" +
+	"\x04View\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x06filter\x18\x04 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12*\n" +
+	"\x05sorts\x18\x05 \x03(\v2\x14.lowcode.v1.SortSpecR\x05sorts\x12,\n" +
+	"\x12visible_column_ids\x18\x06 \x03(\tR\x10visibleColumnIds\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x03\n" +
 	"\x05Value\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12#\n" +
 	"\fnumber_value\x18\x02 \x01(\x01H\x00R\vnumberValue\x12\x1f\n" +
@@ -2733,19 +17737,194 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\vbytes_value\x18\x05 \x01(\fH\x00R\n" +
 	"bytesValue\x128\n" +
 	"\n" +
-	"json_value\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x00R\tjsonValueB\x06\n" +
-	"\x04kind\"\x94\x01\n" +
+	"json_value\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x00R\tjsonValue\x12;\n" +
+	"\n" +
+	"null_value\x18\a \x01(\x0e2\x1a.google.protobuf.NullValueH\x00R\tnullValue\x126\n" +
+	"\n" +
+	"list_value\x18\b \x01(\v2\x15.lowcode.v1.ValueListH\x00R\tlistValueB\x06\n" +
+	"\x04kind\"6\n" +
+	"\tValueList\x12)\n" +
+	"\x06values\x18\x01 \x03(\v2\x11.lowcode.v1.ValueR\x06values\"\x94\x01\n" +
 	"\x03Row\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
 	"\x05cells\x18\x02 \x03(\v2\x1a.lowcode.v1.Row.CellsEntryR\x05cells\x1aK\n" +
 	"\n" +
 	"CellsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
-	"\x05value\x18\x02 \x01(\v2\x11.lowcode.v1.ValueR\x05value:\x028\x01\"%\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.lowcode.v1.ValueR\x05value:\x028\x01\"\x99\x01\n" +
 	"\x13CreateTenantRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03dsn\x18\x02 \x01(\tR\x03dsn\x129\n" +
+	"\tbootstrap\x18\x03 \x01(\v2\x1b.lowcode.v1.TenantBootstrapR\tbootstrap\x12%\n" +
+	"\x0eskip_bootstrap\x18\x04 \x01(\bR\rskipBootstrap\"\xc0\x01\n" +
+	"\x0fTenantBootstrap\x120\n" +
+	"\x06schema\x18\x01 \x01(\v2\x18.lowcode.v1.SchemaBundleR\x06schema\x12!\n" +
+	"\ftemplate_ids\x18\x02 \x03(\tR\vtemplateIds\x12(\n" +
+	"\x10skip_sample_rows\x18\x03 \x01(\bR\x0eskipSampleRows\x12.\n" +
+	"\x04rows\x18\x04 \x03(\v2\x1a.lowcode.v1.TenantSeedRowsR\x04rows\"S\n" +
+	"\x0eTenantSeedRows\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12+\n" +
+	"\x04rows\x18\x02 \x03(\v2\x17.google.protobuf.StructR\x04rows\"&\n" +
 	"\x14CreateTenantResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"q\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc6\x03\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12 \n" +
+	"\vconnections\x18\x03 \x01(\x05R\vconnections\x12+\n" +
+	"\x11allow_connections\x18\x04 \x01(\bR\x10allowConnections\x12\x1b\n" +
+	"\tpool_open\x18\x05 \x01(\bR\bpoolOpen\x12-\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x17.lowcode.v1.TenantStateR\x05state\x12!\n" +
+	"\fstate_reason\x18\a \x01(\tR\vstateReason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tmax_conns\x18\n" +
+	" \x01(\x05R\bmaxConns\x12\x19\n" +
+	"\bdsn_host\x18\v \x01(\tR\adsnHost\x12!\n" +
+	"\freplica_host\x18\f \x01(\tR\vreplicaHost\"C\n" +
+	"\x12ListTenantsRequest\x12-\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x17.lowcode.v1.TenantStateR\x05state\"C\n" +
+	"\x13ListTenantsResponse\x12,\n" +
+	"\atenants\x18\x01 \x03(\v2\x12.lowcode.v1.TenantR\atenants\"\"\n" +
+	"\x10GetTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x11GetTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.lowcode.v1.TenantR\x06tenant\"?\n" +
+	"\x13DeleteTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aconfirm\x18\x02 \x01(\tR\aconfirm\"\x16\n" +
+	"\x14DeleteTenantResponse\"\x9e\x01\n" +
+	"\x12CloneTenantRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12!\n" +
+	"\finclude_data\x18\x03 \x01(\bR\vincludeData\x12+\n" +
+	"\x11disconnect_source\x18\x04 \x01(\bR\x10disconnectSource\"A\n" +
+	"\x13CloneTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.lowcode.v1.TenantR\x06tenant\"\xfa\x01\n" +
+	"\x11TenantArchiveInfo\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12;\n" +
+	"\vexported_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1f\n" +
+	"\vstorage_key\x18\x05 \x01(\tR\n" +
+	"storageKey\x12\x1f\n" +
+	"\vtable_count\x18\x06 \x01(\x05R\n" +
+	"tableCount\x12\x1b\n" +
+	"\trow_count\x18\a \x01(\x03R\browCount\"Q\n" +
+	"\x13ExportTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"to_storage\x18\x02 \x01(\bR\ttoStorage\"n\n" +
+	"\x14ExportTenantResponse\x123\n" +
+	"\x04info\x18\x01 \x01(\v2\x1d.lowcode.v1.TenantArchiveInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"P\n" +
+	"\x10ImportTenantInfo\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vstorage_key\x18\x02 \x01(\tR\n" +
+	"storageKey\"l\n" +
+	"\x13ImportTenantRequest\x122\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.lowcode.v1.ImportTenantInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xaa\x01\n" +
+	"\x14ImportTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.lowcode.v1.TenantR\x06tenant\x12(\n" +
+	"\x10source_tenant_id\x18\x02 \x01(\tR\x0esourceTenantId\x12\x1f\n" +
+	"\vtable_count\x18\x03 \x01(\x05R\n" +
+	"tableCount\x12\x1b\n" +
+	"\trow_count\x18\x04 \x01(\x03R\browCount\"[\n" +
+	"\x18MigrateAllTenantsRequest\x12\x1d\n" +
+	"\n" +
+	"tenant_ids\x18\x01 \x03(\tR\ttenantIds\x12 \n" +
+	"\vconcurrency\x18\x02 \x01(\x05R\vconcurrency\"\x8c\x01\n" +
+	"\x15TenantMigrationResult\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x7f\n" +
+	"\x19MigrateAllTenantsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.lowcode.v1.TenantMigrationResultR\aresults\x12%\n" +
+	"\x0elatest_version\x18\x02 \x01(\x05R\rlatestVersion\"\xab\x03\n" +
+	"\fTenantBackup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12-\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x17.lowcode.v1.BackupStateR\x05state\x123\n" +
+	"\atrigger\x18\x04 \x01(\x0e2\x19.lowcode.v1.BackupTriggerR\atrigger\x12\x1f\n" +
+	"\vstorage_key\x18\x05 \x01(\tR\n" +
+	"storageKey\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x12\x1f\n" +
+	"\vtable_count\x18\a \x01(\x05R\n" +
+	"tableCount\x12\x1b\n" +
+	"\trow_count\x18\b \x01(\x03R\browCount\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"3\n" +
+	"\x14TriggerBackupRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"I\n" +
+	"\x15TriggerBackupResponse\x120\n" +
+	"\x06backup\x18\x01 \x01(\v2\x18.lowcode.v1.TenantBackupR\x06backup\"\x9c\x01\n" +
+	"\x12ListBackupsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12-\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x17.lowcode.v1.BackupStateR\x05state\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"q\n" +
+	"\x13ListBackupsResponse\x122\n" +
+	"\abackups\x18\x01 \x03(\v2\x18.lowcode.v1.TenantBackupR\abackups\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"3\n" +
+	"\x17ListTenantHealthRequest\x12\x18\n" +
+	"\arefresh\x18\x01 \x01(\bR\arefresh\"\xae\x02\n" +
+	"\fTenantHealth\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12\x1b\n" +
+	"\tpool_open\x18\x03 \x01(\bR\bpoolOpen\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x05 \x01(\x03R\tlatencyMs\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x121\n" +
+	"\x14consecutive_failures\x18\a \x01(\x05R\x13consecutiveFailures\x12\x1e\n" +
+	"\n" +
+	"reconnects\x18\b \x01(\x05R\n" +
+	"reconnects\"N\n" +
+	"\x18ListTenantHealthResponse\x122\n" +
+	"\atenants\x18\x01 \x03(\v2\x18.lowcode.v1.TenantHealthR\atenants\"\xaa\x01\n" +
+	"\x13UpdateTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\tmax_conns\x18\x02 \x01(\x05H\x00R\bmaxConns\x88\x01\x01\x12\x15\n" +
+	"\x03dsn\x18\x03 \x01(\tH\x01R\x03dsn\x88\x01\x01\x12$\n" +
+	"\vreplica_dsn\x18\x04 \x01(\tH\x02R\n" +
+	"replicaDsn\x88\x01\x01B\f\n" +
+	"\n" +
+	"_max_connsB\x06\n" +
+	"\x04_dsnB\x0e\n" +
+	"\f_replica_dsn\"B\n" +
+	"\x14UpdateTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.lowcode.v1.TenantR\x06tenant\">\n" +
+	"\x14SuspendTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"C\n" +
+	"\x15SuspendTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.lowcode.v1.TenantR\x06tenant\"%\n" +
+	"\x13ResumeTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x14ResumeTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.lowcode.v1.TenantR\x06tenant\"\x19\n" +
+	"\x17RotateTenantKeysRequest\"\xd4\x01\n" +
+	"\x18RotateTenantKeysResponse\x123\n" +
+	"\toperation\x18\x01 \x01(\v2\x15.lowcode.v1.OperationR\toperation\x12#\n" +
+	"\rresealed_dsns\x18\x02 \x01(\x05R\fresealedDsns\x12+\n" +
+	"\x11reencrypted_cells\x18\x03 \x01(\x03R\x10reencryptedCells\x121\n" +
+	"\x14reencrypted_versions\x18\x04 \x01(\x03R\x13reencryptedVersions\"q\n" +
 	"\x11CreateTypeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
 	"\apg_type\x18\x02 \x01(\tR\x06pgType\x12/\n" +
@@ -2754,28 +17933,293 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\x04type\x18\x01 \x01(\v2\x10.lowcode.v1.TypeR\x04type\"\x12\n" +
 	"\x10ListTypesRequest\";\n" +
 	"\x11ListTypesResponse\x12&\n" +
-	"\x05types\x18\x01 \x03(\v2\x10.lowcode.v1.TypeR\x05types\"#\n" +
+	"\x05types\x18\x01 \x03(\v2\x10.lowcode.v1.TypeR\x05types\" \n" +
+	"\x0eGetTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x0fGetTypeResponse\x12$\n" +
+	"\x04type\x18\x01 \x01(\v2\x10.lowcode.v1.TypeR\x04type\"\x90\x01\n" +
+	"\x11UpdateTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apg_type\x18\x02 \x01(\tR\x06pgType\x12/\n" +
+	"\x06config\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06config\x12!\n" +
+	"\fcast_columns\x18\x04 \x01(\bR\vcastColumns\"c\n" +
+	"\x12UpdateTypeResponse\x12$\n" +
+	"\x04type\x18\x01 \x01(\v2\x10.lowcode.v1.TypeR\x04type\x12'\n" +
+	"\x0faltered_columns\x18\x02 \x01(\x05R\x0ealteredColumns\"#\n" +
 	"\x11DeleteTypeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteTypeResponse\"I\n" +
+	"\x12DeleteTypeResponse\"n\n" +
+	"\x0eTypeDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\apg_type\x18\x02 \x01(\tR\x06pgType\x12/\n" +
+	"\x06config\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06config\"=\n" +
+	"\x12ExportTypesRequest\x12'\n" +
+	"\x0finclude_builtin\x18\x01 \x01(\bR\x0eincludeBuiltin\"G\n" +
+	"\x13ExportTypesResponse\x120\n" +
+	"\x05types\x18\x01 \x03(\v2\x1a.lowcode.v1.TypeDefinitionR\x05types\"d\n" +
+	"\x12ImportTypesRequest\x120\n" +
+	"\x05types\x18\x01 \x03(\v2\x1a.lowcode.v1.TypeDefinitionR\x05types\x12\x1c\n" +
+	"\toverwrite\x18\x02 \x01(\bR\toverwrite\"\x8b\x01\n" +
+	"\x13ImportTypesResponse\x12*\n" +
+	"\acreated\x18\x01 \x03(\v2\x10.lowcode.v1.TypeR\acreated\x12*\n" +
+	"\aupdated\x18\x02 \x03(\v2\x10.lowcode.v1.TypeR\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x03(\tR\tunchanged\"\xc6\x01\n" +
 	"\x12CreateTableRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vschema_name\x18\x02 \x01(\tR\n" +
-	"schemaName\">\n" +
+	"schemaName\x127\n" +
+	"\tpartition\x18\x03 \x01(\v2\x19.lowcode.v1.PartitionSpecR\tpartition\x12\x1f\n" +
+	"\vauto_suffix\x18\x04 \x01(\bR\n" +
+	"autoSuffix\x12!\n" +
+	"\fworkspace_id\x18\x05 \x01(\tR\vworkspaceId\">\n" +
 	"\x13CreateTableResponse\x12'\n" +
-	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\"$\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\"\x84\x03\n" +
+	"\x10ColumnDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\atype_id\x18\x02 \x01(\tR\x06typeId\x12\x1f\n" +
+	"\vis_nullable\x18\x03 \x01(\bR\n" +
+	"isNullable\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12/\n" +
+	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x1b\n" +
+	"\tis_unique\x18\x06 \x01(\bR\bisUnique\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\x12\x1b\n" +
+	"\tis_hidden\x18\b \x01(\bR\bisHidden\x12\x1f\n" +
+	"\vis_readonly\x18\t \x01(\bR\n" +
+	"isReadonly\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x14\n" +
+	"\x05label\x18\v \x01(\tR\x05label\x122\n" +
+	"\bui_hints\x18\f \x01(\v2\x17.google.protobuf.StructR\auiHints\"\xa1\x01\n" +
+	"\x0fIndexDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcolumn_names\x18\x02 \x03(\tR\vcolumnNames\x12\x1b\n" +
+	"\tis_unique\x18\x03 \x01(\bR\bisUnique\x12:\n" +
+	"\findex_method\x18\x04 \x01(\x0e2\x17.lowcode.v1.IndexMethodR\vindexMethod\"\xbf\x02\n" +
+	"\x1cCreateTableWithSchemaRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vschema_name\x18\x02 \x01(\tR\n" +
+	"schemaName\x127\n" +
+	"\tpartition\x18\x03 \x01(\v2\x19.lowcode.v1.PartitionSpecR\tpartition\x126\n" +
+	"\acolumns\x18\x04 \x03(\v2\x1c.lowcode.v1.ColumnDefinitionR\acolumns\x125\n" +
+	"\aindexes\x18\x05 \x03(\v2\x1b.lowcode.v1.IndexDefinitionR\aindexes\x12\x1f\n" +
+	"\vauto_suffix\x18\x06 \x01(\bR\n" +
+	"autoSuffix\x12!\n" +
+	"\fworkspace_id\x18\a \x01(\tR\vworkspaceId\"\xa3\x01\n" +
+	"\x1dCreateTableWithSchemaResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
+	"\aindexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\"\xa5\x02\n" +
+	"\x17ApplyTableSchemaRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vschema_name\x18\x02 \x01(\tR\n" +
+	"schemaName\x127\n" +
+	"\tpartition\x18\x03 \x01(\v2\x19.lowcode.v1.PartitionSpecR\tpartition\x126\n" +
+	"\acolumns\x18\x04 \x03(\v2\x1c.lowcode.v1.ColumnDefinitionR\acolumns\x125\n" +
+	"\aindexes\x18\x05 \x03(\v2\x1b.lowcode.v1.IndexDefinitionR\aindexes\x12\x14\n" +
+	"\x05prune\x18\x06 \x01(\bR\x05prune\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\"\x88\x01\n" +
+	"\fSchemaChange\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x120\n" +
+	"\x06impact\x18\x04 \x01(\v2\x18.lowcode.v1.SchemaImpactR\x06impact\"\x84\x02\n" +
+	"\x18ApplyTableSchemaResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.lowcode.v1.SchemaChangeR\achanges\x12'\n" +
+	"\x05table\x18\x02 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x03 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
+	"\aindexes\x18\x04 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\x120\n" +
+	"\x06impact\x18\x05 \x01(\v2\x18.lowcode.v1.SchemaImpactR\x06impact\"\xa4\x02\n" +
+	"\x0fTableDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vschema_name\x18\x02 \x01(\tR\n" +
+	"schemaName\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x127\n" +
+	"\tpartition\x18\x05 \x01(\v2\x19.lowcode.v1.PartitionSpecR\tpartition\x126\n" +
+	"\acolumns\x18\x06 \x03(\v2\x1c.lowcode.v1.ColumnDefinitionR\acolumns\x125\n" +
+	"\aindexes\x18\a \x03(\v2\x1b.lowcode.v1.IndexDefinitionR\aindexes\"\xcc\x01\n" +
+	"\fSchemaBundle\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12;\n" +
+	"\vexported_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x120\n" +
+	"\x05types\x18\x03 \x03(\v2\x1a.lowcode.v1.TypeDefinitionR\x05types\x123\n" +
+	"\x06tables\x18\x04 \x03(\v2\x1b.lowcode.v1.TableDefinitionR\x06tables\"I\n" +
+	"\x13ExportSchemaRequest\x122\n" +
+	"\x15include_builtin_types\x18\x01 \x01(\bR\x13includeBuiltinTypes\"H\n" +
+	"\x14ExportSchemaResponse\x120\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x18.lowcode.v1.SchemaBundleR\x06bundle\"p\n" +
+	"\x13ImportSchemaRequest\x120\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x18.lowcode.v1.SchemaBundleR\x06bundle\x12'\n" +
+	"\x0foverwrite_types\x18\x02 \x01(\bR\x0eoverwriteTypes\"\xd3\x01\n" +
+	"\x14ImportSchemaResponse\x125\n" +
+	"\x05types\x18\x01 \x01(\v2\x1f.lowcode.v1.ImportTypesResponseR\x05types\x12)\n" +
+	"\x06tables\x18\x02 \x03(\v2\x11.lowcode.v1.TableR\x06tables\x12,\n" +
+	"\acolumns\x18\x03 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
+	"\aindexes\x18\x04 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\"\xe9\x01\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x126\n" +
+	"\acolumns\x18\x04 \x03(\v2\x1c.lowcode.v1.ColumnDefinitionR\acolumns\x125\n" +
+	"\aindexes\x18\x05 \x03(\v2\x1b.lowcode.v1.IndexDefinitionR\aindexes\x12(\n" +
+	"\x10sample_row_count\x18\x06 \x01(\x05R\x0esampleRowCount\"\x16\n" +
+	"\x14ListTemplatesRequest\"K\n" +
+	"\x15ListTemplatesResponse\x122\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x14.lowcode.v1.TemplateR\ttemplates\"\xa0\x01\n" +
+	"\x1eCreateTableFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vschema_name\x18\x03 \x01(\tR\n" +
+	"schemaName\x12(\n" +
+	"\x10skip_sample_rows\x18\x04 \x01(\bR\x0eskipSampleRows\"\xca\x01\n" +
+	"\x1fCreateTableFromTemplateResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
+	"\aindexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\x12#\n" +
+	"\x04rows\x18\x04 \x03(\v2\x0f.lowcode.v1.RowR\x04rows\"\x99\x02\n" +
+	"\x12UpdateTableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x04 \x01(\tH\x01R\x04icon\x88\x01\x01\x12&\n" +
+	"\fworkspace_id\x18\x05 \x01(\tH\x02R\vworkspaceId\x88\x01\x01\x127\n" +
+	"\tpartition\x18\x06 \x01(\v2\x19.lowcode.v1.PartitionSpecR\tpartition\x12\x14\n" +
+	"\x05async\x18\a \x01(\bR\x05asyncB\x0e\n" +
+	"\f_descriptionB\a\n" +
+	"\x05_iconB\x0f\n" +
+	"\r_workspace_id\"s\n" +
+	"\x13UpdateTableResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x123\n" +
+	"\toperation\x18\x02 \x01(\v2\x15.lowcode.v1.OperationR\toperation\"i\n" +
+	"\x15DuplicateTableRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\finclude_data\x18\x03 \x01(\bR\vincludeData\"\xbd\x01\n" +
+	"\x16DuplicateTableResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
+	"\aindexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\x12\x1f\n" +
+	"\vcopied_rows\x18\x04 \x01(\x03R\n" +
+	"copiedRows\"m\n" +
 	"\x12DeleteTableRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13DeleteTableResponse\"\x13\n" +
-	"\x11ListTablesRequest\"?\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acascade\x18\x03 \x01(\bR\acascade\x12\x14\n" +
+	"\x05purge\x18\x04 \x01(\bR\x05purge\"v\n" +
+	"\x13DeleteTableResponse\x120\n" +
+	"\x06impact\x18\x01 \x01(\v2\x18.lowcode.v1.SchemaImpactR\x06impact\x12-\n" +
+	"\barchived\x18\x02 \x01(\v2\x11.lowcode.v1.TableR\barchived\"9\n" +
+	"\x13RestoreTableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"?\n" +
+	"\x14RestoreTableResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\"#\n" +
+	"\x11PurgeTableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12PurgeTableResponse\"\xa9\x02\n" +
+	"\x11ListTablesRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vname_prefix\x18\x04 \x01(\tR\n" +
+	"namePrefix\x12)\n" +
+	"\x04sort\x18\x05 \x01(\x0e2\x15.lowcode.v1.TableSortR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x06 \x01(\bR\n" +
+	"descending\x12!\n" +
+	"\fworkspace_id\x18\a \x01(\tR\vworkspaceId\x12\x1e\n" +
+	"\n" +
+	"unassigned\x18\b \x01(\bR\n" +
+	"unassigned\"g\n" +
 	"\x12ListTablesResponse\x12)\n" +
-	"\x06tables\x18\x01 \x03(\v2\x11.lowcode.v1.TableR\x06tables\"2\n" +
+	"\x06tables\x18\x01 \x03(\v2\x11.lowcode.v1.TableR\x06tables\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"b\n" +
+	"\x16CreateWorkspaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\"N\n" +
+	"\x17CreateWorkspaceResponse\x123\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x15.lowcode.v1.WorkspaceR\tworkspace\"\x17\n" +
+	"\x15ListWorkspacesRequest\"O\n" +
+	"\x16ListWorkspacesResponse\x125\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x15.lowcode.v1.WorkspaceR\n" +
+	"workspaces\"%\n" +
+	"\x13GetWorkspaceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x14GetWorkspaceResponse\x123\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x15.lowcode.v1.WorkspaceR\tworkspace\"\xa3\x01\n" +
+	"\x16UpdateWorkspaceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x04 \x01(\tH\x02R\x04icon\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\a\n" +
+	"\x05_icon\"N\n" +
+	"\x17UpdateWorkspaceResponse\x123\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x15.lowcode.v1.WorkspaceR\tworkspace\"(\n" +
+	"\x16DeleteWorkspaceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteWorkspaceResponse\"!\n" +
+	"\x0fGetTableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x10GetTableResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\"J\n" +
 	"\x15GetTableSchemaRequest\x12\x19\n" +
-	"\btable_id\x18\x01 \x01(\tR\atableId\"\x9c\x01\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x16\n" +
+	"\x06verify\x18\x02 \x01(\bR\x06verify\"\xcb\x01\n" +
 	"\x16GetTableSchemaResponse\x12'\n" +
 	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
 	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
-	"\aindexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\"\xc8\x01\n" +
+	"\aindexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\x12-\n" +
+	"\x05drift\x18\x04 \x03(\v2\x17.lowcode.v1.SchemaDriftR\x05drift\"\xf6\x01\n" +
+	"\vSchemaDrift\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.lowcode.v1.DriftKindR\x04kind\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1b\n" +
+	"\tpg_column\x18\x03 \x01(\tR\bpgColumn\x12\x19\n" +
+	"\bindex_id\x18\x04 \x01(\tR\aindexId\x12\x19\n" +
+	"\bpg_index\x18\x05 \x01(\tR\apgIndex\x12\x1a\n" +
+	"\bexpected\x18\x06 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\a \x01(\tR\x06actual\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\x82\x01\n" +
+	"\x18RepairTableSchemaRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x122\n" +
+	"\x15drop_orphaned_columns\x18\x02 \x01(\bR\x13dropOrphanedColumns\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"~\n" +
+	"\x19RepairTableSchemaResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.lowcode.v1.SchemaChangeR\achanges\x12-\n" +
+	"\x05drift\x18\x02 \x03(\v2\x17.lowcode.v1.SchemaDriftR\x05drift\"^\n" +
+	"\x19GetWorkspaceSchemaRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x1e\n" +
+	"\n" +
+	"unassigned\x18\x02 \x01(\bR\n" +
+	"unassigned\"\x91\x01\n" +
+	"\vTableSchema\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12+\n" +
+	"\aindexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\"\xdd\x01\n" +
+	"\fRelationship\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12&\n" +
+	"\x0ftarget_table_id\x18\x03 \x01(\tR\rtargetTableId\x12$\n" +
+	"\x0elink_column_id\x18\x04 \x01(\tR\flinkColumnId\x12(\n" +
+	"\x10target_column_id\x18\x05 \x01(\tR\x0etargetColumnId\x12\x1d\n" +
+	"\n" +
+	"join_table\x18\x06 \x01(\tR\tjoinTable\"\x8d\x01\n" +
+	"\x1aGetWorkspaceSchemaResponse\x12/\n" +
+	"\x06tables\x18\x01 \x03(\v2\x17.lowcode.v1.TableSchemaR\x06tables\x12>\n" +
+	"\rrelationships\x18\x02 \x03(\v2\x18.lowcode.v1.RelationshipR\rrelationships\"\xfd\x01\n" +
+	"\fSchemaImpact\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\x12?\n" +
+	"\x11dependent_columns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\x10dependentColumns\x12>\n" +
+	"\x11dependent_indexes\x18\x03 \x03(\v2\x11.lowcode.v1.IndexR\x10dependentIndexes\x12'\n" +
+	"\x0fdependent_views\x18\x04 \x03(\tR\x0edependentViews\x12\x1e\n" +
+	"\n" +
+	"statements\x18\x05 \x03(\tR\n" +
+	"statements\"\x8f\x03\n" +
 	"\x10AddColumnRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -2783,21 +18227,89 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\vis_nullable\x18\x04 \x01(\bR\n" +
 	"isNullable\x12\x1a\n" +
 	"\bposition\x18\x05 \x01(\x05R\bposition\x12/\n" +
-	"\x06config\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06config\"?\n" +
+	"\x06config\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x1b\n" +
+	"\tis_unique\x18\a \x01(\bR\bisUnique\x12\x1b\n" +
+	"\tis_hidden\x18\b \x01(\bR\bisHidden\x12\x1f\n" +
+	"\vis_readonly\x18\t \x01(\bR\n" +
+	"isReadonly\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x14\n" +
+	"\x05label\x18\v \x01(\tR\x05label\x122\n" +
+	"\bui_hints\x18\f \x01(\v2\x17.google.protobuf.StructR\auiHints\"u\n" +
 	"\x11AddColumnResponse\x12*\n" +
-	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\"\xa7\x01\n" +
+	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\x124\n" +
+	"\funique_index\x18\x02 \x01(\v2\x11.lowcode.v1.IndexR\vuniqueIndex\"J\n" +
+	"\fSelectOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"a\n" +
+	"\x16AddSelectOptionRequest\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"w\n" +
+	"\x17AddSelectOptionResponse\x12*\n" +
+	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\x120\n" +
+	"\x06option\x18\x02 \x01(\v2\x18.lowcode.v1.SelectOptionR\x06option\"\x81\x01\n" +
+	"\x19UpdateSelectOptionRequest\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x1b\n" +
+	"\toption_id\x18\x02 \x01(\tR\boptionId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\"m\n" +
+	"\x1aUpdateSelectOptionResponse\x12*\n" +
+	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\x12#\n" +
+	"\rmigrated_rows\x18\x02 \x01(\x03R\fmigratedRows\"U\n" +
+	"\x19RemoveSelectOptionRequest\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x1b\n" +
+	"\toption_id\x18\x02 \x01(\tR\boptionId\"m\n" +
+	"\x1aRemoveSelectOptionResponse\x12*\n" +
+	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\x12#\n" +
+	"\rmigrated_rows\x18\x02 \x01(\x03R\fmigratedRows\"Q\n" +
+	"\x15ReorderColumnsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1d\n" +
+	"\n" +
+	"column_ids\x18\x02 \x03(\tR\tcolumnIds\"F\n" +
+	"\x16ReorderColumnsResponse\x12,\n" +
+	"\acolumns\x18\x01 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\"\xcf\x03\n" +
 	"\x13UpdateColumnRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
-	"\vis_nullable\x18\x03 \x01(\bR\n" +
-	"isNullable\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\vis_nullable\x18\x03 \x01(\bH\x00R\n" +
+	"isNullable\x88\x01\x01\x12\x1a\n" +
 	"\bposition\x18\x04 \x01(\x05R\bposition\x12/\n" +
-	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06config\"B\n" +
+	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x1b\n" +
+	"\tpg_column\x18\x06 \x01(\tR\bpgColumn\x12 \n" +
+	"\tis_hidden\x18\a \x01(\bH\x01R\bisHidden\x88\x01\x01\x12$\n" +
+	"\vis_readonly\x18\b \x01(\bH\x02R\n" +
+	"isReadonly\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\t \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05label\x18\n" +
+	" \x01(\tH\x04R\x05label\x88\x01\x01\x122\n" +
+	"\bui_hints\x18\v \x01(\v2\x17.google.protobuf.StructR\auiHintsB\x0e\n" +
+	"\f_is_nullableB\f\n" +
+	"\n" +
+	"_is_hiddenB\x0e\n" +
+	"\f_is_readonlyB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_label\"B\n" +
 	"\x14UpdateColumnResponse\x12*\n" +
-	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\"%\n" +
+	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\"\xc4\x01\n" +
+	"\x17ChangeColumnTypeRequest\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x1e\n" +
+	"\vnew_type_id\x18\x02 \x01(\tR\tnewTypeId\x12=\n" +
+	"\rcast_strategy\x18\x03 \x01(\x0e2\x18.lowcode.v1.CastStrategyR\fcastStrategy\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"\xce\x01\n" +
+	"\x18ChangeColumnTypeResponse\x12*\n" +
+	"\x06column\x18\x01 \x01(\v2\x12.lowcode.v1.ColumnR\x06column\x12\x1f\n" +
+	"\vfailed_rows\x18\x02 \x01(\x03R\n" +
+	"failedRows\x120\n" +
+	"\x06impact\x18\x03 \x01(\v2\x18.lowcode.v1.SchemaImpactR\x06impact\x123\n" +
+	"\toperation\x18\x04 \x01(\v2\x15.lowcode.v1.OperationR\toperation\">\n" +
 	"\x13DeleteColumnRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
-	"\x14DeleteColumnResponse\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"H\n" +
+	"\x14DeleteColumnResponse\x120\n" +
+	"\x06impact\x18\x01 \x01(\v2\x18.lowcode.v1.SchemaImpactR\x06impact\"/\n" +
 	"\x12ListColumnsRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\"C\n" +
 	"\x13ListColumnsResponse\x12,\n" +
@@ -2810,11 +18322,12 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
 	"\x05value\x18\x02 \x01(\v2\x11.lowcode.v1.ValueR\x05value:\x028\x01\"6\n" +
 	"\x11CreateRowResponse\x12!\n" +
-	"\x03row\x18\x01 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"\xd0\x01\n" +
+	"\x03row\x18\x01 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"\xfa\x01\n" +
 	"\x10UpdateRowRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
 	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12=\n" +
-	"\x05cells\x18\x03 \x03(\v2'.lowcode.v1.UpdateRowRequest.CellsEntryR\x05cells\x1aK\n" +
+	"\x05cells\x18\x03 \x03(\v2'.lowcode.v1.UpdateRowRequest.CellsEntryR\x05cells\x12(\n" +
+	"\x10clear_column_ids\x18\x04 \x03(\tR\x0eclearColumnIds\x1aK\n" +
 	"\n" +
 	"CellsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
@@ -2824,74 +18337,793 @@ const file_lowcode_v1_lowcode_service_proto_rawDesc = "" +
 	"\x10DeleteRowRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
 	"\x06row_id\x18\x02 \x01(\tR\x05rowId\"\x13\n" +
-	"\x11DeleteRowResponse\"\x94\x01\n" +
+	"\x11DeleteRowResponse\"E\n" +
+	"\x11RestoreRowRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\"7\n" +
+	"\x12RestoreRowResponse\x12!\n" +
+	"\x03row\x18\x01 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"\xc9\x01\n" +
+	"\n" +
+	"RowVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1c.lowcode.v1.RowVersionActionR\x06action\x12!\n" +
+	"\x03row\x18\x03 \x01(\v2\x0f.lowcode.v1.RowR\x03row\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb5\x01\n" +
+	"\x14GetRowHistoryRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12/\n" +
+	"\x05as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x96\x01\n" +
+	"\x15GetRowHistoryResponse\x122\n" +
+	"\bversions\x18\x01 \x03(\v2\x16.lowcode.v1.RowVersionR\bversions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12!\n" +
+	"\x03row\x18\x03 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"k\n" +
+	"\x18RestoreRowVersionRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\">\n" +
+	"\x19RestoreRowVersionResponse\x12!\n" +
+	"\x03row\x18\x01 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"\x86\x01\n" +
+	"\x0fLinkRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\x12$\n" +
+	"\x0etarget_row_ids\x18\x04 \x03(\tR\ftargetRowIds\"*\n" +
+	"\x10LinkRowsResponse\x12\x16\n" +
+	"\x06linked\x18\x01 \x01(\x03R\x06linked\"\x88\x01\n" +
+	"\x11UnlinkRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\x12$\n" +
+	"\x0etarget_row_ids\x18\x04 \x03(\tR\ftargetRowIds\"0\n" +
+	"\x12UnlinkRowsResponse\x12\x1a\n" +
+	"\bunlinked\x18\x01 \x01(\x03R\bunlinked\"\x89\x01\n" +
+	"\x10PurgeRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x17\n" +
+	"\arow_ids\x18\x02 \x03(\tR\x06rowIds\x12A\n" +
+	"\x0edeleted_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rdeletedBefore\"+\n" +
+	"\x11PurgeRowsResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x03R\x06purged\"\xbd\x01\n" +
+	"\rGetRowRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12*\n" +
+	"\x11expand_column_ids\x18\x03 \x03(\tR\x0fexpandColumnIds\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\x12%\n" +
+	"\x0einclude_hidden\x18\x05 \x01(\bR\rincludeHidden\"3\n" +
+	"\x0eGetRowResponse\x12!\n" +
+	"\x03row\x18\x01 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"\xa5\x01\n" +
+	"\x16FindRowByColumnRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12'\n" +
+	"\x05value\x18\x03 \x01(\v2\x11.lowcode.v1.ValueR\x05value\x12*\n" +
+	"\x11expand_column_ids\x18\x04 \x03(\tR\x0fexpandColumnIds\"<\n" +
+	"\x17FindRowByColumnResponse\x12!\n" +
+	"\x03row\x18\x01 \x01(\v2\x0f.lowcode.v1.RowR\x03row\"\xdf\x01\n" +
+	"\x0fFilterCondition\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x126\n" +
+	"\boperator\x18\x02 \x01(\x0e2\x1a.lowcode.v1.FilterOperatorR\boperator\x12'\n" +
+	"\x05value\x18\x03 \x01(\v2\x11.lowcode.v1.ValueR\x05value\x12)\n" +
+	"\x06values\x18\x04 \x03(\v2\x11.lowcode.v1.ValueR\x06values\x12#\n" +
+	"\rradius_meters\x18\x05 \x01(\x01R\fradiusMeters\"\xbd\x01\n" +
+	"\vFilterGroup\x12B\n" +
+	"\n" +
+	"combinator\x18\x01 \x01(\x0e2\".lowcode.v1.FilterGroup.CombinatorR\n" +
+	"combinator\x12/\n" +
+	"\afilters\x18\x02 \x03(\v2\x15.lowcode.v1.RowFilterR\afilters\"9\n" +
+	"\n" +
+	"Combinator\x12\x1a\n" +
+	"\x16COMBINATOR_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03AND\x10\x01\x12\x06\n" +
+	"\x02OR\x10\x02\"\x81\x01\n" +
+	"\tRowFilter\x12;\n" +
+	"\tcondition\x18\x01 \x01(\v2\x1b.lowcode.v1.FilterConditionH\x00R\tcondition\x12/\n" +
+	"\x05group\x18\x02 \x01(\v2\x17.lowcode.v1.FilterGroupH\x00R\x05groupB\x06\n" +
+	"\x04kind\"\x93\x02\n" +
+	"\bSortSpec\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12<\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1e.lowcode.v1.SortSpec.DirectionR\tdirection\x120\n" +
+	"\x05nulls\x18\x03 \x01(\x0e2\x1a.lowcode.v1.SortSpec.NullsR\x05nulls\"9\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03ASC\x10\x01\x12\b\n" +
+	"\x04DESC\x10\x02\"?\n" +
+	"\x05Nulls\x12\x15\n" +
+	"\x11NULLS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vNULLS_FIRST\x10\x01\x12\x0e\n" +
+	"\n" +
+	"NULLS_LAST\x10\x02\"\xd8\x02\n" +
 	"\x0fListRowsRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\x12*\n" +
-	"\x11expand_column_ids\x18\x04 \x03(\tR\x0fexpandColumnIds\"_\n" +
+	"\x11expand_column_ids\x18\x04 \x03(\tR\x0fexpandColumnIds\x12-\n" +
+	"\x06filter\x18\x05 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12*\n" +
+	"\x05sorts\x18\x06 \x03(\v2\x14.lowcode.v1.SortSpecR\x05sorts\x12'\n" +
+	"\x0finclude_deleted\x18\a \x01(\bR\x0eincludeDeleted\x12%\n" +
+	"\x0einclude_hidden\x18\b \x01(\bR\rincludeHidden\x12\x17\n" +
+	"\aview_id\x18\t \x01(\tR\x06viewId\"_\n" +
 	"\x10ListRowsResponse\x12#\n" +
 	"\x04rows\x18\x01 \x03(\v2\x0f.lowcode.v1.RowR\x04rows\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb7\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfb\x01\n" +
+	"\x11StreamRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12-\n" +
+	"\x06filter\x18\x02 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12*\n" +
+	"\x05sorts\x18\x03 \x03(\v2\x14.lowcode.v1.SortSpecR\x05sorts\x12*\n" +
+	"\x11expand_column_ids\x18\x04 \x03(\tR\x0fexpandColumnIds\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\x12%\n" +
+	"\x0einclude_hidden\x18\x06 \x01(\bR\rincludeHidden\"9\n" +
+	"\x12StreamRowsResponse\x12#\n" +
+	"\x04rows\x18\x01 \x03(\v2\x0f.lowcode.v1.RowR\x04rows\"\x9a\x02\n" +
+	"\x11ExportRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x120\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x18.lowcode.v1.ExportFormatR\x06format\x12-\n" +
+	"\x06filter\x18\x03 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12*\n" +
+	"\x05sorts\x18\x04 \x03(\v2\x14.lowcode.v1.SortSpecR\x05sorts\x12\x17\n" +
+	"\aview_id\x18\x05 \x01(\tR\x06viewId\x12\x1d\n" +
+	"\n" +
+	"column_ids\x18\x06 \x03(\tR\tcolumnIds\x12%\n" +
+	"\x0einclude_hidden\x18\a \x01(\bR\rincludeHidden\"*\n" +
+	"\x12ExportRowsResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\x9f\x01\n" +
+	"\x11SearchRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"column_ids\x18\x03 \x03(\tR\tcolumnIds\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"a\n" +
+	"\x12SearchRowsResponse\x12#\n" +
+	"\x04rows\x18\x01 \x03(\v2\x0f.lowcode.v1.RowR\x04rows\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\vAggregation\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x129\n" +
+	"\bfunction\x18\x02 \x01(\x0e2\x1d.lowcode.v1.AggregateFunctionR\bfunction\"\xe2\x01\n" +
+	"\x14AggregateRowsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12-\n" +
+	"\x13group_by_column_ids\x18\x02 \x03(\tR\x10groupByColumnIds\x12;\n" +
+	"\faggregations\x18\x03 \x03(\v2\x17.lowcode.v1.AggregationR\faggregations\x12-\n" +
+	"\x06filter\x18\x04 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xc1\x01\n" +
+	"\x0eAggregateGroup\x128\n" +
+	"\x04keys\x18\x01 \x03(\v2$.lowcode.v1.AggregateGroup.KeysEntryR\x04keys\x12)\n" +
+	"\x06values\x18\x02 \x03(\v2\x11.lowcode.v1.ValueR\x06values\x1aJ\n" +
+	"\tKeysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.lowcode.v1.ValueR\x05value:\x028\x01\"K\n" +
+	"\x15AggregateRowsResponse\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.lowcode.v1.AggregateGroupR\x06groups\"\x81\x01\n" +
+	"\x19ListDistinctValuesRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06search\x18\x04 \x01(\tR\x06search\"G\n" +
+	"\x1aListDistinctValuesResponse\x12)\n" +
+	"\x06values\x18\x01 \x03(\v2\x11.lowcode.v1.ValueR\x06values\"\xb7\x01\n" +
 	"\x11BulkUpsertRowItem\x12\x15\n" +
 	"\x06row_id\x18\x01 \x01(\tR\x05rowId\x12>\n" +
 	"\x05cells\x18\x02 \x03(\v2(.lowcode.v1.BulkUpsertRowItem.CellsEntryR\x05cells\x1aK\n" +
 	"\n" +
 	"CellsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
-	"\x05value\x18\x02 \x01(\v2\x11.lowcode.v1.ValueR\x05value:\x028\x01\"g\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.lowcode.v1.ValueR\x05value:\x028\x01\"\x97\x01\n" +
 	"\x15BulkUpsertRowsRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x123\n" +
-	"\x05items\x18\x02 \x03(\v2\x1d.lowcode.v1.BulkUpsertRowItemR\x05items\"=\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.lowcode.v1.BulkUpsertRowItemR\x05items\x12.\n" +
+	"\x13conflict_column_ids\x18\x03 \x03(\tR\x11conflictColumnIds\"=\n" +
 	"\x16BulkUpsertRowsResponse\x12#\n" +
 	"\x04rows\x18\x01 \x03(\v2\x0f.lowcode.v1.RowR\x04rows\"K\n" +
 	"\x15BulkDeleteRowsRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x17\n" +
 	"\arow_ids\x18\x02 \x03(\tR\x06rowIds\"\x18\n" +
-	"\x16BulkDeleteRowsResponse\"\x7f\n" +
+	"\x16BulkDeleteRowsResponse\"\xb3\x01\n" +
+	"\x0fCellContentInfo\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x05 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\"p\n" +
+	"\x18UploadCellContentRequest\x121\n" +
+	"\x04info\x18\x01 \x01(\v2\x1b.lowcode.v1.CellContentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"X\n" +
+	"\x19UploadCellContentResponse\x12'\n" +
+	"\x05value\x18\x01 \x01(\v2\x11.lowcode.v1.ValueR\x05value\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"k\n" +
+	"\x1aDownloadCellContentRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\"s\n" +
+	"\x1bDownloadCellContentResponse\x121\n" +
+	"\x04info\x18\x01 \x01(\v2\x1b.lowcode.v1.CellContentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x81\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\"\xf0\x01\n" +
+	"\fPresignedUrl\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12?\n" +
+	"\aheaders\x18\x03 \x03(\v2%.lowcode.v1.PresignedUrl.HeadersEntryR\aheaders\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaa\x01\n" +
+	"\x1dCreateAttachmentUploadRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\"\x8a\x01\n" +
+	"\x1eCreateAttachmentUploadResponse\x126\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x16.lowcode.v1.AttachmentR\n" +
+	"attachment\x120\n" +
+	"\x06upload\x18\x02 \x01(\v2\x18.lowcode.v1.PresignedUrlR\x06upload\"\x8d\x01\n" +
+	"\x17GetAttachmentUrlRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x15\n" +
+	"\x06row_id\x18\x02 \x01(\tR\x05rowId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\x12#\n" +
+	"\rattachment_id\x18\x04 \x01(\tR\fattachmentId\"\x88\x01\n" +
+	"\x18GetAttachmentUrlResponse\x126\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x16.lowcode.v1.AttachmentR\n" +
+	"attachment\x124\n" +
+	"\bdownload\x18\x02 \x01(\v2\x18.lowcode.v1.PresignedUrlR\bdownload\"\xbb\x02\n" +
 	"\x12CreateIndexRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"column_ids\x18\x03 \x03(\tR\tcolumnIds\x12\x1b\n" +
-	"\tis_unique\x18\x04 \x01(\bR\bisUnique\">\n" +
+	"\tis_unique\x18\x04 \x01(\bR\bisUnique\x12;\n" +
+	"\n" +
+	"expression\x18\x05 \x01(\v2\x1b.lowcode.v1.IndexExpressionR\n" +
+	"expression\x12+\n" +
+	"\x05where\x18\x06 \x01(\v2\x15.lowcode.v1.RowFilterR\x05where\x12:\n" +
+	"\findex_method\x18\a \x01(\x0e2\x17.lowcode.v1.IndexMethodR\vindexMethod\x12\x14\n" +
+	"\x05async\x18\b \x01(\bR\x05async\"s\n" +
 	"\x13CreateIndexResponse\x12'\n" +
-	"\x05index\x18\x01 \x01(\v2\x11.lowcode.v1.IndexR\x05index\"$\n" +
+	"\x05index\x18\x01 \x01(\v2\x11.lowcode.v1.IndexR\x05index\x123\n" +
+	"\toperation\x18\x02 \x01(\v2\x15.lowcode.v1.OperationR\toperation\"\x8c\x01\n" +
+	"\x12UpdateIndexRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\arebuild\x18\x03 \x01(\bR\arebuild\x12\"\n" +
+	"\fconcurrently\x18\x04 \x01(\bR\fconcurrently\x12\x14\n" +
+	"\x05async\x18\x05 \x01(\bR\x05async\"s\n" +
+	"\x13UpdateIndexResponse\x12'\n" +
+	"\x05index\x18\x01 \x01(\v2\x11.lowcode.v1.IndexR\x05index\x123\n" +
+	"\toperation\x18\x02 \x01(\v2\x15.lowcode.v1.OperationR\toperation\"H\n" +
+	"\x12SyncIndexesRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"v\n" +
+	"\x13SyncIndexesResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.lowcode.v1.SchemaChangeR\achanges\x12+\n" +
+	"\aindexes\x18\x02 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\"$\n" +
 	"\x12DeleteIndexRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13DeleteIndexResponse\"/\n" +
+	"\x13DeleteIndexResponse\"\xe8\x01\n" +
+	"\x11CreateViewRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x06filter\x18\x03 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12*\n" +
+	"\x05sorts\x18\x04 \x03(\v2\x14.lowcode.v1.SortSpecR\x05sorts\x12,\n" +
+	"\x12visible_column_ids\x18\x05 \x03(\tR\x10visibleColumnIds\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\":\n" +
+	"\x12CreateViewResponse\x12$\n" +
+	"\x04view\x18\x01 \x01(\v2\x10.lowcode.v1.ViewR\x04view\"-\n" +
+	"\x10ListViewsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\";\n" +
+	"\x11ListViewsResponse\x12&\n" +
+	"\x05views\x18\x01 \x03(\v2\x10.lowcode.v1.ViewR\x05views\" \n" +
+	"\x0eGetViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x0fGetViewResponse\x12$\n" +
+	"\x04view\x18\x01 \x01(\v2\x10.lowcode.v1.ViewR\x04view\"\xdd\x01\n" +
+	"\x11UpdateViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x06filter\x18\x03 \x01(\v2\x15.lowcode.v1.RowFilterR\x06filter\x12*\n" +
+	"\x05sorts\x18\x04 \x03(\v2\x14.lowcode.v1.SortSpecR\x05sorts\x12,\n" +
+	"\x12visible_column_ids\x18\x05 \x03(\tR\x10visibleColumnIds\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\":\n" +
+	"\x12UpdateViewResponse\x12$\n" +
+	"\x04view\x18\x01 \x01(\v2\x10.lowcode.v1.ViewR\x04view\"#\n" +
+	"\x11DeleteViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteViewResponse\"/\n" +
 	"\x12ListIndexesRequest\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\"B\n" +
 	"\x13ListIndexesResponse\x12+\n" +
-	"\aindexes\x18\x01 \x03(\v2\x11.lowcode.v1.IndexR\aindexes2\xd8\x12\n" +
+	"\aindexes\x18\x01 \x03(\v2\x11.lowcode.v1.IndexR\aindexes\"U\n" +
+	"\x13ExternalTableExport\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"n\n" +
+	"\x1bImportExternalTablesRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x127\n" +
+	"\x06tables\x18\x02 \x03(\v2\x1f.lowcode.v1.ExternalTableExportR\x06tables\"\x83\x01\n" +
+	"\rImportedTable\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12\x1b\n" +
+	"\trow_count\x18\x03 \x01(\x05R\browCount\"Q\n" +
+	"\x1cImportExternalTablesResponse\x121\n" +
+	"\x06tables\x18\x01 \x03(\v2\x19.lowcode.v1.ImportedTableR\x06tables\"7\n" +
+	"\x1bImportDatabaseSchemaRequest\x12\x18\n" +
+	"\aschemas\x18\x01 \x03(\tR\aschemas\"e\n" +
+	"\fAdoptedTable\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\"f\n" +
+	"\fSkippedTable\x12\x1f\n" +
+	"\vschema_name\x18\x01 \x01(\tR\n" +
+	"schemaName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x1cImportDatabaseSchemaResponse\x120\n" +
+	"\x06tables\x18\x01 \x03(\v2\x18.lowcode.v1.AdoptedTableR\x06tables\x122\n" +
+	"\askipped\x18\x02 \x03(\v2\x18.lowcode.v1.SkippedTableR\askipped\"\xa4\x01\n" +
+	"\x14CreateSQLViewRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vschema_name\x18\x02 \x01(\tR\n" +
+	"schemaName\x12\x10\n" +
+	"\x03sql\x18\x03 \x01(\tR\x03sql\x12\"\n" +
+	"\fmaterialized\x18\x04 \x01(\bR\fmaterialized\x12!\n" +
+	"\fworkspace_id\x18\x05 \x01(\tR\vworkspaceId\"n\n" +
+	"\x15CreateSQLViewResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\"V\n" +
+	"\x15RefreshSQLViewRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\"\n" +
+	"\fconcurrently\x18\x02 \x01(\bR\fconcurrently\"A\n" +
+	"\x16RefreshSQLViewResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\"\x8c\x02\n" +
+	"\x1aImportExistingTableRequest\x12\x1f\n" +
+	"\vschema_name\x18\x01 \x01(\tR\n" +
+	"schemaName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12Z\n" +
+	"\fcolumn_types\x18\x04 \x03(\v27.lowcode.v1.ImportExistingTableRequest.ColumnTypesEntryR\vcolumnTypes\x1a>\n" +
+	"\x10ColumnTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb1\x01\n" +
+	"\x1bImportExistingTableResponse\x12'\n" +
+	"\x05table\x18\x01 \x01(\v2\x11.lowcode.v1.TableR\x05table\x12,\n" +
+	"\acolumns\x18\x02 \x03(\v2\x12.lowcode.v1.ColumnR\acolumns\x12;\n" +
+	"\x0frelated_columns\x18\x03 \x03(\v2\x12.lowcode.v1.ColumnR\x0erelatedColumns\"\xfe\x03\n" +
+	"\tOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1b.lowcode.v1.OperationStatusR\x06status\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done\x123\n" +
+	"\bresponse\x18\x06 \x01(\v2\x17.google.protobuf.StructR\bresponse\x124\n" +
+	"\n" +
+	"error_code\x18\a \x01(\x0e2\x15.lowcode.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12)\n" +
+	"\x10cancel_requested\x18\t \x01(\bR\x0fcancelRequested\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"%\n" +
+	"\x13GetOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x14GetOperationResponse\x123\n" +
+	"\toperation\x18\x01 \x01(\v2\x15.lowcode.v1.OperationR\toperation\"\xb4\x01\n" +
+	"\x15ListOperationsRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.lowcode.v1.OperationStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"w\n" +
+	"\x16ListOperationsResponse\x125\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x15.lowcode.v1.OperationR\n" +
+	"operations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"(\n" +
+	"\x16CancelOperationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x17CancelOperationResponse\x123\n" +
+	"\toperation\x18\x01 \x01(\v2\x15.lowcode.v1.OperationR\toperation\"\xbd\x01\n" +
+	"\x06Member\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.lowcode.v1.RoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x14\n" +
+	"\x12ListMembersRequest\"C\n" +
+	"\x13ListMembersResponse\x12,\n" +
+	"\amembers\x18\x01 \x03(\v2\x12.lowcode.v1.MemberR\amembers\"Q\n" +
+	"\x10SetMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x10.lowcode.v1.RoleR\x04role\"?\n" +
+	"\x11SetMemberResponse\x12*\n" +
+	"\x06member\x18\x01 \x01(\v2\x12.lowcode.v1.MemberR\x06member\".\n" +
+	"\x13DeleteMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x16\n" +
+	"\x14DeleteMemberResponse\"\xba\x02\n" +
+	"\n" +
+	"Permission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\x12$\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x10.lowcode.v1.RoleR\x04role\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x124\n" +
+	"\x06access\x18\x06 \x01(\x0e2\x1c.lowcode.v1.PermissionAccessR\x06access\x124\n" +
+	"\x06effect\x18\a \x01(\x0e2\x1c.lowcode.v1.PermissionEffectR\x06effect\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x16ListPermissionsRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\"S\n" +
+	"\x17ListPermissionsResponse\x128\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x16.lowcode.v1.PermissionR\vpermissions\"\xf9\x01\n" +
+	"\x14SetPermissionRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12$\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x10.lowcode.v1.RoleR\x04role\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x124\n" +
+	"\x06access\x18\x05 \x01(\x0e2\x1c.lowcode.v1.PermissionAccessR\x06access\x124\n" +
+	"\x06effect\x18\x06 \x01(\x0e2\x1c.lowcode.v1.PermissionEffectR\x06effect\"O\n" +
+	"\x15SetPermissionResponse\x126\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x16.lowcode.v1.PermissionR\n" +
+	"permission\")\n" +
+	"\x17DeletePermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1a\n" +
+	"\x18DeletePermissionResponse\"\xd0\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x19\n" +
+	"\btable_id\x18\x05 \x01(\tR\atableId\x12!\n" +
+	"\fresource_ids\x18\x06 \x03(\tR\vresourceIds\x12\x12\n" +
+	"\x04code\x18\a \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x121\n" +
+	"\arequest\x18\t \x01(\v2\x17.google.protobuf.StructR\arequest\x12+\n" +
+	"\x04diff\x18\n" +
+	" \x01(\v2\x17.google.protobuf.StructR\x04diff\"\xb3\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x19\n" +
+	"\btable_id\x18\x03 \x01(\tR\atableId\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"q\n" +
+	"\x17ListAuditEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.lowcode.v1.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xba\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x19\n" +
+	"\btable_id\x18\x03 \x01(\tR\atableId\x12\x18\n" +
+	"\amethods\x18\x04 \x03(\tR\amethods\x12\x1d\n" +
+	"\n" +
+	"has_secret\x18\x05 \x01(\bR\thasSecret\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"u\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x18\n" +
+	"\amethods\x18\x03 \x03(\tR\amethods\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\"F\n" +
+	"\x15CreateWebhookResponse\x12-\n" +
+	"\awebhook\x18\x01 \x01(\v2\x13.lowcode.v1.WebhookR\awebhook\"\x15\n" +
+	"\x13ListWebhooksRequest\"G\n" +
+	"\x14ListWebhooksResponse\x12/\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x13.lowcode.v1.WebhookR\bwebhooks\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteWebhookResponse\"\xa3\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x03 \x01(\tR\twebhookId\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12$\n" +
+	"\x0eaudit_event_id\x18\x05 \x01(\tR\fauditEventId\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x1f\n" +
+	"\vstatus_code\x18\a \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x05R\n" +
+	"durationMs\"\x8c\x02\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1f\n" +
+	"\vfailed_only\x18\x02 \x01(\bR\n" +
+	"failedOnly\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12;\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1b.lowcode.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*\x9e\x01\n" +
+	"\vIndexMethod\x12\x1c\n" +
+	"\x18INDEX_METHOD_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12INDEX_METHOD_BTREE\x10\x01\x12\x15\n" +
+	"\x11INDEX_METHOD_HASH\x10\x02\x12\x14\n" +
+	"\x10INDEX_METHOD_GIN\x10\x03\x12\x15\n" +
+	"\x11INDEX_METHOD_GIST\x10\x04\x12\x15\n" +
+	"\x11INDEX_METHOD_BRIN\x10\x05*\x9b\x01\n" +
+	"\rIndexFunction\x12\x1e\n" +
+	"\x1aINDEX_FUNCTION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14INDEX_FUNCTION_LOWER\x10\x01\x12\x18\n" +
+	"\x14INDEX_FUNCTION_UPPER\x10\x02\x12\x1d\n" +
+	"\x19INDEX_FUNCTION_JSON_FIELD\x10\x03\x12\x17\n" +
+	"\x13INDEX_FUNCTION_DATE\x10\x04*\xce\x03\n" +
+	"\tErrorCode\x12\x1a\n" +
+	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bINTERNAL\x10\x01\x12\x15\n" +
+	"\x11VALIDATION_FAILED\x10\x02\x12\r\n" +
+	"\tNOT_FOUND\x10\x03\x12\x13\n" +
+	"\x0fTABLE_NOT_FOUND\x10\x04\x12\x14\n" +
+	"\x10COLUMN_NOT_FOUND\x10\x05\x12\x11\n" +
+	"\rROW_NOT_FOUND\x10\x06\x12\x12\n" +
+	"\x0eTYPE_NOT_FOUND\x10\a\x12\x13\n" +
+	"\x0fINDEX_NOT_FOUND\x10\b\x12\x12\n" +
+	"\x0eALREADY_EXISTS\x10\t\x12\x14\n" +
+	"\x10UNIQUE_VIOLATION\x10\n" +
+	"\x12\x16\n" +
+	"\x12NOT_NULL_VIOLATION\x10\v\x12\x19\n" +
+	"\x15FOREIGN_KEY_VIOLATION\x10\f\x12\x12\n" +
+	"\x0eQUOTA_EXCEEDED\x10\r\x12\x13\n" +
+	"\x0fTENANT_REQUIRED\x10\x0e\x12\x14\n" +
+	"\x10TENANT_NOT_FOUND\x10\x0f\x12\x14\n" +
+	"\x10TENANT_SUSPENDED\x10\x10\x12\x15\n" +
+	"\x11PERMISSION_DENIED\x10\x11\x12\x13\n" +
+	"\x0fUNAUTHENTICATED\x10\x12\x12\x15\n" +
+	"\x11STATEMENT_TIMEOUT\x10\x13\x12\x15\n" +
+	"\x11PAYLOAD_TOO_LARGE\x10\x14*\x9a\x01\n" +
+	"\vTenantState\x12\x1c\n" +
+	"\x18TENANT_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TENANT_STATE_PROVISIONING\x10\x01\x12\x17\n" +
+	"\x13TENANT_STATE_ACTIVE\x10\x02\x12\x1a\n" +
+	"\x16TENANT_STATE_SUSPENDED\x10\x03\x12\x19\n" +
+	"\x15TENANT_STATE_DELETING\x10\x04*z\n" +
+	"\vBackupState\x12\x1c\n" +
+	"\x18BACKUP_STATE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BACKUP_STATE_RUNNING\x10\x01\x12\x1a\n" +
+	"\x16BACKUP_STATE_SUCCEEDED\x10\x02\x12\x17\n" +
+	"\x13BACKUP_STATE_FAILED\x10\x03*h\n" +
+	"\rBackupTrigger\x12\x1e\n" +
+	"\x1aBACKUP_TRIGGER_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18BACKUP_TRIGGER_SCHEDULED\x10\x01\x12\x19\n" +
+	"\x15BACKUP_TRIGGER_MANUAL\x10\x02*\xad\x01\n" +
+	"\tTableSort\x12\x1a\n" +
+	"\x16TABLE_SORT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15TABLE_SORT_CREATED_AT\x10\x01\x12\x19\n" +
+	"\x15TABLE_SORT_UPDATED_AT\x10\x02\x12\x13\n" +
+	"\x0fTABLE_SORT_NAME\x10\x03\x12\x1d\n" +
+	"\x19TABLE_SORT_ESTIMATED_ROWS\x10\x04\x12\x1a\n" +
+	"\x16TABLE_SORT_TOTAL_BYTES\x10\x05*\x84\x02\n" +
+	"\tDriftKind\x12\x1a\n" +
+	"\x16DRIFT_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DRIFT_KIND_MISSING_TABLE\x10\x01\x12\x1d\n" +
+	"\x19DRIFT_KIND_MISSING_COLUMN\x10\x02\x12\x1c\n" +
+	"\x18DRIFT_KIND_TYPE_MISMATCH\x10\x03\x12#\n" +
+	"\x1fDRIFT_KIND_NULLABILITY_MISMATCH\x10\x04\x12\x1e\n" +
+	"\x1aDRIFT_KIND_ORPHANED_COLUMN\x10\x05\x12\x1c\n" +
+	"\x18DRIFT_KIND_MISSING_INDEX\x10\x06\x12\x1d\n" +
+	"\x19DRIFT_KIND_ORPHANED_INDEX\x10\a*\x82\x01\n" +
+	"\fCastStrategy\x12\x1d\n" +
+	"\x19CAST_STRATEGY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CAST_STRATEGY_CAST\x10\x01\x12\x1a\n" +
+	"\x16CAST_STRATEGY_TRUNCATE\x10\x02\x12\x1f\n" +
+	"\x1bCAST_STRATEGY_FAIL_ON_ERROR\x10\x03*\x94\x01\n" +
+	"\x10RowVersionAction\x12\"\n" +
+	"\x1eROW_VERSION_ACTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ROW_VERSION_ACTION_UPDATE\x10\x01\x12\x1d\n" +
+	"\x19ROW_VERSION_ACTION_DELETE\x10\x02\x12\x1e\n" +
+	"\x1aROW_VERSION_ACTION_RESTORE\x10\x03*\xe0\x03\n" +
+	"\x0eFilterOperator\x12\x1f\n" +
+	"\x1bFILTER_OPERATOR_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12FILTER_OPERATOR_EQ\x10\x01\x12\x17\n" +
+	"\x13FILTER_OPERATOR_NEQ\x10\x02\x12\x16\n" +
+	"\x12FILTER_OPERATOR_LT\x10\x03\x12\x17\n" +
+	"\x13FILTER_OPERATOR_LTE\x10\x04\x12\x16\n" +
+	"\x12FILTER_OPERATOR_GT\x10\x05\x12\x17\n" +
+	"\x13FILTER_OPERATOR_GTE\x10\x06\x12\x1c\n" +
+	"\x18FILTER_OPERATOR_CONTAINS\x10\a\x12\x1f\n" +
+	"\x1bFILTER_OPERATOR_STARTS_WITH\x10\b\x12\x16\n" +
+	"\x12FILTER_OPERATOR_IN\x10\t\x12\x1a\n" +
+	"\x16FILTER_OPERATOR_NOT_IN\x10\n" +
+	"\x12\x1b\n" +
+	"\x17FILTER_OPERATOR_IS_NULL\x10\v\x12\x1f\n" +
+	"\x1bFILTER_OPERATOR_IS_NOT_NULL\x10\f\x12!\n" +
+	"\x1dFILTER_OPERATOR_WITHIN_RADIUS\x10\r\x12\"\n" +
+	"\x1eFILTER_OPERATOR_ARRAY_CONTAINS\x10\x0e\x12\"\n" +
+	"\x1eFILTER_OPERATOR_ARRAY_OVERLAPS\x10\x0f*\x90\x01\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_XLSX\x10\x02\x12\x17\n" +
+	"\x13EXPORT_FORMAT_JSONL\x10\x03\x12\x19\n" +
+	"\x15EXPORT_FORMAT_PARQUET\x10\x04*\xec\x01\n" +
+	"\x11AggregateFunction\x12\"\n" +
+	"\x1eAGGREGATE_FUNCTION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18AGGREGATE_FUNCTION_COUNT\x10\x01\x12\x1a\n" +
+	"\x16AGGREGATE_FUNCTION_SUM\x10\x02\x12\x1a\n" +
+	"\x16AGGREGATE_FUNCTION_AVG\x10\x03\x12\x1a\n" +
+	"\x16AGGREGATE_FUNCTION_MIN\x10\x04\x12\x1a\n" +
+	"\x16AGGREGATE_FUNCTION_MAX\x10\x05\x12%\n" +
+	"!AGGREGATE_FUNCTION_COUNT_DISTINCT\x10\x06*\xae\x01\n" +
+	"\x0fOperationStatus\x12 \n" +
+	"\x1cOPERATION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18OPERATION_STATUS_RUNNING\x10\x01\x12\x1e\n" +
+	"\x1aOPERATION_STATUS_SUCCEEDED\x10\x02\x12\x1b\n" +
+	"\x17OPERATION_STATUS_FAILED\x10\x03\x12\x1e\n" +
+	"\x1aOPERATION_STATUS_CANCELLED\x10\x04*`\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vROLE_VIEWER\x10\x01\x12\x0f\n" +
+	"\vROLE_EDITOR\x10\x02\x12\x10\n" +
+	"\fROLE_BUILDER\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ROLE_OWNER\x10\x04*\x8c\x01\n" +
+	"\x10PermissionAccess\x12!\n" +
+	"\x1dPERMISSION_ACCESS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PERMISSION_ACCESS_READ\x10\x01\x12\x1b\n" +
+	"\x17PERMISSION_ACCESS_WRITE\x10\x02\x12\x1c\n" +
+	"\x18PERMISSION_ACCESS_UNMASK\x10\x03*n\n" +
+	"\x10PermissionEffect\x12!\n" +
+	"\x1dPERMISSION_EFFECT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PERMISSION_EFFECT_ALLOW\x10\x01\x12\x1a\n" +
+	"\x16PERMISSION_EFFECT_DENY\x10\x022\xb1c\n" +
 	"\x0eLowcodeService\x12i\n" +
-	"\fCreateTenant\x12\x1f.lowcode.v1.CreateTenantRequest\x1a .lowcode.v1.CreateTenantResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tenants\x12a\n" +
+	"\fCreateTenant\x12\x1f.lowcode.v1.CreateTenantRequest\x1a .lowcode.v1.CreateTenantResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tenants\x12c\n" +
+	"\vListTenants\x12\x1e.lowcode.v1.ListTenantsRequest\x1a\x1f.lowcode.v1.ListTenantsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/tenants\x12b\n" +
+	"\tGetTenant\x12\x1c.lowcode.v1.GetTenantRequest\x1a\x1d.lowcode.v1.GetTenantResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tenants/{id}\x12k\n" +
+	"\fDeleteTenant\x12\x1f.lowcode.v1.DeleteTenantRequest\x1a .lowcode.v1.DeleteTenantResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/tenants/{id}\x12x\n" +
+	"\vCloneTenant\x12\x1e.lowcode.v1.CloneTenantRequest\x1a\x1f.lowcode.v1.CloneTenantResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tenants/{source_id}:clone\x12S\n" +
+	"\fExportTenant\x12\x1f.lowcode.v1.ExportTenantRequest\x1a .lowcode.v1.ExportTenantResponse0\x01\x12S\n" +
+	"\fImportTenant\x12\x1f.lowcode.v1.ImportTenantRequest\x1a .lowcode.v1.ImportTenantResponse(\x01\x12\x80\x01\n" +
+	"\x11MigrateAllTenants\x12$.lowcode.v1.MigrateAllTenantsRequest\x1a%.lowcode.v1.MigrateAllTenantsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/tenants:migrate\x12y\n" +
+	"\x10ListTenantHealth\x12#.lowcode.v1.ListTenantHealthRequest\x1a$.lowcode.v1.ListTenantHealthResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/tenants:health\x12n\n" +
+	"\fUpdateTenant\x12\x1f.lowcode.v1.UpdateTenantRequest\x1a .lowcode.v1.UpdateTenantResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/tenants/{id}\x12y\n" +
+	"\rSuspendTenant\x12 .lowcode.v1.SuspendTenantRequest\x1a!.lowcode.v1.SuspendTenantResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tenants/{id}:suspend\x12u\n" +
+	"\fResumeTenant\x12\x1f.lowcode.v1.ResumeTenantRequest\x1a .lowcode.v1.ResumeTenantResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tenants/{id}:resume\x12y\n" +
+	"\x10RotateTenantKeys\x12#.lowcode.v1.RotateTenantKeysRequest\x1a$.lowcode.v1.RotateTenantKeysResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/keys:rotate\x12\x80\x01\n" +
+	"\rTriggerBackup\x12 .lowcode.v1.TriggerBackupRequest\x1a!.lowcode.v1.TriggerBackupResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tenants/{tenant_id}/backups\x12\x86\x01\n" +
+	"\vListBackups\x12\x1e.lowcode.v1.ListBackupsRequest\x1a\x1f.lowcode.v1.ListBackupsResponse\"6\x82\xd3\xe4\x93\x020Z\r\x12\v/v1/backups\x12\x1f/v1/tenants/{tenant_id}/backups\x12a\n" +
 	"\n" +
 	"CreateType\x12\x1d.lowcode.v1.CreateTypeRequest\x1a\x1e.lowcode.v1.CreateTypeResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/types\x12[\n" +
-	"\tListTypes\x12\x1c.lowcode.v1.ListTypesRequest\x1a\x1d.lowcode.v1.ListTypesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/types\x12c\n" +
+	"\tListTypes\x12\x1c.lowcode.v1.ListTypesRequest\x1a\x1d.lowcode.v1.ListTypesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/types\x12Z\n" +
+	"\aGetType\x12\x1a.lowcode.v1.GetTypeRequest\x1a\x1b.lowcode.v1.GetTypeResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/types/{id}\x12f\n" +
+	"\n" +
+	"UpdateType\x12\x1d.lowcode.v1.UpdateTypeRequest\x1a\x1e.lowcode.v1.UpdateTypeResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/types/{id}\x12c\n" +
 	"\n" +
-	"DeleteType\x12\x1d.lowcode.v1.DeleteTypeRequest\x1a\x1e.lowcode.v1.DeleteTypeResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/types/{id}\x12e\n" +
+	"DeleteType\x12\x1d.lowcode.v1.DeleteTypeRequest\x1a\x1e.lowcode.v1.DeleteTypeResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/types/{id}\x12h\n" +
+	"\vExportTypes\x12\x1e.lowcode.v1.ExportTypesRequest\x1a\x1f.lowcode.v1.ExportTypesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/types:export\x12k\n" +
+	"\vImportTypes\x12\x1e.lowcode.v1.ImportTypesRequest\x1a\x1f.lowcode.v1.ImportTypesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/types:import\x12e\n" +
 	"\vCreateTable\x12\x1e.lowcode.v1.CreateTableRequest\x1a\x1f.lowcode.v1.CreateTableResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
-	"/v1/tables\x12g\n" +
-	"\vDeleteTable\x12\x1e.lowcode.v1.DeleteTableRequest\x1a\x1f.lowcode.v1.DeleteTableResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/tables/{id}\x12_\n" +
+	"/v1/tables\x12\x94\x01\n" +
+	"\x15CreateTableWithSchema\x12(.lowcode.v1.CreateTableWithSchemaRequest\x1a).lowcode.v1.CreateTableWithSchemaResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/tables:createWithSchema\x12\x80\x01\n" +
+	"\x10ApplyTableSchema\x12#.lowcode.v1.ApplyTableSchemaRequest\x1a$.lowcode.v1.ApplyTableSchemaResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/tables:applySchema\x12l\n" +
+	"\fExportSchema\x12\x1f.lowcode.v1.ExportSchemaRequest\x1a .lowcode.v1.ExportSchemaResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/schema:export\x12o\n" +
+	"\fImportSchema\x12\x1f.lowcode.v1.ImportSchemaRequest\x1a .lowcode.v1.ImportSchemaResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/schema:import\x12k\n" +
+	"\rListTemplates\x12 .lowcode.v1.ListTemplatesRequest\x1a!.lowcode.v1.ListTemplatesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/templates\x12\xa6\x01\n" +
+	"\x17CreateTableFromTemplate\x12*.lowcode.v1.CreateTableFromTemplateRequest\x1a+.lowcode.v1.CreateTableFromTemplateResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/templates/{template_id}:instantiate\x12j\n" +
+	"\vUpdateTable\x12\x1e.lowcode.v1.UpdateTableRequest\x1a\x1f.lowcode.v1.UpdateTableResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/tables/{id}\x12\x83\x01\n" +
+	"\x0eDuplicateTable\x12!.lowcode.v1.DuplicateTableRequest\x1a\".lowcode.v1.DuplicateTableResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tables/{table_id}:duplicate\x12g\n" +
+	"\vDeleteTable\x12\x1e.lowcode.v1.DeleteTableRequest\x1a\x1f.lowcode.v1.DeleteTableResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/tables/{id}\x12u\n" +
+	"\fRestoreTable\x12\x1f.lowcode.v1.RestoreTableRequest\x1a .lowcode.v1.RestoreTableResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tables/{id}:restore\x12m\n" +
+	"\n" +
+	"PurgeTable\x12\x1d.lowcode.v1.PurgeTableRequest\x1a\x1e.lowcode.v1.PurgeTableResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tables/{id}:purge\x12_\n" +
 	"\n" +
 	"ListTables\x12\x1d.lowcode.v1.ListTablesRequest\x1a\x1e.lowcode.v1.ListTablesResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/v1/tables\x12}\n" +
-	"\x0eGetTableSchema\x12!.lowcode.v1.GetTableSchemaRequest\x1a\".lowcode.v1.GetTableSchemaResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/tables/{table_id}/schema\x12r\n" +
+	"/v1/tables\x12^\n" +
+	"\bGetTable\x12\x1b.lowcode.v1.GetTableRequest\x1a\x1c.lowcode.v1.GetTableResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/tables/{id}\x12}\n" +
+	"\x0eGetTableSchema\x12!.lowcode.v1.GetTableSchemaRequest\x1a\".lowcode.v1.GetTableSchemaResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/tables/{table_id}/schema\x12\x8f\x01\n" +
+	"\x11RepairTableSchema\x12$.lowcode.v1.RepairTableSchemaRequest\x1a%.lowcode.v1.RepairTableSchemaResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/tables/{table_id}:repairSchema\x12w\n" +
+	"\x12GetWorkspaceSchema\x12%.lowcode.v1.GetWorkspaceSchemaRequest\x1a&.lowcode.v1.GetWorkspaceSchemaResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/schema\x12u\n" +
+	"\x0fCreateWorkspace\x12\".lowcode.v1.CreateWorkspaceRequest\x1a#.lowcode.v1.CreateWorkspaceResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/workspaces\x12o\n" +
+	"\x0eListWorkspaces\x12!.lowcode.v1.ListWorkspacesRequest\x1a\".lowcode.v1.ListWorkspacesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/workspaces\x12n\n" +
+	"\fGetWorkspace\x12\x1f.lowcode.v1.GetWorkspaceRequest\x1a .lowcode.v1.GetWorkspaceResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/workspaces/{id}\x12z\n" +
+	"\x0fUpdateWorkspace\x12\".lowcode.v1.UpdateWorkspaceRequest\x1a#.lowcode.v1.UpdateWorkspaceResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/workspaces/{id}\x12w\n" +
+	"\x0fDeleteWorkspace\x12\".lowcode.v1.DeleteWorkspaceRequest\x1a#.lowcode.v1.DeleteWorkspaceResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/workspaces/{id}\x12r\n" +
 	"\tAddColumn\x12\x1c.lowcode.v1.AddColumnRequest\x1a\x1d.lowcode.v1.AddColumnResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tables/{table_id}/columns\x12n\n" +
 	"\fUpdateColumn\x12\x1f.lowcode.v1.UpdateColumnRequest\x1a .lowcode.v1.UpdateColumnResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/columns/{id}\x12k\n" +
-	"\fDeleteColumn\x12\x1f.lowcode.v1.DeleteColumnRequest\x1a .lowcode.v1.DeleteColumnResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/columns/{id}\x12u\n" +
-	"\vListColumns\x12\x1e.lowcode.v1.ListColumnsRequest\x1a\x1f.lowcode.v1.ListColumnsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tables/{table_id}/columns\x12o\n" +
+	"\fDeleteColumn\x12\x1f.lowcode.v1.DeleteColumnRequest\x1a .lowcode.v1.DeleteColumnResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/columns/{id}\x12\x8c\x01\n" +
+	"\x10ChangeColumnType\x12#.lowcode.v1.ChangeColumnTypeRequest\x1a$.lowcode.v1.ChangeColumnTypeResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/columns/{column_id}:changeType\x12u\n" +
+	"\vListColumns\x12\x1e.lowcode.v1.ListColumnsRequest\x1a\x1f.lowcode.v1.ListColumnsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tables/{table_id}/columns\x12\x89\x01\n" +
+	"\x0eReorderColumns\x12!.lowcode.v1.ReorderColumnsRequest\x1a\".lowcode.v1.ReorderColumnsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tables/{table_id}/columns:reorder\x12\x86\x01\n" +
+	"\x0fAddSelectOption\x12\".lowcode.v1.AddSelectOptionRequest\x1a#.lowcode.v1.AddSelectOptionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/columns/{column_id}/options\x12\x9b\x01\n" +
+	"\x12UpdateSelectOption\x12%.lowcode.v1.UpdateSelectOptionRequest\x1a&.lowcode.v1.UpdateSelectOptionResponse\"6\x82\xd3\xe4\x93\x020:\x01*2+/v1/columns/{column_id}/options/{option_id}\x12\x98\x01\n" +
+	"\x12RemoveSelectOption\x12%.lowcode.v1.RemoveSelectOptionRequest\x1a&.lowcode.v1.RemoveSelectOptionResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/columns/{column_id}/options/{option_id}\x12o\n" +
 	"\tCreateRow\x12\x1c.lowcode.v1.CreateRowRequest\x1a\x1d.lowcode.v1.CreateRowResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/tables/{table_id}/rows\x12x\n" +
 	"\tUpdateRow\x12\x1c.lowcode.v1.UpdateRowRequest\x1a\x1d.lowcode.v1.UpdateRowResponse\".\x82\xd3\xe4\x93\x02(:\x01*2#/v1/tables/{table_id}/rows/{row_id}\x12u\n" +
-	"\tDeleteRow\x12\x1c.lowcode.v1.DeleteRowRequest\x1a\x1d.lowcode.v1.DeleteRowResponse\"+\x82\xd3\xe4\x93\x02%*#/v1/tables/{table_id}/rows/{row_id}\x12i\n" +
-	"\bListRows\x12\x1b.lowcode.v1.ListRowsRequest\x1a\x1c.lowcode.v1.ListRowsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/tables/{table_id}/rows\x12\x89\x01\n" +
+	"\tDeleteRow\x12\x1c.lowcode.v1.DeleteRowRequest\x1a\x1d.lowcode.v1.DeleteRowResponse\"+\x82\xd3\xe4\x93\x02%*#/v1/tables/{table_id}/rows/{row_id}\x12\x83\x01\n" +
+	"\n" +
+	"RestoreRow\x12\x1d.lowcode.v1.RestoreRowRequest\x1a\x1e.lowcode.v1.RestoreRowResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/tables/{table_id}/rows/{row_id}:restore\x12\x89\x01\n" +
+	"\rGetRowHistory\x12 .lowcode.v1.GetRowHistoryRequest\x1a!.lowcode.v1.GetRowHistoryResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/tables/{table_id}/rows/{row_id}/history\x12\xad\x01\n" +
+	"\x11RestoreRowVersion\x12$.lowcode.v1.RestoreRowVersionRequest\x1a%.lowcode.v1.RestoreRowVersionResponse\"K\x82\xd3\xe4\x93\x02E:\x01*\"@/v1/tables/{table_id}/rows/{row_id}/history/{version_id}:restore\x12z\n" +
+	"\bLinkRows\x12\x1b.lowcode.v1.LinkRowsRequest\x1a\x1c.lowcode.v1.LinkRowsResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/tables/{table_id}/rows/{row_id}:link\x12\x82\x01\n" +
+	"\n" +
+	"UnlinkRows\x12\x1d.lowcode.v1.UnlinkRowsRequest\x1a\x1e.lowcode.v1.UnlinkRowsResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/tables/{table_id}/rows/{row_id}:unlink\x12u\n" +
+	"\tPurgeRows\x12\x1c.lowcode.v1.PurgeRowsRequest\x1a\x1d.lowcode.v1.PurgeRowsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/tables/{table_id}/rows:purge\x12l\n" +
+	"\x06GetRow\x12\x19.lowcode.v1.GetRowRequest\x1a\x1a.lowcode.v1.GetRowResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/tables/{table_id}/rows/{row_id}\x12\x86\x01\n" +
+	"\x0fFindRowByColumn\x12\".lowcode.v1.FindRowByColumnRequest\x1a#.lowcode.v1.FindRowByColumnResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tables/{table_id}/rows:find\x12\x90\x01\n" +
+	"\bListRows\x12\x1b.lowcode.v1.ListRowsRequest\x1a\x1c.lowcode.v1.ListRowsResponse\"I\x82\xd3\xe4\x93\x02CZ%:\x01*\" /v1/tables/{table_id}/rows:query\x12\x1a/v1/tables/{table_id}/rows\x12x\n" +
+	"\n" +
+	"StreamRows\x12\x1d.lowcode.v1.StreamRowsRequest\x1a\x1e.lowcode.v1.StreamRowsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/tables/{table_id}/rows:stream0\x01\x12M\n" +
+	"\n" +
+	"ExportRows\x12\x1d.lowcode.v1.ExportRowsRequest\x1a\x1e.lowcode.v1.ExportRowsResponse0\x01\x12v\n" +
+	"\n" +
+	"SearchRows\x12\x1d.lowcode.v1.SearchRowsRequest\x1a\x1e.lowcode.v1.SearchRowsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/tables/{table_id}/rows:search\x12\x85\x01\n" +
+	"\rAggregateRows\x12 .lowcode.v1.AggregateRowsRequest\x1a!.lowcode.v1.AggregateRowsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tables/{table_id}/rows:aggregate\x12\x9d\x01\n" +
+	"\x12ListDistinctValues\x12%.lowcode.v1.ListDistinctValuesRequest\x1a&.lowcode.v1.ListDistinctValuesResponse\"8\x82\xd3\xe4\x93\x022\x120/v1/tables/{table_id}/columns/{column_id}/values\x12\x89\x01\n" +
 	"\x0eBulkUpsertRows\x12!.lowcode.v1.BulkUpsertRowsRequest\x1a\".lowcode.v1.BulkUpsertRowsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tables/{table_id}/rows:bulkUpsert\x12\x89\x01\n" +
-	"\x0eBulkDeleteRows\x12!.lowcode.v1.BulkDeleteRowsRequest\x1a\".lowcode.v1.BulkDeleteRowsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tables/{table_id}/rows:bulkDelete\x12x\n" +
-	"\vCreateIndex\x12\x1e.lowcode.v1.CreateIndexRequest\x1a\x1f.lowcode.v1.CreateIndexResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tables/{table_id}/indexes\x12h\n" +
+	"\x0eBulkDeleteRows\x12!.lowcode.v1.BulkDeleteRowsRequest\x1a\".lowcode.v1.BulkDeleteRowsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tables/{table_id}/rows:bulkDelete\x12b\n" +
+	"\x11UploadCellContent\x12$.lowcode.v1.UploadCellContentRequest\x1a%.lowcode.v1.UploadCellContentResponse(\x01\x12h\n" +
+	"\x13DownloadCellContent\x12&.lowcode.v1.DownloadCellContentRequest\x1a'.lowcode.v1.DownloadCellContentResponse0\x01\x12\xb1\x01\n" +
+	"\x16CreateAttachmentUpload\x12).lowcode.v1.CreateAttachmentUploadRequest\x1a*.lowcode.v1.CreateAttachmentUploadResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/v1/tables/{table_id}/columns/{column_id}/attachments\x12\xb8\x01\n" +
+	"\x10GetAttachmentUrl\x12#.lowcode.v1.GetAttachmentUrlRequest\x1a$.lowcode.v1.GetAttachmentUrlResponse\"Y\x82\xd3\xe4\x93\x02S\x12Q/v1/tables/{table_id}/rows/{row_id}/cells/{column_id}/attachments/{attachment_id}\x12x\n" +
+	"\vCreateIndex\x12\x1e.lowcode.v1.CreateIndexRequest\x1a\x1f.lowcode.v1.CreateIndexResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tables/{table_id}/indexes\x12k\n" +
+	"\vUpdateIndex\x12\x1e.lowcode.v1.UpdateIndexRequest\x1a\x1f.lowcode.v1.UpdateIndexResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/indexes/{id}\x12h\n" +
 	"\vDeleteIndex\x12\x1e.lowcode.v1.DeleteIndexRequest\x1a\x1f.lowcode.v1.DeleteIndexResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/indexes/{id}\x12u\n" +
-	"\vListIndexes\x12\x1e.lowcode.v1.ListIndexesRequest\x1a\x1f.lowcode.v1.ListIndexesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tables/{table_id}/indexesB<Z:github.com/solat/lowcode-database/gen/lowcode/v1;lowcodev1b\x06proto3"
+	"\vListIndexes\x12\x1e.lowcode.v1.ListIndexesRequest\x1a\x1f.lowcode.v1.ListIndexesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tables/{table_id}/indexes\x12}\n" +
+	"\vSyncIndexes\x12\x1e.lowcode.v1.SyncIndexesRequest\x1a\x1f.lowcode.v1.SyncIndexesResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/tables/{table_id}/indexes:sync\x12s\n" +
+	"\n" +
+	"CreateView\x12\x1d.lowcode.v1.CreateViewRequest\x1a\x1e.lowcode.v1.CreateViewResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/tables/{table_id}/views\x12m\n" +
+	"\tListViews\x12\x1c.lowcode.v1.ListViewsRequest\x1a\x1d.lowcode.v1.ListViewsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/tables/{table_id}/views\x12Z\n" +
+	"\aGetView\x12\x1a.lowcode.v1.GetViewRequest\x1a\x1b.lowcode.v1.GetViewResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/views/{id}\x12f\n" +
+	"\n" +
+	"UpdateView\x12\x1d.lowcode.v1.UpdateViewRequest\x1a\x1e.lowcode.v1.UpdateViewResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/views/{id}\x12c\n" +
+	"\n" +
+	"DeleteView\x12\x1d.lowcode.v1.DeleteViewRequest\x1a\x1e.lowcode.v1.DeleteViewResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/views/{id}\x12\x81\x01\n" +
+	"\x14ImportExternalTables\x12'.lowcode.v1.ImportExternalTablesRequest\x1a(.lowcode.v1.ImportExternalTablesResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/imports\x12\x8f\x01\n" +
+	"\x14ImportDatabaseSchema\x12'.lowcode.v1.ImportDatabaseSchemaRequest\x1a(.lowcode.v1.ImportDatabaseSchemaResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/schema:importDatabase\x12\x89\x01\n" +
+	"\x13ImportExistingTable\x12&.lowcode.v1.ImportExistingTableRequest\x1a'.lowcode.v1.ImportExistingTableResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/schema:importTable\x12m\n" +
+	"\rCreateSQLView\x12 .lowcode.v1.CreateSQLViewRequest\x1a!.lowcode.v1.CreateSQLViewResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/sqlViews\x12\x81\x01\n" +
+	"\x0eRefreshSQLView\x12!.lowcode.v1.RefreshSQLViewRequest\x1a\".lowcode.v1.RefreshSQLViewResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tables/{table_id}:refresh\x12n\n" +
+	"\fGetOperation\x12\x1f.lowcode.v1.GetOperationRequest\x1a .lowcode.v1.GetOperationResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/operations/{id}\x12o\n" +
+	"\x0eListOperations\x12!.lowcode.v1.ListOperationsRequest\x1a\".lowcode.v1.ListOperationsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/operations\x12\x81\x01\n" +
+	"\x0fCancelOperation\x12\".lowcode.v1.CancelOperationRequest\x1a#.lowcode.v1.CancelOperationResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/operations/{id}:cancel\x12c\n" +
+	"\vListMembers\x12\x1e.lowcode.v1.ListMembersRequest\x1a\x1f.lowcode.v1.ListMembersResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/members\x12`\n" +
+	"\tSetMember\x12\x1c.lowcode.v1.SetMemberRequest\x1a\x1d.lowcode.v1.SetMemberResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/members\x12p\n" +
+	"\fDeleteMember\x12\x1f.lowcode.v1.DeleteMemberRequest\x1a .lowcode.v1.DeleteMemberResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/members/{user_id}\x12\x85\x01\n" +
+	"\x0fListPermissions\x12\".lowcode.v1.ListPermissionsRequest\x1a#.lowcode.v1.ListPermissionsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/tables/{table_id}/permissions\x12\x82\x01\n" +
+	"\rSetPermission\x12 .lowcode.v1.SetPermissionRequest\x1a!.lowcode.v1.SetPermissionResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/tables/{table_id}/permissions\x12{\n" +
+	"\x10DeletePermission\x12#.lowcode.v1.DeletePermissionRequest\x1a$.lowcode.v1.DeletePermissionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/permissions/{id}\x12s\n" +
+	"\x0fListAuditEvents\x12\".lowcode.v1.ListAuditEventsRequest\x1a#.lowcode.v1.ListAuditEventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/auditEvents\x12m\n" +
+	"\rCreateWebhook\x12 .lowcode.v1.CreateWebhookRequest\x1a!.lowcode.v1.CreateWebhookResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/webhooks\x12g\n" +
+	"\fListWebhooks\x12\x1f.lowcode.v1.ListWebhooksRequest\x1a .lowcode.v1.ListWebhooksResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12o\n" +
+	"\rDeleteWebhook\x12 .lowcode.v1.DeleteWebhookRequest\x1a!.lowcode.v1.DeleteWebhookResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/webhooks/{id}\x12\x8b\x01\n" +
+	"\x15ListWebhookDeliveries\x12(.lowcode.v1.ListWebhookDeliveriesRequest\x1a).lowcode.v1.ListWebhookDeliveriesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/webhookDeliveriesB<Z:github.com/solat/lowcode-database/gen/lowcode/v1;lowcodev1b\x06proto3"
 
 var (
 	file_lowcode_v1_lowcode_service_proto_rawDescOnce sync.Once
@@ -2905,152 +19137,809 @@ func file_lowcode_v1_lowcode_service_proto_rawDescGZIP() []byte {
 	return file_lowcode_v1_lowcode_service_proto_rawDescData
 }
 
-var file_lowcode_v1_lowcode_service_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_lowcode_v1_lowcode_service_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
+var file_lowcode_v1_lowcode_service_proto_msgTypes = make([]protoimpl.MessageInfo, 271)
 var file_lowcode_v1_lowcode_service_proto_goTypes = []any{
-	(*Type)(nil),                   // 0: lowcode.v1.Type
-	(*Table)(nil),                  // 1: lowcode.v1.Table
-	(*Column)(nil),                 // 2: lowcode.v1.Column
-	(*Index)(nil),                  // 3: lowcode.v1.Index
-	(*Value)(nil),                  // 4: lowcode.v1.Value
-	(*Row)(nil),                    // 5: lowcode.v1.Row
-	(*CreateTenantRequest)(nil),    // 6: lowcode.v1.CreateTenantRequest
-	(*CreateTenantResponse)(nil),   // 7: lowcode.v1.CreateTenantResponse
-	(*CreateTypeRequest)(nil),      // 8: lowcode.v1.CreateTypeRequest
-	(*CreateTypeResponse)(nil),     // 9: lowcode.v1.CreateTypeResponse
-	(*ListTypesRequest)(nil),       // 10: lowcode.v1.ListTypesRequest
-	(*ListTypesResponse)(nil),      // 11: lowcode.v1.ListTypesResponse
-	(*DeleteTypeRequest)(nil),      // 12: lowcode.v1.DeleteTypeRequest
-	(*DeleteTypeResponse)(nil),     // 13: lowcode.v1.DeleteTypeResponse
-	(*CreateTableRequest)(nil),     // 14: lowcode.v1.CreateTableRequest
-	(*CreateTableResponse)(nil),    // 15: lowcode.v1.CreateTableResponse
-	(*DeleteTableRequest)(nil),     // 16: lowcode.v1.DeleteTableRequest
-	(*DeleteTableResponse)(nil),    // 17: lowcode.v1.DeleteTableResponse
-	(*ListTablesRequest)(nil),      // 18: lowcode.v1.ListTablesRequest
-	(*ListTablesResponse)(nil),     // 19: lowcode.v1.ListTablesResponse
-	(*GetTableSchemaRequest)(nil),  // 20: lowcode.v1.GetTableSchemaRequest
-	(*GetTableSchemaResponse)(nil), // 21: lowcode.v1.GetTableSchemaResponse
-	(*AddColumnRequest)(nil),       // 22: lowcode.v1.AddColumnRequest
-	(*AddColumnResponse)(nil),      // 23: lowcode.v1.AddColumnResponse
-	(*UpdateColumnRequest)(nil),    // 24: lowcode.v1.UpdateColumnRequest
-	(*UpdateColumnResponse)(nil),   // 25: lowcode.v1.UpdateColumnResponse
-	(*DeleteColumnRequest)(nil),    // 26: lowcode.v1.DeleteColumnRequest
-	(*DeleteColumnResponse)(nil),   // 27: lowcode.v1.DeleteColumnResponse
-	(*ListColumnsRequest)(nil),     // 28: lowcode.v1.ListColumnsRequest
-	(*ListColumnsResponse)(nil),    // 29: lowcode.v1.ListColumnsResponse
-	(*CreateRowRequest)(nil),       // 30: lowcode.v1.CreateRowRequest
-	(*CreateRowResponse)(nil),      // 31: lowcode.v1.CreateRowResponse
-	(*UpdateRowRequest)(nil),       // 32: lowcode.v1.UpdateRowRequest
-	(*UpdateRowResponse)(nil),      // 33: lowcode.v1.UpdateRowResponse
-	(*DeleteRowRequest)(nil),       // 34: lowcode.v1.DeleteRowRequest
-	(*DeleteRowResponse)(nil),      // 35: lowcode.v1.DeleteRowResponse
-	(*ListRowsRequest)(nil),        // 36: lowcode.v1.ListRowsRequest
-	(*ListRowsResponse)(nil),       // 37: lowcode.v1.ListRowsResponse
-	(*BulkUpsertRowItem)(nil),      // 38: lowcode.v1.BulkUpsertRowItem
-	(*BulkUpsertRowsRequest)(nil),  // 39: lowcode.v1.BulkUpsertRowsRequest
-	(*BulkUpsertRowsResponse)(nil), // 40: lowcode.v1.BulkUpsertRowsResponse
-	(*BulkDeleteRowsRequest)(nil),  // 41: lowcode.v1.BulkDeleteRowsRequest
-	(*BulkDeleteRowsResponse)(nil), // 42: lowcode.v1.BulkDeleteRowsResponse
-	(*CreateIndexRequest)(nil),     // 43: lowcode.v1.CreateIndexRequest
-	(*CreateIndexResponse)(nil),    // 44: lowcode.v1.CreateIndexResponse
-	(*DeleteIndexRequest)(nil),     // 45: lowcode.v1.DeleteIndexRequest
-	(*DeleteIndexResponse)(nil),    // 46: lowcode.v1.DeleteIndexResponse
-	(*ListIndexesRequest)(nil),     // 47: lowcode.v1.ListIndexesRequest
-	(*ListIndexesResponse)(nil),    // 48: lowcode.v1.ListIndexesResponse
-	nil,                            // 49: lowcode.v1.Row.CellsEntry
-	nil,                            // 50: lowcode.v1.CreateRowRequest.CellsEntry
-	nil,                            // 51: lowcode.v1.UpdateRowRequest.CellsEntry
-	nil,                            // 52: lowcode.v1.BulkUpsertRowItem.CellsEntry
-	(*structpb.Struct)(nil),        // 53: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),  // 54: google.protobuf.Timestamp
+	(IndexMethod)(0),                        // 0: lowcode.v1.IndexMethod
+	(IndexFunction)(0),                      // 1: lowcode.v1.IndexFunction
+	(ErrorCode)(0),                          // 2: lowcode.v1.ErrorCode
+	(TenantState)(0),                        // 3: lowcode.v1.TenantState
+	(BackupState)(0),                        // 4: lowcode.v1.BackupState
+	(BackupTrigger)(0),                      // 5: lowcode.v1.BackupTrigger
+	(TableSort)(0),                          // 6: lowcode.v1.TableSort
+	(DriftKind)(0),                          // 7: lowcode.v1.DriftKind
+	(CastStrategy)(0),                       // 8: lowcode.v1.CastStrategy
+	(RowVersionAction)(0),                   // 9: lowcode.v1.RowVersionAction
+	(FilterOperator)(0),                     // 10: lowcode.v1.FilterOperator
+	(ExportFormat)(0),                       // 11: lowcode.v1.ExportFormat
+	(AggregateFunction)(0),                  // 12: lowcode.v1.AggregateFunction
+	(OperationStatus)(0),                    // 13: lowcode.v1.OperationStatus
+	(Role)(0),                               // 14: lowcode.v1.Role
+	(PermissionAccess)(0),                   // 15: lowcode.v1.PermissionAccess
+	(PermissionEffect)(0),                   // 16: lowcode.v1.PermissionEffect
+	(FilterGroup_Combinator)(0),             // 17: lowcode.v1.FilterGroup.Combinator
+	(SortSpec_Direction)(0),                 // 18: lowcode.v1.SortSpec.Direction
+	(SortSpec_Nulls)(0),                     // 19: lowcode.v1.SortSpec.Nulls
+	(*Type)(nil),                            // 20: lowcode.v1.Type
+	(*Table)(nil),                           // 21: lowcode.v1.Table
+	(*SQLViewSpec)(nil),                     // 22: lowcode.v1.SQLViewSpec
+	(*Workspace)(nil),                       // 23: lowcode.v1.Workspace
+	(*TableStats)(nil),                      // 24: lowcode.v1.TableStats
+	(*PartitionSpec)(nil),                   // 25: lowcode.v1.PartitionSpec
+	(*Column)(nil),                          // 26: lowcode.v1.Column
+	(*Index)(nil),                           // 27: lowcode.v1.Index
+	(*IndexExpression)(nil),                 // 28: lowcode.v1.IndexExpression
+	(*View)(nil),                            // 29: lowcode.v1.View
+	(*Value)(nil),                           // 30: lowcode.v1.Value
+	(*ValueList)(nil),                       // 31: lowcode.v1.ValueList
+	(*Row)(nil),                             // 32: lowcode.v1.Row
+	(*CreateTenantRequest)(nil),             // 33: lowcode.v1.CreateTenantRequest
+	(*TenantBootstrap)(nil),                 // 34: lowcode.v1.TenantBootstrap
+	(*TenantSeedRows)(nil),                  // 35: lowcode.v1.TenantSeedRows
+	(*CreateTenantResponse)(nil),            // 36: lowcode.v1.CreateTenantResponse
+	(*Tenant)(nil),                          // 37: lowcode.v1.Tenant
+	(*ListTenantsRequest)(nil),              // 38: lowcode.v1.ListTenantsRequest
+	(*ListTenantsResponse)(nil),             // 39: lowcode.v1.ListTenantsResponse
+	(*GetTenantRequest)(nil),                // 40: lowcode.v1.GetTenantRequest
+	(*GetTenantResponse)(nil),               // 41: lowcode.v1.GetTenantResponse
+	(*DeleteTenantRequest)(nil),             // 42: lowcode.v1.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),            // 43: lowcode.v1.DeleteTenantResponse
+	(*CloneTenantRequest)(nil),              // 44: lowcode.v1.CloneTenantRequest
+	(*CloneTenantResponse)(nil),             // 45: lowcode.v1.CloneTenantResponse
+	(*TenantArchiveInfo)(nil),               // 46: lowcode.v1.TenantArchiveInfo
+	(*ExportTenantRequest)(nil),             // 47: lowcode.v1.ExportTenantRequest
+	(*ExportTenantResponse)(nil),            // 48: lowcode.v1.ExportTenantResponse
+	(*ImportTenantInfo)(nil),                // 49: lowcode.v1.ImportTenantInfo
+	(*ImportTenantRequest)(nil),             // 50: lowcode.v1.ImportTenantRequest
+	(*ImportTenantResponse)(nil),            // 51: lowcode.v1.ImportTenantResponse
+	(*MigrateAllTenantsRequest)(nil),        // 52: lowcode.v1.MigrateAllTenantsRequest
+	(*TenantMigrationResult)(nil),           // 53: lowcode.v1.TenantMigrationResult
+	(*MigrateAllTenantsResponse)(nil),       // 54: lowcode.v1.MigrateAllTenantsResponse
+	(*TenantBackup)(nil),                    // 55: lowcode.v1.TenantBackup
+	(*TriggerBackupRequest)(nil),            // 56: lowcode.v1.TriggerBackupRequest
+	(*TriggerBackupResponse)(nil),           // 57: lowcode.v1.TriggerBackupResponse
+	(*ListBackupsRequest)(nil),              // 58: lowcode.v1.ListBackupsRequest
+	(*ListBackupsResponse)(nil),             // 59: lowcode.v1.ListBackupsResponse
+	(*ListTenantHealthRequest)(nil),         // 60: lowcode.v1.ListTenantHealthRequest
+	(*TenantHealth)(nil),                    // 61: lowcode.v1.TenantHealth
+	(*ListTenantHealthResponse)(nil),        // 62: lowcode.v1.ListTenantHealthResponse
+	(*UpdateTenantRequest)(nil),             // 63: lowcode.v1.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),            // 64: lowcode.v1.UpdateTenantResponse
+	(*SuspendTenantRequest)(nil),            // 65: lowcode.v1.SuspendTenantRequest
+	(*SuspendTenantResponse)(nil),           // 66: lowcode.v1.SuspendTenantResponse
+	(*ResumeTenantRequest)(nil),             // 67: lowcode.v1.ResumeTenantRequest
+	(*ResumeTenantResponse)(nil),            // 68: lowcode.v1.ResumeTenantResponse
+	(*RotateTenantKeysRequest)(nil),         // 69: lowcode.v1.RotateTenantKeysRequest
+	(*RotateTenantKeysResponse)(nil),        // 70: lowcode.v1.RotateTenantKeysResponse
+	(*CreateTypeRequest)(nil),               // 71: lowcode.v1.CreateTypeRequest
+	(*CreateTypeResponse)(nil),              // 72: lowcode.v1.CreateTypeResponse
+	(*ListTypesRequest)(nil),                // 73: lowcode.v1.ListTypesRequest
+	(*ListTypesResponse)(nil),               // 74: lowcode.v1.ListTypesResponse
+	(*GetTypeRequest)(nil),                  // 75: lowcode.v1.GetTypeRequest
+	(*GetTypeResponse)(nil),                 // 76: lowcode.v1.GetTypeResponse
+	(*UpdateTypeRequest)(nil),               // 77: lowcode.v1.UpdateTypeRequest
+	(*UpdateTypeResponse)(nil),              // 78: lowcode.v1.UpdateTypeResponse
+	(*DeleteTypeRequest)(nil),               // 79: lowcode.v1.DeleteTypeRequest
+	(*DeleteTypeResponse)(nil),              // 80: lowcode.v1.DeleteTypeResponse
+	(*TypeDefinition)(nil),                  // 81: lowcode.v1.TypeDefinition
+	(*ExportTypesRequest)(nil),              // 82: lowcode.v1.ExportTypesRequest
+	(*ExportTypesResponse)(nil),             // 83: lowcode.v1.ExportTypesResponse
+	(*ImportTypesRequest)(nil),              // 84: lowcode.v1.ImportTypesRequest
+	(*ImportTypesResponse)(nil),             // 85: lowcode.v1.ImportTypesResponse
+	(*CreateTableRequest)(nil),              // 86: lowcode.v1.CreateTableRequest
+	(*CreateTableResponse)(nil),             // 87: lowcode.v1.CreateTableResponse
+	(*ColumnDefinition)(nil),                // 88: lowcode.v1.ColumnDefinition
+	(*IndexDefinition)(nil),                 // 89: lowcode.v1.IndexDefinition
+	(*CreateTableWithSchemaRequest)(nil),    // 90: lowcode.v1.CreateTableWithSchemaRequest
+	(*CreateTableWithSchemaResponse)(nil),   // 91: lowcode.v1.CreateTableWithSchemaResponse
+	(*ApplyTableSchemaRequest)(nil),         // 92: lowcode.v1.ApplyTableSchemaRequest
+	(*SchemaChange)(nil),                    // 93: lowcode.v1.SchemaChange
+	(*ApplyTableSchemaResponse)(nil),        // 94: lowcode.v1.ApplyTableSchemaResponse
+	(*TableDefinition)(nil),                 // 95: lowcode.v1.TableDefinition
+	(*SchemaBundle)(nil),                    // 96: lowcode.v1.SchemaBundle
+	(*ExportSchemaRequest)(nil),             // 97: lowcode.v1.ExportSchemaRequest
+	(*ExportSchemaResponse)(nil),            // 98: lowcode.v1.ExportSchemaResponse
+	(*ImportSchemaRequest)(nil),             // 99: lowcode.v1.ImportSchemaRequest
+	(*ImportSchemaResponse)(nil),            // 100: lowcode.v1.ImportSchemaResponse
+	(*Template)(nil),                        // 101: lowcode.v1.Template
+	(*ListTemplatesRequest)(nil),            // 102: lowcode.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),           // 103: lowcode.v1.ListTemplatesResponse
+	(*CreateTableFromTemplateRequest)(nil),  // 104: lowcode.v1.CreateTableFromTemplateRequest
+	(*CreateTableFromTemplateResponse)(nil), // 105: lowcode.v1.CreateTableFromTemplateResponse
+	(*UpdateTableRequest)(nil),              // 106: lowcode.v1.UpdateTableRequest
+	(*UpdateTableResponse)(nil),             // 107: lowcode.v1.UpdateTableResponse
+	(*DuplicateTableRequest)(nil),           // 108: lowcode.v1.DuplicateTableRequest
+	(*DuplicateTableResponse)(nil),          // 109: lowcode.v1.DuplicateTableResponse
+	(*DeleteTableRequest)(nil),              // 110: lowcode.v1.DeleteTableRequest
+	(*DeleteTableResponse)(nil),             // 111: lowcode.v1.DeleteTableResponse
+	(*RestoreTableRequest)(nil),             // 112: lowcode.v1.RestoreTableRequest
+	(*RestoreTableResponse)(nil),            // 113: lowcode.v1.RestoreTableResponse
+	(*PurgeTableRequest)(nil),               // 114: lowcode.v1.PurgeTableRequest
+	(*PurgeTableResponse)(nil),              // 115: lowcode.v1.PurgeTableResponse
+	(*ListTablesRequest)(nil),               // 116: lowcode.v1.ListTablesRequest
+	(*ListTablesResponse)(nil),              // 117: lowcode.v1.ListTablesResponse
+	(*CreateWorkspaceRequest)(nil),          // 118: lowcode.v1.CreateWorkspaceRequest
+	(*CreateWorkspaceResponse)(nil),         // 119: lowcode.v1.CreateWorkspaceResponse
+	(*ListWorkspacesRequest)(nil),           // 120: lowcode.v1.ListWorkspacesRequest
+	(*ListWorkspacesResponse)(nil),          // 121: lowcode.v1.ListWorkspacesResponse
+	(*GetWorkspaceRequest)(nil),             // 122: lowcode.v1.GetWorkspaceRequest
+	(*GetWorkspaceResponse)(nil),            // 123: lowcode.v1.GetWorkspaceResponse
+	(*UpdateWorkspaceRequest)(nil),          // 124: lowcode.v1.UpdateWorkspaceRequest
+	(*UpdateWorkspaceResponse)(nil),         // 125: lowcode.v1.UpdateWorkspaceResponse
+	(*DeleteWorkspaceRequest)(nil),          // 126: lowcode.v1.DeleteWorkspaceRequest
+	(*DeleteWorkspaceResponse)(nil),         // 127: lowcode.v1.DeleteWorkspaceResponse
+	(*GetTableRequest)(nil),                 // 128: lowcode.v1.GetTableRequest
+	(*GetTableResponse)(nil),                // 129: lowcode.v1.GetTableResponse
+	(*GetTableSchemaRequest)(nil),           // 130: lowcode.v1.GetTableSchemaRequest
+	(*GetTableSchemaResponse)(nil),          // 131: lowcode.v1.GetTableSchemaResponse
+	(*SchemaDrift)(nil),                     // 132: lowcode.v1.SchemaDrift
+	(*RepairTableSchemaRequest)(nil),        // 133: lowcode.v1.RepairTableSchemaRequest
+	(*RepairTableSchemaResponse)(nil),       // 134: lowcode.v1.RepairTableSchemaResponse
+	(*GetWorkspaceSchemaRequest)(nil),       // 135: lowcode.v1.GetWorkspaceSchemaRequest
+	(*TableSchema)(nil),                     // 136: lowcode.v1.TableSchema
+	(*Relationship)(nil),                    // 137: lowcode.v1.Relationship
+	(*GetWorkspaceSchemaResponse)(nil),      // 138: lowcode.v1.GetWorkspaceSchemaResponse
+	(*SchemaImpact)(nil),                    // 139: lowcode.v1.SchemaImpact
+	(*AddColumnRequest)(nil),                // 140: lowcode.v1.AddColumnRequest
+	(*AddColumnResponse)(nil),               // 141: lowcode.v1.AddColumnResponse
+	(*SelectOption)(nil),                    // 142: lowcode.v1.SelectOption
+	(*AddSelectOptionRequest)(nil),          // 143: lowcode.v1.AddSelectOptionRequest
+	(*AddSelectOptionResponse)(nil),         // 144: lowcode.v1.AddSelectOptionResponse
+	(*UpdateSelectOptionRequest)(nil),       // 145: lowcode.v1.UpdateSelectOptionRequest
+	(*UpdateSelectOptionResponse)(nil),      // 146: lowcode.v1.UpdateSelectOptionResponse
+	(*RemoveSelectOptionRequest)(nil),       // 147: lowcode.v1.RemoveSelectOptionRequest
+	(*RemoveSelectOptionResponse)(nil),      // 148: lowcode.v1.RemoveSelectOptionResponse
+	(*ReorderColumnsRequest)(nil),           // 149: lowcode.v1.ReorderColumnsRequest
+	(*ReorderColumnsResponse)(nil),          // 150: lowcode.v1.ReorderColumnsResponse
+	(*UpdateColumnRequest)(nil),             // 151: lowcode.v1.UpdateColumnRequest
+	(*UpdateColumnResponse)(nil),            // 152: lowcode.v1.UpdateColumnResponse
+	(*ChangeColumnTypeRequest)(nil),         // 153: lowcode.v1.ChangeColumnTypeRequest
+	(*ChangeColumnTypeResponse)(nil),        // 154: lowcode.v1.ChangeColumnTypeResponse
+	(*DeleteColumnRequest)(nil),             // 155: lowcode.v1.DeleteColumnRequest
+	(*DeleteColumnResponse)(nil),            // 156: lowcode.v1.DeleteColumnResponse
+	(*ListColumnsRequest)(nil),              // 157: lowcode.v1.ListColumnsRequest
+	(*ListColumnsResponse)(nil),             // 158: lowcode.v1.ListColumnsResponse
+	(*CreateRowRequest)(nil),                // 159: lowcode.v1.CreateRowRequest
+	(*CreateRowResponse)(nil),               // 160: lowcode.v1.CreateRowResponse
+	(*UpdateRowRequest)(nil),                // 161: lowcode.v1.UpdateRowRequest
+	(*UpdateRowResponse)(nil),               // 162: lowcode.v1.UpdateRowResponse
+	(*DeleteRowRequest)(nil),                // 163: lowcode.v1.DeleteRowRequest
+	(*DeleteRowResponse)(nil),               // 164: lowcode.v1.DeleteRowResponse
+	(*RestoreRowRequest)(nil),               // 165: lowcode.v1.RestoreRowRequest
+	(*RestoreRowResponse)(nil),              // 166: lowcode.v1.RestoreRowResponse
+	(*RowVersion)(nil),                      // 167: lowcode.v1.RowVersion
+	(*GetRowHistoryRequest)(nil),            // 168: lowcode.v1.GetRowHistoryRequest
+	(*GetRowHistoryResponse)(nil),           // 169: lowcode.v1.GetRowHistoryResponse
+	(*RestoreRowVersionRequest)(nil),        // 170: lowcode.v1.RestoreRowVersionRequest
+	(*RestoreRowVersionResponse)(nil),       // 171: lowcode.v1.RestoreRowVersionResponse
+	(*LinkRowsRequest)(nil),                 // 172: lowcode.v1.LinkRowsRequest
+	(*LinkRowsResponse)(nil),                // 173: lowcode.v1.LinkRowsResponse
+	(*UnlinkRowsRequest)(nil),               // 174: lowcode.v1.UnlinkRowsRequest
+	(*UnlinkRowsResponse)(nil),              // 175: lowcode.v1.UnlinkRowsResponse
+	(*PurgeRowsRequest)(nil),                // 176: lowcode.v1.PurgeRowsRequest
+	(*PurgeRowsResponse)(nil),               // 177: lowcode.v1.PurgeRowsResponse
+	(*GetRowRequest)(nil),                   // 178: lowcode.v1.GetRowRequest
+	(*GetRowResponse)(nil),                  // 179: lowcode.v1.GetRowResponse
+	(*FindRowByColumnRequest)(nil),          // 180: lowcode.v1.FindRowByColumnRequest
+	(*FindRowByColumnResponse)(nil),         // 181: lowcode.v1.FindRowByColumnResponse
+	(*FilterCondition)(nil),                 // 182: lowcode.v1.FilterCondition
+	(*FilterGroup)(nil),                     // 183: lowcode.v1.FilterGroup
+	(*RowFilter)(nil),                       // 184: lowcode.v1.RowFilter
+	(*SortSpec)(nil),                        // 185: lowcode.v1.SortSpec
+	(*ListRowsRequest)(nil),                 // 186: lowcode.v1.ListRowsRequest
+	(*ListRowsResponse)(nil),                // 187: lowcode.v1.ListRowsResponse
+	(*StreamRowsRequest)(nil),               // 188: lowcode.v1.StreamRowsRequest
+	(*StreamRowsResponse)(nil),              // 189: lowcode.v1.StreamRowsResponse
+	(*ExportRowsRequest)(nil),               // 190: lowcode.v1.ExportRowsRequest
+	(*ExportRowsResponse)(nil),              // 191: lowcode.v1.ExportRowsResponse
+	(*SearchRowsRequest)(nil),               // 192: lowcode.v1.SearchRowsRequest
+	(*SearchRowsResponse)(nil),              // 193: lowcode.v1.SearchRowsResponse
+	(*Aggregation)(nil),                     // 194: lowcode.v1.Aggregation
+	(*AggregateRowsRequest)(nil),            // 195: lowcode.v1.AggregateRowsRequest
+	(*AggregateGroup)(nil),                  // 196: lowcode.v1.AggregateGroup
+	(*AggregateRowsResponse)(nil),           // 197: lowcode.v1.AggregateRowsResponse
+	(*ListDistinctValuesRequest)(nil),       // 198: lowcode.v1.ListDistinctValuesRequest
+	(*ListDistinctValuesResponse)(nil),      // 199: lowcode.v1.ListDistinctValuesResponse
+	(*BulkUpsertRowItem)(nil),               // 200: lowcode.v1.BulkUpsertRowItem
+	(*BulkUpsertRowsRequest)(nil),           // 201: lowcode.v1.BulkUpsertRowsRequest
+	(*BulkUpsertRowsResponse)(nil),          // 202: lowcode.v1.BulkUpsertRowsResponse
+	(*BulkDeleteRowsRequest)(nil),           // 203: lowcode.v1.BulkDeleteRowsRequest
+	(*BulkDeleteRowsResponse)(nil),          // 204: lowcode.v1.BulkDeleteRowsResponse
+	(*CellContentInfo)(nil),                 // 205: lowcode.v1.CellContentInfo
+	(*UploadCellContentRequest)(nil),        // 206: lowcode.v1.UploadCellContentRequest
+	(*UploadCellContentResponse)(nil),       // 207: lowcode.v1.UploadCellContentResponse
+	(*DownloadCellContentRequest)(nil),      // 208: lowcode.v1.DownloadCellContentRequest
+	(*DownloadCellContentResponse)(nil),     // 209: lowcode.v1.DownloadCellContentResponse
+	(*Attachment)(nil),                      // 210: lowcode.v1.Attachment
+	(*PresignedUrl)(nil),                    // 211: lowcode.v1.PresignedUrl
+	(*CreateAttachmentUploadRequest)(nil),   // 212: lowcode.v1.CreateAttachmentUploadRequest
+	(*CreateAttachmentUploadResponse)(nil),  // 213: lowcode.v1.CreateAttachmentUploadResponse
+	(*GetAttachmentUrlRequest)(nil),         // 214: lowcode.v1.GetAttachmentUrlRequest
+	(*GetAttachmentUrlResponse)(nil),        // 215: lowcode.v1.GetAttachmentUrlResponse
+	(*CreateIndexRequest)(nil),              // 216: lowcode.v1.CreateIndexRequest
+	(*CreateIndexResponse)(nil),             // 217: lowcode.v1.CreateIndexResponse
+	(*UpdateIndexRequest)(nil),              // 218: lowcode.v1.UpdateIndexRequest
+	(*UpdateIndexResponse)(nil),             // 219: lowcode.v1.UpdateIndexResponse
+	(*SyncIndexesRequest)(nil),              // 220: lowcode.v1.SyncIndexesRequest
+	(*SyncIndexesResponse)(nil),             // 221: lowcode.v1.SyncIndexesResponse
+	(*DeleteIndexRequest)(nil),              // 222: lowcode.v1.DeleteIndexRequest
+	(*DeleteIndexResponse)(nil),             // 223: lowcode.v1.DeleteIndexResponse
+	(*CreateViewRequest)(nil),               // 224: lowcode.v1.CreateViewRequest
+	(*CreateViewResponse)(nil),              // 225: lowcode.v1.CreateViewResponse
+	(*ListViewsRequest)(nil),                // 226: lowcode.v1.ListViewsRequest
+	(*ListViewsResponse)(nil),               // 227: lowcode.v1.ListViewsResponse
+	(*GetViewRequest)(nil),                  // 228: lowcode.v1.GetViewRequest
+	(*GetViewResponse)(nil),                 // 229: lowcode.v1.GetViewResponse
+	(*UpdateViewRequest)(nil),               // 230: lowcode.v1.UpdateViewRequest
+	(*UpdateViewResponse)(nil),              // 231: lowcode.v1.UpdateViewResponse
+	(*DeleteViewRequest)(nil),               // 232: lowcode.v1.DeleteViewRequest
+	(*DeleteViewResponse)(nil),              // 233: lowcode.v1.DeleteViewResponse
+	(*ListIndexesRequest)(nil),              // 234: lowcode.v1.ListIndexesRequest
+	(*ListIndexesResponse)(nil),             // 235: lowcode.v1.ListIndexesResponse
+	(*ExternalTableExport)(nil),             // 236: lowcode.v1.ExternalTableExport
+	(*ImportExternalTablesRequest)(nil),     // 237: lowcode.v1.ImportExternalTablesRequest
+	(*ImportedTable)(nil),                   // 238: lowcode.v1.ImportedTable
+	(*ImportExternalTablesResponse)(nil),    // 239: lowcode.v1.ImportExternalTablesResponse
+	(*ImportDatabaseSchemaRequest)(nil),     // 240: lowcode.v1.ImportDatabaseSchemaRequest
+	(*AdoptedTable)(nil),                    // 241: lowcode.v1.AdoptedTable
+	(*SkippedTable)(nil),                    // 242: lowcode.v1.SkippedTable
+	(*ImportDatabaseSchemaResponse)(nil),    // 243: lowcode.v1.ImportDatabaseSchemaResponse
+	(*CreateSQLViewRequest)(nil),            // 244: lowcode.v1.CreateSQLViewRequest
+	(*CreateSQLViewResponse)(nil),           // 245: lowcode.v1.CreateSQLViewResponse
+	(*RefreshSQLViewRequest)(nil),           // 246: lowcode.v1.RefreshSQLViewRequest
+	(*RefreshSQLViewResponse)(nil),          // 247: lowcode.v1.RefreshSQLViewResponse
+	(*ImportExistingTableRequest)(nil),      // 248: lowcode.v1.ImportExistingTableRequest
+	(*ImportExistingTableResponse)(nil),     // 249: lowcode.v1.ImportExistingTableResponse
+	(*Operation)(nil),                       // 250: lowcode.v1.Operation
+	(*GetOperationRequest)(nil),             // 251: lowcode.v1.GetOperationRequest
+	(*GetOperationResponse)(nil),            // 252: lowcode.v1.GetOperationResponse
+	(*ListOperationsRequest)(nil),           // 253: lowcode.v1.ListOperationsRequest
+	(*ListOperationsResponse)(nil),          // 254: lowcode.v1.ListOperationsResponse
+	(*CancelOperationRequest)(nil),          // 255: lowcode.v1.CancelOperationRequest
+	(*CancelOperationResponse)(nil),         // 256: lowcode.v1.CancelOperationResponse
+	(*Member)(nil),                          // 257: lowcode.v1.Member
+	(*ListMembersRequest)(nil),              // 258: lowcode.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),             // 259: lowcode.v1.ListMembersResponse
+	(*SetMemberRequest)(nil),                // 260: lowcode.v1.SetMemberRequest
+	(*SetMemberResponse)(nil),               // 261: lowcode.v1.SetMemberResponse
+	(*DeleteMemberRequest)(nil),             // 262: lowcode.v1.DeleteMemberRequest
+	(*DeleteMemberResponse)(nil),            // 263: lowcode.v1.DeleteMemberResponse
+	(*Permission)(nil),                      // 264: lowcode.v1.Permission
+	(*ListPermissionsRequest)(nil),          // 265: lowcode.v1.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),         // 266: lowcode.v1.ListPermissionsResponse
+	(*SetPermissionRequest)(nil),            // 267: lowcode.v1.SetPermissionRequest
+	(*SetPermissionResponse)(nil),           // 268: lowcode.v1.SetPermissionResponse
+	(*DeletePermissionRequest)(nil),         // 269: lowcode.v1.DeletePermissionRequest
+	(*DeletePermissionResponse)(nil),        // 270: lowcode.v1.DeletePermissionResponse
+	(*AuditEvent)(nil),                      // 271: lowcode.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),          // 272: lowcode.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),         // 273: lowcode.v1.ListAuditEventsResponse
+	(*Webhook)(nil),                         // 274: lowcode.v1.Webhook
+	(*CreateWebhookRequest)(nil),            // 275: lowcode.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),           // 276: lowcode.v1.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),             // 277: lowcode.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),            // 278: lowcode.v1.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),            // 279: lowcode.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),           // 280: lowcode.v1.DeleteWebhookResponse
+	(*WebhookDelivery)(nil),                 // 281: lowcode.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),    // 282: lowcode.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),   // 283: lowcode.v1.ListWebhookDeliveriesResponse
+	nil,                                     // 284: lowcode.v1.Row.CellsEntry
+	nil,                                     // 285: lowcode.v1.CreateRowRequest.CellsEntry
+	nil,                                     // 286: lowcode.v1.UpdateRowRequest.CellsEntry
+	nil,                                     // 287: lowcode.v1.AggregateGroup.KeysEntry
+	nil,                                     // 288: lowcode.v1.BulkUpsertRowItem.CellsEntry
+	nil,                                     // 289: lowcode.v1.PresignedUrl.HeadersEntry
+	nil,                                     // 290: lowcode.v1.ImportExistingTableRequest.ColumnTypesEntry
+	(*structpb.Struct)(nil),                 // 291: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),           // 292: google.protobuf.Timestamp
+	(structpb.NullValue)(0),                 // 293: google.protobuf.NullValue
 }
 var file_lowcode_v1_lowcode_service_proto_depIdxs = []int32{
-	53, // 0: lowcode.v1.Type.config:type_name -> google.protobuf.Struct
-	54, // 1: lowcode.v1.Type.created_at:type_name -> google.protobuf.Timestamp
-	54, // 2: lowcode.v1.Type.updated_at:type_name -> google.protobuf.Timestamp
-	54, // 3: lowcode.v1.Table.created_at:type_name -> google.protobuf.Timestamp
-	54, // 4: lowcode.v1.Table.updated_at:type_name -> google.protobuf.Timestamp
-	53, // 5: lowcode.v1.Column.config:type_name -> google.protobuf.Struct
-	54, // 6: lowcode.v1.Column.created_at:type_name -> google.protobuf.Timestamp
-	54, // 7: lowcode.v1.Column.updated_at:type_name -> google.protobuf.Timestamp
-	54, // 8: lowcode.v1.Index.created_at:type_name -> google.protobuf.Timestamp
-	54, // 9: lowcode.v1.Index.updated_at:type_name -> google.protobuf.Timestamp
-	54, // 10: lowcode.v1.Value.timestamp_value:type_name -> google.protobuf.Timestamp
-	53, // 11: lowcode.v1.Value.json_value:type_name -> google.protobuf.Struct
-	49, // 12: lowcode.v1.Row.cells:type_name -> lowcode.v1.Row.CellsEntry
-	53, // 13: lowcode.v1.CreateTypeRequest.config:type_name -> google.protobuf.Struct
-	0,  // 14: lowcode.v1.CreateTypeResponse.type:type_name -> lowcode.v1.Type
-	0,  // 15: lowcode.v1.ListTypesResponse.types:type_name -> lowcode.v1.Type
-	1,  // 16: lowcode.v1.CreateTableResponse.table:type_name -> lowcode.v1.Table
-	1,  // 17: lowcode.v1.ListTablesResponse.tables:type_name -> lowcode.v1.Table
-	1,  // 18: lowcode.v1.GetTableSchemaResponse.table:type_name -> lowcode.v1.Table
-	2,  // 19: lowcode.v1.GetTableSchemaResponse.columns:type_name -> lowcode.v1.Column
-	3,  // 20: lowcode.v1.GetTableSchemaResponse.indexes:type_name -> lowcode.v1.Index
-	53, // 21: lowcode.v1.AddColumnRequest.config:type_name -> google.protobuf.Struct
-	2,  // 22: lowcode.v1.AddColumnResponse.column:type_name -> lowcode.v1.Column
-	53, // 23: lowcode.v1.UpdateColumnRequest.config:type_name -> google.protobuf.Struct
-	2,  // 24: lowcode.v1.UpdateColumnResponse.column:type_name -> lowcode.v1.Column
-	2,  // 25: lowcode.v1.ListColumnsResponse.columns:type_name -> lowcode.v1.Column
-	50, // 26: lowcode.v1.CreateRowRequest.cells:type_name -> lowcode.v1.CreateRowRequest.CellsEntry
-	5,  // 27: lowcode.v1.CreateRowResponse.row:type_name -> lowcode.v1.Row
-	51, // 28: lowcode.v1.UpdateRowRequest.cells:type_name -> lowcode.v1.UpdateRowRequest.CellsEntry
-	5,  // 29: lowcode.v1.UpdateRowResponse.row:type_name -> lowcode.v1.Row
-	5,  // 30: lowcode.v1.ListRowsResponse.rows:type_name -> lowcode.v1.Row
-	52, // 31: lowcode.v1.BulkUpsertRowItem.cells:type_name -> lowcode.v1.BulkUpsertRowItem.CellsEntry
-	38, // 32: lowcode.v1.BulkUpsertRowsRequest.items:type_name -> lowcode.v1.BulkUpsertRowItem
-	5,  // 33: lowcode.v1.BulkUpsertRowsResponse.rows:type_name -> lowcode.v1.Row
-	3,  // 34: lowcode.v1.CreateIndexResponse.index:type_name -> lowcode.v1.Index
-	3,  // 35: lowcode.v1.ListIndexesResponse.indexes:type_name -> lowcode.v1.Index
-	4,  // 36: lowcode.v1.Row.CellsEntry.value:type_name -> lowcode.v1.Value
-	4,  // 37: lowcode.v1.CreateRowRequest.CellsEntry.value:type_name -> lowcode.v1.Value
-	4,  // 38: lowcode.v1.UpdateRowRequest.CellsEntry.value:type_name -> lowcode.v1.Value
-	4,  // 39: lowcode.v1.BulkUpsertRowItem.CellsEntry.value:type_name -> lowcode.v1.Value
-	6,  // 40: lowcode.v1.LowcodeService.CreateTenant:input_type -> lowcode.v1.CreateTenantRequest
-	8,  // 41: lowcode.v1.LowcodeService.CreateType:input_type -> lowcode.v1.CreateTypeRequest
-	10, // 42: lowcode.v1.LowcodeService.ListTypes:input_type -> lowcode.v1.ListTypesRequest
-	12, // 43: lowcode.v1.LowcodeService.DeleteType:input_type -> lowcode.v1.DeleteTypeRequest
-	14, // 44: lowcode.v1.LowcodeService.CreateTable:input_type -> lowcode.v1.CreateTableRequest
-	16, // 45: lowcode.v1.LowcodeService.DeleteTable:input_type -> lowcode.v1.DeleteTableRequest
-	18, // 46: lowcode.v1.LowcodeService.ListTables:input_type -> lowcode.v1.ListTablesRequest
-	20, // 47: lowcode.v1.LowcodeService.GetTableSchema:input_type -> lowcode.v1.GetTableSchemaRequest
-	22, // 48: lowcode.v1.LowcodeService.AddColumn:input_type -> lowcode.v1.AddColumnRequest
-	24, // 49: lowcode.v1.LowcodeService.UpdateColumn:input_type -> lowcode.v1.UpdateColumnRequest
-	26, // 50: lowcode.v1.LowcodeService.DeleteColumn:input_type -> lowcode.v1.DeleteColumnRequest
-	28, // 51: lowcode.v1.LowcodeService.ListColumns:input_type -> lowcode.v1.ListColumnsRequest
-	30, // 52: lowcode.v1.LowcodeService.CreateRow:input_type -> lowcode.v1.CreateRowRequest
-	32, // 53: lowcode.v1.LowcodeService.UpdateRow:input_type -> lowcode.v1.UpdateRowRequest
-	34, // 54: lowcode.v1.LowcodeService.DeleteRow:input_type -> lowcode.v1.DeleteRowRequest
-	36, // 55: lowcode.v1.LowcodeService.ListRows:input_type -> lowcode.v1.ListRowsRequest
-	39, // 56: lowcode.v1.LowcodeService.BulkUpsertRows:input_type -> lowcode.v1.BulkUpsertRowsRequest
-	41, // 57: lowcode.v1.LowcodeService.BulkDeleteRows:input_type -> lowcode.v1.BulkDeleteRowsRequest
-	43, // 58: lowcode.v1.LowcodeService.CreateIndex:input_type -> lowcode.v1.CreateIndexRequest
-	45, // 59: lowcode.v1.LowcodeService.DeleteIndex:input_type -> lowcode.v1.DeleteIndexRequest
-	47, // 60: lowcode.v1.LowcodeService.ListIndexes:input_type -> lowcode.v1.ListIndexesRequest
-	7,  // 61: lowcode.v1.LowcodeService.CreateTenant:output_type -> lowcode.v1.CreateTenantResponse
-	9,  // 62: lowcode.v1.LowcodeService.CreateType:output_type -> lowcode.v1.CreateTypeResponse
-	11, // 63: lowcode.v1.LowcodeService.ListTypes:output_type -> lowcode.v1.ListTypesResponse
-	13, // 64: lowcode.v1.LowcodeService.DeleteType:output_type -> lowcode.v1.DeleteTypeResponse
-	15, // 65: lowcode.v1.LowcodeService.CreateTable:output_type -> lowcode.v1.CreateTableResponse
-	17, // 66: lowcode.v1.LowcodeService.DeleteTable:output_type -> lowcode.v1.DeleteTableResponse
-	19, // 67: lowcode.v1.LowcodeService.ListTables:output_type -> lowcode.v1.ListTablesResponse
-	21, // 68: lowcode.v1.LowcodeService.GetTableSchema:output_type -> lowcode.v1.GetTableSchemaResponse
-	23, // 69: lowcode.v1.LowcodeService.AddColumn:output_type -> lowcode.v1.AddColumnResponse
-	25, // 70: lowcode.v1.LowcodeService.UpdateColumn:output_type -> lowcode.v1.UpdateColumnResponse
-	27, // 71: lowcode.v1.LowcodeService.DeleteColumn:output_type -> lowcode.v1.DeleteColumnResponse
-	29, // 72: lowcode.v1.LowcodeService.ListColumns:output_type -> lowcode.v1.ListColumnsResponse
-	31, // 73: lowcode.v1.LowcodeService.CreateRow:output_type -> lowcode.v1.CreateRowResponse
-	33, // 74: lowcode.v1.LowcodeService.UpdateRow:output_type -> lowcode.v1.UpdateRowResponse
-	35, // 75: lowcode.v1.LowcodeService.DeleteRow:output_type -> lowcode.v1.DeleteRowResponse
-	37, // 76: lowcode.v1.LowcodeService.ListRows:output_type -> lowcode.v1.ListRowsResponse
-	40, // 77: lowcode.v1.LowcodeService.BulkUpsertRows:output_type -> lowcode.v1.BulkUpsertRowsResponse
-	42, // 78: lowcode.v1.LowcodeService.BulkDeleteRows:output_type -> lowcode.v1.BulkDeleteRowsResponse
-	44, // 79: lowcode.v1.LowcodeService.CreateIndex:output_type -> lowcode.v1.CreateIndexResponse
-	46, // 80: lowcode.v1.LowcodeService.DeleteIndex:output_type -> lowcode.v1.DeleteIndexResponse
-	48, // 81: lowcode.v1.LowcodeService.ListIndexes:output_type -> lowcode.v1.ListIndexesResponse
-	61, // [61:82] is the sub-list for method output_type
-	40, // [40:61] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	291, // 0: lowcode.v1.Type.config:type_name -> google.protobuf.Struct
+	292, // 1: lowcode.v1.Type.created_at:type_name -> google.protobuf.Timestamp
+	292, // 2: lowcode.v1.Type.updated_at:type_name -> google.protobuf.Timestamp
+	292, // 3: lowcode.v1.Table.created_at:type_name -> google.protobuf.Timestamp
+	292, // 4: lowcode.v1.Table.updated_at:type_name -> google.protobuf.Timestamp
+	25,  // 5: lowcode.v1.Table.partition:type_name -> lowcode.v1.PartitionSpec
+	292, // 6: lowcode.v1.Table.archived_at:type_name -> google.protobuf.Timestamp
+	24,  // 7: lowcode.v1.Table.stats:type_name -> lowcode.v1.TableStats
+	22,  // 8: lowcode.v1.Table.sql_view:type_name -> lowcode.v1.SQLViewSpec
+	292, // 9: lowcode.v1.SQLViewSpec.refreshed_at:type_name -> google.protobuf.Timestamp
+	292, // 10: lowcode.v1.Workspace.created_at:type_name -> google.protobuf.Timestamp
+	292, // 11: lowcode.v1.Workspace.updated_at:type_name -> google.protobuf.Timestamp
+	291, // 12: lowcode.v1.Column.config:type_name -> google.protobuf.Struct
+	292, // 13: lowcode.v1.Column.created_at:type_name -> google.protobuf.Timestamp
+	292, // 14: lowcode.v1.Column.updated_at:type_name -> google.protobuf.Timestamp
+	291, // 15: lowcode.v1.Column.ui_hints:type_name -> google.protobuf.Struct
+	292, // 16: lowcode.v1.Index.created_at:type_name -> google.protobuf.Timestamp
+	292, // 17: lowcode.v1.Index.updated_at:type_name -> google.protobuf.Timestamp
+	28,  // 18: lowcode.v1.Index.expression:type_name -> lowcode.v1.IndexExpression
+	184, // 19: lowcode.v1.Index.where:type_name -> lowcode.v1.RowFilter
+	0,   // 20: lowcode.v1.Index.index_method:type_name -> lowcode.v1.IndexMethod
+	1,   // 21: lowcode.v1.IndexExpression.function:type_name -> lowcode.v1.IndexFunction
+	184, // 22: lowcode.v1.View.filter:type_name -> lowcode.v1.RowFilter
+	185, // 23: lowcode.v1.View.sorts:type_name -> lowcode.v1.SortSpec
+	292, // 24: lowcode.v1.View.created_at:type_name -> google.protobuf.Timestamp
+	292, // 25: lowcode.v1.View.updated_at:type_name -> google.protobuf.Timestamp
+	292, // 26: lowcode.v1.Value.timestamp_value:type_name -> google.protobuf.Timestamp
+	291, // 27: lowcode.v1.Value.json_value:type_name -> google.protobuf.Struct
+	293, // 28: lowcode.v1.Value.null_value:type_name -> google.protobuf.NullValue
+	31,  // 29: lowcode.v1.Value.list_value:type_name -> lowcode.v1.ValueList
+	30,  // 30: lowcode.v1.ValueList.values:type_name -> lowcode.v1.Value
+	284, // 31: lowcode.v1.Row.cells:type_name -> lowcode.v1.Row.CellsEntry
+	34,  // 32: lowcode.v1.CreateTenantRequest.bootstrap:type_name -> lowcode.v1.TenantBootstrap
+	96,  // 33: lowcode.v1.TenantBootstrap.schema:type_name -> lowcode.v1.SchemaBundle
+	35,  // 34: lowcode.v1.TenantBootstrap.rows:type_name -> lowcode.v1.TenantSeedRows
+	291, // 35: lowcode.v1.TenantSeedRows.rows:type_name -> google.protobuf.Struct
+	3,   // 36: lowcode.v1.Tenant.state:type_name -> lowcode.v1.TenantState
+	292, // 37: lowcode.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	292, // 38: lowcode.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 39: lowcode.v1.ListTenantsRequest.state:type_name -> lowcode.v1.TenantState
+	37,  // 40: lowcode.v1.ListTenantsResponse.tenants:type_name -> lowcode.v1.Tenant
+	37,  // 41: lowcode.v1.GetTenantResponse.tenant:type_name -> lowcode.v1.Tenant
+	37,  // 42: lowcode.v1.CloneTenantResponse.tenant:type_name -> lowcode.v1.Tenant
+	292, // 43: lowcode.v1.TenantArchiveInfo.exported_at:type_name -> google.protobuf.Timestamp
+	46,  // 44: lowcode.v1.ExportTenantResponse.info:type_name -> lowcode.v1.TenantArchiveInfo
+	49,  // 45: lowcode.v1.ImportTenantRequest.info:type_name -> lowcode.v1.ImportTenantInfo
+	37,  // 46: lowcode.v1.ImportTenantResponse.tenant:type_name -> lowcode.v1.Tenant
+	53,  // 47: lowcode.v1.MigrateAllTenantsResponse.results:type_name -> lowcode.v1.TenantMigrationResult
+	4,   // 48: lowcode.v1.TenantBackup.state:type_name -> lowcode.v1.BackupState
+	5,   // 49: lowcode.v1.TenantBackup.trigger:type_name -> lowcode.v1.BackupTrigger
+	292, // 50: lowcode.v1.TenantBackup.started_at:type_name -> google.protobuf.Timestamp
+	292, // 51: lowcode.v1.TenantBackup.finished_at:type_name -> google.protobuf.Timestamp
+	55,  // 52: lowcode.v1.TriggerBackupResponse.backup:type_name -> lowcode.v1.TenantBackup
+	4,   // 53: lowcode.v1.ListBackupsRequest.state:type_name -> lowcode.v1.BackupState
+	55,  // 54: lowcode.v1.ListBackupsResponse.backups:type_name -> lowcode.v1.TenantBackup
+	292, // 55: lowcode.v1.TenantHealth.checked_at:type_name -> google.protobuf.Timestamp
+	61,  // 56: lowcode.v1.ListTenantHealthResponse.tenants:type_name -> lowcode.v1.TenantHealth
+	37,  // 57: lowcode.v1.UpdateTenantResponse.tenant:type_name -> lowcode.v1.Tenant
+	37,  // 58: lowcode.v1.SuspendTenantResponse.tenant:type_name -> lowcode.v1.Tenant
+	37,  // 59: lowcode.v1.ResumeTenantResponse.tenant:type_name -> lowcode.v1.Tenant
+	250, // 60: lowcode.v1.RotateTenantKeysResponse.operation:type_name -> lowcode.v1.Operation
+	291, // 61: lowcode.v1.CreateTypeRequest.config:type_name -> google.protobuf.Struct
+	20,  // 62: lowcode.v1.CreateTypeResponse.type:type_name -> lowcode.v1.Type
+	20,  // 63: lowcode.v1.ListTypesResponse.types:type_name -> lowcode.v1.Type
+	20,  // 64: lowcode.v1.GetTypeResponse.type:type_name -> lowcode.v1.Type
+	291, // 65: lowcode.v1.UpdateTypeRequest.config:type_name -> google.protobuf.Struct
+	20,  // 66: lowcode.v1.UpdateTypeResponse.type:type_name -> lowcode.v1.Type
+	291, // 67: lowcode.v1.TypeDefinition.config:type_name -> google.protobuf.Struct
+	81,  // 68: lowcode.v1.ExportTypesResponse.types:type_name -> lowcode.v1.TypeDefinition
+	81,  // 69: lowcode.v1.ImportTypesRequest.types:type_name -> lowcode.v1.TypeDefinition
+	20,  // 70: lowcode.v1.ImportTypesResponse.created:type_name -> lowcode.v1.Type
+	20,  // 71: lowcode.v1.ImportTypesResponse.updated:type_name -> lowcode.v1.Type
+	25,  // 72: lowcode.v1.CreateTableRequest.partition:type_name -> lowcode.v1.PartitionSpec
+	21,  // 73: lowcode.v1.CreateTableResponse.table:type_name -> lowcode.v1.Table
+	291, // 74: lowcode.v1.ColumnDefinition.config:type_name -> google.protobuf.Struct
+	291, // 75: lowcode.v1.ColumnDefinition.ui_hints:type_name -> google.protobuf.Struct
+	0,   // 76: lowcode.v1.IndexDefinition.index_method:type_name -> lowcode.v1.IndexMethod
+	25,  // 77: lowcode.v1.CreateTableWithSchemaRequest.partition:type_name -> lowcode.v1.PartitionSpec
+	88,  // 78: lowcode.v1.CreateTableWithSchemaRequest.columns:type_name -> lowcode.v1.ColumnDefinition
+	89,  // 79: lowcode.v1.CreateTableWithSchemaRequest.indexes:type_name -> lowcode.v1.IndexDefinition
+	21,  // 80: lowcode.v1.CreateTableWithSchemaResponse.table:type_name -> lowcode.v1.Table
+	26,  // 81: lowcode.v1.CreateTableWithSchemaResponse.columns:type_name -> lowcode.v1.Column
+	27,  // 82: lowcode.v1.CreateTableWithSchemaResponse.indexes:type_name -> lowcode.v1.Index
+	25,  // 83: lowcode.v1.ApplyTableSchemaRequest.partition:type_name -> lowcode.v1.PartitionSpec
+	88,  // 84: lowcode.v1.ApplyTableSchemaRequest.columns:type_name -> lowcode.v1.ColumnDefinition
+	89,  // 85: lowcode.v1.ApplyTableSchemaRequest.indexes:type_name -> lowcode.v1.IndexDefinition
+	139, // 86: lowcode.v1.SchemaChange.impact:type_name -> lowcode.v1.SchemaImpact
+	93,  // 87: lowcode.v1.ApplyTableSchemaResponse.changes:type_name -> lowcode.v1.SchemaChange
+	21,  // 88: lowcode.v1.ApplyTableSchemaResponse.table:type_name -> lowcode.v1.Table
+	26,  // 89: lowcode.v1.ApplyTableSchemaResponse.columns:type_name -> lowcode.v1.Column
+	27,  // 90: lowcode.v1.ApplyTableSchemaResponse.indexes:type_name -> lowcode.v1.Index
+	139, // 91: lowcode.v1.ApplyTableSchemaResponse.impact:type_name -> lowcode.v1.SchemaImpact
+	25,  // 92: lowcode.v1.TableDefinition.partition:type_name -> lowcode.v1.PartitionSpec
+	88,  // 93: lowcode.v1.TableDefinition.columns:type_name -> lowcode.v1.ColumnDefinition
+	89,  // 94: lowcode.v1.TableDefinition.indexes:type_name -> lowcode.v1.IndexDefinition
+	292, // 95: lowcode.v1.SchemaBundle.exported_at:type_name -> google.protobuf.Timestamp
+	81,  // 96: lowcode.v1.SchemaBundle.types:type_name -> lowcode.v1.TypeDefinition
+	95,  // 97: lowcode.v1.SchemaBundle.tables:type_name -> lowcode.v1.TableDefinition
+	96,  // 98: lowcode.v1.ExportSchemaResponse.bundle:type_name -> lowcode.v1.SchemaBundle
+	96,  // 99: lowcode.v1.ImportSchemaRequest.bundle:type_name -> lowcode.v1.SchemaBundle
+	85,  // 100: lowcode.v1.ImportSchemaResponse.types:type_name -> lowcode.v1.ImportTypesResponse
+	21,  // 101: lowcode.v1.ImportSchemaResponse.tables:type_name -> lowcode.v1.Table
+	26,  // 102: lowcode.v1.ImportSchemaResponse.columns:type_name -> lowcode.v1.Column
+	27,  // 103: lowcode.v1.ImportSchemaResponse.indexes:type_name -> lowcode.v1.Index
+	88,  // 104: lowcode.v1.Template.columns:type_name -> lowcode.v1.ColumnDefinition
+	89,  // 105: lowcode.v1.Template.indexes:type_name -> lowcode.v1.IndexDefinition
+	101, // 106: lowcode.v1.ListTemplatesResponse.templates:type_name -> lowcode.v1.Template
+	21,  // 107: lowcode.v1.CreateTableFromTemplateResponse.table:type_name -> lowcode.v1.Table
+	26,  // 108: lowcode.v1.CreateTableFromTemplateResponse.columns:type_name -> lowcode.v1.Column
+	27,  // 109: lowcode.v1.CreateTableFromTemplateResponse.indexes:type_name -> lowcode.v1.Index
+	32,  // 110: lowcode.v1.CreateTableFromTemplateResponse.rows:type_name -> lowcode.v1.Row
+	25,  // 111: lowcode.v1.UpdateTableRequest.partition:type_name -> lowcode.v1.PartitionSpec
+	21,  // 112: lowcode.v1.UpdateTableResponse.table:type_name -> lowcode.v1.Table
+	250, // 113: lowcode.v1.UpdateTableResponse.operation:type_name -> lowcode.v1.Operation
+	21,  // 114: lowcode.v1.DuplicateTableResponse.table:type_name -> lowcode.v1.Table
+	26,  // 115: lowcode.v1.DuplicateTableResponse.columns:type_name -> lowcode.v1.Column
+	27,  // 116: lowcode.v1.DuplicateTableResponse.indexes:type_name -> lowcode.v1.Index
+	139, // 117: lowcode.v1.DeleteTableResponse.impact:type_name -> lowcode.v1.SchemaImpact
+	21,  // 118: lowcode.v1.DeleteTableResponse.archived:type_name -> lowcode.v1.Table
+	21,  // 119: lowcode.v1.RestoreTableResponse.table:type_name -> lowcode.v1.Table
+	6,   // 120: lowcode.v1.ListTablesRequest.sort:type_name -> lowcode.v1.TableSort
+	21,  // 121: lowcode.v1.ListTablesResponse.tables:type_name -> lowcode.v1.Table
+	23,  // 122: lowcode.v1.CreateWorkspaceResponse.workspace:type_name -> lowcode.v1.Workspace
+	23,  // 123: lowcode.v1.ListWorkspacesResponse.workspaces:type_name -> lowcode.v1.Workspace
+	23,  // 124: lowcode.v1.GetWorkspaceResponse.workspace:type_name -> lowcode.v1.Workspace
+	23,  // 125: lowcode.v1.UpdateWorkspaceResponse.workspace:type_name -> lowcode.v1.Workspace
+	21,  // 126: lowcode.v1.GetTableResponse.table:type_name -> lowcode.v1.Table
+	21,  // 127: lowcode.v1.GetTableSchemaResponse.table:type_name -> lowcode.v1.Table
+	26,  // 128: lowcode.v1.GetTableSchemaResponse.columns:type_name -> lowcode.v1.Column
+	27,  // 129: lowcode.v1.GetTableSchemaResponse.indexes:type_name -> lowcode.v1.Index
+	132, // 130: lowcode.v1.GetTableSchemaResponse.drift:type_name -> lowcode.v1.SchemaDrift
+	7,   // 131: lowcode.v1.SchemaDrift.kind:type_name -> lowcode.v1.DriftKind
+	93,  // 132: lowcode.v1.RepairTableSchemaResponse.changes:type_name -> lowcode.v1.SchemaChange
+	132, // 133: lowcode.v1.RepairTableSchemaResponse.drift:type_name -> lowcode.v1.SchemaDrift
+	21,  // 134: lowcode.v1.TableSchema.table:type_name -> lowcode.v1.Table
+	26,  // 135: lowcode.v1.TableSchema.columns:type_name -> lowcode.v1.Column
+	27,  // 136: lowcode.v1.TableSchema.indexes:type_name -> lowcode.v1.Index
+	136, // 137: lowcode.v1.GetWorkspaceSchemaResponse.tables:type_name -> lowcode.v1.TableSchema
+	137, // 138: lowcode.v1.GetWorkspaceSchemaResponse.relationships:type_name -> lowcode.v1.Relationship
+	26,  // 139: lowcode.v1.SchemaImpact.dependent_columns:type_name -> lowcode.v1.Column
+	27,  // 140: lowcode.v1.SchemaImpact.dependent_indexes:type_name -> lowcode.v1.Index
+	291, // 141: lowcode.v1.AddColumnRequest.config:type_name -> google.protobuf.Struct
+	291, // 142: lowcode.v1.AddColumnRequest.ui_hints:type_name -> google.protobuf.Struct
+	26,  // 143: lowcode.v1.AddColumnResponse.column:type_name -> lowcode.v1.Column
+	27,  // 144: lowcode.v1.AddColumnResponse.unique_index:type_name -> lowcode.v1.Index
+	26,  // 145: lowcode.v1.AddSelectOptionResponse.column:type_name -> lowcode.v1.Column
+	142, // 146: lowcode.v1.AddSelectOptionResponse.option:type_name -> lowcode.v1.SelectOption
+	26,  // 147: lowcode.v1.UpdateSelectOptionResponse.column:type_name -> lowcode.v1.Column
+	26,  // 148: lowcode.v1.RemoveSelectOptionResponse.column:type_name -> lowcode.v1.Column
+	26,  // 149: lowcode.v1.ReorderColumnsResponse.columns:type_name -> lowcode.v1.Column
+	291, // 150: lowcode.v1.UpdateColumnRequest.config:type_name -> google.protobuf.Struct
+	291, // 151: lowcode.v1.UpdateColumnRequest.ui_hints:type_name -> google.protobuf.Struct
+	26,  // 152: lowcode.v1.UpdateColumnResponse.column:type_name -> lowcode.v1.Column
+	8,   // 153: lowcode.v1.ChangeColumnTypeRequest.cast_strategy:type_name -> lowcode.v1.CastStrategy
+	26,  // 154: lowcode.v1.ChangeColumnTypeResponse.column:type_name -> lowcode.v1.Column
+	139, // 155: lowcode.v1.ChangeColumnTypeResponse.impact:type_name -> lowcode.v1.SchemaImpact
+	250, // 156: lowcode.v1.ChangeColumnTypeResponse.operation:type_name -> lowcode.v1.Operation
+	139, // 157: lowcode.v1.DeleteColumnResponse.impact:type_name -> lowcode.v1.SchemaImpact
+	26,  // 158: lowcode.v1.ListColumnsResponse.columns:type_name -> lowcode.v1.Column
+	285, // 159: lowcode.v1.CreateRowRequest.cells:type_name -> lowcode.v1.CreateRowRequest.CellsEntry
+	32,  // 160: lowcode.v1.CreateRowResponse.row:type_name -> lowcode.v1.Row
+	286, // 161: lowcode.v1.UpdateRowRequest.cells:type_name -> lowcode.v1.UpdateRowRequest.CellsEntry
+	32,  // 162: lowcode.v1.UpdateRowResponse.row:type_name -> lowcode.v1.Row
+	32,  // 163: lowcode.v1.RestoreRowResponse.row:type_name -> lowcode.v1.Row
+	9,   // 164: lowcode.v1.RowVersion.action:type_name -> lowcode.v1.RowVersionAction
+	32,  // 165: lowcode.v1.RowVersion.row:type_name -> lowcode.v1.Row
+	292, // 166: lowcode.v1.RowVersion.created_at:type_name -> google.protobuf.Timestamp
+	292, // 167: lowcode.v1.GetRowHistoryRequest.as_of:type_name -> google.protobuf.Timestamp
+	167, // 168: lowcode.v1.GetRowHistoryResponse.versions:type_name -> lowcode.v1.RowVersion
+	32,  // 169: lowcode.v1.GetRowHistoryResponse.row:type_name -> lowcode.v1.Row
+	32,  // 170: lowcode.v1.RestoreRowVersionResponse.row:type_name -> lowcode.v1.Row
+	292, // 171: lowcode.v1.PurgeRowsRequest.deleted_before:type_name -> google.protobuf.Timestamp
+	32,  // 172: lowcode.v1.GetRowResponse.row:type_name -> lowcode.v1.Row
+	30,  // 173: lowcode.v1.FindRowByColumnRequest.value:type_name -> lowcode.v1.Value
+	32,  // 174: lowcode.v1.FindRowByColumnResponse.row:type_name -> lowcode.v1.Row
+	10,  // 175: lowcode.v1.FilterCondition.operator:type_name -> lowcode.v1.FilterOperator
+	30,  // 176: lowcode.v1.FilterCondition.value:type_name -> lowcode.v1.Value
+	30,  // 177: lowcode.v1.FilterCondition.values:type_name -> lowcode.v1.Value
+	17,  // 178: lowcode.v1.FilterGroup.combinator:type_name -> lowcode.v1.FilterGroup.Combinator
+	184, // 179: lowcode.v1.FilterGroup.filters:type_name -> lowcode.v1.RowFilter
+	182, // 180: lowcode.v1.RowFilter.condition:type_name -> lowcode.v1.FilterCondition
+	183, // 181: lowcode.v1.RowFilter.group:type_name -> lowcode.v1.FilterGroup
+	18,  // 182: lowcode.v1.SortSpec.direction:type_name -> lowcode.v1.SortSpec.Direction
+	19,  // 183: lowcode.v1.SortSpec.nulls:type_name -> lowcode.v1.SortSpec.Nulls
+	184, // 184: lowcode.v1.ListRowsRequest.filter:type_name -> lowcode.v1.RowFilter
+	185, // 185: lowcode.v1.ListRowsRequest.sorts:type_name -> lowcode.v1.SortSpec
+	32,  // 186: lowcode.v1.ListRowsResponse.rows:type_name -> lowcode.v1.Row
+	184, // 187: lowcode.v1.StreamRowsRequest.filter:type_name -> lowcode.v1.RowFilter
+	185, // 188: lowcode.v1.StreamRowsRequest.sorts:type_name -> lowcode.v1.SortSpec
+	32,  // 189: lowcode.v1.StreamRowsResponse.rows:type_name -> lowcode.v1.Row
+	11,  // 190: lowcode.v1.ExportRowsRequest.format:type_name -> lowcode.v1.ExportFormat
+	184, // 191: lowcode.v1.ExportRowsRequest.filter:type_name -> lowcode.v1.RowFilter
+	185, // 192: lowcode.v1.ExportRowsRequest.sorts:type_name -> lowcode.v1.SortSpec
+	32,  // 193: lowcode.v1.SearchRowsResponse.rows:type_name -> lowcode.v1.Row
+	12,  // 194: lowcode.v1.Aggregation.function:type_name -> lowcode.v1.AggregateFunction
+	194, // 195: lowcode.v1.AggregateRowsRequest.aggregations:type_name -> lowcode.v1.Aggregation
+	184, // 196: lowcode.v1.AggregateRowsRequest.filter:type_name -> lowcode.v1.RowFilter
+	287, // 197: lowcode.v1.AggregateGroup.keys:type_name -> lowcode.v1.AggregateGroup.KeysEntry
+	30,  // 198: lowcode.v1.AggregateGroup.values:type_name -> lowcode.v1.Value
+	196, // 199: lowcode.v1.AggregateRowsResponse.groups:type_name -> lowcode.v1.AggregateGroup
+	30,  // 200: lowcode.v1.ListDistinctValuesResponse.values:type_name -> lowcode.v1.Value
+	288, // 201: lowcode.v1.BulkUpsertRowItem.cells:type_name -> lowcode.v1.BulkUpsertRowItem.CellsEntry
+	200, // 202: lowcode.v1.BulkUpsertRowsRequest.items:type_name -> lowcode.v1.BulkUpsertRowItem
+	32,  // 203: lowcode.v1.BulkUpsertRowsResponse.rows:type_name -> lowcode.v1.Row
+	205, // 204: lowcode.v1.UploadCellContentRequest.info:type_name -> lowcode.v1.CellContentInfo
+	30,  // 205: lowcode.v1.UploadCellContentResponse.value:type_name -> lowcode.v1.Value
+	205, // 206: lowcode.v1.DownloadCellContentResponse.info:type_name -> lowcode.v1.CellContentInfo
+	289, // 207: lowcode.v1.PresignedUrl.headers:type_name -> lowcode.v1.PresignedUrl.HeadersEntry
+	292, // 208: lowcode.v1.PresignedUrl.expires_at:type_name -> google.protobuf.Timestamp
+	210, // 209: lowcode.v1.CreateAttachmentUploadResponse.attachment:type_name -> lowcode.v1.Attachment
+	211, // 210: lowcode.v1.CreateAttachmentUploadResponse.upload:type_name -> lowcode.v1.PresignedUrl
+	210, // 211: lowcode.v1.GetAttachmentUrlResponse.attachment:type_name -> lowcode.v1.Attachment
+	211, // 212: lowcode.v1.GetAttachmentUrlResponse.download:type_name -> lowcode.v1.PresignedUrl
+	28,  // 213: lowcode.v1.CreateIndexRequest.expression:type_name -> lowcode.v1.IndexExpression
+	184, // 214: lowcode.v1.CreateIndexRequest.where:type_name -> lowcode.v1.RowFilter
+	0,   // 215: lowcode.v1.CreateIndexRequest.index_method:type_name -> lowcode.v1.IndexMethod
+	27,  // 216: lowcode.v1.CreateIndexResponse.index:type_name -> lowcode.v1.Index
+	250, // 217: lowcode.v1.CreateIndexResponse.operation:type_name -> lowcode.v1.Operation
+	27,  // 218: lowcode.v1.UpdateIndexResponse.index:type_name -> lowcode.v1.Index
+	250, // 219: lowcode.v1.UpdateIndexResponse.operation:type_name -> lowcode.v1.Operation
+	93,  // 220: lowcode.v1.SyncIndexesResponse.changes:type_name -> lowcode.v1.SchemaChange
+	27,  // 221: lowcode.v1.SyncIndexesResponse.indexes:type_name -> lowcode.v1.Index
+	184, // 222: lowcode.v1.CreateViewRequest.filter:type_name -> lowcode.v1.RowFilter
+	185, // 223: lowcode.v1.CreateViewRequest.sorts:type_name -> lowcode.v1.SortSpec
+	29,  // 224: lowcode.v1.CreateViewResponse.view:type_name -> lowcode.v1.View
+	29,  // 225: lowcode.v1.ListViewsResponse.views:type_name -> lowcode.v1.View
+	29,  // 226: lowcode.v1.GetViewResponse.view:type_name -> lowcode.v1.View
+	184, // 227: lowcode.v1.UpdateViewRequest.filter:type_name -> lowcode.v1.RowFilter
+	185, // 228: lowcode.v1.UpdateViewRequest.sorts:type_name -> lowcode.v1.SortSpec
+	29,  // 229: lowcode.v1.UpdateViewResponse.view:type_name -> lowcode.v1.View
+	27,  // 230: lowcode.v1.ListIndexesResponse.indexes:type_name -> lowcode.v1.Index
+	236, // 231: lowcode.v1.ImportExternalTablesRequest.tables:type_name -> lowcode.v1.ExternalTableExport
+	21,  // 232: lowcode.v1.ImportedTable.table:type_name -> lowcode.v1.Table
+	26,  // 233: lowcode.v1.ImportedTable.columns:type_name -> lowcode.v1.Column
+	238, // 234: lowcode.v1.ImportExternalTablesResponse.tables:type_name -> lowcode.v1.ImportedTable
+	21,  // 235: lowcode.v1.AdoptedTable.table:type_name -> lowcode.v1.Table
+	26,  // 236: lowcode.v1.AdoptedTable.columns:type_name -> lowcode.v1.Column
+	241, // 237: lowcode.v1.ImportDatabaseSchemaResponse.tables:type_name -> lowcode.v1.AdoptedTable
+	242, // 238: lowcode.v1.ImportDatabaseSchemaResponse.skipped:type_name -> lowcode.v1.SkippedTable
+	21,  // 239: lowcode.v1.CreateSQLViewResponse.table:type_name -> lowcode.v1.Table
+	26,  // 240: lowcode.v1.CreateSQLViewResponse.columns:type_name -> lowcode.v1.Column
+	21,  // 241: lowcode.v1.RefreshSQLViewResponse.table:type_name -> lowcode.v1.Table
+	290, // 242: lowcode.v1.ImportExistingTableRequest.column_types:type_name -> lowcode.v1.ImportExistingTableRequest.ColumnTypesEntry
+	21,  // 243: lowcode.v1.ImportExistingTableResponse.table:type_name -> lowcode.v1.Table
+	26,  // 244: lowcode.v1.ImportExistingTableResponse.columns:type_name -> lowcode.v1.Column
+	26,  // 245: lowcode.v1.ImportExistingTableResponse.related_columns:type_name -> lowcode.v1.Column
+	13,  // 246: lowcode.v1.Operation.status:type_name -> lowcode.v1.OperationStatus
+	291, // 247: lowcode.v1.Operation.response:type_name -> google.protobuf.Struct
+	2,   // 248: lowcode.v1.Operation.error_code:type_name -> lowcode.v1.ErrorCode
+	292, // 249: lowcode.v1.Operation.created_at:type_name -> google.protobuf.Timestamp
+	292, // 250: lowcode.v1.Operation.updated_at:type_name -> google.protobuf.Timestamp
+	292, // 251: lowcode.v1.Operation.finished_at:type_name -> google.protobuf.Timestamp
+	250, // 252: lowcode.v1.GetOperationResponse.operation:type_name -> lowcode.v1.Operation
+	13,  // 253: lowcode.v1.ListOperationsRequest.status:type_name -> lowcode.v1.OperationStatus
+	250, // 254: lowcode.v1.ListOperationsResponse.operations:type_name -> lowcode.v1.Operation
+	250, // 255: lowcode.v1.CancelOperationResponse.operation:type_name -> lowcode.v1.Operation
+	14,  // 256: lowcode.v1.Member.role:type_name -> lowcode.v1.Role
+	292, // 257: lowcode.v1.Member.created_at:type_name -> google.protobuf.Timestamp
+	292, // 258: lowcode.v1.Member.updated_at:type_name -> google.protobuf.Timestamp
+	257, // 259: lowcode.v1.ListMembersResponse.members:type_name -> lowcode.v1.Member
+	14,  // 260: lowcode.v1.SetMemberRequest.role:type_name -> lowcode.v1.Role
+	257, // 261: lowcode.v1.SetMemberResponse.member:type_name -> lowcode.v1.Member
+	14,  // 262: lowcode.v1.Permission.role:type_name -> lowcode.v1.Role
+	15,  // 263: lowcode.v1.Permission.access:type_name -> lowcode.v1.PermissionAccess
+	16,  // 264: lowcode.v1.Permission.effect:type_name -> lowcode.v1.PermissionEffect
+	292, // 265: lowcode.v1.Permission.created_at:type_name -> google.protobuf.Timestamp
+	264, // 266: lowcode.v1.ListPermissionsResponse.permissions:type_name -> lowcode.v1.Permission
+	14,  // 267: lowcode.v1.SetPermissionRequest.role:type_name -> lowcode.v1.Role
+	15,  // 268: lowcode.v1.SetPermissionRequest.access:type_name -> lowcode.v1.PermissionAccess
+	16,  // 269: lowcode.v1.SetPermissionRequest.effect:type_name -> lowcode.v1.PermissionEffect
+	264, // 270: lowcode.v1.SetPermissionResponse.permission:type_name -> lowcode.v1.Permission
+	292, // 271: lowcode.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	291, // 272: lowcode.v1.AuditEvent.request:type_name -> google.protobuf.Struct
+	291, // 273: lowcode.v1.AuditEvent.diff:type_name -> google.protobuf.Struct
+	292, // 274: lowcode.v1.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	292, // 275: lowcode.v1.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	271, // 276: lowcode.v1.ListAuditEventsResponse.events:type_name -> lowcode.v1.AuditEvent
+	292, // 277: lowcode.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	274, // 278: lowcode.v1.CreateWebhookResponse.webhook:type_name -> lowcode.v1.Webhook
+	274, // 279: lowcode.v1.ListWebhooksResponse.webhooks:type_name -> lowcode.v1.Webhook
+	292, // 280: lowcode.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	292, // 281: lowcode.v1.ListWebhookDeliveriesRequest.start_time:type_name -> google.protobuf.Timestamp
+	292, // 282: lowcode.v1.ListWebhookDeliveriesRequest.end_time:type_name -> google.protobuf.Timestamp
+	281, // 283: lowcode.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> lowcode.v1.WebhookDelivery
+	30,  // 284: lowcode.v1.Row.CellsEntry.value:type_name -> lowcode.v1.Value
+	30,  // 285: lowcode.v1.CreateRowRequest.CellsEntry.value:type_name -> lowcode.v1.Value
+	30,  // 286: lowcode.v1.UpdateRowRequest.CellsEntry.value:type_name -> lowcode.v1.Value
+	30,  // 287: lowcode.v1.AggregateGroup.KeysEntry.value:type_name -> lowcode.v1.Value
+	30,  // 288: lowcode.v1.BulkUpsertRowItem.CellsEntry.value:type_name -> lowcode.v1.Value
+	33,  // 289: lowcode.v1.LowcodeService.CreateTenant:input_type -> lowcode.v1.CreateTenantRequest
+	38,  // 290: lowcode.v1.LowcodeService.ListTenants:input_type -> lowcode.v1.ListTenantsRequest
+	40,  // 291: lowcode.v1.LowcodeService.GetTenant:input_type -> lowcode.v1.GetTenantRequest
+	42,  // 292: lowcode.v1.LowcodeService.DeleteTenant:input_type -> lowcode.v1.DeleteTenantRequest
+	44,  // 293: lowcode.v1.LowcodeService.CloneTenant:input_type -> lowcode.v1.CloneTenantRequest
+	47,  // 294: lowcode.v1.LowcodeService.ExportTenant:input_type -> lowcode.v1.ExportTenantRequest
+	50,  // 295: lowcode.v1.LowcodeService.ImportTenant:input_type -> lowcode.v1.ImportTenantRequest
+	52,  // 296: lowcode.v1.LowcodeService.MigrateAllTenants:input_type -> lowcode.v1.MigrateAllTenantsRequest
+	60,  // 297: lowcode.v1.LowcodeService.ListTenantHealth:input_type -> lowcode.v1.ListTenantHealthRequest
+	63,  // 298: lowcode.v1.LowcodeService.UpdateTenant:input_type -> lowcode.v1.UpdateTenantRequest
+	65,  // 299: lowcode.v1.LowcodeService.SuspendTenant:input_type -> lowcode.v1.SuspendTenantRequest
+	67,  // 300: lowcode.v1.LowcodeService.ResumeTenant:input_type -> lowcode.v1.ResumeTenantRequest
+	69,  // 301: lowcode.v1.LowcodeService.RotateTenantKeys:input_type -> lowcode.v1.RotateTenantKeysRequest
+	56,  // 302: lowcode.v1.LowcodeService.TriggerBackup:input_type -> lowcode.v1.TriggerBackupRequest
+	58,  // 303: lowcode.v1.LowcodeService.ListBackups:input_type -> lowcode.v1.ListBackupsRequest
+	71,  // 304: lowcode.v1.LowcodeService.CreateType:input_type -> lowcode.v1.CreateTypeRequest
+	73,  // 305: lowcode.v1.LowcodeService.ListTypes:input_type -> lowcode.v1.ListTypesRequest
+	75,  // 306: lowcode.v1.LowcodeService.GetType:input_type -> lowcode.v1.GetTypeRequest
+	77,  // 307: lowcode.v1.LowcodeService.UpdateType:input_type -> lowcode.v1.UpdateTypeRequest
+	79,  // 308: lowcode.v1.LowcodeService.DeleteType:input_type -> lowcode.v1.DeleteTypeRequest
+	82,  // 309: lowcode.v1.LowcodeService.ExportTypes:input_type -> lowcode.v1.ExportTypesRequest
+	84,  // 310: lowcode.v1.LowcodeService.ImportTypes:input_type -> lowcode.v1.ImportTypesRequest
+	86,  // 311: lowcode.v1.LowcodeService.CreateTable:input_type -> lowcode.v1.CreateTableRequest
+	90,  // 312: lowcode.v1.LowcodeService.CreateTableWithSchema:input_type -> lowcode.v1.CreateTableWithSchemaRequest
+	92,  // 313: lowcode.v1.LowcodeService.ApplyTableSchema:input_type -> lowcode.v1.ApplyTableSchemaRequest
+	97,  // 314: lowcode.v1.LowcodeService.ExportSchema:input_type -> lowcode.v1.ExportSchemaRequest
+	99,  // 315: lowcode.v1.LowcodeService.ImportSchema:input_type -> lowcode.v1.ImportSchemaRequest
+	102, // 316: lowcode.v1.LowcodeService.ListTemplates:input_type -> lowcode.v1.ListTemplatesRequest
+	104, // 317: lowcode.v1.LowcodeService.CreateTableFromTemplate:input_type -> lowcode.v1.CreateTableFromTemplateRequest
+	106, // 318: lowcode.v1.LowcodeService.UpdateTable:input_type -> lowcode.v1.UpdateTableRequest
+	108, // 319: lowcode.v1.LowcodeService.DuplicateTable:input_type -> lowcode.v1.DuplicateTableRequest
+	110, // 320: lowcode.v1.LowcodeService.DeleteTable:input_type -> lowcode.v1.DeleteTableRequest
+	112, // 321: lowcode.v1.LowcodeService.RestoreTable:input_type -> lowcode.v1.RestoreTableRequest
+	114, // 322: lowcode.v1.LowcodeService.PurgeTable:input_type -> lowcode.v1.PurgeTableRequest
+	116, // 323: lowcode.v1.LowcodeService.ListTables:input_type -> lowcode.v1.ListTablesRequest
+	128, // 324: lowcode.v1.LowcodeService.GetTable:input_type -> lowcode.v1.GetTableRequest
+	130, // 325: lowcode.v1.LowcodeService.GetTableSchema:input_type -> lowcode.v1.GetTableSchemaRequest
+	133, // 326: lowcode.v1.LowcodeService.RepairTableSchema:input_type -> lowcode.v1.RepairTableSchemaRequest
+	135, // 327: lowcode.v1.LowcodeService.GetWorkspaceSchema:input_type -> lowcode.v1.GetWorkspaceSchemaRequest
+	118, // 328: lowcode.v1.LowcodeService.CreateWorkspace:input_type -> lowcode.v1.CreateWorkspaceRequest
+	120, // 329: lowcode.v1.LowcodeService.ListWorkspaces:input_type -> lowcode.v1.ListWorkspacesRequest
+	122, // 330: lowcode.v1.LowcodeService.GetWorkspace:input_type -> lowcode.v1.GetWorkspaceRequest
+	124, // 331: lowcode.v1.LowcodeService.UpdateWorkspace:input_type -> lowcode.v1.UpdateWorkspaceRequest
+	126, // 332: lowcode.v1.LowcodeService.DeleteWorkspace:input_type -> lowcode.v1.DeleteWorkspaceRequest
+	140, // 333: lowcode.v1.LowcodeService.AddColumn:input_type -> lowcode.v1.AddColumnRequest
+	151, // 334: lowcode.v1.LowcodeService.UpdateColumn:input_type -> lowcode.v1.UpdateColumnRequest
+	155, // 335: lowcode.v1.LowcodeService.DeleteColumn:input_type -> lowcode.v1.DeleteColumnRequest
+	153, // 336: lowcode.v1.LowcodeService.ChangeColumnType:input_type -> lowcode.v1.ChangeColumnTypeRequest
+	157, // 337: lowcode.v1.LowcodeService.ListColumns:input_type -> lowcode.v1.ListColumnsRequest
+	149, // 338: lowcode.v1.LowcodeService.ReorderColumns:input_type -> lowcode.v1.ReorderColumnsRequest
+	143, // 339: lowcode.v1.LowcodeService.AddSelectOption:input_type -> lowcode.v1.AddSelectOptionRequest
+	145, // 340: lowcode.v1.LowcodeService.UpdateSelectOption:input_type -> lowcode.v1.UpdateSelectOptionRequest
+	147, // 341: lowcode.v1.LowcodeService.RemoveSelectOption:input_type -> lowcode.v1.RemoveSelectOptionRequest
+	159, // 342: lowcode.v1.LowcodeService.CreateRow:input_type -> lowcode.v1.CreateRowRequest
+	161, // 343: lowcode.v1.LowcodeService.UpdateRow:input_type -> lowcode.v1.UpdateRowRequest
+	163, // 344: lowcode.v1.LowcodeService.DeleteRow:input_type -> lowcode.v1.DeleteRowRequest
+	165, // 345: lowcode.v1.LowcodeService.RestoreRow:input_type -> lowcode.v1.RestoreRowRequest
+	168, // 346: lowcode.v1.LowcodeService.GetRowHistory:input_type -> lowcode.v1.GetRowHistoryRequest
+	170, // 347: lowcode.v1.LowcodeService.RestoreRowVersion:input_type -> lowcode.v1.RestoreRowVersionRequest
+	172, // 348: lowcode.v1.LowcodeService.LinkRows:input_type -> lowcode.v1.LinkRowsRequest
+	174, // 349: lowcode.v1.LowcodeService.UnlinkRows:input_type -> lowcode.v1.UnlinkRowsRequest
+	176, // 350: lowcode.v1.LowcodeService.PurgeRows:input_type -> lowcode.v1.PurgeRowsRequest
+	178, // 351: lowcode.v1.LowcodeService.GetRow:input_type -> lowcode.v1.GetRowRequest
+	180, // 352: lowcode.v1.LowcodeService.FindRowByColumn:input_type -> lowcode.v1.FindRowByColumnRequest
+	186, // 353: lowcode.v1.LowcodeService.ListRows:input_type -> lowcode.v1.ListRowsRequest
+	188, // 354: lowcode.v1.LowcodeService.StreamRows:input_type -> lowcode.v1.StreamRowsRequest
+	190, // 355: lowcode.v1.LowcodeService.ExportRows:input_type -> lowcode.v1.ExportRowsRequest
+	192, // 356: lowcode.v1.LowcodeService.SearchRows:input_type -> lowcode.v1.SearchRowsRequest
+	195, // 357: lowcode.v1.LowcodeService.AggregateRows:input_type -> lowcode.v1.AggregateRowsRequest
+	198, // 358: lowcode.v1.LowcodeService.ListDistinctValues:input_type -> lowcode.v1.ListDistinctValuesRequest
+	201, // 359: lowcode.v1.LowcodeService.BulkUpsertRows:input_type -> lowcode.v1.BulkUpsertRowsRequest
+	203, // 360: lowcode.v1.LowcodeService.BulkDeleteRows:input_type -> lowcode.v1.BulkDeleteRowsRequest
+	206, // 361: lowcode.v1.LowcodeService.UploadCellContent:input_type -> lowcode.v1.UploadCellContentRequest
+	208, // 362: lowcode.v1.LowcodeService.DownloadCellContent:input_type -> lowcode.v1.DownloadCellContentRequest
+	212, // 363: lowcode.v1.LowcodeService.CreateAttachmentUpload:input_type -> lowcode.v1.CreateAttachmentUploadRequest
+	214, // 364: lowcode.v1.LowcodeService.GetAttachmentUrl:input_type -> lowcode.v1.GetAttachmentUrlRequest
+	216, // 365: lowcode.v1.LowcodeService.CreateIndex:input_type -> lowcode.v1.CreateIndexRequest
+	218, // 366: lowcode.v1.LowcodeService.UpdateIndex:input_type -> lowcode.v1.UpdateIndexRequest
+	222, // 367: lowcode.v1.LowcodeService.DeleteIndex:input_type -> lowcode.v1.DeleteIndexRequest
+	234, // 368: lowcode.v1.LowcodeService.ListIndexes:input_type -> lowcode.v1.ListIndexesRequest
+	220, // 369: lowcode.v1.LowcodeService.SyncIndexes:input_type -> lowcode.v1.SyncIndexesRequest
+	224, // 370: lowcode.v1.LowcodeService.CreateView:input_type -> lowcode.v1.CreateViewRequest
+	226, // 371: lowcode.v1.LowcodeService.ListViews:input_type -> lowcode.v1.ListViewsRequest
+	228, // 372: lowcode.v1.LowcodeService.GetView:input_type -> lowcode.v1.GetViewRequest
+	230, // 373: lowcode.v1.LowcodeService.UpdateView:input_type -> lowcode.v1.UpdateViewRequest
+	232, // 374: lowcode.v1.LowcodeService.DeleteView:input_type -> lowcode.v1.DeleteViewRequest
+	237, // 375: lowcode.v1.LowcodeService.ImportExternalTables:input_type -> lowcode.v1.ImportExternalTablesRequest
+	240, // 376: lowcode.v1.LowcodeService.ImportDatabaseSchema:input_type -> lowcode.v1.ImportDatabaseSchemaRequest
+	248, // 377: lowcode.v1.LowcodeService.ImportExistingTable:input_type -> lowcode.v1.ImportExistingTableRequest
+	244, // 378: lowcode.v1.LowcodeService.CreateSQLView:input_type -> lowcode.v1.CreateSQLViewRequest
+	246, // 379: lowcode.v1.LowcodeService.RefreshSQLView:input_type -> lowcode.v1.RefreshSQLViewRequest
+	251, // 380: lowcode.v1.LowcodeService.GetOperation:input_type -> lowcode.v1.GetOperationRequest
+	253, // 381: lowcode.v1.LowcodeService.ListOperations:input_type -> lowcode.v1.ListOperationsRequest
+	255, // 382: lowcode.v1.LowcodeService.CancelOperation:input_type -> lowcode.v1.CancelOperationRequest
+	258, // 383: lowcode.v1.LowcodeService.ListMembers:input_type -> lowcode.v1.ListMembersRequest
+	260, // 384: lowcode.v1.LowcodeService.SetMember:input_type -> lowcode.v1.SetMemberRequest
+	262, // 385: lowcode.v1.LowcodeService.DeleteMember:input_type -> lowcode.v1.DeleteMemberRequest
+	265, // 386: lowcode.v1.LowcodeService.ListPermissions:input_type -> lowcode.v1.ListPermissionsRequest
+	267, // 387: lowcode.v1.LowcodeService.SetPermission:input_type -> lowcode.v1.SetPermissionRequest
+	269, // 388: lowcode.v1.LowcodeService.DeletePermission:input_type -> lowcode.v1.DeletePermissionRequest
+	272, // 389: lowcode.v1.LowcodeService.ListAuditEvents:input_type -> lowcode.v1.ListAuditEventsRequest
+	275, // 390: lowcode.v1.LowcodeService.CreateWebhook:input_type -> lowcode.v1.CreateWebhookRequest
+	277, // 391: lowcode.v1.LowcodeService.ListWebhooks:input_type -> lowcode.v1.ListWebhooksRequest
+	279, // 392: lowcode.v1.LowcodeService.DeleteWebhook:input_type -> lowcode.v1.DeleteWebhookRequest
+	282, // 393: lowcode.v1.LowcodeService.ListWebhookDeliveries:input_type -> lowcode.v1.ListWebhookDeliveriesRequest
+	36,  // 394: lowcode.v1.LowcodeService.CreateTenant:output_type -> lowcode.v1.CreateTenantResponse
+	39,  // 395: lowcode.v1.LowcodeService.ListTenants:output_type -> lowcode.v1.ListTenantsResponse
+	41,  // 396: lowcode.v1.LowcodeService.GetTenant:output_type -> lowcode.v1.GetTenantResponse
+	43,  // 397: lowcode.v1.LowcodeService.DeleteTenant:output_type -> lowcode.v1.DeleteTenantResponse
+	45,  // 398: lowcode.v1.LowcodeService.CloneTenant:output_type -> lowcode.v1.CloneTenantResponse
+	48,  // 399: lowcode.v1.LowcodeService.ExportTenant:output_type -> lowcode.v1.ExportTenantResponse
+	51,  // 400: lowcode.v1.LowcodeService.ImportTenant:output_type -> lowcode.v1.ImportTenantResponse
+	54,  // 401: lowcode.v1.LowcodeService.MigrateAllTenants:output_type -> lowcode.v1.MigrateAllTenantsResponse
+	62,  // 402: lowcode.v1.LowcodeService.ListTenantHealth:output_type -> lowcode.v1.ListTenantHealthResponse
+	64,  // 403: lowcode.v1.LowcodeService.UpdateTenant:output_type -> lowcode.v1.UpdateTenantResponse
+	66,  // 404: lowcode.v1.LowcodeService.SuspendTenant:output_type -> lowcode.v1.SuspendTenantResponse
+	68,  // 405: lowcode.v1.LowcodeService.ResumeTenant:output_type -> lowcode.v1.ResumeTenantResponse
+	70,  // 406: lowcode.v1.LowcodeService.RotateTenantKeys:output_type -> lowcode.v1.RotateTenantKeysResponse
+	57,  // 407: lowcode.v1.LowcodeService.TriggerBackup:output_type -> lowcode.v1.TriggerBackupResponse
+	59,  // 408: lowcode.v1.LowcodeService.ListBackups:output_type -> lowcode.v1.ListBackupsResponse
+	72,  // 409: lowcode.v1.LowcodeService.CreateType:output_type -> lowcode.v1.CreateTypeResponse
+	74,  // 410: lowcode.v1.LowcodeService.ListTypes:output_type -> lowcode.v1.ListTypesResponse
+	76,  // 411: lowcode.v1.LowcodeService.GetType:output_type -> lowcode.v1.GetTypeResponse
+	78,  // 412: lowcode.v1.LowcodeService.UpdateType:output_type -> lowcode.v1.UpdateTypeResponse
+	80,  // 413: lowcode.v1.LowcodeService.DeleteType:output_type -> lowcode.v1.DeleteTypeResponse
+	83,  // 414: lowcode.v1.LowcodeService.ExportTypes:output_type -> lowcode.v1.ExportTypesResponse
+	85,  // 415: lowcode.v1.LowcodeService.ImportTypes:output_type -> lowcode.v1.ImportTypesResponse
+	87,  // 416: lowcode.v1.LowcodeService.CreateTable:output_type -> lowcode.v1.CreateTableResponse
+	91,  // 417: lowcode.v1.LowcodeService.CreateTableWithSchema:output_type -> lowcode.v1.CreateTableWithSchemaResponse
+	94,  // 418: lowcode.v1.LowcodeService.ApplyTableSchema:output_type -> lowcode.v1.ApplyTableSchemaResponse
+	98,  // 419: lowcode.v1.LowcodeService.ExportSchema:output_type -> lowcode.v1.ExportSchemaResponse
+	100, // 420: lowcode.v1.LowcodeService.ImportSchema:output_type -> lowcode.v1.ImportSchemaResponse
+	103, // 421: lowcode.v1.LowcodeService.ListTemplates:output_type -> lowcode.v1.ListTemplatesResponse
+	105, // 422: lowcode.v1.LowcodeService.CreateTableFromTemplate:output_type -> lowcode.v1.CreateTableFromTemplateResponse
+	107, // 423: lowcode.v1.LowcodeService.UpdateTable:output_type -> lowcode.v1.UpdateTableResponse
+	109, // 424: lowcode.v1.LowcodeService.DuplicateTable:output_type -> lowcode.v1.DuplicateTableResponse
+	111, // 425: lowcode.v1.LowcodeService.DeleteTable:output_type -> lowcode.v1.DeleteTableResponse
+	113, // 426: lowcode.v1.LowcodeService.RestoreTable:output_type -> lowcode.v1.RestoreTableResponse
+	115, // 427: lowcode.v1.LowcodeService.PurgeTable:output_type -> lowcode.v1.PurgeTableResponse
+	117, // 428: lowcode.v1.LowcodeService.ListTables:output_type -> lowcode.v1.ListTablesResponse
+	129, // 429: lowcode.v1.LowcodeService.GetTable:output_type -> lowcode.v1.GetTableResponse
+	131, // 430: lowcode.v1.LowcodeService.GetTableSchema:output_type -> lowcode.v1.GetTableSchemaResponse
+	134, // 431: lowcode.v1.LowcodeService.RepairTableSchema:output_type -> lowcode.v1.RepairTableSchemaResponse
+	138, // 432: lowcode.v1.LowcodeService.GetWorkspaceSchema:output_type -> lowcode.v1.GetWorkspaceSchemaResponse
+	119, // 433: lowcode.v1.LowcodeService.CreateWorkspace:output_type -> lowcode.v1.CreateWorkspaceResponse
+	121, // 434: lowcode.v1.LowcodeService.ListWorkspaces:output_type -> lowcode.v1.ListWorkspacesResponse
+	123, // 435: lowcode.v1.LowcodeService.GetWorkspace:output_type -> lowcode.v1.GetWorkspaceResponse
+	125, // 436: lowcode.v1.LowcodeService.UpdateWorkspace:output_type -> lowcode.v1.UpdateWorkspaceResponse
+	127, // 437: lowcode.v1.LowcodeService.DeleteWorkspace:output_type -> lowcode.v1.DeleteWorkspaceResponse
+	141, // 438: lowcode.v1.LowcodeService.AddColumn:output_type -> lowcode.v1.AddColumnResponse
+	152, // 439: lowcode.v1.LowcodeService.UpdateColumn:output_type -> lowcode.v1.UpdateColumnResponse
+	156, // 440: lowcode.v1.LowcodeService.DeleteColumn:output_type -> lowcode.v1.DeleteColumnResponse
+	154, // 441: lowcode.v1.LowcodeService.ChangeColumnType:output_type -> lowcode.v1.ChangeColumnTypeResponse
+	158, // 442: lowcode.v1.LowcodeService.ListColumns:output_type -> lowcode.v1.ListColumnsResponse
+	150, // 443: lowcode.v1.LowcodeService.ReorderColumns:output_type -> lowcode.v1.ReorderColumnsResponse
+	144, // 444: lowcode.v1.LowcodeService.AddSelectOption:output_type -> lowcode.v1.AddSelectOptionResponse
+	146, // 445: lowcode.v1.LowcodeService.UpdateSelectOption:output_type -> lowcode.v1.UpdateSelectOptionResponse
+	148, // 446: lowcode.v1.LowcodeService.RemoveSelectOption:output_type -> lowcode.v1.RemoveSelectOptionResponse
+	160, // 447: lowcode.v1.LowcodeService.CreateRow:output_type -> lowcode.v1.CreateRowResponse
+	162, // 448: lowcode.v1.LowcodeService.UpdateRow:output_type -> lowcode.v1.UpdateRowResponse
+	164, // 449: lowcode.v1.LowcodeService.DeleteRow:output_type -> lowcode.v1.DeleteRowResponse
+	166, // 450: lowcode.v1.LowcodeService.RestoreRow:output_type -> lowcode.v1.RestoreRowResponse
+	169, // 451: lowcode.v1.LowcodeService.GetRowHistory:output_type -> lowcode.v1.GetRowHistoryResponse
+	171, // 452: lowcode.v1.LowcodeService.RestoreRowVersion:output_type -> lowcode.v1.RestoreRowVersionResponse
+	173, // 453: lowcode.v1.LowcodeService.LinkRows:output_type -> lowcode.v1.LinkRowsResponse
+	175, // 454: lowcode.v1.LowcodeService.UnlinkRows:output_type -> lowcode.v1.UnlinkRowsResponse
+	177, // 455: lowcode.v1.LowcodeService.PurgeRows:output_type -> lowcode.v1.PurgeRowsResponse
+	179, // 456: lowcode.v1.LowcodeService.GetRow:output_type -> lowcode.v1.GetRowResponse
+	181, // 457: lowcode.v1.LowcodeService.FindRowByColumn:output_type -> lowcode.v1.FindRowByColumnResponse
+	187, // 458: lowcode.v1.LowcodeService.ListRows:output_type -> lowcode.v1.ListRowsResponse
+	189, // 459: lowcode.v1.LowcodeService.StreamRows:output_type -> lowcode.v1.StreamRowsResponse
+	191, // 460: lowcode.v1.LowcodeService.ExportRows:output_type -> lowcode.v1.ExportRowsResponse
+	193, // 461: lowcode.v1.LowcodeService.SearchRows:output_type -> lowcode.v1.SearchRowsResponse
+	197, // 462: lowcode.v1.LowcodeService.AggregateRows:output_type -> lowcode.v1.AggregateRowsResponse
+	199, // 463: lowcode.v1.LowcodeService.ListDistinctValues:output_type -> lowcode.v1.ListDistinctValuesResponse
+	202, // 464: lowcode.v1.LowcodeService.BulkUpsertRows:output_type -> lowcode.v1.BulkUpsertRowsResponse
+	204, // 465: lowcode.v1.LowcodeService.BulkDeleteRows:output_type -> lowcode.v1.BulkDeleteRowsResponse
+	207, // 466: lowcode.v1.LowcodeService.UploadCellContent:output_type -> lowcode.v1.UploadCellContentResponse
+	209, // 467: lowcode.v1.LowcodeService.DownloadCellContent:output_type -> lowcode.v1.DownloadCellContentResponse
+	213, // 468: lowcode.v1.LowcodeService.CreateAttachmentUpload:output_type -> lowcode.v1.CreateAttachmentUploadResponse
+	215, // 469: lowcode.v1.LowcodeService.GetAttachmentUrl:output_type -> lowcode.v1.GetAttachmentUrlResponse
+	217, // 470: lowcode.v1.LowcodeService.CreateIndex:output_type -> lowcode.v1.CreateIndexResponse
+	219, // 471: lowcode.v1.LowcodeService.UpdateIndex:output_type -> lowcode.v1.UpdateIndexResponse
+	223, // 472: lowcode.v1.LowcodeService.DeleteIndex:output_type -> lowcode.v1.DeleteIndexResponse
+	235, // 473: lowcode.v1.LowcodeService.ListIndexes:output_type -> lowcode.v1.ListIndexesResponse
+	221, // 474: lowcode.v1.LowcodeService.SyncIndexes:output_type -> lowcode.v1.SyncIndexesResponse
+	225, // 475: lowcode.v1.LowcodeService.CreateView:output_type -> lowcode.v1.CreateViewResponse
+	227, // 476: lowcode.v1.LowcodeService.ListViews:output_type -> lowcode.v1.ListViewsResponse
+	229, // 477: lowcode.v1.LowcodeService.GetView:output_type -> lowcode.v1.GetViewResponse
+	231, // 478: lowcode.v1.LowcodeService.UpdateView:output_type -> lowcode.v1.UpdateViewResponse
+	233, // 479: lowcode.v1.LowcodeService.DeleteView:output_type -> lowcode.v1.DeleteViewResponse
+	239, // 480: lowcode.v1.LowcodeService.ImportExternalTables:output_type -> lowcode.v1.ImportExternalTablesResponse
+	243, // 481: lowcode.v1.LowcodeService.ImportDatabaseSchema:output_type -> lowcode.v1.ImportDatabaseSchemaResponse
+	249, // 482: lowcode.v1.LowcodeService.ImportExistingTable:output_type -> lowcode.v1.ImportExistingTableResponse
+	245, // 483: lowcode.v1.LowcodeService.CreateSQLView:output_type -> lowcode.v1.CreateSQLViewResponse
+	247, // 484: lowcode.v1.LowcodeService.RefreshSQLView:output_type -> lowcode.v1.RefreshSQLViewResponse
+	252, // 485: lowcode.v1.LowcodeService.GetOperation:output_type -> lowcode.v1.GetOperationResponse
+	254, // 486: lowcode.v1.LowcodeService.ListOperations:output_type -> lowcode.v1.ListOperationsResponse
+	256, // 487: lowcode.v1.LowcodeService.CancelOperation:output_type -> lowcode.v1.CancelOperationResponse
+	259, // 488: lowcode.v1.LowcodeService.ListMembers:output_type -> lowcode.v1.ListMembersResponse
+	261, // 489: lowcode.v1.LowcodeService.SetMember:output_type -> lowcode.v1.SetMemberResponse
+	263, // 490: lowcode.v1.LowcodeService.DeleteMember:output_type -> lowcode.v1.DeleteMemberResponse
+	266, // 491: lowcode.v1.LowcodeService.ListPermissions:output_type -> lowcode.v1.ListPermissionsResponse
+	268, // 492: lowcode.v1.LowcodeService.SetPermission:output_type -> lowcode.v1.SetPermissionResponse
+	270, // 493: lowcode.v1.LowcodeService.DeletePermission:output_type -> lowcode.v1.DeletePermissionResponse
+	273, // 494: lowcode.v1.LowcodeService.ListAuditEvents:output_type -> lowcode.v1.ListAuditEventsResponse
+	276, // 495: lowcode.v1.LowcodeService.CreateWebhook:output_type -> lowcode.v1.CreateWebhookResponse
+	278, // 496: lowcode.v1.LowcodeService.ListWebhooks:output_type -> lowcode.v1.ListWebhooksResponse
+	280, // 497: lowcode.v1.LowcodeService.DeleteWebhook:output_type -> lowcode.v1.DeleteWebhookResponse
+	283, // 498: lowcode.v1.LowcodeService.ListWebhookDeliveries:output_type -> lowcode.v1.ListWebhookDeliveriesResponse
+	394, // [394:499] is the sub-list for method output_type
+	289, // [289:394] is the sub-list for method input_type
+	289, // [289:289] is the sub-list for extension type_name
+	289, // [289:289] is the sub-list for extension extendee
+	0,   // [0:289] is the sub-list for field type_name
 }
 
 func init() { file_lowcode_v1_lowcode_service_proto_init() }
@@ -3058,26 +19947,53 @@ func file_lowcode_v1_lowcode_service_proto_init() {
 	if File_lowcode_v1_lowcode_service_proto != nil {
 		return
 	}
-	file_lowcode_v1_lowcode_service_proto_msgTypes[4].OneofWrappers = []any{
+	file_lowcode_v1_lowcode_service_proto_msgTypes[10].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_NumberValue)(nil),
 		(*Value_BoolValue)(nil),
 		(*Value_TimestampValue)(nil),
 		(*Value_BytesValue)(nil),
 		(*Value_JsonValue)(nil),
+		(*Value_NullValue)(nil),
+		(*Value_ListValue)(nil),
+	}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[28].OneofWrappers = []any{
+		(*ExportTenantResponse_Info)(nil),
+		(*ExportTenantResponse_Chunk)(nil),
+	}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[30].OneofWrappers = []any{
+		(*ImportTenantRequest_Info)(nil),
+		(*ImportTenantRequest_Chunk)(nil),
+	}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[86].OneofWrappers = []any{}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[104].OneofWrappers = []any{}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[131].OneofWrappers = []any{}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[164].OneofWrappers = []any{
+		(*RowFilter_Condition)(nil),
+		(*RowFilter_Group)(nil),
+	}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[186].OneofWrappers = []any{
+		(*UploadCellContentRequest_Info)(nil),
+		(*UploadCellContentRequest_Chunk)(nil),
+	}
+	file_lowcode_v1_lowcode_service_proto_msgTypes[189].OneofWrappers = []any{
+		(*DownloadCellContentResponse_Info)(nil),
+		(*DownloadCellContentResponse_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lowcode_v1_lowcode_service_proto_rawDesc), len(file_lowcode_v1_lowcode_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   53,
+			NumEnums:      20,
+			NumMessages:   271,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_lowcode_v1_lowcode_service_proto_goTypes,
 		DependencyIndexes: file_lowcode_v1_lowcode_service_proto_depIdxs,
+		EnumInfos:         file_lowcode_v1_lowcode_service_proto_enumTypes,
 		MessageInfos:      file_lowcode_v1_lowcode_service_proto_msgTypes,
 	}.Build()
 	File_lowcode_v1_lowcode_service_proto = out.File
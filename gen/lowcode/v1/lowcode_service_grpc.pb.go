@@ -19,27 +19,111 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LowcodeService_CreateTenant_FullMethodName   = "/lowcode.v1.LowcodeService/CreateTenant"
-	LowcodeService_CreateType_FullMethodName     = "/lowcode.v1.LowcodeService/CreateType"
-	LowcodeService_ListTypes_FullMethodName      = "/lowcode.v1.LowcodeService/ListTypes"
-	LowcodeService_DeleteType_FullMethodName     = "/lowcode.v1.LowcodeService/DeleteType"
-	LowcodeService_CreateTable_FullMethodName    = "/lowcode.v1.LowcodeService/CreateTable"
-	LowcodeService_DeleteTable_FullMethodName    = "/lowcode.v1.LowcodeService/DeleteTable"
-	LowcodeService_ListTables_FullMethodName     = "/lowcode.v1.LowcodeService/ListTables"
-	LowcodeService_GetTableSchema_FullMethodName = "/lowcode.v1.LowcodeService/GetTableSchema"
-	LowcodeService_AddColumn_FullMethodName      = "/lowcode.v1.LowcodeService/AddColumn"
-	LowcodeService_UpdateColumn_FullMethodName   = "/lowcode.v1.LowcodeService/UpdateColumn"
-	LowcodeService_DeleteColumn_FullMethodName   = "/lowcode.v1.LowcodeService/DeleteColumn"
-	LowcodeService_ListColumns_FullMethodName    = "/lowcode.v1.LowcodeService/ListColumns"
-	LowcodeService_CreateRow_FullMethodName      = "/lowcode.v1.LowcodeService/CreateRow"
-	LowcodeService_UpdateRow_FullMethodName      = "/lowcode.v1.LowcodeService/UpdateRow"
-	LowcodeService_DeleteRow_FullMethodName      = "/lowcode.v1.LowcodeService/DeleteRow"
-	LowcodeService_ListRows_FullMethodName       = "/lowcode.v1.LowcodeService/ListRows"
-	LowcodeService_BulkUpsertRows_FullMethodName = "/lowcode.v1.LowcodeService/BulkUpsertRows"
-	LowcodeService_BulkDeleteRows_FullMethodName = "/lowcode.v1.LowcodeService/BulkDeleteRows"
-	LowcodeService_CreateIndex_FullMethodName    = "/lowcode.v1.LowcodeService/CreateIndex"
-	LowcodeService_DeleteIndex_FullMethodName    = "/lowcode.v1.LowcodeService/DeleteIndex"
-	LowcodeService_ListIndexes_FullMethodName    = "/lowcode.v1.LowcodeService/ListIndexes"
+	LowcodeService_CreateTenant_FullMethodName            = "/lowcode.v1.LowcodeService/CreateTenant"
+	LowcodeService_ListTenants_FullMethodName             = "/lowcode.v1.LowcodeService/ListTenants"
+	LowcodeService_GetTenant_FullMethodName               = "/lowcode.v1.LowcodeService/GetTenant"
+	LowcodeService_DeleteTenant_FullMethodName            = "/lowcode.v1.LowcodeService/DeleteTenant"
+	LowcodeService_CloneTenant_FullMethodName             = "/lowcode.v1.LowcodeService/CloneTenant"
+	LowcodeService_ExportTenant_FullMethodName            = "/lowcode.v1.LowcodeService/ExportTenant"
+	LowcodeService_ImportTenant_FullMethodName            = "/lowcode.v1.LowcodeService/ImportTenant"
+	LowcodeService_MigrateAllTenants_FullMethodName       = "/lowcode.v1.LowcodeService/MigrateAllTenants"
+	LowcodeService_ListTenantHealth_FullMethodName        = "/lowcode.v1.LowcodeService/ListTenantHealth"
+	LowcodeService_UpdateTenant_FullMethodName            = "/lowcode.v1.LowcodeService/UpdateTenant"
+	LowcodeService_SuspendTenant_FullMethodName           = "/lowcode.v1.LowcodeService/SuspendTenant"
+	LowcodeService_ResumeTenant_FullMethodName            = "/lowcode.v1.LowcodeService/ResumeTenant"
+	LowcodeService_RotateTenantKeys_FullMethodName        = "/lowcode.v1.LowcodeService/RotateTenantKeys"
+	LowcodeService_TriggerBackup_FullMethodName           = "/lowcode.v1.LowcodeService/TriggerBackup"
+	LowcodeService_ListBackups_FullMethodName             = "/lowcode.v1.LowcodeService/ListBackups"
+	LowcodeService_CreateType_FullMethodName              = "/lowcode.v1.LowcodeService/CreateType"
+	LowcodeService_ListTypes_FullMethodName               = "/lowcode.v1.LowcodeService/ListTypes"
+	LowcodeService_GetType_FullMethodName                 = "/lowcode.v1.LowcodeService/GetType"
+	LowcodeService_UpdateType_FullMethodName              = "/lowcode.v1.LowcodeService/UpdateType"
+	LowcodeService_DeleteType_FullMethodName              = "/lowcode.v1.LowcodeService/DeleteType"
+	LowcodeService_ExportTypes_FullMethodName             = "/lowcode.v1.LowcodeService/ExportTypes"
+	LowcodeService_ImportTypes_FullMethodName             = "/lowcode.v1.LowcodeService/ImportTypes"
+	LowcodeService_CreateTable_FullMethodName             = "/lowcode.v1.LowcodeService/CreateTable"
+	LowcodeService_CreateTableWithSchema_FullMethodName   = "/lowcode.v1.LowcodeService/CreateTableWithSchema"
+	LowcodeService_ApplyTableSchema_FullMethodName        = "/lowcode.v1.LowcodeService/ApplyTableSchema"
+	LowcodeService_ExportSchema_FullMethodName            = "/lowcode.v1.LowcodeService/ExportSchema"
+	LowcodeService_ImportSchema_FullMethodName            = "/lowcode.v1.LowcodeService/ImportSchema"
+	LowcodeService_ListTemplates_FullMethodName           = "/lowcode.v1.LowcodeService/ListTemplates"
+	LowcodeService_CreateTableFromTemplate_FullMethodName = "/lowcode.v1.LowcodeService/CreateTableFromTemplate"
+	LowcodeService_UpdateTable_FullMethodName             = "/lowcode.v1.LowcodeService/UpdateTable"
+	LowcodeService_DuplicateTable_FullMethodName          = "/lowcode.v1.LowcodeService/DuplicateTable"
+	LowcodeService_DeleteTable_FullMethodName             = "/lowcode.v1.LowcodeService/DeleteTable"
+	LowcodeService_RestoreTable_FullMethodName            = "/lowcode.v1.LowcodeService/RestoreTable"
+	LowcodeService_PurgeTable_FullMethodName              = "/lowcode.v1.LowcodeService/PurgeTable"
+	LowcodeService_ListTables_FullMethodName              = "/lowcode.v1.LowcodeService/ListTables"
+	LowcodeService_GetTable_FullMethodName                = "/lowcode.v1.LowcodeService/GetTable"
+	LowcodeService_GetTableSchema_FullMethodName          = "/lowcode.v1.LowcodeService/GetTableSchema"
+	LowcodeService_RepairTableSchema_FullMethodName       = "/lowcode.v1.LowcodeService/RepairTableSchema"
+	LowcodeService_GetWorkspaceSchema_FullMethodName      = "/lowcode.v1.LowcodeService/GetWorkspaceSchema"
+	LowcodeService_CreateWorkspace_FullMethodName         = "/lowcode.v1.LowcodeService/CreateWorkspace"
+	LowcodeService_ListWorkspaces_FullMethodName          = "/lowcode.v1.LowcodeService/ListWorkspaces"
+	LowcodeService_GetWorkspace_FullMethodName            = "/lowcode.v1.LowcodeService/GetWorkspace"
+	LowcodeService_UpdateWorkspace_FullMethodName         = "/lowcode.v1.LowcodeService/UpdateWorkspace"
+	LowcodeService_DeleteWorkspace_FullMethodName         = "/lowcode.v1.LowcodeService/DeleteWorkspace"
+	LowcodeService_AddColumn_FullMethodName               = "/lowcode.v1.LowcodeService/AddColumn"
+	LowcodeService_UpdateColumn_FullMethodName            = "/lowcode.v1.LowcodeService/UpdateColumn"
+	LowcodeService_DeleteColumn_FullMethodName            = "/lowcode.v1.LowcodeService/DeleteColumn"
+	LowcodeService_ChangeColumnType_FullMethodName        = "/lowcode.v1.LowcodeService/ChangeColumnType"
+	LowcodeService_ListColumns_FullMethodName             = "/lowcode.v1.LowcodeService/ListColumns"
+	LowcodeService_ReorderColumns_FullMethodName          = "/lowcode.v1.LowcodeService/ReorderColumns"
+	LowcodeService_AddSelectOption_FullMethodName         = "/lowcode.v1.LowcodeService/AddSelectOption"
+	LowcodeService_UpdateSelectOption_FullMethodName      = "/lowcode.v1.LowcodeService/UpdateSelectOption"
+	LowcodeService_RemoveSelectOption_FullMethodName      = "/lowcode.v1.LowcodeService/RemoveSelectOption"
+	LowcodeService_CreateRow_FullMethodName               = "/lowcode.v1.LowcodeService/CreateRow"
+	LowcodeService_UpdateRow_FullMethodName               = "/lowcode.v1.LowcodeService/UpdateRow"
+	LowcodeService_DeleteRow_FullMethodName               = "/lowcode.v1.LowcodeService/DeleteRow"
+	LowcodeService_RestoreRow_FullMethodName              = "/lowcode.v1.LowcodeService/RestoreRow"
+	LowcodeService_GetRowHistory_FullMethodName           = "/lowcode.v1.LowcodeService/GetRowHistory"
+	LowcodeService_RestoreRowVersion_FullMethodName       = "/lowcode.v1.LowcodeService/RestoreRowVersion"
+	LowcodeService_LinkRows_FullMethodName                = "/lowcode.v1.LowcodeService/LinkRows"
+	LowcodeService_UnlinkRows_FullMethodName              = "/lowcode.v1.LowcodeService/UnlinkRows"
+	LowcodeService_PurgeRows_FullMethodName               = "/lowcode.v1.LowcodeService/PurgeRows"
+	LowcodeService_GetRow_FullMethodName                  = "/lowcode.v1.LowcodeService/GetRow"
+	LowcodeService_FindRowByColumn_FullMethodName         = "/lowcode.v1.LowcodeService/FindRowByColumn"
+	LowcodeService_ListRows_FullMethodName                = "/lowcode.v1.LowcodeService/ListRows"
+	LowcodeService_StreamRows_FullMethodName              = "/lowcode.v1.LowcodeService/StreamRows"
+	LowcodeService_ExportRows_FullMethodName              = "/lowcode.v1.LowcodeService/ExportRows"
+	LowcodeService_SearchRows_FullMethodName              = "/lowcode.v1.LowcodeService/SearchRows"
+	LowcodeService_AggregateRows_FullMethodName           = "/lowcode.v1.LowcodeService/AggregateRows"
+	LowcodeService_ListDistinctValues_FullMethodName      = "/lowcode.v1.LowcodeService/ListDistinctValues"
+	LowcodeService_BulkUpsertRows_FullMethodName          = "/lowcode.v1.LowcodeService/BulkUpsertRows"
+	LowcodeService_BulkDeleteRows_FullMethodName          = "/lowcode.v1.LowcodeService/BulkDeleteRows"
+	LowcodeService_UploadCellContent_FullMethodName       = "/lowcode.v1.LowcodeService/UploadCellContent"
+	LowcodeService_DownloadCellContent_FullMethodName     = "/lowcode.v1.LowcodeService/DownloadCellContent"
+	LowcodeService_CreateAttachmentUpload_FullMethodName  = "/lowcode.v1.LowcodeService/CreateAttachmentUpload"
+	LowcodeService_GetAttachmentUrl_FullMethodName        = "/lowcode.v1.LowcodeService/GetAttachmentUrl"
+	LowcodeService_CreateIndex_FullMethodName             = "/lowcode.v1.LowcodeService/CreateIndex"
+	LowcodeService_UpdateIndex_FullMethodName             = "/lowcode.v1.LowcodeService/UpdateIndex"
+	LowcodeService_DeleteIndex_FullMethodName             = "/lowcode.v1.LowcodeService/DeleteIndex"
+	LowcodeService_ListIndexes_FullMethodName             = "/lowcode.v1.LowcodeService/ListIndexes"
+	LowcodeService_SyncIndexes_FullMethodName             = "/lowcode.v1.LowcodeService/SyncIndexes"
+	LowcodeService_CreateView_FullMethodName              = "/lowcode.v1.LowcodeService/CreateView"
+	LowcodeService_ListViews_FullMethodName               = "/lowcode.v1.LowcodeService/ListViews"
+	LowcodeService_GetView_FullMethodName                 = "/lowcode.v1.LowcodeService/GetView"
+	LowcodeService_UpdateView_FullMethodName              = "/lowcode.v1.LowcodeService/UpdateView"
+	LowcodeService_DeleteView_FullMethodName              = "/lowcode.v1.LowcodeService/DeleteView"
+	LowcodeService_ImportExternalTables_FullMethodName    = "/lowcode.v1.LowcodeService/ImportExternalTables"
+	LowcodeService_ImportDatabaseSchema_FullMethodName    = "/lowcode.v1.LowcodeService/ImportDatabaseSchema"
+	LowcodeService_ImportExistingTable_FullMethodName     = "/lowcode.v1.LowcodeService/ImportExistingTable"
+	LowcodeService_CreateSQLView_FullMethodName           = "/lowcode.v1.LowcodeService/CreateSQLView"
+	LowcodeService_RefreshSQLView_FullMethodName          = "/lowcode.v1.LowcodeService/RefreshSQLView"
+	LowcodeService_GetOperation_FullMethodName            = "/lowcode.v1.LowcodeService/GetOperation"
+	LowcodeService_ListOperations_FullMethodName          = "/lowcode.v1.LowcodeService/ListOperations"
+	LowcodeService_CancelOperation_FullMethodName         = "/lowcode.v1.LowcodeService/CancelOperation"
+	LowcodeService_ListMembers_FullMethodName             = "/lowcode.v1.LowcodeService/ListMembers"
+	LowcodeService_SetMember_FullMethodName               = "/lowcode.v1.LowcodeService/SetMember"
+	LowcodeService_DeleteMember_FullMethodName            = "/lowcode.v1.LowcodeService/DeleteMember"
+	LowcodeService_ListPermissions_FullMethodName         = "/lowcode.v1.LowcodeService/ListPermissions"
+	LowcodeService_SetPermission_FullMethodName           = "/lowcode.v1.LowcodeService/SetPermission"
+	LowcodeService_DeletePermission_FullMethodName        = "/lowcode.v1.LowcodeService/DeletePermission"
+	LowcodeService_ListAuditEvents_FullMethodName         = "/lowcode.v1.LowcodeService/ListAuditEvents"
+	LowcodeService_CreateWebhook_FullMethodName           = "/lowcode.v1.LowcodeService/CreateWebhook"
+	LowcodeService_ListWebhooks_FullMethodName            = "/lowcode.v1.LowcodeService/ListWebhooks"
+	LowcodeService_DeleteWebhook_FullMethodName           = "/lowcode.v1.LowcodeService/DeleteWebhook"
+	LowcodeService_ListWebhookDeliveries_FullMethodName   = "/lowcode.v1.LowcodeService/ListWebhookDeliveries"
 )
 
 // LowcodeServiceClient is the client API for LowcodeService service.
@@ -48,35 +132,206 @@ const (
 type LowcodeServiceClient interface {
 	// ------ Tenant ------
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
+	// 列出 tenant（仅 multi 模式）
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	// 删除 tenant 的数据库（断开其全部连接），不可恢复；confirm 必须与 id 相同
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	// 复制一个 tenant（如从生产复制出 staging），以源库为模板创建新库
+	CloneTenant(ctx context.Context, in *CloneTenantRequest, opts ...grpc.CallOption) (*CloneTenantResponse, error)
+	// 导出 tenant 的完整逻辑备份（tar.gz 归档：schema 文档 + 全部行数据），用于 tenant 下线或跨区域迁移。
+	// to_storage 时归档写入对象存储，只返回一条 info；否则先分块返回归档内容，最后一条消息为 info。
+	// HTTP 对应 GET /v1/tenants/{tenant_id}/archive（body 为原始归档）
+	ExportTenant(ctx context.Context, in *ExportTenantRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTenantResponse], error)
+	// 把 ExportTenant 的归档恢复为一个新 tenant。第一条消息为 info，之后为归档分块；
+	// info.storage_key 非空时从对象存储读取归档，不再发送分块。
+	// HTTP 对应 PUT /v1/tenants/{tenant_id}/archive（body 为原始归档）
+	ImportTenant(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportTenantRequest, ImportTenantResponse], error)
+	// 把 tenant 库迁移到最新版本。tenant 首次被访问时也会自动迁移，这里用于发布后主动完成全部迁移
+	MigrateAllTenants(ctx context.Context, in *MigrateAllTenantsRequest, opts ...grpc.CallOption) (*MigrateAllTenantsResponse, error)
+	// 本服务实例中 tenant 连接池的健康状态。连接池定期被 ping（TENANT_HEALTH_SECONDS），失败的池被关闭并自动重建
+	ListTenantHealth(ctx context.Context, in *ListTenantHealthRequest, opts ...grpc.CallOption) (*ListTenantHealthResponse, error)
+	// 修改 tenant 的连接池配置
+	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error)
+	// 暂停 tenant：之后该 tenant 的请求返回 TENANT_SUSPENDED，数据保留
+	SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*SuspendTenantResponse, error)
+	ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...grpc.CallOption) (*ResumeTenantResponse, error)
+	// 轮换当前 tenant 的数据密钥（需要 KMS_PROVIDER）：之后的写入使用新密钥，已有的加密列（含行历史）
+	// 和注册表中的专属 DSN 在后台用新密钥重新加密。立即返回 operation，结果在 operation 的 response 中
+	RotateTenantKeys(ctx context.Context, in *RotateTenantKeysRequest, opts ...grpc.CallOption) (*RotateTenantKeysResponse, error)
+	// 立即备份 tenant（同定期备份：归档写入对象存储的 tenant-backups/），备份在后台进行，
+	// 返回 RUNNING 状态的记录，结果用 ListBackups 查看。同一 tenant 同时只能有一个备份在进行
+	TriggerBackup(ctx context.Context, in *TriggerBackupRequest, opts ...grpc.CallOption) (*TriggerBackupResponse, error)
+	// 列出备份，按开始时间从新到旧；tenant_id 为空时列出所有 tenant 的备份（包括已删除的 tenant）
+	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
 	// ------ Type ------
 	CreateType(ctx context.Context, in *CreateTypeRequest, opts ...grpc.CallOption) (*CreateTypeResponse, error)
 	ListTypes(ctx context.Context, in *ListTypesRequest, opts ...grpc.CallOption) (*ListTypesResponse, error)
+	// 按 id 或 name 获取类型
+	GetType(ctx context.Context, in *GetTypeRequest, opts ...grpc.CallOption) (*GetTypeResponse, error)
+	// 修改类型的 pg_type / config；已被列使用时修改 pg_type 需要 cast_columns
+	UpdateType(ctx context.Context, in *UpdateTypeRequest, opts ...grpc.CallOption) (*UpdateTypeResponse, error)
 	DeleteType(ctx context.Context, in *DeleteTypeRequest, opts ...grpc.CallOption) (*DeleteTypeResponse, error)
+	// 导出自定义类型目录（声明式文档），用于版本管理和跨 tenant / 环境同步
+	ExportTypes(ctx context.Context, in *ExportTypesRequest, opts ...grpc.CallOption) (*ExportTypesResponse, error)
+	// 按文档导入类型目录：不存在的创建，已存在且有差异的按 overwrite 决定是否更新
+	ImportTypes(ctx context.Context, in *ImportTypesRequest, opts ...grpc.CallOption) (*ImportTypesResponse, error)
 	// ------ Table ------
 	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error)
+	// 在一个事务内创建表及其全部列和索引，任一步失败整体回滚
+	CreateTableWithSchema(ctx context.Context, in *CreateTableWithSchemaRequest, opts ...grpc.CallOption) (*CreateTableWithSchemaResponse, error)
+	// 声明式 schema：对比期望的表定义与当前元数据，执行最少的变更；dry_run 只返回计划
+	ApplyTableSchema(ctx context.Context, in *ApplyTableSchemaRequest, opts ...grpc.CallOption) (*ApplyTableSchemaResponse, error)
+	// 导出 tenant 的全部自定义类型、表、列和索引为带版本号的 JSON 文档，用于在 tenant / 环境之间迁移应用
+	ExportSchema(ctx context.Context, in *ExportSchemaRequest, opts ...grpc.CallOption) (*ExportSchemaResponse, error)
+	// 在当前 tenant 中按 ExportSchema 的文档重建类型、表、列和索引，列使用新的 id，config 中的列引用随之改写
+	ImportSchema(ctx context.Context, in *ImportSchemaRequest, opts ...grpc.CallOption) (*ImportSchemaResponse, error)
+	// 内置的表模板（CRM、项目跟踪、库存等）
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// 按模板创建表：列、索引以及示例行在一个事务内创建
+	CreateTableFromTemplate(ctx context.Context, in *CreateTableFromTemplateRequest, opts ...grpc.CallOption) (*CreateTableFromTemplateResponse, error)
+	// 修改表名、描述和图标。改名会改变 table id，引用该表的 relationship 配置同步更新
+	UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*UpdateTableResponse, error)
+	// 复制表结构（列、索引），include_data 时连同数据一起复制到新的物理表
+	DuplicateTable(ctx context.Context, in *DuplicateTableRequest, opts ...grpc.CallOption) (*DuplicateTableResponse, error)
 	// 获取所有 table
+	// 默认归档：表改名为归档名并保留物理表，保留期内可以 RestoreTable；purge 时立即删除
 	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error)
+	// 恢复归档的表，改回原表名
+	RestoreTable(ctx context.Context, in *RestoreTableRequest, opts ...grpc.CallOption) (*RestoreTableResponse, error)
+	// 立即删除归档的表（物理表、多对多关联表和元数据）
+	PurgeTable(ctx context.Context, in *PurgeTableRequest, opts ...grpc.CallOption) (*PurgeTableResponse, error)
 	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
+	// 只返回表记录和统计，不查询列和索引
+	GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*GetTableResponse, error)
 	// 获取单个 table 及其所有 columns 和 indexes
 	GetTableSchema(ctx context.Context, in *GetTableSchemaRequest, opts ...grpc.CallOption) (*GetTableSchemaResponse, error)
+	// 按 GetTableSchema(verify) 的差异修复：补建缺失的物理列、按需删除未登记的物理列、按 pg_indexes 同步 lc_indexes
+	RepairTableSchema(ctx context.Context, in *RepairTableSchemaRequest, opts ...grpc.CallOption) (*RepairTableSchemaResponse, error)
+	// 一次返回所有 table 及其 columns / indexes / relationships，用于 UI 启动时加载元数据
+	GetWorkspaceSchema(ctx context.Context, in *GetWorkspaceSchemaRequest, opts ...grpc.CallOption) (*GetWorkspaceSchemaResponse, error)
+	// ------ Workspace ------
+	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error)
+	ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error)
+	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*GetWorkspaceResponse, error)
+	UpdateWorkspace(ctx context.Context, in *UpdateWorkspaceRequest, opts ...grpc.CallOption) (*UpdateWorkspaceResponse, error)
+	// 删除 workspace，其中的表不删除，变为未分组
+	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error)
 	// ------ Column ------
 	AddColumn(ctx context.Context, in *AddColumnRequest, opts ...grpc.CallOption) (*AddColumnResponse, error)
 	UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*UpdateColumnResponse, error)
 	DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error)
+	// 修改列类型：ALTER COLUMN ... TYPE ... USING 转换已有数据，并在同一事务内更新 lc_columns.type_id
+	ChangeColumnType(ctx context.Context, in *ChangeColumnTypeRequest, opts ...grpc.CallOption) (*ChangeColumnTypeResponse, error)
 	ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error)
+	// 按给定顺序重排列，position 在一个事务内重写为 1..n
+	ReorderColumns(ctx context.Context, in *ReorderColumnsRequest, opts ...grpc.CallOption) (*ReorderColumnsResponse, error)
+	// single_select / multi_select 列的选项管理；改名和删除会同步迁移已有单元格
+	AddSelectOption(ctx context.Context, in *AddSelectOptionRequest, opts ...grpc.CallOption) (*AddSelectOptionResponse, error)
+	UpdateSelectOption(ctx context.Context, in *UpdateSelectOptionRequest, opts ...grpc.CallOption) (*UpdateSelectOptionResponse, error)
+	RemoveSelectOption(ctx context.Context, in *RemoveSelectOptionRequest, opts ...grpc.CallOption) (*RemoveSelectOptionResponse, error)
 	// ------ Row / Cell ------
 	CreateRow(ctx context.Context, in *CreateRowRequest, opts ...grpc.CallOption) (*CreateRowResponse, error)
 	UpdateRow(ctx context.Context, in *UpdateRowRequest, opts ...grpc.CallOption) (*UpdateRowResponse, error)
 	DeleteRow(ctx context.Context, in *DeleteRowRequest, opts ...grpc.CallOption) (*DeleteRowResponse, error)
+	// 从回收站恢复软删除的行
+	RestoreRow(ctx context.Context, in *RestoreRowRequest, opts ...grpc.CallOption) (*RestoreRowResponse, error)
+	// 行的修改历史：UpdateRow / DeleteRow 等修改前的完整行，按时间倒序；as_of 返回某一时刻的行
+	GetRowHistory(ctx context.Context, in *GetRowHistoryRequest, opts ...grpc.CallOption) (*GetRowHistoryResponse, error)
+	// 把行恢复为历史中的某个版本（撤销修改），已物理删除的行按原 id 重新插入
+	RestoreRowVersion(ctx context.Context, in *RestoreRowVersionRequest, opts ...grpc.CallOption) (*RestoreRowVersionResponse, error)
+	// 为多对多 relationship 列添加当前行与目标行的关联
+	LinkRows(ctx context.Context, in *LinkRowsRequest, opts ...grpc.CallOption) (*LinkRowsResponse, error)
+	// 移除多对多 relationship 列上的关联
+	UnlinkRows(ctx context.Context, in *UnlinkRowsRequest, opts ...grpc.CallOption) (*UnlinkRowsResponse, error)
+	// 永久删除回收站中的行
+	PurgeRows(ctx context.Context, in *PurgeRowsRequest, opts ...grpc.CallOption) (*PurgeRowsResponse, error)
+	GetRow(ctx context.Context, in *GetRowRequest, opts ...grpc.CallOption) (*GetRowResponse, error)
+	// 按业务键（某列的值）查找单行，列上有唯一索引时走索引
+	FindRowByColumn(ctx context.Context, in *FindRowByColumnRequest, opts ...grpc.CallOption) (*FindRowByColumnResponse, error)
 	ListRows(ctx context.Context, in *ListRowsRequest, opts ...grpc.CallOption) (*ListRowsResponse, error)
+	// 服务端流式导出：按 keyset 分批扫描，不受 MAX_ROW 限制
+	StreamRows(ctx context.Context, in *StreamRowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamRowsResponse], error)
+	// 按 ListRows 的过滤、排序（或视图）导出行为文件，表头为列名，分块返回文件内容。
+	// HTTP 对应 GET /v1/tables/{table_id}/export?format=csv|xlsx|jsonl|parquet（响应体为文件本身，不经过 grpc-gateway）
+	ExportRows(ctx context.Context, in *ExportRowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportRowsResponse], error)
+	// 在文本列中做不区分大小写的关键字搜索
+	SearchRows(ctx context.Context, in *SearchRowsRequest, opts ...grpc.CallOption) (*SearchRowsResponse, error)
+	// 分组聚合（GROUP BY + COUNT / SUM / AVG / MIN / MAX）
+	AggregateRows(ctx context.Context, in *AggregateRowsRequest, opts ...grpc.CallOption) (*AggregateRowsResponse, error)
+	// 列的去重取值，用于筛选下拉框
+	ListDistinctValues(ctx context.Context, in *ListDistinctValuesRequest, opts ...grpc.CallOption) (*ListDistinctValuesResponse, error)
 	// 批量 upsert
 	BulkUpsertRows(ctx context.Context, in *BulkUpsertRowsRequest, opts ...grpc.CallOption) (*BulkUpsertRowsResponse, error)
 	// 批量删除
 	BulkDeleteRows(ctx context.Context, in *BulkDeleteRowsRequest, opts ...grpc.CallOption) (*BulkDeleteRowsResponse, error)
+	// 分块上传 bytes / json(附件) 单元格内容，第一条消息必须是 info，之后为 chunk。
+	// HTTP 对应 PUT /v1/tables/{table_id}/rows/{row_id}/cells/{column_id}/content（原始 body，由 cmd/server 直接处理）
+	UploadCellContent(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadCellContentRequest, UploadCellContentResponse], error)
+	// 分块下载单元格内容，第一条消息为 info，之后为 chunk。
+	// HTTP 对应 GET /v1/tables/{table_id}/rows/{row_id}/cells/{column_id}/content
+	DownloadCellContent(ctx context.Context, in *DownloadCellContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadCellContentResponse], error)
+	// ------ Attachment ------
+	// 为 attachment 列申请预签名上传 URL；客户端上传完成后把返回的 attachment 写入单元格（list_value）
+	CreateAttachmentUpload(ctx context.Context, in *CreateAttachmentUploadRequest, opts ...grpc.CallOption) (*CreateAttachmentUploadResponse, error)
+	// 为单元格中的某个附件生成预签名下载 URL
+	GetAttachmentUrl(ctx context.Context, in *GetAttachmentUrlRequest, opts ...grpc.CallOption) (*GetAttachmentUrlResponse, error)
 	// ------ Index ------
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error)
+	// 修改索引的逻辑名，或重建（REINDEX）物理索引
+	UpdateIndex(ctx context.Context, in *UpdateIndexRequest, opts ...grpc.CallOption) (*UpdateIndexResponse, error)
 	DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error)
 	ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error)
+	// 按 pg_catalog 同步表的 lc_indexes：删除物理索引已不存在的登记，把在 API 之外创建的索引登记为 external（只读）
+	SyncIndexes(ctx context.Context, in *SyncIndexesRequest, opts ...grpc.CallOption) (*SyncIndexesResponse, error)
+	// ------ View ------
+	// 保存的视图：表上的一组过滤、排序、可见列和分页大小，ListRows 传 view_id 时在服务端应用
+	CreateView(ctx context.Context, in *CreateViewRequest, opts ...grpc.CallOption) (*CreateViewResponse, error)
+	ListViews(ctx context.Context, in *ListViewsRequest, opts ...grpc.CallOption) (*ListViewsResponse, error)
+	GetView(ctx context.Context, in *GetViewRequest, opts ...grpc.CallOption) (*GetViewResponse, error)
+	// 整体替换视图定义，name 为空时保持不变
+	UpdateView(ctx context.Context, in *UpdateViewRequest, opts ...grpc.CallOption) (*UpdateViewResponse, error)
+	DeleteView(ctx context.Context, in *DeleteViewRequest, opts ...grpc.CallOption) (*DeleteViewResponse, error)
+	// ------ Import ------
+	// 从 Airtable / Notion 的导出文件创建表并导入数据，同一批导入的表之间的 link 会尽量还原为 relationship 列
+	ImportExternalTables(ctx context.Context, in *ImportExternalTablesRequest, opts ...grpc.CallOption) (*ImportExternalTablesResponse, error)
+	// 接管 tenant 库中已有的用户表：注册到 lc_* 元数据，单列外键映射为 relationship 列
+	ImportDatabaseSchema(ctx context.Context, in *ImportDatabaseSchemaRequest, opts ...grpc.CallOption) (*ImportDatabaseSchemaResponse, error)
+	// 接管一张已有的物理表：列按 PG 类型映射为逻辑类型（可用 column_types 覆盖），与已注册表之间的单列外键映射为 relationship 列
+	ImportExistingTable(ctx context.Context, in *ImportExistingTableRequest, opts ...grpc.CallOption) (*ImportExistingTableResponse, error)
+	// ------ SQL View ------
+	// 用只读 SELECT 在已注册的表之上定义 PG 视图（或物理化视图），注册为只读表，可以用 ListRows 查询
+	CreateSQLView(ctx context.Context, in *CreateSQLViewRequest, opts ...grpc.CallOption) (*CreateSQLViewResponse, error)
+	// 刷新物化视图
+	RefreshSQLView(ctx context.Context, in *RefreshSQLViewRequest, opts ...grpc.CallOption) (*RefreshSQLViewResponse, error)
+	// ------ Operation ------
+	// 以 async=true 发起的长时间操作（建索引、改列类型等）立即返回 Operation，通过以下接口查询进度和结果
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// 请求取消；执行中的 SQL 被中断并回滚，操作变为 CANCELLED
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error)
+	// ------ Member ------
+	// 租户成员及其角色，开启 OIDC 认证时按角色检查每个请求。以下接口需要 OWNER
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// 添加成员或修改成员的角色
+	SetMember(ctx context.Context, in *SetMemberRequest, opts ...grpc.CallOption) (*SetMemberResponse, error)
+	DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*DeleteMemberResponse, error)
+	// ------ Permission ------
+	// 按表 / 列对角色或用户允许、拒绝读写，细化角色的默认权限。以下接口需要 OWNER
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	// 添加规则；同一表 / 列、同一对象、同一 access 的规则已存在时修改其 effect
+	SetPermission(ctx context.Context, in *SetPermissionRequest, opts ...grpc.CallOption) (*SetPermissionResponse, error)
+	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error)
+	// ------ Audit ------
+	// 按时间倒序列出当前 tenant 的审计事件（所有修改表结构和数据的调用，含失败的调用），需要 OWNER
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// ------ Webhook ------
+	// 订阅当前 tenant 的修改操作：成功的修改调用写入审计日志后，其审计事件会 POST 到匹配的 webhook，需要 OWNER
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// 按时间倒序列出 webhook 的投递记录
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
 }
 
 type lowcodeServiceClient struct {
@@ -97,6 +352,158 @@ func (c *lowcodeServiceClient) CreateTenant(ctx context.Context, in *CreateTenan
 	return out, nil
 }
 
+func (c *lowcodeServiceClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CloneTenant(ctx context.Context, in *CloneTenantRequest, opts ...grpc.CallOption) (*CloneTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneTenantResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CloneTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ExportTenant(ctx context.Context, in *ExportTenantRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTenantResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LowcodeService_ServiceDesc.Streams[0], LowcodeService_ExportTenant_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTenantRequest, ExportTenantResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_ExportTenantClient = grpc.ServerStreamingClient[ExportTenantResponse]
+
+func (c *lowcodeServiceClient) ImportTenant(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportTenantRequest, ImportTenantResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LowcodeService_ServiceDesc.Streams[1], LowcodeService_ImportTenant_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportTenantRequest, ImportTenantResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_ImportTenantClient = grpc.ClientStreamingClient[ImportTenantRequest, ImportTenantResponse]
+
+func (c *lowcodeServiceClient) MigrateAllTenants(ctx context.Context, in *MigrateAllTenantsRequest, opts ...grpc.CallOption) (*MigrateAllTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateAllTenantsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_MigrateAllTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListTenantHealth(ctx context.Context, in *ListTenantHealthRequest, opts ...grpc.CallOption) (*ListTenantHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantHealthResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListTenantHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*SuspendTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendTenantResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_SuspendTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...grpc.CallOption) (*ResumeTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeTenantResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ResumeTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) RotateTenantKeys(ctx context.Context, in *RotateTenantKeysRequest, opts ...grpc.CallOption) (*RotateTenantKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateTenantKeysResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RotateTenantKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) TriggerBackup(ctx context.Context, in *TriggerBackupRequest, opts ...grpc.CallOption) (*TriggerBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerBackupResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_TriggerBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackupsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListBackups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lowcodeServiceClient) CreateType(ctx context.Context, in *CreateTypeRequest, opts ...grpc.CallOption) (*CreateTypeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTypeResponse)
@@ -117,6 +524,26 @@ func (c *lowcodeServiceClient) ListTypes(ctx context.Context, in *ListTypesReque
 	return out, nil
 }
 
+func (c *lowcodeServiceClient) GetType(ctx context.Context, in *GetTypeRequest, opts ...grpc.CallOption) (*GetTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTypeResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateType(ctx context.Context, in *UpdateTypeRequest, opts ...grpc.CallOption) (*UpdateTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTypeResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lowcodeServiceClient) DeleteType(ctx context.Context, in *DeleteTypeRequest, opts ...grpc.CallOption) (*DeleteTypeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteTypeResponse)
@@ -127,6 +554,26 @@ func (c *lowcodeServiceClient) DeleteType(ctx context.Context, in *DeleteTypeReq
 	return out, nil
 }
 
+func (c *lowcodeServiceClient) ExportTypes(ctx context.Context, in *ExportTypesRequest, opts ...grpc.CallOption) (*ExportTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTypesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ExportTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ImportTypes(ctx context.Context, in *ImportTypesRequest, opts ...grpc.CallOption) (*ImportTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTypesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ImportTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lowcodeServiceClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTableResponse)
@@ -137,669 +584,3244 @@ func (c *lowcodeServiceClient) CreateTable(ctx context.Context, in *CreateTableR
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error) {
+func (c *lowcodeServiceClient) CreateTableWithSchema(ctx context.Context, in *CreateTableWithSchemaRequest, opts ...grpc.CallOption) (*CreateTableWithSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteTableResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_DeleteTable_FullMethodName, in, out, cOpts...)
+	out := new(CreateTableWithSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateTableWithSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
+func (c *lowcodeServiceClient) ApplyTableSchema(ctx context.Context, in *ApplyTableSchemaRequest, opts ...grpc.CallOption) (*ApplyTableSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTablesResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_ListTables_FullMethodName, in, out, cOpts...)
+	out := new(ApplyTableSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ApplyTableSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) GetTableSchema(ctx context.Context, in *GetTableSchemaRequest, opts ...grpc.CallOption) (*GetTableSchemaResponse, error) {
+func (c *lowcodeServiceClient) ExportSchema(ctx context.Context, in *ExportSchemaRequest, opts ...grpc.CallOption) (*ExportSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTableSchemaResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_GetTableSchema_FullMethodName, in, out, cOpts...)
+	out := new(ExportSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ExportSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) AddColumn(ctx context.Context, in *AddColumnRequest, opts ...grpc.CallOption) (*AddColumnResponse, error) {
+func (c *lowcodeServiceClient) ImportSchema(ctx context.Context, in *ImportSchemaRequest, opts ...grpc.CallOption) (*ImportSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AddColumnResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_AddColumn_FullMethodName, in, out, cOpts...)
+	out := new(ImportSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ImportSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*UpdateColumnResponse, error) {
+func (c *lowcodeServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateColumnResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_UpdateColumn_FullMethodName, in, out, cOpts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListTemplates_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error) {
+func (c *lowcodeServiceClient) CreateTableFromTemplate(ctx context.Context, in *CreateTableFromTemplateRequest, opts ...grpc.CallOption) (*CreateTableFromTemplateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteColumnResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_DeleteColumn_FullMethodName, in, out, cOpts...)
+	out := new(CreateTableFromTemplateResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateTableFromTemplate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error) {
+func (c *lowcodeServiceClient) UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*UpdateTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListColumnsResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_ListColumns_FullMethodName, in, out, cOpts...)
+	out := new(UpdateTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateTable_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) CreateRow(ctx context.Context, in *CreateRowRequest, opts ...grpc.CallOption) (*CreateRowResponse, error) {
+func (c *lowcodeServiceClient) DuplicateTable(ctx context.Context, in *DuplicateTableRequest, opts ...grpc.CallOption) (*DuplicateTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateRowResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_CreateRow_FullMethodName, in, out, cOpts...)
+	out := new(DuplicateTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DuplicateTable_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) UpdateRow(ctx context.Context, in *UpdateRowRequest, opts ...grpc.CallOption) (*UpdateRowResponse, error) {
+func (c *lowcodeServiceClient) DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateRowResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_UpdateRow_FullMethodName, in, out, cOpts...)
+	out := new(DeleteTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteTable_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) DeleteRow(ctx context.Context, in *DeleteRowRequest, opts ...grpc.CallOption) (*DeleteRowResponse, error) {
+func (c *lowcodeServiceClient) RestoreTable(ctx context.Context, in *RestoreTableRequest, opts ...grpc.CallOption) (*RestoreTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteRowResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_DeleteRow_FullMethodName, in, out, cOpts...)
+	out := new(RestoreTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RestoreTable_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) ListRows(ctx context.Context, in *ListRowsRequest, opts ...grpc.CallOption) (*ListRowsResponse, error) {
+func (c *lowcodeServiceClient) PurgeTable(ctx context.Context, in *PurgeTableRequest, opts ...grpc.CallOption) (*PurgeTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListRowsResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_ListRows_FullMethodName, in, out, cOpts...)
+	out := new(PurgeTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_PurgeTable_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) BulkUpsertRows(ctx context.Context, in *BulkUpsertRowsRequest, opts ...grpc.CallOption) (*BulkUpsertRowsResponse, error) {
+func (c *lowcodeServiceClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BulkUpsertRowsResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_BulkUpsertRows_FullMethodName, in, out, cOpts...)
+	out := new(ListTablesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListTables_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) BulkDeleteRows(ctx context.Context, in *BulkDeleteRowsRequest, opts ...grpc.CallOption) (*BulkDeleteRowsResponse, error) {
+func (c *lowcodeServiceClient) GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*GetTableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BulkDeleteRowsResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_BulkDeleteRows_FullMethodName, in, out, cOpts...)
+	out := new(GetTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetTable_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error) {
+func (c *lowcodeServiceClient) GetTableSchema(ctx context.Context, in *GetTableSchemaRequest, opts ...grpc.CallOption) (*GetTableSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateIndexResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_CreateIndex_FullMethodName, in, out, cOpts...)
+	out := new(GetTableSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetTableSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error) {
+func (c *lowcodeServiceClient) RepairTableSchema(ctx context.Context, in *RepairTableSchemaRequest, opts ...grpc.CallOption) (*RepairTableSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteIndexResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_DeleteIndex_FullMethodName, in, out, cOpts...)
+	out := new(RepairTableSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RepairTableSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lowcodeServiceClient) ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error) {
+func (c *lowcodeServiceClient) GetWorkspaceSchema(ctx context.Context, in *GetWorkspaceSchemaRequest, opts ...grpc.CallOption) (*GetWorkspaceSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListIndexesResponse)
-	err := c.cc.Invoke(ctx, LowcodeService_ListIndexes_FullMethodName, in, out, cOpts...)
+	out := new(GetWorkspaceSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetWorkspaceSchema_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LowcodeServiceServer is the server API for LowcodeService service.
-// All implementations must embed UnimplementedLowcodeServiceServer
-// for forward compatibility.
-type LowcodeServiceServer interface {
-	// ------ Tenant ------
-	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
-	// ------ Type ------
-	CreateType(context.Context, *CreateTypeRequest) (*CreateTypeResponse, error)
-	ListTypes(context.Context, *ListTypesRequest) (*ListTypesResponse, error)
-	DeleteType(context.Context, *DeleteTypeRequest) (*DeleteTypeResponse, error)
-	// ------ Table ------
-	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
-	// 获取所有 table
-	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error)
-	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
-	// 获取单个 table 及其所有 columns 和 indexes
-	GetTableSchema(context.Context, *GetTableSchemaRequest) (*GetTableSchemaResponse, error)
-	// ------ Column ------
-	AddColumn(context.Context, *AddColumnRequest) (*AddColumnResponse, error)
-	UpdateColumn(context.Context, *UpdateColumnRequest) (*UpdateColumnResponse, error)
-	DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error)
-	ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error)
-	// ------ Row / Cell ------
-	CreateRow(context.Context, *CreateRowRequest) (*CreateRowResponse, error)
-	UpdateRow(context.Context, *UpdateRowRequest) (*UpdateRowResponse, error)
-	DeleteRow(context.Context, *DeleteRowRequest) (*DeleteRowResponse, error)
-	ListRows(context.Context, *ListRowsRequest) (*ListRowsResponse, error)
-	// 批量 upsert
-	BulkUpsertRows(context.Context, *BulkUpsertRowsRequest) (*BulkUpsertRowsResponse, error)
-	// 批量删除
-	BulkDeleteRows(context.Context, *BulkDeleteRowsRequest) (*BulkDeleteRowsResponse, error)
-	// ------ Index ------
-	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
-	DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error)
-	ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error)
-	mustEmbedUnimplementedLowcodeServiceServer()
+func (c *lowcodeServiceClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedLowcodeServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedLowcodeServiceServer struct{}
+func (c *lowcodeServiceClient) ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkspacesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListWorkspaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedLowcodeServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTenant not implemented")
+func (c *lowcodeServiceClient) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*GetWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLowcodeServiceServer) CreateType(context.Context, *CreateTypeRequest) (*CreateTypeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateType not implemented")
+
+func (c *lowcodeServiceClient) UpdateWorkspace(ctx context.Context, in *UpdateWorkspaceRequest, opts ...grpc.CallOption) (*UpdateWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLowcodeServiceServer) ListTypes(context.Context, *ListTypesRequest) (*ListTypesResponse, error) {
+
+func (c *lowcodeServiceClient) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWorkspaceResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) AddColumn(ctx context.Context, in *AddColumnRequest, opts ...grpc.CallOption) (*AddColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddColumnResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_AddColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateColumn(ctx context.Context, in *UpdateColumnRequest, opts ...grpc.CallOption) (*UpdateColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateColumnResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteColumnResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ChangeColumnType(ctx context.Context, in *ChangeColumnTypeRequest, opts ...grpc.CallOption) (*ChangeColumnTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeColumnTypeResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ChangeColumnType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListColumnsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ReorderColumns(ctx context.Context, in *ReorderColumnsRequest, opts ...grpc.CallOption) (*ReorderColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderColumnsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ReorderColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) AddSelectOption(ctx context.Context, in *AddSelectOptionRequest, opts ...grpc.CallOption) (*AddSelectOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSelectOptionResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_AddSelectOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateSelectOption(ctx context.Context, in *UpdateSelectOptionRequest, opts ...grpc.CallOption) (*UpdateSelectOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSelectOptionResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateSelectOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) RemoveSelectOption(ctx context.Context, in *RemoveSelectOptionRequest, opts ...grpc.CallOption) (*RemoveSelectOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSelectOptionResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RemoveSelectOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CreateRow(ctx context.Context, in *CreateRowRequest, opts ...grpc.CallOption) (*CreateRowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRowResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateRow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateRow(ctx context.Context, in *UpdateRowRequest, opts ...grpc.CallOption) (*UpdateRowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRowResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateRow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteRow(ctx context.Context, in *DeleteRowRequest, opts ...grpc.CallOption) (*DeleteRowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRowResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteRow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) RestoreRow(ctx context.Context, in *RestoreRowRequest, opts ...grpc.CallOption) (*RestoreRowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRowResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RestoreRow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) GetRowHistory(ctx context.Context, in *GetRowHistoryRequest, opts ...grpc.CallOption) (*GetRowHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRowHistoryResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetRowHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) RestoreRowVersion(ctx context.Context, in *RestoreRowVersionRequest, opts ...grpc.CallOption) (*RestoreRowVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRowVersionResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RestoreRowVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) LinkRows(ctx context.Context, in *LinkRowsRequest, opts ...grpc.CallOption) (*LinkRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_LinkRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UnlinkRows(ctx context.Context, in *UnlinkRowsRequest, opts ...grpc.CallOption) (*UnlinkRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UnlinkRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) PurgeRows(ctx context.Context, in *PurgeRowsRequest, opts ...grpc.CallOption) (*PurgeRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_PurgeRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) GetRow(ctx context.Context, in *GetRowRequest, opts ...grpc.CallOption) (*GetRowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRowResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetRow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) FindRowByColumn(ctx context.Context, in *FindRowByColumnRequest, opts ...grpc.CallOption) (*FindRowByColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindRowByColumnResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_FindRowByColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListRows(ctx context.Context, in *ListRowsRequest, opts ...grpc.CallOption) (*ListRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) StreamRows(ctx context.Context, in *StreamRowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamRowsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LowcodeService_ServiceDesc.Streams[2], LowcodeService_StreamRows_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRowsRequest, StreamRowsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_StreamRowsClient = grpc.ServerStreamingClient[StreamRowsResponse]
+
+func (c *lowcodeServiceClient) ExportRows(ctx context.Context, in *ExportRowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportRowsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LowcodeService_ServiceDesc.Streams[3], LowcodeService_ExportRows_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRowsRequest, ExportRowsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_ExportRowsClient = grpc.ServerStreamingClient[ExportRowsResponse]
+
+func (c *lowcodeServiceClient) SearchRows(ctx context.Context, in *SearchRowsRequest, opts ...grpc.CallOption) (*SearchRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_SearchRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) AggregateRows(ctx context.Context, in *AggregateRowsRequest, opts ...grpc.CallOption) (*AggregateRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_AggregateRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListDistinctValues(ctx context.Context, in *ListDistinctValuesRequest, opts ...grpc.CallOption) (*ListDistinctValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDistinctValuesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListDistinctValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) BulkUpsertRows(ctx context.Context, in *BulkUpsertRowsRequest, opts ...grpc.CallOption) (*BulkUpsertRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpsertRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_BulkUpsertRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) BulkDeleteRows(ctx context.Context, in *BulkDeleteRowsRequest, opts ...grpc.CallOption) (*BulkDeleteRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteRowsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_BulkDeleteRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UploadCellContent(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadCellContentRequest, UploadCellContentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LowcodeService_ServiceDesc.Streams[4], LowcodeService_UploadCellContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadCellContentRequest, UploadCellContentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_UploadCellContentClient = grpc.ClientStreamingClient[UploadCellContentRequest, UploadCellContentResponse]
+
+func (c *lowcodeServiceClient) DownloadCellContent(ctx context.Context, in *DownloadCellContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadCellContentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LowcodeService_ServiceDesc.Streams[5], LowcodeService_DownloadCellContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadCellContentRequest, DownloadCellContentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_DownloadCellContentClient = grpc.ServerStreamingClient[DownloadCellContentResponse]
+
+func (c *lowcodeServiceClient) CreateAttachmentUpload(ctx context.Context, in *CreateAttachmentUploadRequest, opts ...grpc.CallOption) (*CreateAttachmentUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAttachmentUploadResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) GetAttachmentUrl(ctx context.Context, in *GetAttachmentUrlRequest, opts ...grpc.CallOption) (*GetAttachmentUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentUrlResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetAttachmentUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIndexResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateIndex(ctx context.Context, in *UpdateIndexRequest, opts ...grpc.CallOption) (*UpdateIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateIndexResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIndexResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIndexesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListIndexes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) SyncIndexes(ctx context.Context, in *SyncIndexesRequest, opts ...grpc.CallOption) (*SyncIndexesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncIndexesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_SyncIndexes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CreateView(ctx context.Context, in *CreateViewRequest, opts ...grpc.CallOption) (*CreateViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateViewResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListViews(ctx context.Context, in *ListViewsRequest, opts ...grpc.CallOption) (*ListViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListViewsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) GetView(ctx context.Context, in *GetViewRequest, opts ...grpc.CallOption) (*GetViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetViewResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) UpdateView(ctx context.Context, in *UpdateViewRequest, opts ...grpc.CallOption) (*UpdateViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateViewResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_UpdateView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteView(ctx context.Context, in *DeleteViewRequest, opts ...grpc.CallOption) (*DeleteViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteViewResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ImportExternalTables(ctx context.Context, in *ImportExternalTablesRequest, opts ...grpc.CallOption) (*ImportExternalTablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExternalTablesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ImportExternalTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ImportDatabaseSchema(ctx context.Context, in *ImportDatabaseSchemaRequest, opts ...grpc.CallOption) (*ImportDatabaseSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDatabaseSchemaResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ImportDatabaseSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ImportExistingTable(ctx context.Context, in *ImportExistingTableRequest, opts ...grpc.CallOption) (*ImportExistingTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExistingTableResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ImportExistingTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CreateSQLView(ctx context.Context, in *CreateSQLViewRequest, opts ...grpc.CallOption) (*CreateSQLViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSQLViewResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateSQLView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) RefreshSQLView(ctx context.Context, in *RefreshSQLViewRequest, opts ...grpc.CallOption) (*RefreshSQLViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSQLViewResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_RefreshSQLView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOperationResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) SetMember(ctx context.Context, in *SetMemberRequest, opts ...grpc.CallOption) (*SetMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_SetMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*DeleteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMemberResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) SetPermission(ctx context.Context, in *SetPermissionRequest, opts ...grpc.CallOption) (*SetPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPermissionResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_SetPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePermissionResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeletePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lowcodeServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, LowcodeService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LowcodeServiceServer is the server API for LowcodeService service.
+// All implementations must embed UnimplementedLowcodeServiceServer
+// for forward compatibility.
+type LowcodeServiceServer interface {
+	// ------ Tenant ------
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+	// 列出 tenant（仅 multi 模式）
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	// 删除 tenant 的数据库（断开其全部连接），不可恢复；confirm 必须与 id 相同
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	// 复制一个 tenant（如从生产复制出 staging），以源库为模板创建新库
+	CloneTenant(context.Context, *CloneTenantRequest) (*CloneTenantResponse, error)
+	// 导出 tenant 的完整逻辑备份（tar.gz 归档：schema 文档 + 全部行数据），用于 tenant 下线或跨区域迁移。
+	// to_storage 时归档写入对象存储，只返回一条 info；否则先分块返回归档内容，最后一条消息为 info。
+	// HTTP 对应 GET /v1/tenants/{tenant_id}/archive（body 为原始归档）
+	ExportTenant(*ExportTenantRequest, grpc.ServerStreamingServer[ExportTenantResponse]) error
+	// 把 ExportTenant 的归档恢复为一个新 tenant。第一条消息为 info，之后为归档分块；
+	// info.storage_key 非空时从对象存储读取归档，不再发送分块。
+	// HTTP 对应 PUT /v1/tenants/{tenant_id}/archive（body 为原始归档）
+	ImportTenant(grpc.ClientStreamingServer[ImportTenantRequest, ImportTenantResponse]) error
+	// 把 tenant 库迁移到最新版本。tenant 首次被访问时也会自动迁移，这里用于发布后主动完成全部迁移
+	MigrateAllTenants(context.Context, *MigrateAllTenantsRequest) (*MigrateAllTenantsResponse, error)
+	// 本服务实例中 tenant 连接池的健康状态。连接池定期被 ping（TENANT_HEALTH_SECONDS），失败的池被关闭并自动重建
+	ListTenantHealth(context.Context, *ListTenantHealthRequest) (*ListTenantHealthResponse, error)
+	// 修改 tenant 的连接池配置
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
+	// 暂停 tenant：之后该 tenant 的请求返回 TENANT_SUSPENDED，数据保留
+	SuspendTenant(context.Context, *SuspendTenantRequest) (*SuspendTenantResponse, error)
+	ResumeTenant(context.Context, *ResumeTenantRequest) (*ResumeTenantResponse, error)
+	// 轮换当前 tenant 的数据密钥（需要 KMS_PROVIDER）：之后的写入使用新密钥，已有的加密列（含行历史）
+	// 和注册表中的专属 DSN 在后台用新密钥重新加密。立即返回 operation，结果在 operation 的 response 中
+	RotateTenantKeys(context.Context, *RotateTenantKeysRequest) (*RotateTenantKeysResponse, error)
+	// 立即备份 tenant（同定期备份：归档写入对象存储的 tenant-backups/），备份在后台进行，
+	// 返回 RUNNING 状态的记录，结果用 ListBackups 查看。同一 tenant 同时只能有一个备份在进行
+	TriggerBackup(context.Context, *TriggerBackupRequest) (*TriggerBackupResponse, error)
+	// 列出备份，按开始时间从新到旧；tenant_id 为空时列出所有 tenant 的备份（包括已删除的 tenant）
+	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
+	// ------ Type ------
+	CreateType(context.Context, *CreateTypeRequest) (*CreateTypeResponse, error)
+	ListTypes(context.Context, *ListTypesRequest) (*ListTypesResponse, error)
+	// 按 id 或 name 获取类型
+	GetType(context.Context, *GetTypeRequest) (*GetTypeResponse, error)
+	// 修改类型的 pg_type / config；已被列使用时修改 pg_type 需要 cast_columns
+	UpdateType(context.Context, *UpdateTypeRequest) (*UpdateTypeResponse, error)
+	DeleteType(context.Context, *DeleteTypeRequest) (*DeleteTypeResponse, error)
+	// 导出自定义类型目录（声明式文档），用于版本管理和跨 tenant / 环境同步
+	ExportTypes(context.Context, *ExportTypesRequest) (*ExportTypesResponse, error)
+	// 按文档导入类型目录：不存在的创建，已存在且有差异的按 overwrite 决定是否更新
+	ImportTypes(context.Context, *ImportTypesRequest) (*ImportTypesResponse, error)
+	// ------ Table ------
+	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
+	// 在一个事务内创建表及其全部列和索引，任一步失败整体回滚
+	CreateTableWithSchema(context.Context, *CreateTableWithSchemaRequest) (*CreateTableWithSchemaResponse, error)
+	// 声明式 schema：对比期望的表定义与当前元数据，执行最少的变更；dry_run 只返回计划
+	ApplyTableSchema(context.Context, *ApplyTableSchemaRequest) (*ApplyTableSchemaResponse, error)
+	// 导出 tenant 的全部自定义类型、表、列和索引为带版本号的 JSON 文档，用于在 tenant / 环境之间迁移应用
+	ExportSchema(context.Context, *ExportSchemaRequest) (*ExportSchemaResponse, error)
+	// 在当前 tenant 中按 ExportSchema 的文档重建类型、表、列和索引，列使用新的 id，config 中的列引用随之改写
+	ImportSchema(context.Context, *ImportSchemaRequest) (*ImportSchemaResponse, error)
+	// 内置的表模板（CRM、项目跟踪、库存等）
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// 按模板创建表：列、索引以及示例行在一个事务内创建
+	CreateTableFromTemplate(context.Context, *CreateTableFromTemplateRequest) (*CreateTableFromTemplateResponse, error)
+	// 修改表名、描述和图标。改名会改变 table id，引用该表的 relationship 配置同步更新
+	UpdateTable(context.Context, *UpdateTableRequest) (*UpdateTableResponse, error)
+	// 复制表结构（列、索引），include_data 时连同数据一起复制到新的物理表
+	DuplicateTable(context.Context, *DuplicateTableRequest) (*DuplicateTableResponse, error)
+	// 获取所有 table
+	// 默认归档：表改名为归档名并保留物理表，保留期内可以 RestoreTable；purge 时立即删除
+	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error)
+	// 恢复归档的表，改回原表名
+	RestoreTable(context.Context, *RestoreTableRequest) (*RestoreTableResponse, error)
+	// 立即删除归档的表（物理表、多对多关联表和元数据）
+	PurgeTable(context.Context, *PurgeTableRequest) (*PurgeTableResponse, error)
+	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
+	// 只返回表记录和统计，不查询列和索引
+	GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error)
+	// 获取单个 table 及其所有 columns 和 indexes
+	GetTableSchema(context.Context, *GetTableSchemaRequest) (*GetTableSchemaResponse, error)
+	// 按 GetTableSchema(verify) 的差异修复：补建缺失的物理列、按需删除未登记的物理列、按 pg_indexes 同步 lc_indexes
+	RepairTableSchema(context.Context, *RepairTableSchemaRequest) (*RepairTableSchemaResponse, error)
+	// 一次返回所有 table 及其 columns / indexes / relationships，用于 UI 启动时加载元数据
+	GetWorkspaceSchema(context.Context, *GetWorkspaceSchemaRequest) (*GetWorkspaceSchemaResponse, error)
+	// ------ Workspace ------
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
+	ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error)
+	GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error)
+	UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*UpdateWorkspaceResponse, error)
+	// 删除 workspace，其中的表不删除，变为未分组
+	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
+	// ------ Column ------
+	AddColumn(context.Context, *AddColumnRequest) (*AddColumnResponse, error)
+	UpdateColumn(context.Context, *UpdateColumnRequest) (*UpdateColumnResponse, error)
+	DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error)
+	// 修改列类型：ALTER COLUMN ... TYPE ... USING 转换已有数据，并在同一事务内更新 lc_columns.type_id
+	ChangeColumnType(context.Context, *ChangeColumnTypeRequest) (*ChangeColumnTypeResponse, error)
+	ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error)
+	// 按给定顺序重排列，position 在一个事务内重写为 1..n
+	ReorderColumns(context.Context, *ReorderColumnsRequest) (*ReorderColumnsResponse, error)
+	// single_select / multi_select 列的选项管理；改名和删除会同步迁移已有单元格
+	AddSelectOption(context.Context, *AddSelectOptionRequest) (*AddSelectOptionResponse, error)
+	UpdateSelectOption(context.Context, *UpdateSelectOptionRequest) (*UpdateSelectOptionResponse, error)
+	RemoveSelectOption(context.Context, *RemoveSelectOptionRequest) (*RemoveSelectOptionResponse, error)
+	// ------ Row / Cell ------
+	CreateRow(context.Context, *CreateRowRequest) (*CreateRowResponse, error)
+	UpdateRow(context.Context, *UpdateRowRequest) (*UpdateRowResponse, error)
+	DeleteRow(context.Context, *DeleteRowRequest) (*DeleteRowResponse, error)
+	// 从回收站恢复软删除的行
+	RestoreRow(context.Context, *RestoreRowRequest) (*RestoreRowResponse, error)
+	// 行的修改历史：UpdateRow / DeleteRow 等修改前的完整行，按时间倒序；as_of 返回某一时刻的行
+	GetRowHistory(context.Context, *GetRowHistoryRequest) (*GetRowHistoryResponse, error)
+	// 把行恢复为历史中的某个版本（撤销修改），已物理删除的行按原 id 重新插入
+	RestoreRowVersion(context.Context, *RestoreRowVersionRequest) (*RestoreRowVersionResponse, error)
+	// 为多对多 relationship 列添加当前行与目标行的关联
+	LinkRows(context.Context, *LinkRowsRequest) (*LinkRowsResponse, error)
+	// 移除多对多 relationship 列上的关联
+	UnlinkRows(context.Context, *UnlinkRowsRequest) (*UnlinkRowsResponse, error)
+	// 永久删除回收站中的行
+	PurgeRows(context.Context, *PurgeRowsRequest) (*PurgeRowsResponse, error)
+	GetRow(context.Context, *GetRowRequest) (*GetRowResponse, error)
+	// 按业务键（某列的值）查找单行，列上有唯一索引时走索引
+	FindRowByColumn(context.Context, *FindRowByColumnRequest) (*FindRowByColumnResponse, error)
+	ListRows(context.Context, *ListRowsRequest) (*ListRowsResponse, error)
+	// 服务端流式导出：按 keyset 分批扫描，不受 MAX_ROW 限制
+	StreamRows(*StreamRowsRequest, grpc.ServerStreamingServer[StreamRowsResponse]) error
+	// 按 ListRows 的过滤、排序（或视图）导出行为文件，表头为列名，分块返回文件内容。
+	// HTTP 对应 GET /v1/tables/{table_id}/export?format=csv|xlsx|jsonl|parquet（响应体为文件本身，不经过 grpc-gateway）
+	ExportRows(*ExportRowsRequest, grpc.ServerStreamingServer[ExportRowsResponse]) error
+	// 在文本列中做不区分大小写的关键字搜索
+	SearchRows(context.Context, *SearchRowsRequest) (*SearchRowsResponse, error)
+	// 分组聚合（GROUP BY + COUNT / SUM / AVG / MIN / MAX）
+	AggregateRows(context.Context, *AggregateRowsRequest) (*AggregateRowsResponse, error)
+	// 列的去重取值，用于筛选下拉框
+	ListDistinctValues(context.Context, *ListDistinctValuesRequest) (*ListDistinctValuesResponse, error)
+	// 批量 upsert
+	BulkUpsertRows(context.Context, *BulkUpsertRowsRequest) (*BulkUpsertRowsResponse, error)
+	// 批量删除
+	BulkDeleteRows(context.Context, *BulkDeleteRowsRequest) (*BulkDeleteRowsResponse, error)
+	// 分块上传 bytes / json(附件) 单元格内容，第一条消息必须是 info，之后为 chunk。
+	// HTTP 对应 PUT /v1/tables/{table_id}/rows/{row_id}/cells/{column_id}/content（原始 body，由 cmd/server 直接处理）
+	UploadCellContent(grpc.ClientStreamingServer[UploadCellContentRequest, UploadCellContentResponse]) error
+	// 分块下载单元格内容，第一条消息为 info，之后为 chunk。
+	// HTTP 对应 GET /v1/tables/{table_id}/rows/{row_id}/cells/{column_id}/content
+	DownloadCellContent(*DownloadCellContentRequest, grpc.ServerStreamingServer[DownloadCellContentResponse]) error
+	// ------ Attachment ------
+	// 为 attachment 列申请预签名上传 URL；客户端上传完成后把返回的 attachment 写入单元格（list_value）
+	CreateAttachmentUpload(context.Context, *CreateAttachmentUploadRequest) (*CreateAttachmentUploadResponse, error)
+	// 为单元格中的某个附件生成预签名下载 URL
+	GetAttachmentUrl(context.Context, *GetAttachmentUrlRequest) (*GetAttachmentUrlResponse, error)
+	// ------ Index ------
+	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
+	// 修改索引的逻辑名，或重建（REINDEX）物理索引
+	UpdateIndex(context.Context, *UpdateIndexRequest) (*UpdateIndexResponse, error)
+	DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error)
+	ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error)
+	// 按 pg_catalog 同步表的 lc_indexes：删除物理索引已不存在的登记，把在 API 之外创建的索引登记为 external（只读）
+	SyncIndexes(context.Context, *SyncIndexesRequest) (*SyncIndexesResponse, error)
+	// ------ View ------
+	// 保存的视图：表上的一组过滤、排序、可见列和分页大小，ListRows 传 view_id 时在服务端应用
+	CreateView(context.Context, *CreateViewRequest) (*CreateViewResponse, error)
+	ListViews(context.Context, *ListViewsRequest) (*ListViewsResponse, error)
+	GetView(context.Context, *GetViewRequest) (*GetViewResponse, error)
+	// 整体替换视图定义，name 为空时保持不变
+	UpdateView(context.Context, *UpdateViewRequest) (*UpdateViewResponse, error)
+	DeleteView(context.Context, *DeleteViewRequest) (*DeleteViewResponse, error)
+	// ------ Import ------
+	// 从 Airtable / Notion 的导出文件创建表并导入数据，同一批导入的表之间的 link 会尽量还原为 relationship 列
+	ImportExternalTables(context.Context, *ImportExternalTablesRequest) (*ImportExternalTablesResponse, error)
+	// 接管 tenant 库中已有的用户表：注册到 lc_* 元数据，单列外键映射为 relationship 列
+	ImportDatabaseSchema(context.Context, *ImportDatabaseSchemaRequest) (*ImportDatabaseSchemaResponse, error)
+	// 接管一张已有的物理表：列按 PG 类型映射为逻辑类型（可用 column_types 覆盖），与已注册表之间的单列外键映射为 relationship 列
+	ImportExistingTable(context.Context, *ImportExistingTableRequest) (*ImportExistingTableResponse, error)
+	// ------ SQL View ------
+	// 用只读 SELECT 在已注册的表之上定义 PG 视图（或物理化视图），注册为只读表，可以用 ListRows 查询
+	CreateSQLView(context.Context, *CreateSQLViewRequest) (*CreateSQLViewResponse, error)
+	// 刷新物化视图
+	RefreshSQLView(context.Context, *RefreshSQLViewRequest) (*RefreshSQLViewResponse, error)
+	// ------ Operation ------
+	// 以 async=true 发起的长时间操作（建索引、改列类型等）立即返回 Operation，通过以下接口查询进度和结果
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// 请求取消；执行中的 SQL 被中断并回滚，操作变为 CANCELLED
+	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error)
+	// ------ Member ------
+	// 租户成员及其角色，开启 OIDC 认证时按角色检查每个请求。以下接口需要 OWNER
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// 添加成员或修改成员的角色
+	SetMember(context.Context, *SetMemberRequest) (*SetMemberResponse, error)
+	DeleteMember(context.Context, *DeleteMemberRequest) (*DeleteMemberResponse, error)
+	// ------ Permission ------
+	// 按表 / 列对角色或用户允许、拒绝读写，细化角色的默认权限。以下接口需要 OWNER
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// 添加规则；同一表 / 列、同一对象、同一 access 的规则已存在时修改其 effect
+	SetPermission(context.Context, *SetPermissionRequest) (*SetPermissionResponse, error)
+	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
+	// ------ Audit ------
+	// 按时间倒序列出当前 tenant 的审计事件（所有修改表结构和数据的调用，含失败的调用），需要 OWNER
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ------ Webhook ------
+	// 订阅当前 tenant 的修改操作：成功的修改调用写入审计日志后，其审计事件会 POST 到匹配的 webhook，需要 OWNER
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// 按时间倒序列出 webhook 的投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	mustEmbedUnimplementedLowcodeServiceServer()
+}
+
+// UnimplementedLowcodeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLowcodeServiceServer struct{}
+
+func (UnimplementedLowcodeServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CloneTenant(context.Context, *CloneTenantRequest) (*CloneTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ExportTenant(*ExportTenantRequest, grpc.ServerStreamingServer[ExportTenantResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ImportTenant(grpc.ClientStreamingServer[ImportTenantRequest, ImportTenantResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) MigrateAllTenants(context.Context, *MigrateAllTenantsRequest) (*MigrateAllTenantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MigrateAllTenants not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListTenantHealth(context.Context, *ListTenantHealthRequest) (*ListTenantHealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTenantHealth not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) SuspendTenant(context.Context, *SuspendTenantRequest) (*SuspendTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ResumeTenant(context.Context, *ResumeTenantRequest) (*ResumeTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeTenant not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RotateTenantKeys(context.Context, *RotateTenantKeysRequest) (*RotateTenantKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateTenantKeys not implemented")
+}
+func (UnimplementedLowcodeServiceServer) TriggerBackup(context.Context, *TriggerBackupRequest) (*TriggerBackupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerBackup not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateType(context.Context, *CreateTypeRequest) (*CreateTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateType not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListTypes(context.Context, *ListTypesRequest) (*ListTypesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTypes not implemented")
 }
-func (UnimplementedLowcodeServiceServer) DeleteType(context.Context, *DeleteTypeRequest) (*DeleteTypeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteType not implemented")
+func (UnimplementedLowcodeServiceServer) GetType(context.Context, *GetTypeRequest) (*GetTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetType not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateType(context.Context, *UpdateTypeRequest) (*UpdateTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateType not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteType(context.Context, *DeleteTypeRequest) (*DeleteTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteType not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ExportTypes(context.Context, *ExportTypesRequest) (*ExportTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportTypes not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ImportTypes(context.Context, *ImportTypesRequest) (*ImportTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportTypes not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateTableWithSchema(context.Context, *CreateTableWithSchemaRequest) (*CreateTableWithSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTableWithSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ApplyTableSchema(context.Context, *ApplyTableSchemaRequest) (*ApplyTableSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyTableSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ExportSchema(context.Context, *ExportSchemaRequest) (*ExportSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ImportSchema(context.Context, *ImportSchemaRequest) (*ImportSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateTableFromTemplate(context.Context, *CreateTableFromTemplateRequest) (*CreateTableFromTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTableFromTemplate not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateTable(context.Context, *UpdateTableRequest) (*UpdateTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DuplicateTable(context.Context, *DuplicateTableRequest) (*DuplicateTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RestoreTable(context.Context, *RestoreTableRequest) (*RestoreTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) PurgeTable(context.Context, *PurgeTableRequest) (*PurgeTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetTableSchema(context.Context, *GetTableSchemaRequest) (*GetTableSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTableSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RepairTableSchema(context.Context, *RepairTableSchemaRequest) (*RepairTableSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepairTableSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetWorkspaceSchema(context.Context, *GetWorkspaceSchemaRequest) (*GetWorkspaceSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkspaceSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWorkspace not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWorkspaces not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkspace not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*UpdateWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWorkspace not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWorkspace not implemented")
+}
+func (UnimplementedLowcodeServiceServer) AddColumn(context.Context, *AddColumnRequest) (*AddColumnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddColumn not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateColumn(context.Context, *UpdateColumnRequest) (*UpdateColumnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateColumn not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteColumn not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ChangeColumnType(context.Context, *ChangeColumnTypeRequest) (*ChangeColumnTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeColumnType not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListColumns not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ReorderColumns(context.Context, *ReorderColumnsRequest) (*ReorderColumnsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderColumns not implemented")
+}
+func (UnimplementedLowcodeServiceServer) AddSelectOption(context.Context, *AddSelectOptionRequest) (*AddSelectOptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddSelectOption not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateSelectOption(context.Context, *UpdateSelectOptionRequest) (*UpdateSelectOptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSelectOption not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RemoveSelectOption(context.Context, *RemoveSelectOptionRequest) (*RemoveSelectOptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveSelectOption not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateRow(context.Context, *CreateRowRequest) (*CreateRowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRow not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateRow(context.Context, *UpdateRowRequest) (*UpdateRowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRow not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteRow(context.Context, *DeleteRowRequest) (*DeleteRowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRow not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RestoreRow(context.Context, *RestoreRowRequest) (*RestoreRowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreRow not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetRowHistory(context.Context, *GetRowHistoryRequest) (*GetRowHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRowHistory not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RestoreRowVersion(context.Context, *RestoreRowVersionRequest) (*RestoreRowVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreRowVersion not implemented")
+}
+func (UnimplementedLowcodeServiceServer) LinkRows(context.Context, *LinkRowsRequest) (*LinkRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UnlinkRows(context.Context, *UnlinkRowsRequest) (*UnlinkRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) PurgeRows(context.Context, *PurgeRowsRequest) (*PurgeRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetRow(context.Context, *GetRowRequest) (*GetRowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRow not implemented")
+}
+func (UnimplementedLowcodeServiceServer) FindRowByColumn(context.Context, *FindRowByColumnRequest) (*FindRowByColumnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindRowByColumn not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListRows(context.Context, *ListRowsRequest) (*ListRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) StreamRows(*StreamRowsRequest, grpc.ServerStreamingServer[StreamRowsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ExportRows(*ExportRowsRequest, grpc.ServerStreamingServer[ExportRowsResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) SearchRows(context.Context, *SearchRowsRequest) (*SearchRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) AggregateRows(context.Context, *AggregateRowsRequest) (*AggregateRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AggregateRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListDistinctValues(context.Context, *ListDistinctValuesRequest) (*ListDistinctValuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDistinctValues not implemented")
+}
+func (UnimplementedLowcodeServiceServer) BulkUpsertRows(context.Context, *BulkUpsertRowsRequest) (*BulkUpsertRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkUpsertRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) BulkDeleteRows(context.Context, *BulkDeleteRowsRequest) (*BulkDeleteRowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkDeleteRows not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UploadCellContent(grpc.ClientStreamingServer[UploadCellContentRequest, UploadCellContentResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadCellContent not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DownloadCellContent(*DownloadCellContentRequest, grpc.ServerStreamingServer[DownloadCellContentResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadCellContent not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateAttachmentUpload(context.Context, *CreateAttachmentUploadRequest) (*CreateAttachmentUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAttachmentUpload not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetAttachmentUrl(context.Context, *GetAttachmentUrlRequest) (*GetAttachmentUrlResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttachmentUrl not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateIndex(context.Context, *UpdateIndexRequest) (*UpdateIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateIndex not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteIndex not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIndexes not implemented")
+}
+func (UnimplementedLowcodeServiceServer) SyncIndexes(context.Context, *SyncIndexesRequest) (*SyncIndexesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncIndexes not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateView(context.Context, *CreateViewRequest) (*CreateViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateView not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListViews(context.Context, *ListViewsRequest) (*ListViewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListViews not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetView(context.Context, *GetViewRequest) (*GetViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetView not implemented")
+}
+func (UnimplementedLowcodeServiceServer) UpdateView(context.Context, *UpdateViewRequest) (*UpdateViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateView not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteView(context.Context, *DeleteViewRequest) (*DeleteViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteView not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ImportExternalTables(context.Context, *ImportExternalTablesRequest) (*ImportExternalTablesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportExternalTables not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ImportDatabaseSchema(context.Context, *ImportDatabaseSchemaRequest) (*ImportDatabaseSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportDatabaseSchema not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ImportExistingTable(context.Context, *ImportExistingTableRequest) (*ImportExistingTableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportExistingTable not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateSQLView(context.Context, *CreateSQLViewRequest) (*CreateSQLViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSQLView not implemented")
+}
+func (UnimplementedLowcodeServiceServer) RefreshSQLView(context.Context, *RefreshSQLViewRequest) (*RefreshSQLViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshSQLView not implemented")
+}
+func (UnimplementedLowcodeServiceServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedLowcodeServiceServer) SetMember(context.Context, *SetMemberRequest) (*SetMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMember not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteMember(context.Context, *DeleteMemberRequest) (*DeleteMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMember not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedLowcodeServiceServer) SetPermission(context.Context, *SetPermissionRequest) (*SetPermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPermission not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePermission not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedLowcodeServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedLowcodeServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedLowcodeServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedLowcodeServiceServer) mustEmbedUnimplementedLowcodeServiceServer() {}
+func (UnimplementedLowcodeServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeLowcodeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LowcodeServiceServer will
+// result in compilation errors.
+type UnsafeLowcodeServiceServer interface {
+	mustEmbedUnimplementedLowcodeServiceServer()
+}
+
+func RegisterLowcodeServiceServer(s grpc.ServiceRegistrar, srv LowcodeServiceServer) {
+	// If the following call panics, it indicates UnimplementedLowcodeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LowcodeService_ServiceDesc, srv)
+}
+
+func _LowcodeService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CloneTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CloneTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CloneTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CloneTenant(ctx, req.(*CloneTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ExportTenant_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTenantRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LowcodeServiceServer).ExportTenant(m, &grpc.GenericServerStream[ExportTenantRequest, ExportTenantResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_ExportTenantServer = grpc.ServerStreamingServer[ExportTenantResponse]
+
+func _LowcodeService_ImportTenant_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LowcodeServiceServer).ImportTenant(&grpc.GenericServerStream[ImportTenantRequest, ImportTenantResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_ImportTenantServer = grpc.ClientStreamingServer[ImportTenantRequest, ImportTenantResponse]
+
+func _LowcodeService_MigrateAllTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateAllTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).MigrateAllTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_MigrateAllTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).MigrateAllTenants(ctx, req.(*MigrateAllTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListTenantHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListTenantHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListTenantHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListTenantHealth(ctx, req.(*ListTenantHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_SuspendTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).SuspendTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_SuspendTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).SuspendTenant(ctx, req.(*SuspendTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ResumeTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ResumeTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ResumeTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ResumeTenant(ctx, req.(*ResumeTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_RotateTenantKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTenantKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).RotateTenantKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_RotateTenantKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).RotateTenantKeys(ctx, req.(*RotateTenantKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_TriggerBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).TriggerBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_TriggerBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).TriggerBackup(ctx, req.(*TriggerBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListBackups(ctx, req.(*ListBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CreateType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateType(ctx, req.(*CreateTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListTypes(ctx, req.(*ListTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetType(ctx, req.(*GetTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UpdateType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateType(ctx, req.(*UpdateTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DeleteType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteType(ctx, req.(*DeleteTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ExportTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ExportTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ExportTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ExportTypes(ctx, req.(*ExportTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ImportTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ImportTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ImportTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ImportTypes(ctx, req.(*ImportTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateTable(ctx, req.(*CreateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CreateTableWithSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableWithSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateTableWithSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateTableWithSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateTableWithSchema(ctx, req.(*CreateTableWithSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ApplyTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyTableSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ApplyTableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ApplyTableSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ApplyTableSchema(ctx, req.(*ApplyTableSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ExportSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ExportSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ExportSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ExportSchema(ctx, req.(*ExportSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ImportSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ImportSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ImportSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ImportSchema(ctx, req.(*ImportSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CreateTableFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateTableFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateTableFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateTableFromTemplate(ctx, req.(*CreateTableFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UpdateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateTable(ctx, req.(*UpdateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DuplicateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DuplicateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DuplicateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DuplicateTable(ctx, req.(*DuplicateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteTable(ctx, req.(*DeleteTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_RestoreTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).RestoreTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_RestoreTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).RestoreTable(ctx, req.(*RestoreTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_PurgeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).PurgeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_PurgeTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).PurgeTable(ctx, req.(*PurgeTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetTable(ctx, req.(*GetTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetTableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetTableSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetTableSchema(ctx, req.(*GetTableSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_RepairTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairTableSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).RepairTableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_RepairTableSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).RepairTableSchema(ctx, req.(*RepairTableSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetWorkspaceSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetWorkspaceSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetWorkspaceSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetWorkspaceSchema(ctx, req.(*GetWorkspaceSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListWorkspaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListWorkspaces(ctx, req.(*ListWorkspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetWorkspace(ctx, req.(*GetWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UpdateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateWorkspace(ctx, req.(*UpdateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DeleteWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteWorkspace(ctx, req.(*DeleteWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_AddColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).AddColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_AddColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).AddColumn(ctx, req.(*AddColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UpdateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateColumn(ctx, req.(*UpdateColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DeleteColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteColumn(ctx, req.(*DeleteColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ChangeColumnType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeColumnTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ChangeColumnType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ChangeColumnType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ChangeColumnType(ctx, req.(*ChangeColumnTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListColumns(ctx, req.(*ListColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ReorderColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ReorderColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ReorderColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ReorderColumns(ctx, req.(*ReorderColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTable not implemented")
+
+func _LowcodeService_AddSelectOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSelectOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).AddSelectOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_AddSelectOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).AddSelectOption(ctx, req.(*AddSelectOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteTable not implemented")
+
+func _LowcodeService_UpdateSelectOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSelectOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateSelectOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateSelectOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateSelectOption(ctx, req.(*UpdateSelectOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTables not implemented")
+
+func _LowcodeService_RemoveSelectOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSelectOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).RemoveSelectOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_RemoveSelectOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).RemoveSelectOption(ctx, req.(*RemoveSelectOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_CreateRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateRow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateRow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateRow(ctx, req.(*CreateRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UpdateRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateRow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateRow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateRow(ctx, req.(*UpdateRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_DeleteRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteRow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteRow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteRow(ctx, req.(*DeleteRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_RestoreRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).RestoreRow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_RestoreRow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).RestoreRow(ctx, req.(*RestoreRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetRowHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetRowHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetRowHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetRowHistory(ctx, req.(*GetRowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_RestoreRowVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRowVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).RestoreRowVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_RestoreRowVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).RestoreRowVersion(ctx, req.(*RestoreRowVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_LinkRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).LinkRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_LinkRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).LinkRows(ctx, req.(*LinkRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_UnlinkRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UnlinkRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UnlinkRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UnlinkRows(ctx, req.(*UnlinkRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_PurgeRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).PurgeRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_PurgeRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).PurgeRows(ctx, req.(*PurgeRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_GetRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetRow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetRow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetRow(ctx, req.(*GetRowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_FindRowByColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRowByColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).FindRowByColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_FindRowByColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).FindRowByColumn(ctx, req.(*FindRowByColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_ListRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListRows(ctx, req.(*ListRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LowcodeService_StreamRows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LowcodeServiceServer).StreamRows(m, &grpc.GenericServerStream[StreamRowsRequest, StreamRowsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_StreamRowsServer = grpc.ServerStreamingServer[StreamRowsResponse]
+
+func _LowcodeService_ExportRows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LowcodeServiceServer).ExportRows(m, &grpc.GenericServerStream[ExportRowsRequest, ExportRowsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_ExportRowsServer = grpc.ServerStreamingServer[ExportRowsResponse]
+
+func _LowcodeService_SearchRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).SearchRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_SearchRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).SearchRows(ctx, req.(*SearchRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) GetTableSchema(context.Context, *GetTableSchemaRequest) (*GetTableSchemaResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTableSchema not implemented")
+
+func _LowcodeService_AggregateRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).AggregateRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_AggregateRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).AggregateRows(ctx, req.(*AggregateRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) AddColumn(context.Context, *AddColumnRequest) (*AddColumnResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddColumn not implemented")
+
+func _LowcodeService_ListDistinctValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDistinctValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListDistinctValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListDistinctValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListDistinctValues(ctx, req.(*ListDistinctValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) UpdateColumn(context.Context, *UpdateColumnRequest) (*UpdateColumnResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateColumn not implemented")
+
+func _LowcodeService_BulkUpsertRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).BulkUpsertRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_BulkUpsertRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).BulkUpsertRows(ctx, req.(*BulkUpsertRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteColumn not implemented")
+
+func _LowcodeService_BulkDeleteRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).BulkDeleteRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_BulkDeleteRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).BulkDeleteRows(ctx, req.(*BulkDeleteRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListColumns not implemented")
+
+func _LowcodeService_UploadCellContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LowcodeServiceServer).UploadCellContent(&grpc.GenericServerStream[UploadCellContentRequest, UploadCellContentResponse]{ServerStream: stream})
 }
-func (UnimplementedLowcodeServiceServer) CreateRow(context.Context, *CreateRowRequest) (*CreateRowResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateRow not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_UploadCellContentServer = grpc.ClientStreamingServer[UploadCellContentRequest, UploadCellContentResponse]
+
+func _LowcodeService_DownloadCellContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadCellContentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LowcodeServiceServer).DownloadCellContent(m, &grpc.GenericServerStream[DownloadCellContentRequest, DownloadCellContentResponse]{ServerStream: stream})
 }
-func (UnimplementedLowcodeServiceServer) UpdateRow(context.Context, *UpdateRowRequest) (*UpdateRowResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateRow not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LowcodeService_DownloadCellContentServer = grpc.ServerStreamingServer[DownloadCellContentResponse]
+
+func _LowcodeService_CreateAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateAttachmentUpload(ctx, req.(*CreateAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) DeleteRow(context.Context, *DeleteRowRequest) (*DeleteRowResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteRow not implemented")
+
+func _LowcodeService_GetAttachmentUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetAttachmentUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetAttachmentUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetAttachmentUrl(ctx, req.(*GetAttachmentUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) ListRows(context.Context, *ListRowsRequest) (*ListRowsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListRows not implemented")
+
+func _LowcodeService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) BulkUpsertRows(context.Context, *BulkUpsertRowsRequest) (*BulkUpsertRowsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method BulkUpsertRows not implemented")
+
+func _LowcodeService_UpdateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).UpdateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_UpdateIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).UpdateIndex(ctx, req.(*UpdateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) BulkDeleteRows(context.Context, *BulkDeleteRowsRequest) (*BulkDeleteRowsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method BulkDeleteRows not implemented")
+
+func _LowcodeService_DeleteIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).DeleteIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_DeleteIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).DeleteIndex(ctx, req.(*DeleteIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateIndex not implemented")
+
+func _LowcodeService_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListIndexes(ctx, req.(*ListIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteIndex not implemented")
+
+func _LowcodeService_SyncIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).SyncIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_SyncIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).SyncIndexes(ctx, req.(*SyncIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListIndexes not implemented")
+
+func _LowcodeService_CreateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).CreateView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_CreateView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).CreateView(ctx, req.(*CreateViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLowcodeServiceServer) mustEmbedUnimplementedLowcodeServiceServer() {}
-func (UnimplementedLowcodeServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeLowcodeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LowcodeServiceServer will
-// result in compilation errors.
-type UnsafeLowcodeServiceServer interface {
-	mustEmbedUnimplementedLowcodeServiceServer()
+func _LowcodeService_ListViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).ListViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_ListViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).ListViews(ctx, req.(*ListViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLowcodeServiceServer(s grpc.ServiceRegistrar, srv LowcodeServiceServer) {
-	// If the following call panics, it indicates UnimplementedLowcodeServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _LowcodeService_GetView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&LowcodeService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(LowcodeServiceServer).GetView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LowcodeService_GetView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LowcodeServiceServer).GetView(ctx, req.(*GetViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTenantRequest)
+func _LowcodeService_UpdateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).CreateTenant(ctx, in)
+		return srv.(LowcodeServiceServer).UpdateView(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_CreateTenant_FullMethodName,
+		FullMethod: LowcodeService_UpdateView_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+		return srv.(LowcodeServiceServer).UpdateView(ctx, req.(*UpdateViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_CreateType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTypeRequest)
+func _LowcodeService_DeleteView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).CreateType(ctx, in)
+		return srv.(LowcodeServiceServer).DeleteView(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_CreateType_FullMethodName,
+		FullMethod: LowcodeService_DeleteView_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).CreateType(ctx, req.(*CreateTypeRequest))
+		return srv.(LowcodeServiceServer).DeleteView(ctx, req.(*DeleteViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_ListTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTypesRequest)
+func _LowcodeService_ImportExternalTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExternalTablesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).ListTypes(ctx, in)
+		return srv.(LowcodeServiceServer).ImportExternalTables(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_ListTypes_FullMethodName,
+		FullMethod: LowcodeService_ImportExternalTables_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).ListTypes(ctx, req.(*ListTypesRequest))
+		return srv.(LowcodeServiceServer).ImportExternalTables(ctx, req.(*ImportExternalTablesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_DeleteType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTypeRequest)
+func _LowcodeService_ImportDatabaseSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDatabaseSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).DeleteType(ctx, in)
+		return srv.(LowcodeServiceServer).ImportDatabaseSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_DeleteType_FullMethodName,
+		FullMethod: LowcodeService_ImportDatabaseSchema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).DeleteType(ctx, req.(*DeleteTypeRequest))
+		return srv.(LowcodeServiceServer).ImportDatabaseSchema(ctx, req.(*ImportDatabaseSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTableRequest)
+func _LowcodeService_ImportExistingTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExistingTableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).CreateTable(ctx, in)
+		return srv.(LowcodeServiceServer).ImportExistingTable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_CreateTable_FullMethodName,
+		FullMethod: LowcodeService_ImportExistingTable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).CreateTable(ctx, req.(*CreateTableRequest))
+		return srv.(LowcodeServiceServer).ImportExistingTable(ctx, req.(*ImportExistingTableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTableRequest)
+func _LowcodeService_CreateSQLView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSQLViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).DeleteTable(ctx, in)
+		return srv.(LowcodeServiceServer).CreateSQLView(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_DeleteTable_FullMethodName,
+		FullMethod: LowcodeService_CreateSQLView_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).DeleteTable(ctx, req.(*DeleteTableRequest))
+		return srv.(LowcodeServiceServer).CreateSQLView(ctx, req.(*CreateSQLViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTablesRequest)
+func _LowcodeService_RefreshSQLView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSQLViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).ListTables(ctx, in)
+		return srv.(LowcodeServiceServer).RefreshSQLView(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_ListTables_FullMethodName,
+		FullMethod: LowcodeService_RefreshSQLView_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).ListTables(ctx, req.(*ListTablesRequest))
+		return srv.(LowcodeServiceServer).RefreshSQLView(ctx, req.(*RefreshSQLViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_GetTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTableSchemaRequest)
+func _LowcodeService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).GetTableSchema(ctx, in)
+		return srv.(LowcodeServiceServer).GetOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_GetTableSchema_FullMethodName,
+		FullMethod: LowcodeService_GetOperation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).GetTableSchema(ctx, req.(*GetTableSchemaRequest))
+		return srv.(LowcodeServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_AddColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddColumnRequest)
+func _LowcodeService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).AddColumn(ctx, in)
+		return srv.(LowcodeServiceServer).ListOperations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_AddColumn_FullMethodName,
+		FullMethod: LowcodeService_ListOperations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).AddColumn(ctx, req.(*AddColumnRequest))
+		return srv.(LowcodeServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_UpdateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateColumnRequest)
+func _LowcodeService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).UpdateColumn(ctx, in)
+		return srv.(LowcodeServiceServer).CancelOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_UpdateColumn_FullMethodName,
+		FullMethod: LowcodeService_CancelOperation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).UpdateColumn(ctx, req.(*UpdateColumnRequest))
+		return srv.(LowcodeServiceServer).CancelOperation(ctx, req.(*CancelOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_DeleteColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteColumnRequest)
+func _LowcodeService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).DeleteColumn(ctx, in)
+		return srv.(LowcodeServiceServer).ListMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_DeleteColumn_FullMethodName,
+		FullMethod: LowcodeService_ListMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).DeleteColumn(ctx, req.(*DeleteColumnRequest))
+		return srv.(LowcodeServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_ListColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListColumnsRequest)
+func _LowcodeService_SetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).ListColumns(ctx, in)
+		return srv.(LowcodeServiceServer).SetMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_ListColumns_FullMethodName,
+		FullMethod: LowcodeService_SetMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).ListColumns(ctx, req.(*ListColumnsRequest))
+		return srv.(LowcodeServiceServer).SetMember(ctx, req.(*SetMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_CreateRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRowRequest)
+func _LowcodeService_DeleteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).CreateRow(ctx, in)
+		return srv.(LowcodeServiceServer).DeleteMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_CreateRow_FullMethodName,
+		FullMethod: LowcodeService_DeleteMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).CreateRow(ctx, req.(*CreateRowRequest))
+		return srv.(LowcodeServiceServer).DeleteMember(ctx, req.(*DeleteMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_UpdateRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRowRequest)
+func _LowcodeService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).UpdateRow(ctx, in)
+		return srv.(LowcodeServiceServer).ListPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_UpdateRow_FullMethodName,
+		FullMethod: LowcodeService_ListPermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).UpdateRow(ctx, req.(*UpdateRowRequest))
+		return srv.(LowcodeServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_DeleteRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRowRequest)
+func _LowcodeService_SetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPermissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).DeleteRow(ctx, in)
+		return srv.(LowcodeServiceServer).SetPermission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_DeleteRow_FullMethodName,
+		FullMethod: LowcodeService_SetPermission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).DeleteRow(ctx, req.(*DeleteRowRequest))
+		return srv.(LowcodeServiceServer).SetPermission(ctx, req.(*SetPermissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_ListRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRowsRequest)
+func _LowcodeService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePermissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).ListRows(ctx, in)
+		return srv.(LowcodeServiceServer).DeletePermission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_ListRows_FullMethodName,
+		FullMethod: LowcodeService_DeletePermission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).ListRows(ctx, req.(*ListRowsRequest))
+		return srv.(LowcodeServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_BulkUpsertRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkUpsertRowsRequest)
+func _LowcodeService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).BulkUpsertRows(ctx, in)
+		return srv.(LowcodeServiceServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_BulkUpsertRows_FullMethodName,
+		FullMethod: LowcodeService_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).BulkUpsertRows(ctx, req.(*BulkUpsertRowsRequest))
+		return srv.(LowcodeServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_BulkDeleteRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkDeleteRowsRequest)
+func _LowcodeService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).BulkDeleteRows(ctx, in)
+		return srv.(LowcodeServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_BulkDeleteRows_FullMethodName,
+		FullMethod: LowcodeService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).BulkDeleteRows(ctx, req.(*BulkDeleteRowsRequest))
+		return srv.(LowcodeServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIndexRequest)
+func _LowcodeService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).CreateIndex(ctx, in)
+		return srv.(LowcodeServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_CreateIndex_FullMethodName,
+		FullMethod: LowcodeService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+		return srv.(LowcodeServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_DeleteIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteIndexRequest)
+func _LowcodeService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).DeleteIndex(ctx, in)
+		return srv.(LowcodeServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_DeleteIndex_FullMethodName,
+		FullMethod: LowcodeService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).DeleteIndex(ctx, req.(*DeleteIndexRequest))
+		return srv.(LowcodeServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LowcodeService_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIndexesRequest)
+func _LowcodeService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LowcodeServiceServer).ListIndexes(ctx, in)
+		return srv.(LowcodeServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LowcodeService_ListIndexes_FullMethodName,
+		FullMethod: LowcodeService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LowcodeServiceServer).ListIndexes(ctx, req.(*ListIndexesRequest))
+		return srv.(LowcodeServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -815,6 +3837,54 @@ var LowcodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTenant",
 			Handler:    _LowcodeService_CreateTenant_Handler,
 		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _LowcodeService_ListTenants_Handler,
+		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _LowcodeService_GetTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _LowcodeService_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "CloneTenant",
+			Handler:    _LowcodeService_CloneTenant_Handler,
+		},
+		{
+			MethodName: "MigrateAllTenants",
+			Handler:    _LowcodeService_MigrateAllTenants_Handler,
+		},
+		{
+			MethodName: "ListTenantHealth",
+			Handler:    _LowcodeService_ListTenantHealth_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _LowcodeService_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "SuspendTenant",
+			Handler:    _LowcodeService_SuspendTenant_Handler,
+		},
+		{
+			MethodName: "ResumeTenant",
+			Handler:    _LowcodeService_ResumeTenant_Handler,
+		},
+		{
+			MethodName: "RotateTenantKeys",
+			Handler:    _LowcodeService_RotateTenantKeys_Handler,
+		},
+		{
+			MethodName: "TriggerBackup",
+			Handler:    _LowcodeService_TriggerBackup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _LowcodeService_ListBackups_Handler,
+		},
 		{
 			MethodName: "CreateType",
 			Handler:    _LowcodeService_CreateType_Handler,
@@ -823,26 +3893,114 @@ var LowcodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTypes",
 			Handler:    _LowcodeService_ListTypes_Handler,
 		},
+		{
+			MethodName: "GetType",
+			Handler:    _LowcodeService_GetType_Handler,
+		},
+		{
+			MethodName: "UpdateType",
+			Handler:    _LowcodeService_UpdateType_Handler,
+		},
 		{
 			MethodName: "DeleteType",
 			Handler:    _LowcodeService_DeleteType_Handler,
 		},
+		{
+			MethodName: "ExportTypes",
+			Handler:    _LowcodeService_ExportTypes_Handler,
+		},
+		{
+			MethodName: "ImportTypes",
+			Handler:    _LowcodeService_ImportTypes_Handler,
+		},
 		{
 			MethodName: "CreateTable",
 			Handler:    _LowcodeService_CreateTable_Handler,
 		},
+		{
+			MethodName: "CreateTableWithSchema",
+			Handler:    _LowcodeService_CreateTableWithSchema_Handler,
+		},
+		{
+			MethodName: "ApplyTableSchema",
+			Handler:    _LowcodeService_ApplyTableSchema_Handler,
+		},
+		{
+			MethodName: "ExportSchema",
+			Handler:    _LowcodeService_ExportSchema_Handler,
+		},
+		{
+			MethodName: "ImportSchema",
+			Handler:    _LowcodeService_ImportSchema_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _LowcodeService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateTableFromTemplate",
+			Handler:    _LowcodeService_CreateTableFromTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTable",
+			Handler:    _LowcodeService_UpdateTable_Handler,
+		},
+		{
+			MethodName: "DuplicateTable",
+			Handler:    _LowcodeService_DuplicateTable_Handler,
+		},
 		{
 			MethodName: "DeleteTable",
 			Handler:    _LowcodeService_DeleteTable_Handler,
 		},
+		{
+			MethodName: "RestoreTable",
+			Handler:    _LowcodeService_RestoreTable_Handler,
+		},
+		{
+			MethodName: "PurgeTable",
+			Handler:    _LowcodeService_PurgeTable_Handler,
+		},
 		{
 			MethodName: "ListTables",
 			Handler:    _LowcodeService_ListTables_Handler,
 		},
+		{
+			MethodName: "GetTable",
+			Handler:    _LowcodeService_GetTable_Handler,
+		},
 		{
 			MethodName: "GetTableSchema",
 			Handler:    _LowcodeService_GetTableSchema_Handler,
 		},
+		{
+			MethodName: "RepairTableSchema",
+			Handler:    _LowcodeService_RepairTableSchema_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceSchema",
+			Handler:    _LowcodeService_GetWorkspaceSchema_Handler,
+		},
+		{
+			MethodName: "CreateWorkspace",
+			Handler:    _LowcodeService_CreateWorkspace_Handler,
+		},
+		{
+			MethodName: "ListWorkspaces",
+			Handler:    _LowcodeService_ListWorkspaces_Handler,
+		},
+		{
+			MethodName: "GetWorkspace",
+			Handler:    _LowcodeService_GetWorkspace_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspace",
+			Handler:    _LowcodeService_UpdateWorkspace_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspace",
+			Handler:    _LowcodeService_DeleteWorkspace_Handler,
+		},
 		{
 			MethodName: "AddColumn",
 			Handler:    _LowcodeService_AddColumn_Handler,
@@ -855,10 +4013,30 @@ var LowcodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteColumn",
 			Handler:    _LowcodeService_DeleteColumn_Handler,
 		},
+		{
+			MethodName: "ChangeColumnType",
+			Handler:    _LowcodeService_ChangeColumnType_Handler,
+		},
 		{
 			MethodName: "ListColumns",
 			Handler:    _LowcodeService_ListColumns_Handler,
 		},
+		{
+			MethodName: "ReorderColumns",
+			Handler:    _LowcodeService_ReorderColumns_Handler,
+		},
+		{
+			MethodName: "AddSelectOption",
+			Handler:    _LowcodeService_AddSelectOption_Handler,
+		},
+		{
+			MethodName: "UpdateSelectOption",
+			Handler:    _LowcodeService_UpdateSelectOption_Handler,
+		},
+		{
+			MethodName: "RemoveSelectOption",
+			Handler:    _LowcodeService_RemoveSelectOption_Handler,
+		},
 		{
 			MethodName: "CreateRow",
 			Handler:    _LowcodeService_CreateRow_Handler,
@@ -871,10 +4049,54 @@ var LowcodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRow",
 			Handler:    _LowcodeService_DeleteRow_Handler,
 		},
+		{
+			MethodName: "RestoreRow",
+			Handler:    _LowcodeService_RestoreRow_Handler,
+		},
+		{
+			MethodName: "GetRowHistory",
+			Handler:    _LowcodeService_GetRowHistory_Handler,
+		},
+		{
+			MethodName: "RestoreRowVersion",
+			Handler:    _LowcodeService_RestoreRowVersion_Handler,
+		},
+		{
+			MethodName: "LinkRows",
+			Handler:    _LowcodeService_LinkRows_Handler,
+		},
+		{
+			MethodName: "UnlinkRows",
+			Handler:    _LowcodeService_UnlinkRows_Handler,
+		},
+		{
+			MethodName: "PurgeRows",
+			Handler:    _LowcodeService_PurgeRows_Handler,
+		},
+		{
+			MethodName: "GetRow",
+			Handler:    _LowcodeService_GetRow_Handler,
+		},
+		{
+			MethodName: "FindRowByColumn",
+			Handler:    _LowcodeService_FindRowByColumn_Handler,
+		},
 		{
 			MethodName: "ListRows",
 			Handler:    _LowcodeService_ListRows_Handler,
 		},
+		{
+			MethodName: "SearchRows",
+			Handler:    _LowcodeService_SearchRows_Handler,
+		},
+		{
+			MethodName: "AggregateRows",
+			Handler:    _LowcodeService_AggregateRows_Handler,
+		},
+		{
+			MethodName: "ListDistinctValues",
+			Handler:    _LowcodeService_ListDistinctValues_Handler,
+		},
 		{
 			MethodName: "BulkUpsertRows",
 			Handler:    _LowcodeService_BulkUpsertRows_Handler,
@@ -883,10 +4105,22 @@ var LowcodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkDeleteRows",
 			Handler:    _LowcodeService_BulkDeleteRows_Handler,
 		},
+		{
+			MethodName: "CreateAttachmentUpload",
+			Handler:    _LowcodeService_CreateAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "GetAttachmentUrl",
+			Handler:    _LowcodeService_GetAttachmentUrl_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _LowcodeService_CreateIndex_Handler,
 		},
+		{
+			MethodName: "UpdateIndex",
+			Handler:    _LowcodeService_UpdateIndex_Handler,
+		},
 		{
 			MethodName: "DeleteIndex",
 			Handler:    _LowcodeService_DeleteIndex_Handler,
@@ -895,7 +4129,138 @@ var LowcodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListIndexes",
 			Handler:    _LowcodeService_ListIndexes_Handler,
 		},
+		{
+			MethodName: "SyncIndexes",
+			Handler:    _LowcodeService_SyncIndexes_Handler,
+		},
+		{
+			MethodName: "CreateView",
+			Handler:    _LowcodeService_CreateView_Handler,
+		},
+		{
+			MethodName: "ListViews",
+			Handler:    _LowcodeService_ListViews_Handler,
+		},
+		{
+			MethodName: "GetView",
+			Handler:    _LowcodeService_GetView_Handler,
+		},
+		{
+			MethodName: "UpdateView",
+			Handler:    _LowcodeService_UpdateView_Handler,
+		},
+		{
+			MethodName: "DeleteView",
+			Handler:    _LowcodeService_DeleteView_Handler,
+		},
+		{
+			MethodName: "ImportExternalTables",
+			Handler:    _LowcodeService_ImportExternalTables_Handler,
+		},
+		{
+			MethodName: "ImportDatabaseSchema",
+			Handler:    _LowcodeService_ImportDatabaseSchema_Handler,
+		},
+		{
+			MethodName: "ImportExistingTable",
+			Handler:    _LowcodeService_ImportExistingTable_Handler,
+		},
+		{
+			MethodName: "CreateSQLView",
+			Handler:    _LowcodeService_CreateSQLView_Handler,
+		},
+		{
+			MethodName: "RefreshSQLView",
+			Handler:    _LowcodeService_RefreshSQLView_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _LowcodeService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _LowcodeService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _LowcodeService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _LowcodeService_ListMembers_Handler,
+		},
+		{
+			MethodName: "SetMember",
+			Handler:    _LowcodeService_SetMember_Handler,
+		},
+		{
+			MethodName: "DeleteMember",
+			Handler:    _LowcodeService_DeleteMember_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _LowcodeService_ListPermissions_Handler,
+		},
+		{
+			MethodName: "SetPermission",
+			Handler:    _LowcodeService_SetPermission_Handler,
+		},
+		{
+			MethodName: "DeletePermission",
+			Handler:    _LowcodeService_DeletePermission_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _LowcodeService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _LowcodeService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _LowcodeService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _LowcodeService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _LowcodeService_ListWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTenant",
+			Handler:       _LowcodeService_ExportTenant_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTenant",
+			Handler:       _LowcodeService_ImportTenant_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamRows",
+			Handler:       _LowcodeService_StreamRows_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportRows",
+			Handler:       _LowcodeService_ExportRows_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadCellContent",
+			Handler:       _LowcodeService_UploadCellContent_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadCellContent",
+			Handler:       _LowcodeService_DownloadCellContent_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lowcode/v1/lowcode_service.proto",
 }
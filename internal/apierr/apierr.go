@@ -0,0 +1,196 @@
+// Package apierr 为所有 RPC 错误附加稳定的 lowcode 错误码（google.rpc.ErrorInfo），
+// 客户端按 ErrorInfo.reason 分支，而不是解析错误字符串。
+package apierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/db"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// Domain 是 ErrorInfo.domain 的固定取值。
+const Domain = "lowcode.v1"
+
+// New 返回带错误码的 gRPC status 错误。
+func New(code lowcodev1.ErrorCode, c codes.Code, format string, args ...any) error {
+	return withInfo(status.New(c, fmt.Sprintf(format, args...)), code, nil).Err()
+}
+
+// FieldViolation 描述一个字段（如列 id）的校验失败。Reason 为可选的机器可读原因（如 INVALID_EMAIL）。
+type FieldViolation struct {
+	Field       string
+	Description string
+	Reason      string
+}
+
+// NewValidation 返回 VALIDATION_FAILED / InvalidArgument 错误，并附带 google.rpc.BadRequest 列出每个字段的问题；
+// ErrorInfo.metadata.fields 为逗号分隔的字段列表，方便只看 ErrorInfo 的客户端；
+// 带 Reason 的字段另外记录在 metadata.reasons 中（"field=REASON"，逗号分隔）。
+func NewValidation(violations []FieldViolation) error {
+	fields := make([]string, 0, len(violations))
+	var reasons []string
+	br := &errdetails.BadRequest{}
+	for _, v := range violations {
+		fields = append(fields, v.Field)
+		if v.Reason != "" {
+			reasons = append(reasons, v.Field+"="+v.Reason)
+		}
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description})
+	}
+	msg := "validation failed"
+	if len(violations) > 0 {
+		msg = fmt.Sprintf("validation failed: %s: %s", violations[0].Field, violations[0].Description)
+		if len(violations) > 1 {
+			msg += fmt.Sprintf(" (and %d more)", len(violations)-1)
+		}
+	}
+	meta := map[string]string{"fields": strings.Join(fields, ",")}
+	if len(reasons) > 0 {
+		meta["reasons"] = strings.Join(reasons, ",")
+	}
+	st := withInfo(status.New(codes.InvalidArgument, msg), lowcodev1.ErrorCode_VALIDATION_FAILED, meta)
+	if out, err := st.WithDetails(br); err == nil {
+		st = out
+	}
+	return st.Err()
+}
+
+// NewPayloadTooLarge 返回 PAYLOAD_TOO_LARGE / InvalidArgument 错误，metadata 中 field 为超限的字段，
+// limit / size 为上限和实际大小（条数或字节数，同错误描述）。
+func NewPayloadTooLarge(field string, limit, size int, format string, args ...any) error {
+	meta := map[string]string{"field": field, "limit": strconv.Itoa(limit), "size": strconv.Itoa(size)}
+	return withInfo(status.New(codes.InvalidArgument, fmt.Sprintf(format, args...)), lowcodev1.ErrorCode_PAYLOAD_TOO_LARGE, meta).Err()
+}
+
+// CodeOf 返回错误上的 lowcode 错误码，没有附加时为 ERROR_CODE_UNSPECIFIED。
+func CodeOf(err error) lowcodev1.ErrorCode {
+	st, ok := status.FromError(err)
+	if !ok {
+		return lowcodev1.ErrorCode_ERROR_CODE_UNSPECIFIED
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == Domain {
+			return lowcodev1.ErrorCode(lowcodev1.ErrorCode_value[info.GetReason()])
+		}
+	}
+	return lowcodev1.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// Annotate 确保 err 带有 ErrorInfo：已带错误码的原样返回，PG 错误按 SQLSTATE 转换（见 fromPg），
+// 其余按 status code 分类，gRPC status code 保持不变。
+func Annotate(err error) error {
+	return AnnotateWith(err, nil)
+}
+
+// AnnotateWith 同 Annotate，names 为 PG 错误中物理表名 / 列名对应的逻辑名字（键见 fromPg），
+// 合并进 ErrorInfo.metadata 并用于错误描述。
+func AnnotateWith(err error, names map[string]string) error {
+	if err == nil || CodeOf(err) != lowcodev1.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return fromPg(err, pgErr, names)
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(plainCode(err), err.Error())
+	}
+	code, meta := classify(err, st.Code())
+	return withInfo(st, code, meta).Err()
+}
+
+// plainCode 为不带 status 的错误选择 gRPC status code：tenant 状态错误来自 db 包，语义明确。
+func plainCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, tenant.ErrInvalidID), errors.Is(err, db.ErrInvalidDSN):
+		return codes.InvalidArgument
+	case errors.Is(err, db.ErrTenantNotFound):
+		return codes.NotFound
+	case errors.Is(err, db.ErrTenantExists):
+		return codes.AlreadyExists
+	case errors.Is(err, db.ErrTenantSuspended), errors.Is(err, db.ErrTenantUnavailable), errors.Is(err, db.ErrTenantBusy),
+		errors.Is(err, db.ErrDSNEncryptionUnavailable), errors.Is(err, db.ErrBackupRunning):
+		return codes.FailedPrecondition
+	case errors.Is(err, db.ErrClosed):
+		return codes.Unavailable
+	case errors.Is(err, pgx.ErrNoRows):
+		return codes.NotFound
+	}
+	return codes.Unknown
+}
+
+func classify(err error, c codes.Code) (lowcodev1.ErrorCode, map[string]string) {
+	if errors.Is(err, db.ErrTenantRequired) {
+		return lowcodev1.ErrorCode_TENANT_REQUIRED, nil
+	}
+	if errors.Is(err, db.ErrTenantNotFound) {
+		return lowcodev1.ErrorCode_TENANT_NOT_FOUND, nil
+	}
+	if errors.Is(err, db.ErrTenantSuspended) {
+		return lowcodev1.ErrorCode_TENANT_SUSPENDED, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return lowcodev1.ErrorCode_NOT_FOUND, nil
+	}
+
+	switch c {
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return lowcodev1.ErrorCode_VALIDATION_FAILED, nil
+	case codes.NotFound:
+		return lowcodev1.ErrorCode_NOT_FOUND, nil
+	case codes.AlreadyExists:
+		return lowcodev1.ErrorCode_ALREADY_EXISTS, nil
+	case codes.ResourceExhausted:
+		// streaming RPC 中收到超过 MaxRecvMsgSize 的消息时 grpc 返回的错误
+		if st, ok := status.FromError(err); ok && strings.HasPrefix(st.Message(), "grpc: received message larger than max") {
+			return lowcodev1.ErrorCode_PAYLOAD_TOO_LARGE, nil
+		}
+		return lowcodev1.ErrorCode_QUOTA_EXCEEDED, nil
+	case codes.PermissionDenied:
+		return lowcodev1.ErrorCode_PERMISSION_DENIED, nil
+	case codes.Unauthenticated:
+		return lowcodev1.ErrorCode_UNAUTHENTICATED, nil
+	}
+	return lowcodev1.ErrorCode_INTERNAL, nil
+}
+
+func withInfo(st *status.Status, code lowcodev1.ErrorCode, meta map[string]string) *status.Status {
+	out, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   code.String(),
+		Domain:   Domain,
+		Metadata: meta,
+	})
+	if err != nil {
+		return st
+	}
+	return out
+}
+
+// UnaryServerInterceptor 为所有 unary RPC 的错误附加错误码。
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, Annotate(err)
+}
+
+// StreamServerInterceptor 为所有 streaming RPC 的错误附加错误码。
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return Annotate(handler(srv, ss))
+}
@@ -0,0 +1,123 @@
+package apierr
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+// PG 错误 metadata 中逻辑名字的键，由 AnnotateWith 的调用方按物理表名 / 列名解析后传入。
+const (
+	MetaTableID     = "table_id"
+	MetaColumnIDs   = "column_ids"   // 逗号分隔
+	MetaColumnNames = "column_names" // 逗号分隔，与 column_ids 一一对应
+)
+
+// PgColumns 返回 PG 错误涉及的物理列名：not-null 等错误取 column 字段，唯一约束和外键错误
+// 从 detail（"Key (a, b)=(...) ..."）中解析。无法确定时返回 nil。
+func PgColumns(pgErr *pgconn.PgError) []string {
+	if pgErr.ColumnName != "" {
+		return []string{pgErr.ColumnName}
+	}
+	rest, ok := strings.CutPrefix(pgErr.Detail, "Key (")
+	if !ok {
+		return nil
+	}
+	list, _, ok := strings.Cut(rest, ")=(")
+	if !ok {
+		return nil
+	}
+	cols := strings.Split(list, ", ")
+	for i, c := range cols {
+		cols[i] = strings.Trim(c, `"`)
+	}
+	return cols
+}
+
+// fromPg 把 PG 错误转换为带错误码的 status 错误。描述不包含 SQL、约束名和 detail 中的值（可能是敏感数据），
+// 原始错误只记录在日志中；物理表名、列名和约束名放在 metadata 的 table / column / constraint 中，
+// names 中的逻辑名字（MetaTableID 等）一并合并进去。
+func fromPg(err error, pgErr *pgconn.PgError, names map[string]string) error {
+	meta := map[string]string{"sqlstate": pgErr.Code}
+	if pgErr.TableName != "" {
+		meta["table"] = pgErr.TableName
+	}
+	if cols := PgColumns(pgErr); len(cols) > 0 {
+		meta["column"] = strings.Join(cols, ",")
+	}
+	if pgErr.ConstraintName != "" {
+		meta["constraint"] = pgErr.ConstraintName
+	}
+	for k, v := range names {
+		if v != "" {
+			meta[k] = v
+		}
+	}
+
+	// 描述中优先使用逻辑名字
+	table := meta[MetaTableID]
+	if table == "" {
+		table = meta["table"]
+	}
+	column := strings.ReplaceAll(meta[MetaColumnNames], ",", ", ")
+	if column == "" {
+		column = strings.ReplaceAll(meta["column"], ",", ", ")
+	}
+
+	c, code, msg := codes.Internal, lowcodev1.ErrorCode_INTERNAL, ""
+	switch {
+	case pgErr.Code == "23505":
+		c, code = codes.AlreadyExists, lowcodev1.ErrorCode_UNIQUE_VIOLATION
+		msg = fmt.Sprintf("a row with the same %s already exists in table %s", orDefault(column, "key"), table)
+	case pgErr.Code == "23503":
+		c, code = codes.FailedPrecondition, lowcodev1.ErrorCode_FOREIGN_KEY_VIOLATION
+		if strings.Contains(pgErr.Detail, "is still referenced") {
+			msg = fmt.Sprintf("row is still referenced from table %s", table)
+		} else {
+			msg = fmt.Sprintf("%s of table %s references a row that does not exist", orDefault(column, "a column"), table)
+		}
+	case pgErr.Code == "23502":
+		c, code = codes.InvalidArgument, lowcodev1.ErrorCode_NOT_NULL_VIOLATION
+		msg = fmt.Sprintf("%s of table %s must not be null", orDefault(column, "a column"), table)
+	case pgErr.Code == "23514":
+		c, code = codes.InvalidArgument, lowcodev1.ErrorCode_VALIDATION_FAILED
+		msg = fmt.Sprintf("row violates a check constraint of table %s", table)
+	case strings.HasPrefix(pgErr.Code, "23"):
+		// 其它完整性约束（如排他约束）
+		c, code = codes.FailedPrecondition, lowcodev1.ErrorCode_VALIDATION_FAILED
+		msg = fmt.Sprintf("row conflicts with an existing row in table %s", table)
+	case strings.HasPrefix(pgErr.Code, "22"):
+		// data exception：message 描述的是值本身（如 invalid input syntax for type integer），不含 SQL
+		c, code, msg = codes.InvalidArgument, lowcodev1.ErrorCode_VALIDATION_FAILED, "invalid value: "+pgErr.Message
+	case pgErr.Code == "42P01":
+		c, code, msg = codes.NotFound, lowcodev1.ErrorCode_TABLE_NOT_FOUND, pgErr.Message
+	case pgErr.Code == "42703":
+		c, code, msg = codes.NotFound, lowcodev1.ErrorCode_COLUMN_NOT_FOUND, pgErr.Message
+	case pgErr.Code == "42P07" || pgErr.Code == "42701" || pgErr.Code == "42710":
+		c, code, msg = codes.AlreadyExists, lowcodev1.ErrorCode_ALREADY_EXISTS, pgErr.Message
+	case pgErr.Code == "3D000":
+		c, code, msg = codes.NotFound, lowcodev1.ErrorCode_TENANT_NOT_FOUND, "tenant database does not exist"
+	case pgErr.Code == "57014":
+		// query_canceled：statement_timeout
+		c, code, msg = codes.DeadlineExceeded, lowcodev1.ErrorCode_STATEMENT_TIMEOUT, "statement canceled after exceeding the time limit"
+	default:
+		msg = fmt.Sprintf("internal database error (SQLSTATE %s)", pgErr.Code)
+	}
+	if code == lowcodev1.ErrorCode_INTERNAL {
+		log.Printf("database error: %v", err)
+	}
+	return withInfo(status.New(c, msg), code, meta).Err()
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,33 @@
+package auth
+
+import "context"
+
+type ctxKey struct{}
+
+var key ctxKey
+
+// WithUserID stores the caller's user id in context.
+func WithUserID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, key, id)
+}
+
+// UserIDFromContext extracts the caller's user id from context if present.
+func UserIDFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(key).(string); ok {
+		return v
+	}
+	return ""
+}
+
+type identityKey struct{}
+
+// WithIdentity stores the identity of an authenticated caller in context.
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns the authenticated caller, or nil when authentication is disabled.
+func IdentityFromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
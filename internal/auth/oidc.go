@@ -0,0 +1,408 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidToken 表示 bearer token 缺失、格式错误、签名不对或 claims 校验失败。
+var ErrInvalidToken = errors.New("invalid token")
+
+const (
+	// clockSkew 是校验 exp / nbf 时允许的时钟误差。
+	clockSkew = time.Minute
+	// jwksMaxAge 后重新获取 JWKS，issuer 轮换的密钥在此时间内生效。
+	jwksMaxAge = time.Hour
+	// jwksMinRefresh 是遇到未知 kid 时两次获取 JWKS 的最小间隔，避免伪造的 kid 让每个请求都访问 issuer。
+	jwksMinRefresh = time.Minute
+)
+
+// OIDCConfig 配置 bearer token 的校验。
+type OIDCConfig struct {
+	Issuer   string // token 的 iss 必须与之相同；JWKS 地址从 {Issuer}/.well-known/openid-configuration 获取
+	Audience string // 非空时 token 的 aud 必须包含它
+	JWKSURL  string // 非空时直接使用，不做 discovery
+
+	TenantClaim string // tenant id 所在的 claim，默认 "tenant_id"；值可以是字符串或字符串数组，"*" 表示任意 tenant
+	UserClaim   string // user id 所在的 claim，默认 "sub"
+}
+
+// Identity 是校验通过的 token 对应的调用方。
+type Identity struct {
+	Subject string
+	UserID  string
+	Tenants []string // token 允许访问的 tenant，"*" 表示任意 tenant
+}
+
+// IsAdmin 报告 token 是否允许访问任意 tenant。这样的调用方是平台管理员：可以管理全部 tenant，在每个 tenant 中都是 owner。
+func (id *Identity) IsAdmin() bool {
+	for _, t := range id.Tenants {
+		if t == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsTenant 报告 token 是否允许访问 tenantID。
+func (id *Identity) AllowsTenant(tenantID string) bool {
+	for _, t := range id.Tenants {
+		if t == "*" || t == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifier 按 OIDC issuer 的 JWKS 校验 JWT。密钥缓存在内存中，遇到未知 kid 或超过 jwksMaxAge 时重新获取。
+type Verifier struct {
+	cfg     OIDCConfig
+	client  *http.Client
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey // kid -> key
+	fetched time.Time
+}
+
+// NewVerifier 创建 Verifier 并获取一次 JWKS，issuer 不可达时启动失败。
+func NewVerifier(ctx context.Context, cfg OIDCConfig) (*Verifier, error) {
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("oidc: issuer is required")
+	}
+	if cfg.TenantClaim == "" {
+		cfg.TenantClaim = "tenant_id"
+	}
+	if cfg.UserClaim == "" {
+		cfg.UserClaim = "sub"
+	}
+	v := &Verifier{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	if v.cfg.JWKSURL == "" {
+		var doc struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := v.getJSON(ctx, strings.TrimRight(cfg.Issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+			return nil, err
+		}
+		if doc.Issuer != cfg.Issuer {
+			return nil, fmt.Errorf("oidc: discovery issuer %q does not match %q", doc.Issuer, cfg.Issuer)
+		}
+		if doc.JWKSURI == "" {
+			return nil, fmt.Errorf("oidc: discovery document of %s has no jwks_uri", cfg.Issuer)
+		}
+		v.cfg.JWKSURL = doc.JWKSURI
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if err := v.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Verify 校验 compact 格式的 JWT 并返回调用方。除网络错误外的失败都包装 ErrInvalidToken。
+func (v *Verifier) Verify(ctx context.Context, token string) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed jwt", ErrInvalidToken)
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrInvalidToken, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrInvalidToken, err)
+	}
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: claims: %v", ErrInvalidToken, err)
+	}
+	if err := v.checkClaims(claims, time.Now()); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	id := &Identity{Tenants: stringsClaim(claims[v.cfg.TenantClaim])}
+	id.Subject, _ = claims["sub"].(string)
+	id.UserID, _ = claims[v.cfg.UserClaim].(string)
+	return id, nil
+}
+
+// checkClaims 校验 iss / aud / exp / nbf。
+func (v *Verifier) checkClaims(claims map[string]any, now time.Time) error {
+	if iss, _ := claims["iss"].(string); iss != v.cfg.Issuer {
+		return fmt.Errorf("unexpected issuer %q", iss)
+	}
+	if v.cfg.Audience != "" {
+		found := false
+		for _, aud := range stringsClaim(claims["aud"]) {
+			if aud == v.cfg.Audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("token is not issued for audience %q", v.cfg.Audience)
+		}
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("exp is required")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return fmt.Errorf("token is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return fmt.Errorf("token is not valid yet")
+	}
+	return nil
+}
+
+// key 返回 kid 对应的公钥；不在缓存中时（issuer 轮换了密钥）按 jwksMinRefresh 限频重新获取 JWKS。
+func (v *Verifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	key, ok := v.lookupLocked(kid)
+	age := time.Since(v.fetched)
+	if ok && age < jwksMaxAge {
+		return key, nil
+	}
+	if !ok && age < jwksMinRefresh {
+		return nil, fmt.Errorf("%w: unknown key id %q", ErrInvalidToken, kid)
+	}
+	if err := v.refreshLocked(ctx); err != nil {
+		if ok {
+			// issuer 暂时不可达时继续使用缓存的密钥
+			return key, nil
+		}
+		return nil, err
+	}
+	if key, ok = v.lookupLocked(kid); !ok {
+		return nil, fmt.Errorf("%w: unknown key id %q", ErrInvalidToken, kid)
+	}
+	return key, nil
+}
+
+// lookupLocked 按 kid 查找密钥；token 没有 kid 时只在 JWKS 仅有一个密钥时使用它。
+func (v *Verifier) lookupLocked(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(v.keys) == 1 {
+		for _, k := range v.keys {
+			return k, true
+		}
+	}
+	k, ok := v.keys[kid]
+	return k, ok
+}
+
+// refreshLocked 获取 JWKS 并替换缓存的密钥，跳过不支持的密钥类型和加密用途的密钥。
+func (v *Verifier) refreshLocked(ctx context.Context) error {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	// 即使失败也记录时间，让 jwksMinRefresh 同样限制失败的重试
+	v.fetched = time.Now()
+	if err := v.getJSON(ctx, v.cfg.JWKSURL, &set); err != nil {
+		return err
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("oidc: no usable signing keys in %s", v.cfg.JWKSURL)
+	}
+	v.keys = keys
+	return nil
+}
+
+func (v *Verifier) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("oidc: get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("oidc: get %s: %s: %s", url, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("oidc: decode %s: %w", url, err)
+	}
+	return nil
+}
+
+// jwk 是 JWKS 中的一个公钥（RFC 7517），只支持 RSA 和 EC。
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid rsa exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("ec point is not on curve %s", k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// verifySignature 校验 RS* / PS* / ES* 签名；none 和 HS* 一律拒绝，token 不能用公钥当 HMAC 密钥。
+func verifySignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg[min(2, len(alg)):] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("alg %s does not match key type", alg)
+		}
+		if alg[0] == 'P' {
+			return rsa.VerifyPSS(pub, hash, digest, sig, nil)
+		}
+		return rsa.VerifyPKCS1v15(pub, hash, digest, sig)
+	case strings.HasPrefix(alg, "ES"):
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("alg %s does not match key type", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return fmt.Errorf("invalid ecdsa signature length")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return fmt.Errorf("ecdsa signature verification failed")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported alg %q", alg)
+}
+
+func decodeSegment(seg string, out any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(raw) == 0 {
+		return nil, fmt.Errorf("invalid key parameter")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
+
+// stringsClaim 把字符串或字符串数组的 claim 转为切片。
+func stringsClaim(v any) []string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, s := range v {
+			if s, ok := s.(string); ok && s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
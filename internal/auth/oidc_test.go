@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testIssuer 是 httptest 上的 OIDC issuer，提供 discovery 文档和可替换的 JWKS。
+type testIssuer struct {
+	srv *httptest.Server
+
+	mu      sync.Mutex
+	keys    []jwk
+	down    bool // JWKS 返回 503
+	fetches int  // JWKS 被获取的次数
+}
+
+func newTestIssuer(t *testing.T, keys ...jwk) *testIssuer {
+	t.Helper()
+	iss := &testIssuer{keys: keys}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": iss.srv.URL, "jwks_uri": iss.srv.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		iss.mu.Lock()
+		defer iss.mu.Unlock()
+		iss.fetches++
+		if iss.down {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"keys": iss.keys})
+	})
+	iss.srv = httptest.NewServer(mux)
+	t.Cleanup(iss.srv.Close)
+	return iss
+}
+
+func (iss *testIssuer) setKeys(keys ...jwk) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	iss.keys = keys
+}
+
+func (iss *testIssuer) setDown(down bool) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	iss.down = down
+}
+
+func (iss *testIssuer) fetchCount() int {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	return iss.fetches
+}
+
+func rsaJWK(kid string, pub *rsa.PublicKey) jwk {
+	return jwk{
+		Kty: "RSA", Kid: kid, Use: "sig",
+		N: base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, pub *ecdsa.PublicKey) jwk {
+	return jwk{
+		Kty: "EC", Kid: kid, Use: "sig", Crv: "P-256",
+		X: base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, 32))),
+		Y: base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+// signToken 生成 compact JWT。key 为 *rsa.PrivateKey（RS256 / PS256）、*ecdsa.PrivateKey（ES256）、
+// []byte（HS256）或 nil（none，签名为空）。
+func signToken(t *testing.T, alg, kid string, key any, claims map[string]any) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	var sig []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		if alg == "PS256" {
+			sig, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest[:], nil)
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		}
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, digest[:])
+		if err == nil {
+			sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signed))
+		sig = mac.Sum(nil)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// TestVerify 覆盖 Verify 的签名和 claims 校验。
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := newTestIssuer(t, rsaJWK("rsa", &rsaKey.PublicKey), ecJWK("ec", &ecKey.PublicKey))
+	v, err := NewVerifier(ctx, OIDCConfig{Issuer: iss.srv.URL, Audience: "lowcode"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+
+	now := time.Now()
+	claims := func(edit func(c map[string]any)) map[string]any {
+		c := map[string]any{
+			"iss":       iss.srv.URL,
+			"aud":       "lowcode",
+			"sub":       "u1",
+			"tenant_id": []string{"a", "b"},
+			"exp":       now.Add(time.Hour).Unix(),
+			"iat":       now.Unix(),
+		}
+		if edit != nil {
+			edit(c)
+		}
+		return c
+	}
+	valid := signToken(t, "RS256", "rsa", rsaKey, claims(nil))
+	parts := strings.Split(valid, ".")
+	otherPayload := strings.Split(signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["sub"] = "admin" })), ".")[1]
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"RS256", valid, false},
+		{"PS256", signToken(t, "PS256", "rsa", rsaKey, claims(nil)), false},
+		{"ES256", signToken(t, "ES256", "ec", ecKey, claims(nil)), false},
+		{"aud array", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["aud"] = []string{"other", "lowcode"} })), false},
+		{"expired within clock skew", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["exp"] = now.Add(-clockSkew / 2).Unix() })), false},
+		{"nbf in the past", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["nbf"] = now.Add(-time.Hour).Unix() })), false},
+
+		{"malformed", "abc.def", true},
+		{"signed by another key", signToken(t, "RS256", "rsa", otherKey, claims(nil)), true},
+		{"payload swapped", parts[0] + "." + otherPayload + "." + parts[2], true},
+		{"truncated signature", valid[:len(valid)-4], true},
+		{"alg none", signToken(t, "none", "rsa", nil, claims(nil)), true},
+		{"HS256 keyed with the public key", signToken(t, "HS256", "rsa", rsaKey.PublicKey.N.Bytes(), claims(nil)), true},
+		{"RS256 header on an EC key", signToken(t, "RS256", "ec", rsaKey, claims(nil)), true},
+		{"expired", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["exp"] = now.Add(-time.Hour).Unix() })), true},
+		{"no exp", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { delete(c, "exp") })), true},
+		{"nbf in the future", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["nbf"] = now.Add(time.Hour).Unix() })), true},
+		{"wrong iss", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["iss"] = "https://evil.example" })), true},
+		{"wrong aud", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { c["aud"] = "other" })), true},
+		{"no aud", signToken(t, "RS256", "rsa", rsaKey, claims(func(c map[string]any) { delete(c, "aud") })), true},
+		{"unknown kid", signToken(t, "RS256", "nope", rsaKey, claims(nil)), true},
+		{"no kid with several keys", signToken(t, "RS256", "", rsaKey, claims(nil)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetches := iss.fetchCount()
+			id, err := v.Verify(ctx, tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("Verify err = %v, want ErrInvalidToken", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Verify: %v", err)
+				}
+				if id.Subject != "u1" || id.UserID != "u1" || !slices.Equal(id.Tenants, []string{"a", "b"}) {
+					t.Errorf("Verify = %+v", id)
+				}
+			}
+			// JWKS 刚获取过，未知 kid 不能触发重新获取
+			if n := iss.fetchCount(); n != fetches {
+				t.Errorf("Verify fetched the JWKS %d times", n-fetches)
+			}
+		})
+	}
+}
+
+// TestVerifyJWKSRefresh 确认 issuer 轮换密钥后：jwksMinRefresh 内未知 kid 直接拒绝，之后重新获取 JWKS 并接受新密钥；
+// 缓存超过 jwksMaxAge 时重新获取，issuer 不可达时继续使用缓存的密钥，未知 kid 返回获取 JWKS 的错误。
+func TestVerifyJWKSRefresh(t *testing.T) {
+	ctx := context.Background()
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := newTestIssuer(t, rsaJWK("k1", &oldKey.PublicKey))
+	v, err := NewVerifier(ctx, OIDCConfig{Issuer: iss.srv.URL, JWKSURL: iss.srv.URL + "/jwks", UserClaim: "email"})
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+	claims := map[string]any{
+		"iss":       iss.srv.URL,
+		"sub":       "u1",
+		"email":     "u1@example.com",
+		"tenant_id": "*",
+		"exp":       time.Now().Add(time.Hour).Unix(),
+	}
+	oldToken := signToken(t, "RS256", "k1", oldKey, claims)
+	newToken := signToken(t, "RS256", "k2", newKey, claims)
+	backdate := func(d time.Duration) {
+		v.mu.Lock()
+		v.fetched = time.Now().Add(-d)
+		v.mu.Unlock()
+	}
+	verify := func(name, token string, wantErr bool, wantFetches int) {
+		t.Helper()
+		id, err := v.Verify(ctx, token)
+		if wantErr != errors.Is(err, ErrInvalidToken) || (!wantErr && err != nil) {
+			t.Fatalf("%s: Verify err = %v, want invalid token = %v", name, err, wantErr)
+		}
+		if err == nil && (id.UserID != "u1@example.com" || !id.IsAdmin()) {
+			t.Errorf("%s: Verify = %+v", name, id)
+		}
+		if n := iss.fetchCount(); n != wantFetches {
+			t.Errorf("%s: JWKS fetched %d times, want %d", name, n, wantFetches)
+		}
+	}
+
+	verify("before rotation", oldToken, false, 1)
+	iss.setKeys(rsaJWK("k2", &newKey.PublicKey))
+	verify("new kid within jwksMinRefresh", newToken, true, 1)
+	backdate(2 * jwksMinRefresh)
+	verify("new kid after jwksMinRefresh", newToken, false, 2)
+	verify("old kid after rotation", oldToken, true, 2)
+
+	iss.setDown(true)
+	backdate(2 * jwksMaxAge)
+	verify("stale cache, issuer down", newToken, false, 3)
+	backdate(2 * jwksMinRefresh)
+	if _, err := v.Verify(ctx, oldToken); err == nil || errors.Is(err, ErrInvalidToken) {
+		t.Errorf("unknown kid, issuer down: Verify err = %v, want the fetch error", err)
+	}
+	if n := iss.fetchCount(); n != 4 {
+		t.Errorf("unknown kid, issuer down: JWKS fetched %d times, want 4", n)
+	}
+}
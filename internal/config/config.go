@@ -10,7 +10,7 @@ import (
 
 // Config holds all service configuration that used to come from env vars.
 type Config struct {
-	// TENANT_MODE: "single" (default) or "multi"
+	// TENANT_MODE: "single" (default), "multi" or "pooled"
 	TenantMode string
 
 	// Single-tenant DSNs.
@@ -20,14 +20,89 @@ type Config struct {
 	// Multi-tenant.
 	TenantDSNTemplate string
 	TenantAdminDB     string
+	// TENANT_POOL_MAX: 最多缓存的 tenant 连接池数，超过时关闭最久未使用的；<= 0 不限制。
+	TenantPoolMax int
+	// TENANT_POOL_IDLE_SECONDS: 空闲超过该时间的 tenant 连接池被关闭；<= 0 不按空闲关闭。
+	TenantPoolIdleSeconds int
+	// TENANT_POOL_MAX_CONNS: 每个 tenant 连接池的默认最大连接数，可在注册表中按 tenant 覆盖。
+	TenantPoolMaxConns int
+	// TENANT_HEALTH_SECONDS: 检查已打开的 tenant 连接池的间隔，失败的池被关闭并重建；<= 0 不检查。
+	TenantHealthSeconds int
+	// TENANT_BOOTSTRAP_FILE: TenantBootstrap 的 JSON，CreateTenant 默认写入新库的表和初始行；为空时建空库。
+	TenantBootstrapFile string
+	// READ_REPLICA_DSN: 只读副本，行查询走副本、写入走主库。multi 模式下是含 %s 的模板（同 TENANT_DSN_TEMPLATE），
+	// 其它模式下是共享库副本的 DSN；为空时不使用副本。
+	ReadReplicaDSN string
+
+	// STATEMENT_TIMEOUT_SECONDS: 处理请求的连接上单条语句的最长执行时间；<= 0 不限制。
+	// 带 deadline 的请求（gRPC deadline / grpc-timeout 头）在剩余时间更短时按剩余时间限制。
+	StatementTimeout int
+
+	// Authentication.
+	// OIDC_ISSUER: 非空时所有请求都要带该 issuer 签发的 bearer token，tenant id 和 user id 取自 token 的 claims，
+	// 不再信任 x-tenant-id / x-user-id 头；为空时不认证（仅适合在可信网关之后部署）。
+	OIDCIssuer      string
+	OIDCAudience    string // OIDC_AUDIENCE: 非空时 token 的 aud 必须包含它
+	OIDCJWKSURL     string // OIDC_JWKS_URL: 为空时从 issuer 的 discovery 文档获取
+	OIDCTenantClaim string // OIDC_TENANT_CLAIM: tenant id 所在的 claim，默认 tenant_id
+	OIDCUserClaim   string // OIDC_USER_CLAIM: user id 所在的 claim，默认 sub
 
 	// Server addresses.
 	GRPCAddr string
 	HTTPAddr string
+	// SHUTDOWN_TIMEOUT_SECONDS: 收到退出信号后等待进行中的请求（含 streaming）结束的最长时间，超时后强制关闭。
+	ShutdownTimeout int
 
 	// MAX_ROW: default and maximum row count per ListRows call.
 	// If <= 0, falls back to internal defaults.
 	MaxRow int
+
+	// MAX_CELL_BYTES: upper bound for streamed cell content uploads.
+	MaxCellBytes int64
+
+	// Request size limits; <= 0 disables the limit.
+	MaxMessageBytes int // MAX_MESSAGE_BYTES: gRPC 消息和 HTTP 请求体的最大字节数
+	MaxBulkItems    int // MAX_BULK_ITEMS: BulkUpsertRows 一次最多的 items 数
+	MaxCellsPerRow  int // MAX_CELLS_PER_ROW: 写入一行时最多的 cells 数
+	MaxValueBytes   int // MAX_VALUE_BYTES: 消息中单个单元格值的最大字节数，更大的内容用流式上传
+
+	// TABLE_RETENTION_DAYS: days archived tables are kept before being purged.
+	// 0 keeps them until PurgeTable is called.
+	ArchiveRetention int
+
+	// Scheduled tenant backups (multi mode, requires STORAGE_PROVIDER).
+	BackupInterval      int // BACKUP_INTERVAL_HOURS: hours between backups of each active tenant, 0 disables them
+	BackupRetentionDays int // BACKUP_RETENTION_DAYS: days backups are kept, 0 keeps them forever
+	BackupKeepLast      int // BACKUP_KEEP_LAST: successful backups always kept per registered tenant
+
+	// Key management (envelope encryption of per-tenant DSNs).
+	// KMS_PROVIDER: "" (disabled), "local", "vault", "aws", "gcp"
+	KMSProvider       string
+	KMSKeyTemplate    string // per-tenant master key id, e.g. "lowcode-%s"
+	KMSLocalKey       string // base64 32-byte master key for provider=local
+	VaultAddr         string
+	VaultToken        string
+	VaultTransitMount string
+	AWSRegion         string // provider=aws
+	AWSKMSEndpoint    string // provider=aws: defaults to https://kms.<region>.amazonaws.com
+	AWSAccessKey      string
+	AWSSecretKey      string
+	AWSSessionToken   string
+	GCPKMSEndpoint    string // provider=gcp: defaults to https://cloudkms.googleapis.com
+	GCPAccessToken    string // provider=gcp: empty uses the GCE/GKE metadata server
+
+	// Attachment storage.
+	// STORAGE_PROVIDER: "" (disabled), "local", "s3", "minio"
+	StorageProvider   string
+	StorageURLTTL     int    // STORAGE_URL_TTL: presigned URL lifetime in seconds
+	StorageLocalDir   string // provider=local: directory for uploaded files
+	StoragePublicURL  string // provider=local: externally reachable base URL of this server
+	StorageSigningKey string // provider=local: HMAC key for presigned URLs
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKey       string
+	S3SecretKey       string
 }
 
 // Load reads configuration from environment variables, optionally populating
@@ -36,14 +111,59 @@ func Load() (*Config, error) {
 	loadDotEnvIfPresent()
 
 	cfg := &Config{
-		TenantMode:        getenvDefault("TENANT_MODE", "single"),
-		SingleDatabaseURL: os.Getenv("SINGLE_DATABASE_URL"),
-		DatabaseURL:       os.Getenv("DATABASE_URL"),
-		TenantDSNTemplate: os.Getenv("TENANT_DSN_TEMPLATE"),
-		TenantAdminDB:     os.Getenv("TENANT_ADMIN_DB"),
-		GRPCAddr:          getenvDefault("GRPC_ADDR", ":9090"),
-		HTTPAddr:          getenvDefault("HTTP_ADDR", ":8080"),
-		MaxRow:            getenvInt("MAX_ROW", 100),
+		TenantMode:            getenvDefault("TENANT_MODE", "single"),
+		SingleDatabaseURL:     os.Getenv("SINGLE_DATABASE_URL"),
+		DatabaseURL:           os.Getenv("DATABASE_URL"),
+		TenantDSNTemplate:     os.Getenv("TENANT_DSN_TEMPLATE"),
+		TenantAdminDB:         os.Getenv("TENANT_ADMIN_DB"),
+		TenantPoolMax:         getenvInt("TENANT_POOL_MAX", 100),
+		TenantPoolIdleSeconds: getenvInt("TENANT_POOL_IDLE_SECONDS", 600),
+		TenantPoolMaxConns:    getenvInt("TENANT_POOL_MAX_CONNS", 10),
+		TenantHealthSeconds:   getenvInt("TENANT_HEALTH_SECONDS", 30),
+		TenantBootstrapFile:   os.Getenv("TENANT_BOOTSTRAP_FILE"),
+		ReadReplicaDSN:        os.Getenv("READ_REPLICA_DSN"),
+		StatementTimeout:      getenvInt("STATEMENT_TIMEOUT_SECONDS", 0),
+		ShutdownTimeout:       getenvInt("SHUTDOWN_TIMEOUT_SECONDS", 30),
+		OIDCIssuer:            os.Getenv("OIDC_ISSUER"),
+		OIDCAudience:          os.Getenv("OIDC_AUDIENCE"),
+		OIDCJWKSURL:           os.Getenv("OIDC_JWKS_URL"),
+		OIDCTenantClaim:       getenvDefault("OIDC_TENANT_CLAIM", "tenant_id"),
+		OIDCUserClaim:         getenvDefault("OIDC_USER_CLAIM", "sub"),
+		GRPCAddr:              getenvDefault("GRPC_ADDR", ":9090"),
+		HTTPAddr:              getenvDefault("HTTP_ADDR", ":8080"),
+		MaxRow:                getenvInt("MAX_ROW", 100),
+		MaxCellBytes:          int64(getenvInt("MAX_CELL_BYTES", 64<<20)),
+		MaxMessageBytes:       getenvInt("MAX_MESSAGE_BYTES", 4<<20),
+		MaxBulkItems:          getenvInt("MAX_BULK_ITEMS", 1000),
+		MaxCellsPerRow:        getenvInt("MAX_CELLS_PER_ROW", 1000),
+		MaxValueBytes:         getenvInt("MAX_VALUE_BYTES", 1<<20),
+		ArchiveRetention:      getenvInt("TABLE_RETENTION_DAYS", 30),
+		BackupInterval:        getenvInt("BACKUP_INTERVAL_HOURS", 0),
+		BackupRetentionDays:   getenvInt("BACKUP_RETENTION_DAYS", 30),
+		BackupKeepLast:        getenvInt("BACKUP_KEEP_LAST", 3),
+		KMSProvider:           os.Getenv("KMS_PROVIDER"),
+		KMSKeyTemplate:        os.Getenv("KMS_KEY_TEMPLATE"),
+		KMSLocalKey:           os.Getenv("KMS_LOCAL_KEY"),
+		VaultAddr:             os.Getenv("VAULT_ADDR"),
+		VaultToken:            os.Getenv("VAULT_TOKEN"),
+		VaultTransitMount:     getenvDefault("VAULT_TRANSIT_MOUNT", "transit"),
+		AWSRegion:             os.Getenv("AWS_REGION"),
+		AWSKMSEndpoint:        os.Getenv("AWS_KMS_ENDPOINT"),
+		AWSAccessKey:          os.Getenv("AWS_ACCESS_KEY_ID"),
+		AWSSecretKey:          os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		AWSSessionToken:       os.Getenv("AWS_SESSION_TOKEN"),
+		GCPKMSEndpoint:        os.Getenv("GCP_KMS_ENDPOINT"),
+		GCPAccessToken:        os.Getenv("GCP_ACCESS_TOKEN"),
+		StorageProvider:       os.Getenv("STORAGE_PROVIDER"),
+		StorageURLTTL:         getenvInt("STORAGE_URL_TTL", 900),
+		StorageLocalDir:       getenvDefault("STORAGE_LOCAL_DIR", "data/attachments"),
+		StoragePublicURL:      os.Getenv("STORAGE_PUBLIC_URL"),
+		StorageSigningKey:     os.Getenv("STORAGE_SIGNING_KEY"),
+		S3Endpoint:            os.Getenv("S3_ENDPOINT"),
+		S3Region:              getenvDefault("S3_REGION", "us-east-1"),
+		S3Bucket:              os.Getenv("S3_BUCKET"),
+		S3AccessKey:           os.Getenv("S3_ACCESS_KEY_ID"),
+		S3SecretKey:           os.Getenv("S3_SECRET_ACCESS_KEY"),
 	}
 
 	// Fallback: if SINGLE_DATABASE_URL is empty, use DATABASE_URL.
@@ -66,8 +186,13 @@ func Load() (*Config, error) {
 		if cfg.TenantDSNTemplate == "" {
 			return nil, fmt.Errorf("TENANT_MODE=multi requires TENANT_DSN_TEMPLATE")
 		}
+	case "pooled":
+		// 应用连接必须受 RLS 约束，安装事件触发器需要超级用户，所以两个 DSN 都要显式给出
+		if os.Getenv("SINGLE_DATABASE_URL") == "" || cfg.DatabaseURL == "" {
+			return nil, fmt.Errorf("TENANT_MODE=pooled requires SINGLE_DATABASE_URL (application role) and DATABASE_URL (admin role)")
+		}
 	default:
-		return nil, fmt.Errorf("invalid TENANT_MODE %q (expected \"single\" or \"multi\")", cfg.TenantMode)
+		return nil, fmt.Errorf("invalid TENANT_MODE %q (expected \"single\", \"multi\" or \"pooled\")", cfg.TenantMode)
 	}
 
 	return cfg, nil
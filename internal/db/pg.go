@@ -3,12 +3,16 @@ package db
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/solat/lowcode-database/internal/auth"
 	"github.com/solat/lowcode-database/internal/config"
 	"github.com/solat/lowcode-database/internal/migrate"
+	"github.com/solat/lowcode-database/internal/tenant"
 )
 
 // NewPool creates a pgx connection pool using config.DatabaseURL (single-tenant mode).
@@ -25,6 +29,20 @@ func NewPool(ctx context.Context) (*pgxpool.Pool, error) {
 
 // NewPoolFromDSN creates a pgx connection pool from a full DSN.
 func NewPoolFromDSN(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	cfg, err := poolConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create pool: %w", err)
+	}
+
+	return pool, nil
+}
+
+// poolConfig 解析 DSN 并设置连接池的默认参数。
+func poolConfig(dsn string) (*pgxpool.Config, error) {
 	cfg, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("parse pool config: %w", err)
@@ -32,7 +50,124 @@ func NewPoolFromDSN(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
 	cfg.MaxConns = 10
 	cfg.MinConns = 1
 	cfg.MaxConnLifetime = time.Hour
+	// DSN 中没有指定 application_name 时使用服务名
+	if cfg.ConnConfig.RuntimeParams["application_name"] == "" {
+		cfg.ConnConfig.RuntimeParams["application_name"] = defaultApplicationName
+	}
+	setSessionIdentity(cfg)
+	return cfg, nil
+}
+
+// defaultApplicationName 是连接的默认 application_name。
+const defaultApplicationName = "lowcode-database"
+
+// setSessionIdentity 让 cfg 的连接在每次借出时按 ctx 设置 app.tenant_id、app.user_id，并把 application_name
+// 设为 "<application_name> <tenant>/<user>"，Postgres 日志（log_line_prefix 的 %a）、RLS 策略和审计触发器
+// 据此区分调用方。没有 tenant / user 的连接（后台任务）设置为空字符串，不会沿用上一次借出时的值。
+// 每次借出都重新设置、不按上次的值跳过：借出期间执行的语句可能用 set_config 改过这些参数，
+// pooled 模式下沿用会让 RLS 按错误的 tenant 过滤。
+func setSessionIdentity(cfg *pgxpool.Config) {
+	base := cfg.ConnConfig.RuntimeParams["application_name"]
+	cfg.BeforeAcquire = func(ctx context.Context, conn *pgx.Conn) bool {
+		tenantID, userID := tenant.FromContext(ctx), auth.UserIDFromContext(ctx)
+		appName := base
+		if tenantID != "" || userID != "" {
+			appName += " " + tenantID + "/" + userID
+		}
+		_, err := conn.Exec(ctx, `
+			SELECT set_config('app.tenant_id', $1, false), set_config('app.user_id', $2, false),
+			       set_config('application_name', $3, false)`, tenantID, userID, appName)
+		return err == nil
+	}
+}
+
+// statementTimeoutSet 记在连接的 CustomData 中，表示本次借出时按 deadline 改过 statement_timeout。
+const statementTimeoutSet = "lc_statement_timeout_set"
+
+type noStatementTimeoutKey struct{}
+
+// WithoutStatementTimeout 让 ctx 借出的连接不受 statement_timeout 限制，用于后台执行的长操作（如建索引）。
+func WithoutStatementTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noStatementTimeoutKey{}, true)
+}
+
+// requestStatementTimeout 返回借出连接时要为 ctx 设置的 statement_timeout（毫秒），不需要改变时返回空字符串。
+func requestStatementTimeout(ctx context.Context, timeout time.Duration) string {
+	if v, _ := ctx.Value(noStatementTimeoutKey{}).(bool); v {
+		if timeout <= 0 {
+			return ""
+		}
+		return "0"
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return ""
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 || (timeout > 0 && remaining >= timeout) {
+		return ""
+	}
+	return strconv.FormatInt(max(remaining.Milliseconds(), 1), 10)
+}
+
+// setStatementTimeout 让 cfg 的连接以 timeout 为 statement_timeout（<= 0 不限制）。借出连接时 ctx 的 deadline
+// 早于 timeout 则把这次借出的 statement_timeout 缩短到剩余时间，超时的语句在服务端被取消而不是继续占用连接；
+// 归还时恢复。
+func setStatementTimeout(cfg *pgxpool.Config, timeout time.Duration) {
+	if timeout > 0 {
+		cfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(timeout.Milliseconds(), 10)
+	}
+	before := cfg.BeforeAcquire
+	cfg.BeforeAcquire = func(ctx context.Context, conn *pgx.Conn) bool {
+		if before != nil && !before(ctx, conn) {
+			return false
+		}
+		v := requestStatementTimeout(ctx, timeout)
+		if v == "" {
+			return true
+		}
+		if _, err := conn.Exec(ctx, `SELECT set_config('statement_timeout', $1, false)`, v); err != nil {
+			return false
+		}
+		conn.PgConn().CustomData()[statementTimeoutSet] = true
+		return true
+	}
+	cfg.AfterRelease = func(conn *pgx.Conn) bool {
+		data := conn.PgConn().CustomData()
+		if data[statementTimeoutSet] == nil {
+			return true
+		}
+		delete(data, statementTimeoutSet)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		// 恢复为连接参数中的值；失败时丢弃连接
+		_, err := conn.Exec(ctx, `RESET statement_timeout`)
+		return err == nil
+	}
+}
+
+// newSinglePool 创建 single 模式处理请求的共享连接池，statement_timeout 见 setStatementTimeout。
+func newSinglePool(ctx context.Context, dsn string, statementTimeout time.Duration) (*pgxpool.Pool, error) {
+	cfg, err := poolConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	setStatementTimeout(cfg, statementTimeout)
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create pool: %w", err)
+	}
+	return pool, nil
+}
 
+// newPooledPool 创建 pooled 模式的共享连接池：借出连接时按 ctx 中的 tenant 设置的 app.tenant_id（见 setSessionIdentity）
+// 让 RLS 策略只放行该 tenant 的行。没有 tenant 的连接（后台任务）看不到任何 tenant 的行。
+func newPooledPool(ctx context.Context, dsn string, statementTimeout time.Duration) (*pgxpool.Pool, error) {
+	cfg, err := poolConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	setStatementTimeout(cfg, statementTimeout)
 	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("create pool: %w", err)
@@ -0,0 +1,17 @@
+package db
+
+import "context"
+
+type strongReadsKey struct{}
+
+// WithStrongReads 让 ReadPoolFor 返回主库的连接池（请求头 x-read-consistency: strong），
+// 用于需要读到自己刚写入数据（read-your-writes）的请求。
+func WithStrongReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, strongReadsKey{}, true)
+}
+
+// StrongReads 返回 ctx 是否要求从主库读取。
+func StrongReads(ctx context.Context) bool {
+	v, _ := ctx.Value(strongReadsKey{}).(bool)
+	return v
+}
@@ -0,0 +1,212 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrBackupRunning is returned by StartBackup when the tenant already has a backup in progress.
+var ErrBackupRunning = errors.New("a backup of the tenant is already running")
+
+// BackupState 是 lc_tenant_backups 中记录的备份状态。
+type BackupState string
+
+const (
+	BackupRunning   BackupState = "running"
+	BackupSucceeded BackupState = "succeeded"
+	BackupFailed    BackupState = "failed"
+)
+
+// BackupTrigger 区分定期备份和 TriggerBackup。
+type BackupTrigger string
+
+const (
+	BackupScheduled BackupTrigger = "scheduled"
+	BackupManual    BackupTrigger = "manual"
+)
+
+// backupStaleAfter 之前开始、仍为 running 的备份视为进程中断遗留，StartBackup 时标记为 failed。
+const backupStaleAfter = 6 * time.Hour
+
+// backupRetryAfter 是定期备份失败后重试的间隔（不超过备份间隔）。
+const backupRetryAfter = time.Hour
+
+// tenantBackupsSQL 创建备份目录。不引用 lc_tenants：tenant 删除后备份仍保留，用于恢复。
+// 同一 tenant 同时只能有一个 running 的备份（多个服务实例的定期备份不会重复）。
+var tenantBackupsSQL = []string{
+	`CREATE TABLE IF NOT EXISTS lc_tenant_backups (
+		id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		tenant_id   TEXT NOT NULL,
+		state       TEXT NOT NULL DEFAULT 'running' CHECK (state IN ('running', 'succeeded', 'failed')),
+		trigger     TEXT NOT NULL CHECK (trigger IN ('scheduled', 'manual')),
+		storage_key TEXT NOT NULL DEFAULT '',
+		size_bytes  BIGINT NOT NULL DEFAULT 0,
+		table_count INT NOT NULL DEFAULT 0,
+		row_count   BIGINT NOT NULL DEFAULT 0,
+		error       TEXT NOT NULL DEFAULT '',
+		started_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+		finished_at TIMESTAMPTZ
+	)`,
+	`CREATE INDEX IF NOT EXISTS lc_tenant_backups_started_at_idx ON lc_tenant_backups (tenant_id, started_at DESC, id DESC)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS lc_tenant_backups_running_idx ON lc_tenant_backups (tenant_id) WHERE state = 'running'`,
+}
+
+// BackupInfo 是 lc_tenant_backups 中的一条备份记录。
+type BackupInfo struct {
+	ID         string
+	TenantID   string
+	State      BackupState
+	Trigger    BackupTrigger
+	StorageKey string
+	SizeBytes  int64
+	TableCount int32
+	RowCount   int64
+	Error      string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+const backupColumns = `id::text, tenant_id, state, trigger, storage_key, size_bytes, table_count, row_count, error, started_at, finished_at`
+
+func scanBackup(row pgx.Row) (*BackupInfo, error) {
+	var b BackupInfo
+	if err := row.Scan(&b.ID, &b.TenantID, &b.State, &b.Trigger, &b.StorageKey, &b.SizeBytes, &b.TableCount, &b.RowCount, &b.Error, &b.StartedAt, &b.FinishedAt); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (m *TenantManager) requireRegistry() error {
+	if m.mode != TenantModeMulti {
+		return fmt.Errorf("tenant management is only supported in multi-tenant mode")
+	}
+	return nil
+}
+
+// StartBackup 为 tenant 登记一个 running 的备份。notWithin > 0 时（定期备份），如果 notWithin 内已有
+// running 或成功的备份、或 backupRetryAfter 内有失败的备份则不登记，返回 nil。只有 active 和 suspended 的 tenant 可以备份。
+func (m *TenantManager) StartBackup(ctx context.Context, tenantID string, trigger BackupTrigger, notWithin time.Duration) (*BackupInfo, error) {
+	if err := m.requireRegistry(); err != nil {
+		return nil, err
+	}
+	var state TenantState
+	err := m.adminPool.QueryRow(ctx, `SELECT state FROM lc_tenants WHERE id = $1`, tenantID).Scan(&state)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrTenantNotFound, tenantID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if state != TenantStateActive && state != TenantStateSuspended {
+		return nil, fmt.Errorf("%w: %s is %s", ErrTenantUnavailable, tenantID, state)
+	}
+
+	if _, err := m.adminPool.Exec(ctx, `
+		UPDATE lc_tenant_backups SET state = 'failed', error = 'interrupted', finished_at = now()
+		WHERE tenant_id = $1 AND state = 'running' AND started_at < now() - make_interval(secs => $2)`,
+		tenantID, backupStaleAfter.Seconds()); err != nil {
+		return nil, err
+	}
+	b, err := scanBackup(m.adminPool.QueryRow(ctx, `
+		INSERT INTO lc_tenant_backups (tenant_id, trigger)
+		SELECT $1::text, $2::text
+		WHERE $3::float8 <= 0 OR NOT EXISTS (
+			SELECT 1 FROM lc_tenant_backups
+			WHERE tenant_id = $1 AND (
+				(state IN ('running', 'succeeded') AND started_at > now() - make_interval(secs => $3))
+				OR (state = 'failed' AND started_at > now() - make_interval(secs => $4::float8))))
+		RETURNING `+backupColumns, tenantID, string(trigger), notWithin.Seconds(), min(notWithin, backupRetryAfter).Seconds()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return nil, fmt.Errorf("%w: %s", ErrBackupRunning, tenantID)
+	}
+	return b, err
+}
+
+// FinishBackup 记录备份的结果：backupErr 为 nil 时为成功，storageKey 等为归档的信息。
+func (m *TenantManager) FinishBackup(ctx context.Context, id, storageKey string, sizeBytes int64, tableCount int32, rowCount int64, backupErr error) (*BackupInfo, error) {
+	state, msg := BackupSucceeded, ""
+	if backupErr != nil {
+		state, msg = BackupFailed, backupErr.Error()
+	}
+	return scanBackup(m.adminPool.QueryRow(ctx, `
+		UPDATE lc_tenant_backups
+		SET state = $2, storage_key = $3, size_bytes = $4, table_count = $5, row_count = $6, error = $7, finished_at = now()
+		WHERE id = $1::uuid
+		RETURNING `+backupColumns, id, string(state), storageKey, sizeBytes, tableCount, rowCount, msg))
+}
+
+// ListBackups 按开始时间从新到旧列出备份，tenantID / state 为空时不过滤。after 为上一页最后一条的 id，
+// 最多返回 limit 条；还有更多时 more 为 true。
+func (m *TenantManager) ListBackups(ctx context.Context, tenantID string, state BackupState, after string, limit int) (backups []*BackupInfo, more bool, err error) {
+	if err := m.requireRegistry(); err != nil {
+		return nil, false, err
+	}
+	rows, err := m.adminPool.Query(ctx, `
+		SELECT `+backupColumns+` FROM lc_tenant_backups b
+		WHERE ($1 = '' OR b.tenant_id = $1) AND ($2 = '' OR b.state = $2)
+		  AND ($3 = '' OR (b.started_at, b.id) < (SELECT started_at, id FROM lc_tenant_backups WHERE id::text = $3))
+		ORDER BY b.started_at DESC, b.id DESC
+		LIMIT $4`, tenantID, string(state), after, limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	backups, err = pgx.CollectRows(rows, func(r pgx.CollectableRow) (*BackupInfo, error) { return scanBackup(r) })
+	if err != nil {
+		return nil, false, err
+	}
+	if len(backups) > limit {
+		return backups[:limit], true, nil
+	}
+	return backups, false, nil
+}
+
+// ExpiredBackups 返回 before 之前开始的已结束备份，但仍在注册表中的 tenant 保留最近 keep 个成功的备份。
+func (m *TenantManager) ExpiredBackups(ctx context.Context, before time.Time, keep int) ([]*BackupInfo, error) {
+	if err := m.requireRegistry(); err != nil {
+		return nil, err
+	}
+	rows, err := m.adminPool.Query(ctx, `
+		SELECT `+backupColumns+` FROM (
+			SELECT b.*,
+				row_number() OVER (PARTITION BY b.tenant_id, b.state ORDER BY b.started_at DESC) AS n,
+				EXISTS (SELECT 1 FROM lc_tenants t WHERE t.id = b.tenant_id) AS registered
+			FROM lc_tenant_backups b
+			WHERE b.state <> 'running'
+		) x
+		WHERE x.started_at < $1 AND NOT (x.state = 'succeeded' AND x.registered AND x.n <= $2)
+		ORDER BY x.started_at`, before, keep)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(r pgx.CollectableRow) (*BackupInfo, error) { return scanBackup(r) })
+}
+
+// DeleteBackup 删除备份记录，对象存储中的归档由调用方先删除。
+func (m *TenantManager) DeleteBackup(ctx context.Context, id string) error {
+	if err := m.requireRegistry(); err != nil {
+		return err
+	}
+	_, err := m.adminPool.Exec(ctx, `DELETE FROM lc_tenant_backups WHERE id = $1::uuid`, id)
+	return err
+}
+
+// BackupCandidates 返回定期备份要检查的 tenant：所有 active 的 tenant。suspended 的 tenant 数据不再变化，不做定期备份。
+func (m *TenantManager) BackupCandidates(ctx context.Context) ([]string, error) {
+	if err := m.requireRegistry(); err != nil {
+		return nil, err
+	}
+	rows, err := m.adminPool.Query(ctx, `SELECT id FROM lc_tenants WHERE state = 'active' ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/solat/lowcode-database/internal/config"
+)
+
+// newTestTenantManager 用 LC_TEST_TENANT_DSN_TEMPLATE（同 TENANT_DSN_TEMPLATE，需要 CREATEDB 权限）创建 multi 模式的
+// TenantManager，未设置时跳过测试。
+func newTestTenantManager(t *testing.T) *TenantManager {
+	t.Helper()
+	tpl := os.Getenv("LC_TEST_TENANT_DSN_TEMPLATE")
+	if tpl == "" {
+		t.Skip("LC_TEST_TENANT_DSN_TEMPLATE is not set")
+	}
+	ctx := context.Background()
+	m, err := NewTenantManager(ctx, &config.Config{TenantMode: string(TenantModeMulti), TenantDSNTemplate: tpl, TenantPoolMax: 10})
+	if err != nil {
+		t.Fatalf("NewTenantManager: %v", err)
+	}
+	t.Cleanup(func() { m.Close(ctx) })
+	return m
+}
+
+// testTenantID 返回本次测试唯一的 tenant id，测试结束时删除该 tenant。
+func testTenantID(t *testing.T, m *TenantManager, prefix string) string {
+	t.Helper()
+	id := prefix + strconv.FormatInt(time.Now().UnixNano(), 36)
+	t.Cleanup(func() { m.DeleteTenant(context.Background(), id) })
+	return id
+}
+
+func TestCloneTenantWithoutDataClearsHistory(t *testing.T) {
+	m := newTestTenantManager(t)
+	ctx := context.Background()
+	source := testTenantID(t, m, "lc_test_src_")
+	target := testTenantID(t, m, "lc_test_dst_")
+	if err := m.CreateTenant(ctx, source, "", nil); err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+
+	pool, err := m.OpenTenant(ctx, source)
+	if err != nil {
+		t.Fatalf("OpenTenant: %v", err)
+	}
+	for _, stmt := range []string{
+		`INSERT INTO lc_tables (name, schema_name, table_name) VALUES ('t', 'public', 'lc_t_t')`,
+		`INSERT INTO lc_row_history (table_id, row_id, action, row, column_ids) VALUES ('t', gen_random_uuid(), 'create', '{}', '{}')`,
+		`INSERT INTO lc_audit_log (method, code) VALUES ('CreateRow', 'OK')`,
+	} {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			pool.Close()
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+	pool.Close()
+
+	if _, err := m.CloneTenant(ctx, source, target, false, true); err != nil {
+		t.Fatalf("CloneTenant: %v", err)
+	}
+	pool, err = m.OpenTenant(ctx, target)
+	if err != nil {
+		t.Fatalf("OpenTenant: %v", err)
+	}
+	defer pool.Close()
+
+	tests := []struct {
+		table string
+		want  int
+	}{
+		{"lc_tables", 1},
+		{"lc_row_history", 0},
+		{"lc_audit_log", 0},
+	}
+	for _, tt := range tests {
+		var n int
+		if err := pool.QueryRow(ctx, `SELECT count(*) FROM `+tt.table).Scan(&n); err != nil {
+			t.Fatalf("count %s: %v", tt.table, err)
+		}
+		if n != tt.want {
+			t.Errorf("%s has %d rows after clone without data, want %d", tt.table, n, tt.want)
+		}
+	}
+}
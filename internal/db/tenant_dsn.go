@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrInvalidDSN is returned when a per-tenant DSN cannot be parsed.
+var ErrInvalidDSN = errors.New("invalid tenant dsn")
+
+// ErrDSNEncryptionUnavailable is returned when a per-tenant DSN is set without KMS_PROVIDER.
+var ErrDSNEncryptionUnavailable = errors.New("per-tenant dsn requires KMS_PROVIDER to encrypt its credentials")
+
+// maintenanceDB 是在专属实例上建库 / 删库时连接的库。
+const maintenanceDB = "postgres"
+
+// tenantDSN 返回 tenant 库的连接串：注册表中有专属 DSN 时解密使用，否则按 TENANT_DSN_TEMPLATE 生成。
+func (m *TenantManager) tenantDSN(ctx context.Context, tenantID string) (string, error) {
+	var sealed []byte
+	if err := m.adminPool.QueryRow(ctx, `SELECT dsn FROM lc_tenants WHERE id = $1`, tenantID).Scan(&sealed); err != nil {
+		return "", fmt.Errorf("load tenant %s: %w", tenantID, err)
+	}
+	return m.openDSN(ctx, tenantID, sealed)
+}
+
+// openDSN 解密注册表中的 dsn 列，为空时使用模板。
+func (m *TenantManager) openDSN(ctx context.Context, tenantID string, sealed []byte) (string, error) {
+	if sealed == nil {
+		return fmt.Sprintf(m.tenantTemplate, tenantID), nil
+	}
+	if m.keyring == nil {
+		return "", fmt.Errorf("%w: tenant %s has a dsn override", ErrDSNEncryptionUnavailable, tenantID)
+	}
+	dsn, err := m.keyring.Open(ctx, tenantID, sealed)
+	if err != nil {
+		return "", fmt.Errorf("decrypt dsn of tenant %s: %w", tenantID, err)
+	}
+	return string(dsn), nil
+}
+
+// openReplicaDSN 返回 tenant 只读副本的连接串：注册表中有专属副本时解密使用；否则对使用模板的 tenant
+// 按 READ_REPLICA_DSN 生成，专属 DSN 的 tenant 不在模板的实例上，没有副本。返回空字符串表示没有副本。
+func (m *TenantManager) openReplicaDSN(ctx context.Context, tenantID string, sealed, sealedReplica []byte) (string, error) {
+	if sealedReplica != nil {
+		return m.openDSN(ctx, tenantID, sealedReplica)
+	}
+	if sealed != nil || m.replicaTemplate == "" {
+		return "", nil
+	}
+	return fmt.Sprintf(m.replicaTemplate, tenantID), nil
+}
+
+// sealDSN 校验并加密专属 DSN，同时返回不含凭据的 host:port/database 用于展示。
+// 密文绑定 tenant id，不能挪给其它 tenant 使用。
+func (m *TenantManager) sealDSN(ctx context.Context, tenantID, dsn string) ([]byte, string, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		// 解析错误可能带有原文（含密码），不透出
+		return nil, "", fmt.Errorf("%w for tenant %s", ErrInvalidDSN, tenantID)
+	}
+	if cfg.ConnConfig.Database == "" {
+		return nil, "", fmt.Errorf("%w for tenant %s: database name is required", ErrInvalidDSN, tenantID)
+	}
+	if m.keyring == nil {
+		return nil, "", ErrDSNEncryptionUnavailable
+	}
+	sealed, err := m.keyring.Seal(ctx, tenantID, []byte(dsn))
+	if err != nil {
+		return nil, "", fmt.Errorf("encrypt dsn of tenant %s: %w", tenantID, err)
+	}
+	host := cfg.ConnConfig.Host + ":" + strconv.Itoa(int(cfg.ConnConfig.Port)) + "/" + cfg.ConnConfig.Database
+	return sealed, host, nil
+}
+
+// maintenancePool 为专属 DSN 所在的实例打开一个连到 maintenanceDB 的临时连接池，用于建库 / 删库，调用方负责关闭。
+// 同时返回 DSN 中的库名。
+func maintenancePool(ctx context.Context, dsn string) (*pgxpool.Pool, string, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, "", ErrInvalidDSN
+	}
+	dbName := cfg.ConnConfig.Database
+	cfg.ConnConfig.Database = maintenanceDB
+	cfg.MaxConns = 2
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, "", err
+	}
+	return pool, dbName, nil
+}
+
+// ResealTenantDSN 在 KMS 轮换 tenant 的数据密钥后，用新密钥重新加密注册表中的专属 DSN 和只读副本 DSN，
+// 返回重写的连接串个数。只在 multi 模式下有意义，其它模式返回 0。
+func (m *TenantManager) ResealTenantDSN(ctx context.Context, tenantID string) (int, error) {
+	if m.mode != TenantModeMulti {
+		return 0, nil
+	}
+	tx, err := m.adminPool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+	var sealed, sealedReplica []byte
+	if err := tx.QueryRow(ctx, `SELECT dsn, replica_dsn FROM lc_tenants WHERE id = $1 FOR UPDATE`, tenantID).Scan(&sealed, &sealedReplica); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%w: %s", ErrTenantNotFound, tenantID)
+		}
+		return 0, err
+	}
+	n := 0
+	for _, p := range []*[]byte{&sealed, &sealedReplica} {
+		if *p == nil {
+			continue
+		}
+		if m.keyring == nil {
+			return 0, fmt.Errorf("%w: tenant %s has a dsn override", ErrDSNEncryptionUnavailable, tenantID)
+		}
+		if *p, err = m.keyring.Reencrypt(ctx, tenantID, *p); err != nil {
+			return 0, fmt.Errorf("re-encrypt dsn of tenant %s: %w", tenantID, err)
+		}
+		n++
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	if _, err := tx.Exec(ctx, `UPDATE lc_tenants SET dsn = $2, replica_dsn = $3, updated_at = now() WHERE id = $1`, tenantID, sealed, sealedReplica); err != nil {
+		return 0, err
+	}
+	return n, tx.Commit(ctx)
+}
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// tenantPingTimeout 是健康检查中单次 ping 的超时。
+	tenantPingTimeout = 5 * time.Second
+	// tenantHealthConcurrency 是一轮健康检查中同时 ping 的池数量。
+	tenantHealthConcurrency = 8
+)
+
+// TenantHealth 是本进程对一个 tenant 连接池最近一次健康检查的结果。
+type TenantHealth struct {
+	TenantID            string
+	Healthy             bool
+	PoolOpen            bool // 检查失败的池被关闭，重建成功前为 false
+	CheckedAt           time.Time
+	Latency             time.Duration
+	LastError           string
+	ConsecutiveFailures int
+	Reconnects          int // 检查失败后重建连接池成功的次数
+}
+
+// healthCheckLoop 每隔 healthInterval 检查一次已打开的 tenant 连接池，直到 Close。
+func (m *TenantManager) healthCheckLoop() {
+	// Close 时取消进行中的检查
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-m.done
+		cancel()
+	}()
+	ticker := time.NewTicker(m.healthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+		}
+		m.checkTenantPools(ctx)
+	}
+}
+
+// checkTenantPools ping 每个已打开的 tenant 连接池。失败的池从缓存中移除并关闭，下次访问时重建；
+// 上一轮失败、尚未重建的 tenant 由这里尝试重建，tenant 库重启后不必等到有请求才恢复。
+func (m *TenantManager) checkTenantPools(ctx context.Context) {
+	m.mu.Lock()
+	pools := make(map[string]*tenantPool, len(m.pools))
+	for id, tp := range m.pools {
+		pools[id] = tp
+	}
+	var broken []string
+	for id, h := range m.health {
+		if _, ok := pools[id]; ok {
+			continue
+		}
+		if h.Healthy {
+			// 池已被正常淘汰或关闭，不再跟踪
+			delete(m.health, id)
+		} else {
+			broken = append(broken, id)
+		}
+	}
+	m.mu.Unlock()
+
+	sem := make(chan struct{}, tenantHealthConcurrency)
+	var wg sync.WaitGroup
+	for id, tp := range pools {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string, tp *tenantPool) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			m.checkPool(ctx, id, tp)
+		}(id, tp)
+	}
+	for _, id := range broken {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			m.reconnectPool(ctx, id)
+		}(id)
+	}
+	wg.Wait()
+}
+
+// checkPool ping 一个已缓存的池并记录结果，失败时把它移出缓存。
+func (m *TenantManager) checkPool(ctx context.Context, tenantID string, tp *tenantPool) {
+	latency, err := pingPool(ctx, tp)
+	if ctx.Err() != nil {
+		// 进程退出中，不把取消当作故障
+		return
+	}
+	if err != nil {
+		m.mu.Lock()
+		current := m.pools[tenantID] == tp
+		if current {
+			delete(m.pools, tenantID)
+		}
+		m.mu.Unlock()
+		if !current {
+			// 检查期间池已被替换或关闭，结果不代表当前的池
+			return
+		}
+		m.closeLater(tp)
+	}
+	m.recordHealth(tenantID, latency, err, false)
+}
+
+// reconnectPool 为上一轮检查失败的 tenant 重建连接池。tenant 已被删除或不再是 active 时停止跟踪。
+func (m *TenantManager) reconnectPool(ctx context.Context, tenantID string) {
+	cs, err := m.checkTenantState(ctx, tenantID)
+	if errors.Is(err, ErrTenantNotFound) || errors.Is(err, ErrTenantSuspended) || errors.Is(err, ErrTenantUnavailable) {
+		m.mu.Lock()
+		delete(m.health, tenantID)
+		m.mu.Unlock()
+		return
+	}
+	if err == nil {
+		_, err = m.poolForTenant(ctx, tenantID, cs)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	var latency time.Duration
+	if err == nil {
+		m.mu.RLock()
+		tp, ok := m.pools[tenantID]
+		m.mu.RUnlock()
+		if !ok {
+			return
+		}
+		latency, err = pingPool(ctx, tp)
+	}
+	m.recordHealth(tenantID, latency, err, err == nil)
+}
+
+// pingPool 在 tenantPingTimeout 内 ping 一次池（有只读副本时也 ping 副本）。不刷新 lastUsed，健康检查不会让池免于空闲淘汰。
+func pingPool(ctx context.Context, tp *tenantPool) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantPingTimeout)
+	defer cancel()
+	start := time.Now()
+	err := tp.pool.Ping(ctx)
+	if err == nil && tp.replica != nil {
+		if err = tp.replica.Ping(ctx); err != nil {
+			err = fmt.Errorf("read replica: %w", err)
+		}
+	}
+	return time.Since(start), err
+}
+
+// recordHealth 记录一次检查结果，reconnected 表示本次检查重建了之前失败的池。
+func (m *TenantManager) recordHealth(tenantID string, latency time.Duration, err error, reconnected bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h, ok := m.health[tenantID]
+	if !ok {
+		h = &TenantHealth{TenantID: tenantID}
+		m.health[tenantID] = h
+	}
+	h.CheckedAt = time.Now()
+	h.Latency = latency
+	_, h.PoolOpen = m.pools[tenantID]
+	if err != nil {
+		h.Healthy = false
+		h.LastError = err.Error()
+		h.ConsecutiveFailures++
+		return
+	}
+	h.Healthy = true
+	h.ConsecutiveFailures = 0
+	if reconnected {
+		h.Reconnects++
+	}
+}
+
+// PoolHealth 返回本进程已打开连接池、以及最近检查失败的 tenant 的健康状态，按 tenant id 排序。
+// refresh 为 true 时先同步执行一轮检查；否则返回上一轮的结果，尚未检查过的池只有 PoolOpen。
+func (m *TenantManager) PoolHealth(ctx context.Context, refresh bool) ([]TenantHealth, error) {
+	if m.mode != TenantModeMulti {
+		return nil, fmt.Errorf("tenant management is only supported in multi-tenant mode")
+	}
+	if refresh {
+		m.checkTenantPools(ctx)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	m.mu.RLock()
+	out := make([]TenantHealth, 0, len(m.pools))
+	for id, h := range m.health {
+		cp := *h
+		_, cp.PoolOpen = m.pools[id]
+		out = append(out, cp)
+	}
+	for id := range m.pools {
+		if _, ok := m.health[id]; !ok {
+			out = append(out, TenantHealth{TenantID: id, PoolOpen: true})
+		}
+	}
+	m.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].TenantID < out[j].TenantID })
+	return out, nil
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// TenantColumn 是 pooled 模式下共享物理表上记录行所属 tenant 的列。
+	TenantColumn = "tenant_id"
+	// TenantPolicy 是按 TenantColumn 过滤行的 RLS 策略名，表上有它即表示按 tenant 隔离。
+	TenantPolicy = "lc_tenant_isolation"
+)
+
+// tenantIsolationSQL 在共享库中安装 pooled 模式的隔离机制：
+//   - lc_enable_tenant_isolation(t) 为表加上 tenant_id 列（默认取 app.tenant_id）并启用 FORCE RLS，
+//     已有的行 tenant_id 为空，对任何 tenant 都不可见；
+//   - 事件触发器在建表（lc_t_* 数据表、lc_j_* 关联表）后自动调用它，分区继承父表的列，由父表的策略覆盖；
+//   - 成员表 lc_members、权限规则表 lc_permissions、审计日志 lc_audit_log、行历史 lc_row_history
+//     和 webhook（lc_webhooks、lc_webhook_deliveries）同样隔离，user_id 只在 tenant 内唯一。
+//
+// 事件触发器只能由超级用户创建，所以这段 SQL 通过 admin DSN 执行；函数本身以调用者身份运行。
+const tenantIsolationSQL = `
+CREATE OR REPLACE FUNCTION lc_enable_tenant_isolation(t regclass) RETURNS void LANGUAGE plpgsql AS $$
+BEGIN
+	EXECUTE format('ALTER TABLE %s ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT ''''', t);
+	EXECUTE format('ALTER TABLE %s ALTER COLUMN tenant_id SET DEFAULT current_setting(''app.tenant_id'')', t);
+	EXECUTE format('ALTER TABLE %s ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY', t);
+	IF NOT EXISTS (SELECT 1 FROM pg_policy WHERE polrelid = t AND polname = 'lc_tenant_isolation') THEN
+		EXECUTE format('CREATE POLICY lc_tenant_isolation ON %s
+			USING (tenant_id = current_setting(''app.tenant_id'', true))
+			WITH CHECK (tenant_id = current_setting(''app.tenant_id'', true))', t);
+	END IF;
+END
+$$;
+
+CREATE OR REPLACE FUNCTION lc_tenant_isolation_trigger() RETURNS event_trigger LANGUAGE plpgsql AS $$
+DECLARE
+	r record;
+BEGIN
+	FOR r IN
+		SELECT c.oid FROM pg_event_trigger_ddl_commands() e
+		JOIN pg_class c ON c.oid = e.objid
+		WHERE e.object_type = 'table' AND NOT c.relispartition
+		  AND (c.relname LIKE 'lc\_t\_%' OR c.relname LIKE 'lc\_j\_%')
+	LOOP
+		PERFORM lc_enable_tenant_isolation(r.oid::regclass);
+	END LOOP;
+END
+$$;
+
+DROP EVENT TRIGGER IF EXISTS lc_tenant_isolation;
+CREATE EVENT TRIGGER lc_tenant_isolation ON ddl_command_end
+	WHEN TAG IN ('CREATE TABLE', 'CREATE TABLE AS', 'SELECT INTO')
+	EXECUTE FUNCTION lc_tenant_isolation_trigger();
+
+-- 已有的数据表、关联表、blob（单元格内容）表、成员表、权限规则表、审计日志、行历史和 webhook
+SELECT lc_enable_tenant_isolation(c.oid)
+FROM pg_class c
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition
+  AND (c.relname LIKE 'lc\_t\_%' OR c.relname LIKE 'lc\_j\_%' OR c.relname IN ('lc_blobs', 'lc_blob_chunks', 'lc_members', 'lc_permissions', 'lc_audit_log', 'lc_row_history', 'lc_webhooks', 'lc_webhook_deliveries'));
+
+DROP INDEX IF EXISTS lc_members_user_id_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS lc_members_tenant_user_id_idx ON lc_members (tenant_id, user_id);
+`
+
+// installTenantIsolation 用 admin DSN 连接共享库 dbName 并安装 tenantIsolationSQL，可重复执行。
+func installTenantIsolation(ctx context.Context, adminDSN, dbName string) error {
+	dsn, err := dsnWithDatabase(adminDSN, dbName)
+	if err != nil {
+		return err
+	}
+	pool, err := NewPoolFromDSN(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	if _, err := pool.Exec(ctx, tenantIsolationSQL); err != nil {
+		return fmt.Errorf("install tenant isolation: %w", err)
+	}
+	return nil
+}
+
+// checkRLSRole 确认应用连接受 RLS 约束：超级用户和 BYPASSRLS 角色会绕过所有策略，pooled 模式不能使用。
+func checkRLSRole(ctx context.Context, pool *pgxpool.Pool) error {
+	var role string
+	var bypass bool
+	if err := pool.QueryRow(ctx, `SELECT rolname, rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`).Scan(&role, &bypass); err != nil {
+		return err
+	}
+	if bypass {
+		return fmt.Errorf("role %q bypasses row level security; TENANT_MODE=pooled requires SINGLE_DATABASE_URL to use a role without SUPERUSER or BYPASSRLS", role)
+	}
+	return nil
+}
+
+// dsnWithDatabase 把 URL 形式 DSN 的库名替换为 dbName。
+func dsnWithDatabase(dsn, dbName string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", err
+	}
+	u.Path = "/" + dbName
+	return u.String(), nil
+}
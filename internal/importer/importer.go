@@ -0,0 +1,556 @@
+// Package importer 解析 Airtable / Notion 的导出文件，推断字段类型并尽量还原表之间的 link。
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SourceAirtable = "airtable"
+	SourceNotion   = "notion"
+)
+
+// 字段类型，和内置 lc_types 的 id 一一对应（link 除外）。
+const (
+	KindText      = "text"
+	KindNumber    = "number"
+	KindBool      = "bool"
+	KindTimestamp = "timestamp"
+	KindJSON      = "json"
+	KindLink      = "link"
+
+	KindSingleSelect = "single_select"
+	KindMultiSelect  = "multi_select"
+)
+
+type Field struct {
+	Name string
+	Kind string
+
+	// 仅 KindLink：目标表名；Multiple 表示存在一条记录链接多条目标记录。
+	Target   string
+	Multiple bool
+
+	// 仅选择类型：按首次出现顺序排列的全部取值。
+	Options []string
+}
+
+type Record struct {
+	// ID 是源系统中的记录 id（Airtable recXXX / Notion page id），CSV 没有 id 时为行号。
+	ID     string
+	Title  string
+	Values map[string]any
+}
+
+type Table struct {
+	Name    string
+	Fields  []Field
+	Records []Record
+
+	// links 保存 link 字段的原始值（记录 id 或标题），ResolveLinks 后转为目标记录 id。
+	links map[string]bool
+	// rowNumberIDs 表示记录 id 是行号（CSV 或没有 id 的 JSON），不能用来匹配 link。
+	rowNumberIDs bool
+}
+
+// Parse 解析单个导出文件。format 为 csv 或 json。
+func Parse(source, format, name string, data []byte) (*Table, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	t := &Table{Name: name, links: map[string]bool{}}
+	var err error
+	switch {
+	case format == "csv":
+		err = t.parseCSV(source, data)
+	case format == "json" && source == SourceAirtable:
+		err = t.parseAirtableJSON(data)
+	case format == "json" && source == SourceNotion:
+		err = t.parseNotionJSON(data)
+	default:
+		return nil, fmt.Errorf("unsupported import source/format %q/%q", source, format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s %s export %q: %w", source, format, name, err)
+	}
+	for i := range t.Fields {
+		f := &t.Fields[i]
+		if f.Kind == "" {
+			f.Kind = t.inferKind(f.Name)
+		}
+		if f.Kind == KindSingleSelect || f.Kind == KindMultiSelect {
+			f.Options = t.selectOptions(f.Name)
+		}
+	}
+	return t, nil
+}
+
+func (t *Table) parseCSV(source string, data []byte) error {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	lines, err := r.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return fmt.Errorf("empty csv")
+	}
+	for _, h := range lines[0] {
+		t.Fields = append(t.Fields, Field{Name: strings.TrimSpace(h)})
+	}
+	t.rowNumberIDs = true
+	for i, line := range lines[1:] {
+		rec := Record{ID: strconv.Itoa(i + 1), Values: map[string]any{}}
+		for j, v := range line {
+			if j >= len(t.Fields) || v == "" {
+				continue
+			}
+			rec.Values[t.Fields[j].Name] = v
+			if j == 0 {
+				rec.Title = v
+			}
+			// Notion 的 relation 导出为 "Title (https://www.notion.so/...)"，以标题匹配目标表。
+			if source == SourceNotion && strings.Contains(v, "notion.so/") {
+				t.links[t.Fields[j].Name] = true
+				rec.Values[t.Fields[j].Name] = notionTitles(v)
+			}
+		}
+		t.Records = append(t.Records, rec)
+	}
+	return nil
+}
+
+var notionRelRe = regexp.MustCompile(`([^,]+?)\s*\(https://www\.notion\.so/[^)]*\)`)
+
+func notionTitles(v string) []any {
+	var out []any
+	for _, m := range notionRelRe.FindAllStringSubmatch(v, -1) {
+		out = append(out, strings.TrimSpace(m[1]))
+	}
+	return out
+}
+
+var airtableRecRe = regexp.MustCompile(`^rec[A-Za-z0-9]{14}$`)
+
+// parseAirtableJSON 支持 API 格式 {"records":[{"id","fields"}]} 以及扁平对象数组。
+func (t *Table) parseAirtableJSON(data []byte) error {
+	var raws []json.RawMessage
+	var wrapper struct {
+		Records []json.RawMessage `json:"records"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err == nil && wrapper.Records != nil {
+		raws = wrapper.Records
+	} else if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for i, raw := range raws {
+		var item struct {
+			ID     string          `json:"id"`
+			Fields json.RawMessage `json:"fields"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return err
+		}
+		body := item.Fields
+		if body == nil {
+			body = raw
+		}
+		keys, err := orderedKeys(body)
+		if err != nil {
+			return err
+		}
+		var values map[string]any
+		if err := json.Unmarshal(body, &values); err != nil {
+			return err
+		}
+		if item.Fields == nil {
+			delete(values, "id")
+		}
+		rec := Record{ID: item.ID, Values: values}
+		if rec.ID == "" {
+			rec.ID = strconv.Itoa(i + 1)
+			t.rowNumberIDs = true
+		}
+		for _, k := range keys {
+			if item.Fields == nil && k == "id" {
+				continue
+			}
+			if !seen[k] {
+				seen[k] = true
+				t.Fields = append(t.Fields, Field{Name: k})
+			}
+			if list, ok := values[k].([]any); ok && len(list) > 0 && allRecordIDs(list) {
+				t.links[k] = true
+			}
+		}
+		if len(t.Fields) > 0 {
+			if s, ok := values[t.Fields[0].Name].(string); ok {
+				rec.Title = s
+			}
+		}
+		t.Records = append(t.Records, rec)
+	}
+	return nil
+}
+
+func allRecordIDs(list []any) bool {
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok || !airtableRecRe.MatchString(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseNotionJSON 解析 Notion API databases.query 的结果 {"results":[{"id","properties"}]}，
+// Notion 自带属性类型，不需要推断。
+func (t *Table) parseNotionJSON(data []byte) error {
+	var doc struct {
+		Results []struct {
+			ID         string                     `json:"id"`
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	kinds := map[string]string{}
+	for _, page := range doc.Results {
+		rec := Record{ID: page.ID, Values: map[string]any{}}
+		names := make([]string, 0, len(page.Properties))
+		for name := range page.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			raw := page.Properties[name]
+			var prop map[string]any
+			if err := json.Unmarshal(raw, &prop); err != nil {
+				return err
+			}
+			typ, _ := prop["type"].(string)
+			kind, val := notionValue(typ, prop[typ])
+			if _, ok := kinds[name]; !ok {
+				kinds[name] = kind
+				f := Field{Name: name, Kind: kind}
+				if kind == KindLink {
+					f.Kind = ""
+					t.links[name] = true
+				}
+				if typ == "title" {
+					t.Fields = append([]Field{f}, t.Fields...)
+				} else {
+					t.Fields = append(t.Fields, f)
+				}
+			}
+			if val == nil {
+				continue
+			}
+			rec.Values[name] = val
+			if typ == "title" {
+				rec.Title, _ = val.(string)
+			}
+		}
+		t.Records = append(t.Records, rec)
+	}
+	return nil
+}
+
+func notionValue(typ string, v any) (string, any) {
+	switch typ {
+	case "title", "rich_text":
+		var sb strings.Builder
+		list, _ := v.([]any)
+		for _, item := range list {
+			if m, ok := item.(map[string]any); ok {
+				s, _ := m["plain_text"].(string)
+				sb.WriteString(s)
+			}
+		}
+		if sb.Len() == 0 {
+			return KindText, nil
+		}
+		return KindText, sb.String()
+	case "number":
+		return KindNumber, v
+	case "checkbox":
+		return KindBool, v
+	case "date":
+		if m, ok := v.(map[string]any); ok {
+			if s, _ := m["start"].(string); s != "" {
+				return KindTimestamp, s
+			}
+		}
+		return KindTimestamp, nil
+	case "created_time", "last_edited_time":
+		return KindTimestamp, v
+	case "select", "status":
+		if m, ok := v.(map[string]any); ok {
+			return KindSingleSelect, m["name"]
+		}
+		return KindSingleSelect, nil
+	case "multi_select":
+		var names []any
+		list, _ := v.([]any)
+		for _, item := range list {
+			if m, ok := item.(map[string]any); ok {
+				names = append(names, m["name"])
+			}
+		}
+		return KindMultiSelect, names
+	case "relation":
+		var ids []any
+		list, _ := v.([]any)
+		for _, item := range list {
+			if m, ok := item.(map[string]any); ok {
+				ids = append(ids, m["id"])
+			}
+		}
+		return KindLink, ids
+	case "url", "email", "phone_number":
+		return KindText, v
+	default:
+		return KindJSON, v
+	}
+}
+
+// inferKind 根据字段的全部非空值推断类型（CSV 与 Airtable JSON 不带类型信息）。
+// 字符串数组（Airtable 的多选）推断为 multi_select，其它数组和对象为 json。
+func (t *Table) inferKind(field string) string {
+	if t.links[field] {
+		return KindLink
+	}
+	kind := ""
+	merge := func(k string) {
+		if kind == "" || kind == k {
+			kind = k
+			return
+		}
+		kind = KindText
+	}
+
+	for _, rec := range t.Records {
+		v, ok := rec.Values[field]
+		if !ok || v == nil {
+			continue
+		}
+		switch x := v.(type) {
+		case bool:
+			merge(KindBool)
+		case float64:
+			merge(KindNumber)
+		case []any, map[string]any:
+			k := KindJSON
+			if list, ok := x.([]any); ok && allStrings(list) {
+				k = KindMultiSelect
+			}
+			if kind != "" && kind != k {
+				return KindJSON
+			}
+			kind = k
+		case string:
+			switch {
+			case isBoolString(x):
+				merge(KindBool)
+			case isNumberString(x):
+				merge(KindNumber)
+			case ParseTime(x) != nil:
+				merge(KindTimestamp)
+			default:
+				merge(KindText)
+			}
+		default:
+			merge(KindText)
+		}
+		if kind == KindText {
+			return KindText
+		}
+	}
+	if kind == "" {
+		return KindText
+	}
+	return kind
+}
+
+func allStrings(list []any) bool {
+	for _, v := range list {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// selectOptions 返回选择字段的全部非空取值，按首次出现的顺序去重。
+func (t *Table) selectOptions(field string) []string {
+	var options []string
+	seen := map[string]bool{}
+	add := func(v any) {
+		if s, ok := v.(string); ok && s != "" && !seen[s] {
+			seen[s] = true
+			options = append(options, s)
+		}
+	}
+	for _, rec := range t.Records {
+		switch x := rec.Values[field].(type) {
+		case []any:
+			for _, v := range x {
+				add(v)
+			}
+		default:
+			add(x)
+		}
+	}
+	return options
+}
+
+func isBoolString(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "false", "checked", "yes", "no":
+		return true
+	}
+	return false
+}
+
+func isNumberString(s string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return err == nil
+}
+
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05.000Z",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"1/2/2006 3:04pm",
+	"1/2/2006 15:04",
+	"1/2/2006",
+	"January 2, 2006 3:04 PM",
+	"January 2, 2006",
+}
+
+// ParseTime 尝试用 Airtable / Notion 常见的日期格式解析字符串，失败返回 nil。
+func ParseTime(s string) *time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// ResolveLinks 在同一批导入的表之间匹配 link 字段：值为目标表记录 id（或 Notion CSV 中的标题）。
+// 无法匹配到任何一张表的 link 字段会退化为 json。
+// 记录 id 只在表内唯一（CSV 的 id 是行号），索引按表分开；行号 id 不参与匹配。
+func ResolveLinks(tables []*Table) {
+	idIndex := map[string]map[string]bool{}      // table name -> record ids
+	titleIndex := map[string]map[string]string{} // table name -> title -> record id
+	for _, t := range tables {
+		idIndex[t.Name] = map[string]bool{}
+		titleIndex[t.Name] = map[string]string{}
+		for _, rec := range t.Records {
+			if !t.rowNumberIDs {
+				idIndex[t.Name][rec.ID] = true
+			}
+			if rec.Title != "" {
+				titleIndex[t.Name][rec.Title] = rec.ID
+			}
+		}
+	}
+	// findTarget 返回记录 id（优先）或标题为 s 的表，多张表都有时取靠前的一张。
+	findTarget := func(s string) string {
+		for _, t := range tables {
+			if idIndex[t.Name][s] {
+				return t.Name
+			}
+		}
+		for _, t := range tables {
+			if _, ok := titleIndex[t.Name][s]; ok {
+				return t.Name
+			}
+		}
+		return ""
+	}
+
+	for _, t := range tables {
+		for i := range t.Fields {
+			f := &t.Fields[i]
+			if f.Kind != KindLink {
+				continue
+			}
+			target := ""
+			for _, rec := range t.Records {
+				list, _ := rec.Values[f.Name].([]any)
+				for _, v := range list {
+					s, _ := v.(string)
+					if target = findTarget(s); target != "" {
+						break
+					}
+				}
+				if target != "" {
+					break
+				}
+			}
+			if target == "" {
+				f.Kind = KindJSON
+				continue
+			}
+			f.Target = target
+			for _, rec := range t.Records {
+				list, _ := rec.Values[f.Name].([]any)
+				var ids []string
+				for _, v := range list {
+					s, _ := v.(string)
+					switch id, ok := titleIndex[target][s]; {
+					case idIndex[target][s]:
+					case ok:
+						s = id
+					default:
+						continue
+					}
+					ids = append(ids, s)
+				}
+				if len(ids) > 1 {
+					f.Multiple = true
+				}
+				rec.Values[f.Name] = ids
+			}
+		}
+	}
+}
+
+// orderedKeys 返回 JSON 对象顶层 key 的原始顺序，用于保持列顺序。
+func orderedKeys(raw json.RawMessage) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return nil, fmt.Errorf("expected object")
+	}
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, tok.(string))
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
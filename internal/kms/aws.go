@@ -0,0 +1,151 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// AWSOptions 配置 AWS KMS；凭据只支持静态的 access key（可带 session token）。
+type AWSOptions struct {
+	Region       string
+	Endpoint     string // 为空时使用 https://kms.<region>.amazonaws.com
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+// AWSKMS 通过 AWS KMS 的 JSON API（TrentService）生成/解包数据密钥，请求用 SigV4 签名，不依赖 AWS SDK。
+// keyID 可以是 key id、ARN 或 alias/<name>。
+type AWSKMS struct {
+	opts   AWSOptions
+	host   string
+	client *http.Client
+}
+
+// NewAWSKMS creates a KeyManager backed by AWS KMS.
+func NewAWSKMS(opts AWSOptions) (*AWSKMS, error) {
+	if opts.Region == "" || opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, fmt.Errorf("aws kms requires AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+	if opts.Endpoint == "" {
+		opts.Endpoint = "https://kms." + opts.Region + ".amazonaws.com"
+	}
+	u, err := url.Parse(opts.Endpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid AWS_KMS_ENDPOINT %q", opts.Endpoint)
+	}
+	opts.Endpoint = strings.TrimRight(opts.Endpoint, "/")
+	return &AWSKMS{opts: opts, host: u.Host, client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+func (a *AWSKMS) GenerateDataKey(ctx context.Context, keyID string) ([]byte, []byte, error) {
+	var out struct {
+		CiphertextBlob []byte
+		Plaintext      []byte
+	}
+	if err := a.do(ctx, "GenerateDataKey", map[string]any{"KeyId": keyID, "KeySpec": "AES_256"}, &out); err != nil {
+		return nil, nil, err
+	}
+	return out.Plaintext, out.CiphertextBlob, nil
+}
+
+func (a *AWSKMS) DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	var out struct {
+		Plaintext []byte
+	}
+	body := map[string]any{"KeyId": keyID, "CiphertextBlob": wrapped}
+	if err := a.do(ctx, "Decrypt", body, &out); err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
+
+// do 调用 TrentService.<action>；请求和响应中的 blob 字段是 base64，由 encoding/json 按 []byte 编解码。
+func (a *AWSKMS) do(ctx context.Context, action string, body any, out any) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.opts.Endpoint+"/", bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+	a.sign(req, raw, time.Now())
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("aws kms %s: %w", action, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("aws kms %s: %s: %s", action, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// sign 按 SigV4 规则签名请求头（content-type、host、x-amz-*）和 payload。
+func (a *AWSKMS) sign(req *http.Request, payload []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	scope := day + "/" + a.opts.Region + "/kms/aws4_request"
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if a.opts.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", a.opts.SessionToken)
+	}
+	headers := [][2]string{
+		{"content-type", req.Header.Get("Content-Type")},
+		{"host", a.host},
+		{"x-amz-date", amzDate},
+	}
+	if a.opts.SessionToken != "" {
+		headers = append(headers, [2]string{"x-amz-security-token", a.opts.SessionToken})
+	}
+	headers = append(headers, [2]string{"x-amz-target", req.Header.Get("X-Amz-Target")})
+	var canonicalHeaders strings.Builder
+	names := make([]string, len(headers))
+	for i, h := range headers {
+		canonicalHeaders.WriteString(h[0] + ":" + strings.TrimSpace(h[1]) + "\n")
+		names[i] = h[0]
+	}
+	signedHeaders := strings.Join(names, ";")
+	payloadHash := sha256.Sum256(payload)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		"/",
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+a.opts.SecretKey), day)
+	for _, part := range []string{a.opts.Region, "kms", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.opts.AccessKey, scope, signedHeaders, hex.EncodeToString(hmacSHA256(signingKey, stringToSign))))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
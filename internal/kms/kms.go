@@ -0,0 +1,183 @@
+// Package kms 提供信封加密（envelope encryption）与密钥管理，用于加密注册表中 tenant 的专属 DSN
+// 和 encrypted 类型的列。
+//
+// 每个 tenant 使用独立的数据密钥（DEK）加密数据，DEK 本身由 KeyManager
+// （本地主密钥 / Vault transit / AWS KMS / Cloud KMS）包裹后与密文一起存放，因此解密时不需要
+// 额外的密钥表。RotateTenantKeys 先用 Keyring.Rotate 换掉当前 DEK，再用 Reencrypt 重写已有密文。
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// KeyManager 负责生成并解包数据密钥，主密钥永远不会离开 KMS。
+type KeyManager interface {
+	// GenerateDataKey 返回一个新的 256 位数据密钥及其被 keyID 包裹后的密文。
+	GenerateDataKey(ctx context.Context, keyID string) (plaintext, wrapped []byte, err error)
+	// DecryptDataKey 解包 GenerateDataKey 返回的 wrapped。
+	DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// envelopeVersion 是密文格式版本：
+//
+//	[1 byte version][2 bytes len(wrapped)][wrapped DEK][12 bytes nonce][AES-GCM ciphertext]
+const envelopeVersion = 1
+
+var ErrInvalidCiphertext = errors.New("kms: invalid ciphertext")
+
+// Keyring 以 tenant 为粒度缓存数据密钥。
+type Keyring struct {
+	km          KeyManager
+	keyTemplate string // e.g. "lowcode-%s"；为空时所有 tenant 共用 "lowcode"
+
+	mu      sync.Mutex
+	current map[string]*dataKey // tenantID -> 当前用于加密的 DEK
+	opened  map[string][]byte   // string(wrapped) -> plaintext DEK
+}
+
+type dataKey struct {
+	plaintext []byte
+	wrapped   []byte
+}
+
+// NewKeyring creates a per-tenant keyring on top of km.
+func NewKeyring(km KeyManager, keyTemplate string) *Keyring {
+	return &Keyring{
+		km:          km,
+		keyTemplate: keyTemplate,
+		current:     make(map[string]*dataKey),
+		opened:      make(map[string][]byte),
+	}
+}
+
+// KeyID 返回 tenant 对应的 KMS 主密钥 id。
+func (k *Keyring) KeyID(tenantID string) string {
+	if k.keyTemplate == "" {
+		return "lowcode"
+	}
+	return fmt.Sprintf(k.keyTemplate, tenantID)
+}
+
+// Seal 使用 tenant 当前的数据密钥加密 plaintext。
+func (k *Keyring) Seal(ctx context.Context, tenantID string, plaintext []byte) ([]byte, error) {
+	dk, err := k.currentKey(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(dk.plaintext)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, 3+len(dk.wrapped)+len(nonce)+len(plaintext)+gcm.Overhead())
+	out = append(out, envelopeVersion)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(dk.wrapped)))
+	out = append(out, dk.wrapped...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, plaintext, []byte(tenantID)), nil
+}
+
+// Open 解密 Seal 生成的密文；旧的数据密钥（轮换前）同样可以解密。
+func (k *Keyring) Open(ctx context.Context, tenantID string, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < 3 || ciphertext[0] != envelopeVersion {
+		return nil, ErrInvalidCiphertext
+	}
+	n := int(binary.BigEndian.Uint16(ciphertext[1:3]))
+	rest := ciphertext[3:]
+	if len(rest) < n {
+		return nil, ErrInvalidCiphertext
+	}
+	wrapped, rest := rest[:n], rest[n:]
+
+	key, err := k.openKey(ctx, tenantID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, body := rest[:gcm.NonceSize()], rest[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, body, []byte(tenantID))
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return plaintext, nil
+}
+
+// Rotate 丢弃 tenant 当前的数据密钥，之后的 Seal 会生成新密钥。
+func (k *Keyring) Rotate(tenantID string) {
+	k.mu.Lock()
+	delete(k.current, tenantID)
+	k.mu.Unlock()
+}
+
+// Reencrypt 用 tenant 当前的数据密钥重新加密一段密文，供轮换后重写列数据使用。
+func (k *Keyring) Reencrypt(ctx context.Context, tenantID string, ciphertext []byte) ([]byte, error) {
+	return k.Reseal(ctx, tenantID, tenantID, ciphertext)
+}
+
+// Reseal 解密绑定 from 的密文，再用 to 当前的数据密钥加密。复制或导入 tenant 时用它把数据转给新的 tenant。
+func (k *Keyring) Reseal(ctx context.Context, from, to string, ciphertext []byte) ([]byte, error) {
+	plaintext, err := k.Open(ctx, from, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return k.Seal(ctx, to, plaintext)
+}
+
+func (k *Keyring) currentKey(ctx context.Context, tenantID string) (*dataKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if dk, ok := k.current[tenantID]; ok {
+		return dk, nil
+	}
+	plaintext, wrapped, err := k.km.GenerateDataKey(ctx, k.KeyID(tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("generate data key for tenant %s: %w", tenantID, err)
+	}
+	dk := &dataKey{plaintext: plaintext, wrapped: wrapped}
+	k.current[tenantID] = dk
+	k.opened[string(wrapped)] = plaintext
+	return dk, nil
+}
+
+func (k *Keyring) openKey(ctx context.Context, tenantID string, wrapped []byte) ([]byte, error) {
+	k.mu.Lock()
+	if key, ok := k.opened[string(wrapped)]; ok {
+		k.mu.Unlock()
+		return key, nil
+	}
+	k.mu.Unlock()
+
+	key, err := k.km.DecryptDataKey(ctx, k.KeyID(tenantID), wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt data key for tenant %s: %w", tenantID, err)
+	}
+	k.mu.Lock()
+	k.opened[string(wrapped)] = key
+	k.mu.Unlock()
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
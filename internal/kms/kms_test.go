@@ -0,0 +1,74 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func newTestKeyring(t *testing.T) *Keyring {
+	t.Helper()
+	km, err := NewLocalKeyManager(bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatalf("NewLocalKeyManager: %v", err)
+	}
+	return NewKeyring(km, "lowcode-%s")
+}
+
+// TestKeyringRotate 确认轮换后新密文使用新的数据密钥，旧密文仍可解密，Reencrypt / Reseal 得到的密文绑定目标 tenant。
+func TestKeyringRotate(t *testing.T) {
+	ctx := context.Background()
+	k := newTestKeyring(t)
+	plaintext := []byte("postgres://u:secret@db/a")
+
+	old, err := k.Seal(ctx, "a", plaintext)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	k.Rotate("a")
+	rotated, err := k.Reencrypt(ctx, "a", old)
+	if err != nil {
+		t.Fatalf("Reencrypt: %v", err)
+	}
+	if bytes.Equal(wrappedKey(t, old), wrappedKey(t, rotated)) {
+		t.Fatalf("Reencrypt after Rotate kept the old data key")
+	}
+	moved, err := k.Reseal(ctx, "a", "b", old)
+	if err != nil {
+		t.Fatalf("Reseal: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		tenantID   string
+		ciphertext []byte
+		wantErr    error
+	}{
+		{"before rotation", "a", old, nil},
+		{"after rotation", "a", rotated, nil},
+		{"resealed for b", "b", moved, nil},
+		{"resealed, opened as a", "a", moved, ErrInvalidCiphertext},
+		{"other tenant", "b", rotated, ErrInvalidCiphertext},
+		{"truncated", "a", rotated[:len(rotated)-1], ErrInvalidCiphertext},
+		{"unknown version", "a", append([]byte{0}, rotated[1:]...), ErrInvalidCiphertext},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := k.Open(ctx, tt.tenantID, tt.ciphertext)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Open err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !bytes.Equal(got, plaintext) {
+				t.Errorf("Open = %q, want %q", got, plaintext)
+			}
+		})
+	}
+}
+
+// wrappedKey 取出信封中被包裹的数据密钥。
+func wrappedKey(t *testing.T, ciphertext []byte) []byte {
+	t.Helper()
+	n := int(ciphertext[1])<<8 | int(ciphertext[2])
+	return ciphertext[3 : 3+n]
+}
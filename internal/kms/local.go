@@ -0,0 +1,53 @@
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+// LocalKeyManager 使用进程内的主密钥包裹数据密钥，适合开发环境或没有外部 KMS 的部署。
+type LocalKeyManager struct {
+	master []byte
+}
+
+// NewLocalKeyManager creates a KeyManager from a 32-byte master key.
+func NewLocalKeyManager(master []byte) (*LocalKeyManager, error) {
+	if len(master) != 32 {
+		return nil, fmt.Errorf("local master key must be 32 bytes, got %d", len(master))
+	}
+	return &LocalKeyManager{master: master}, nil
+}
+
+func (l *LocalKeyManager) GenerateDataKey(ctx context.Context, keyID string) ([]byte, []byte, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	gcm, err := newGCM(l.master)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	wrapped := gcm.Seal(nonce, nonce, key, []byte(keyID))
+	return key, wrapped, nil
+}
+
+func (l *LocalKeyManager) DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	gcm, err := newGCM(l.master)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, body := wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():]
+	key, err := gcm.Open(nil, nonce, body, []byte(keyID))
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return key, nil
+}
@@ -0,0 +1,36 @@
+package kms
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/solat/lowcode-database/internal/config"
+)
+
+// New 根据配置创建 KeyManager；KMS_PROVIDER 为空时返回 nil（不启用加密）。
+func New(cfg *config.Config) (KeyManager, error) {
+	switch cfg.KMSProvider {
+	case "":
+		return nil, nil
+	case "local":
+		master, err := base64.StdEncoding.DecodeString(cfg.KMSLocalKey)
+		if err != nil {
+			return nil, fmt.Errorf("decode KMS_LOCAL_KEY: %w", err)
+		}
+		return NewLocalKeyManager(master)
+	case "vault":
+		return NewVaultTransit(cfg.VaultAddr, cfg.VaultToken, cfg.VaultTransitMount)
+	case "aws":
+		return NewAWSKMS(AWSOptions{
+			Region:       cfg.AWSRegion,
+			Endpoint:     cfg.AWSKMSEndpoint,
+			AccessKey:    cfg.AWSAccessKey,
+			SecretKey:    cfg.AWSSecretKey,
+			SessionToken: cfg.AWSSessionToken,
+		})
+	case "gcp":
+		return NewGCPKMS(cfg.GCPKMSEndpoint, cfg.GCPAccessToken), nil
+	default:
+		return nil, fmt.Errorf("invalid KMS_PROVIDER %q", cfg.KMSProvider)
+	}
+}
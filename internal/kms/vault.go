@@ -0,0 +1,97 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// VaultTransit 通过 Vault transit secrets engine 的 HTTP API 生成/解包数据密钥。
+type VaultTransit struct {
+	addr   string // e.g. "http://127.0.0.1:8200"
+	token  string
+	mount  string // transit 挂载路径，默认 "transit"
+	client *http.Client
+}
+
+// NewVaultTransit creates a KeyManager backed by Vault transit.
+func NewVaultTransit(addr, token, mount string) (*VaultTransit, error) {
+	if addr == "" || token == "" {
+		return nil, fmt.Errorf("vault transit requires address and token")
+	}
+	if mount == "" {
+		mount = "transit"
+	}
+	return &VaultTransit{
+		addr:   strings.TrimRight(addr, "/"),
+		token:  token,
+		mount:  strings.Trim(mount, "/"),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (v *VaultTransit) GenerateDataKey(ctx context.Context, keyID string) ([]byte, []byte, error) {
+	var out struct {
+		Data struct {
+			Plaintext  string `json:"plaintext"`
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	body := map[string]any{"bits": 256}
+	if err := v.do(ctx, "/datakey/plaintext/"+keyID, body, &out); err != nil {
+		return nil, nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(out.Data.Plaintext)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode vault data key: %w", err)
+	}
+	return key, []byte(out.Data.Ciphertext), nil
+}
+
+func (v *VaultTransit) DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	var out struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	body := map[string]any{"ciphertext": string(wrapped)}
+	if err := v.do(ctx, "/decrypt/"+keyID, body, &out); err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(out.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("decode vault data key: %w", err)
+	}
+	return key, nil
+}
+
+func (v *VaultTransit) do(ctx context.Context, path string, body any, out any) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	url := v.addr + "/v1/" + v.mount + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("vault transit %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("vault transit %s: %s: %s", path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
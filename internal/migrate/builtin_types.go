@@ -0,0 +1,85 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BuiltinType 是代码中定义的内置类型。内置类型只读：定义以这里为准，每次 Migrate 都会同步到
+// tenant 的 lc_types（lc_columns.type_id 外键需要这一行），避免各 tenant 库中的副本漂移。
+type BuiltinType struct {
+	ID     string
+	PgType string
+	Config map[string]any
+}
+
+// BuiltinTypes 按 id 排列，迁移步骤中预置的类型都应在这里登记。
+var BuiltinTypes = []BuiltinType{
+	{ID: "attachment", PgType: "jsonb", Config: map[string]any{"kind": "attachment"}},
+	{ID: "bool", PgType: "boolean", Config: map[string]any{}},
+	{ID: "currency", PgType: "numeric", Config: map[string]any{"kind": "currency", "precision": 2, "currency_code": "USD", "display": "symbol"}},
+	{ID: "duration", PgType: "numeric", Config: map[string]any{"kind": "duration", "precision": 0, "format": "h:mm"}},
+	{ID: "email", PgType: "text", Config: map[string]any{"kind": "email"}},
+	{ID: "encrypted_text", PgType: "bytea", Config: map[string]any{"kind": "encrypted"}},
+	{ID: "formula", PgType: "jsonb", Config: map[string]any{"kind": "formula"}},
+	{ID: "geometry", PgType: "geometry(Geometry,4326)", Config: map[string]any{"kind": "geo"}},
+	{ID: "json", PgType: "jsonb", Config: map[string]any{}},
+	{ID: "lookup", PgType: "jsonb", Config: map[string]any{"kind": "lookup"}},
+	{ID: "multi_select", PgType: "text[]", Config: map[string]any{"kind": "multi_select"}},
+	{ID: "number", PgType: "numeric", Config: map[string]any{}},
+	{ID: "number_list", PgType: "numeric[]", Config: map[string]any{"kind": "list"}},
+	{ID: "percent", PgType: "numeric", Config: map[string]any{"kind": "percent", "precision": 0}},
+	{ID: "phone", PgType: "text", Config: map[string]any{"kind": "phone"}},
+	{ID: "point", PgType: "geometry(Point,4326)", Config: map[string]any{"kind": "geo"}},
+	{ID: "rating", PgType: "smallint", Config: map[string]any{"kind": "rating", "max": 5, "icon": "star"}},
+	{ID: "relationship", PgType: "jsonb", Config: map[string]any{"kind": "relationship"}},
+	{ID: "rollup", PgType: "jsonb", Config: map[string]any{"kind": "rollup"}},
+	{ID: "single_select", PgType: "text", Config: map[string]any{"kind": "single_select"}},
+	{ID: "text", PgType: "text", Config: map[string]any{}},
+	{ID: "text_list", PgType: "text[]", Config: map[string]any{"kind": "list"}},
+	{ID: "timestamp", PgType: "timestamptz", Config: map[string]any{}},
+	{ID: "url", PgType: "text", Config: map[string]any{"kind": "url"}},
+}
+
+var builtinByID = func() map[string]BuiltinType {
+	m := make(map[string]BuiltinType, len(BuiltinTypes))
+	for _, t := range BuiltinTypes {
+		m[t.ID] = t
+	}
+	return m
+}()
+
+// LookupBuiltinType 返回 id（即 name）对应的内置类型。
+func LookupBuiltinType(id string) (BuiltinType, bool) {
+	t, ok := builtinByID[id]
+	return t, ok
+}
+
+// syncBuiltinTypes 把 BuiltinTypes 写入 lc_types：缺失的插入，config 与代码不一致的更新并记入 lc_type_versions。
+// pg_type 不同的行不会被修改：那是内置类型登记之前 tenant 自建的同名类型，可能已有列在使用。
+func syncBuiltinTypes(ctx context.Context, pool *pgxpool.Pool) error {
+	for _, t := range BuiltinTypes {
+		cfg, err := json.Marshal(t.Config)
+		if err != nil {
+			return err
+		}
+		if _, err := pool.Exec(ctx, `
+			WITH t AS (
+				INSERT INTO lc_types (id, name, pg_type, config)
+				VALUES ($1, $1, $2, $3::jsonb)
+				ON CONFLICT (id) DO UPDATE
+				SET config = EXCLUDED.config, version = lc_types.version + 1, updated_at = now()
+				WHERE lc_types.pg_type = EXCLUDED.pg_type AND lc_types.config IS DISTINCT FROM EXCLUDED.config
+				RETURNING id, version, pg_type, config
+			)
+			INSERT INTO lc_type_versions (type_id, version, pg_type, config)
+			SELECT id, version, pg_type, config FROM t
+			ON CONFLICT DO NOTHING`, t.ID, t.PgType, string(cfg)); err != nil {
+			return fmt.Errorf("sync builtin type %s: %w", t.ID, err)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -24,11 +25,167 @@ var steps = []step{
 		Name:    "seed virtual column types",
 		Up:      stepSeedVirtualTypes,
 	},
+	{
+		Version: 3,
+		Name:    "create lc_blobs for streamed cell content",
+		Up:      stepBlobs,
+	},
+	{
+		Version: 4,
+		Name:    "add lc_tables.config",
+		Up:      stepTableConfig,
+	},
+	{
+		Version: 5,
+		Name:    "create lc_touch_updated_at trigger function",
+		Up:      stepTouchUpdatedAt,
+	},
+	{
+		Version: 6,
+		Name:    "add deleted_at to tables with system columns",
+		Up:      stepSoftDelete,
+	},
+	{
+		Version: 7,
+		Name:    "seed rollup column type",
+		Up:      stepSeedRollupType,
+	},
+	{
+		Version: 8,
+		Name:    "seed lookup column type",
+		Up:      stepSeedLookupType,
+	},
+	{
+		Version: 9,
+		Name:    "seed select column types",
+		Up:      stepSeedSelectTypes,
+	},
+	{
+		Version: 10,
+		Name:    "seed attachment column type",
+		Up:      stepSeedAttachmentType,
+	},
+	{
+		Version: 11,
+		Name:    "seed formatted number types",
+		Up:      stepSeedNumberFormatTypes,
+	},
+	{
+		Version: 12,
+		Name:    "seed geospatial column types",
+		Up:      stepSeedGeoTypes,
+	},
+	{
+		Version: 13,
+		Name:    "seed array column types",
+		Up:      stepSeedListTypes,
+	},
+	{
+		Version: 14,
+		Name:    "seed validated text types",
+		Up:      stepSeedTextFormatTypes,
+	},
+	{
+		Version: 15,
+		Name:    "add type versions",
+		Up:      stepTypeVersions,
+	},
+	{
+		Version: 16,
+		Name:    "add table description/icon and cascade table renames",
+		Up:      stepTableMetadata,
+	},
+	{
+		Version: 17,
+		Name:    "add archived state to lc_tables",
+		Up:      stepTableArchive,
+	},
+	{
+		Version: 18,
+		Name:    "add hidden/readonly flags to lc_columns",
+		Up:      stepColumnFlags,
+	},
+	{
+		Version: 19,
+		Name:    "add description/label/ui_hints to lc_columns",
+		Up:      stepColumnMetadata,
+	},
+	{
+		Version: 20,
+		Name:    "add lc_workspaces and lc_tables.workspace_id",
+		Up:      stepWorkspaces,
+	},
+	{
+		Version: 21,
+		Name:    "add lc_views",
+		Up:      stepViews,
+	},
+	{
+		Version: 22,
+		Name:    "add config to lc_indexes",
+		Up:      stepIndexConfig,
+	},
+	{
+		Version: 23,
+		Name:    "create lc_operations",
+		Up:      stepOperations,
+	},
+	{
+		Version: 24,
+		Name:    "create lc_members",
+		Up:      stepMembers,
+	},
+	{
+		Version: 25,
+		Name:    "create lc_permissions",
+		Up:      stepPermissions,
+	},
+	{
+		Version: 26,
+		Name:    "create lc_audit_log",
+		Up:      stepAuditLog,
+	},
+	{
+		Version: 27,
+		Name:    "create lc_row_history",
+		Up:      stepRowHistory,
+	},
+	{
+		Version: 28,
+		Name:    "create lc_webhooks",
+		Up:      stepWebhooks,
+	},
+}
+
+// Latest 返回代码中最新的迁移版本。
+func Latest() int {
+	return steps[len(steps)-1].Version
+}
+
+// CurrentVersion 返回库中已应用的最新迁移版本，从未迁移过时为 0。
+func CurrentVersion(ctx context.Context, pool *pgxpool.Pool) (int, error) {
+	var current int
+	err := pool.QueryRow(ctx, `
+		SELECT CASE WHEN to_regclass('lc_schema_migrations') IS NULL THEN 0
+		ELSE (SELECT COALESCE(MAX(version), 0) FROM lc_schema_migrations) END`).Scan(&current)
+	return current, err
 }
 
 // Migrate applies all pending migrations against the given tenant database.
 // It is idempotent and safe to call multiple times.
+// 多个进程同时迁移同一个库时由 advisory lock 串行执行，后到者在锁释放后看到已应用的版本。
+// 持锁占用一个连接，步骤在其它连接上执行，所以 pool 至少要有两个连接。
 func Migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext('lc_schema_migrations'))`); err != nil {
+		return fmt.Errorf("lock migrations: %w", err)
+	}
+	defer conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock(hashtext('lc_schema_migrations'))`)
+
 	if _, err := pool.Exec(ctx, `
 		CREATE TABLE IF NOT EXISTS lc_schema_migrations (
 			version    INT PRIMARY KEY,
@@ -58,7 +215,7 @@ func Migrate(ctx context.Context, pool *pgxpool.Pool) error {
 			return fmt.Errorf("record migration %d: %w", s.Version, err)
 		}
 	}
-	return nil
+	return syncBuiltinTypes(ctx, pool)
 }
 
 // stepInitCore creates the core lc_* tables and seeds a few builtin types.
@@ -140,3 +297,488 @@ func stepSeedVirtualTypes(ctx context.Context, pool *pgxpool.Pool) error {
 	}
 	return nil
 }
+
+// stepBlobs 创建分块存储的 blob 表，供 UploadCellContent 使用。
+// 内容按上传顺序切成多行存放，追加写是 O(1)，不需要反复重写整个 bytea。
+func stepBlobs(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_blobs (
+			id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			content_type TEXT NOT NULL DEFAULT '',
+			filename     TEXT NOT NULL DEFAULT '',
+			size         BIGINT NOT NULL DEFAULT 0,
+			created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+		);`,
+		`CREATE TABLE IF NOT EXISTS lc_blob_chunks (
+			blob_id UUID NOT NULL REFERENCES lc_blobs(id) ON DELETE CASCADE,
+			seq     INT NOT NULL,
+			data    BYTEA NOT NULL,
+			PRIMARY KEY (blob_id, seq)
+		);`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepBlobs: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepTableConfig 给 lc_tables 加上 config，用于保存分区等表级配置。
+func stepTableConfig(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `ALTER TABLE lc_tables ADD COLUMN IF NOT EXISTS config JSONB NOT NULL DEFAULT '{}'::jsonb`); err != nil {
+		return fmt.Errorf("stepTableConfig: %w", err)
+	}
+	return nil
+}
+
+// stepTouchUpdatedAt 创建维护系统列 updated_at 的触发器函数，CreateTable 会为新表挂上该触发器。
+func stepTouchUpdatedAt(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		CREATE OR REPLACE FUNCTION lc_touch_updated_at() RETURNS trigger AS $$
+		BEGIN
+			NEW.updated_at := now();
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql
+	`); err != nil {
+		return fmt.Errorf("stepTouchUpdatedAt: %w", err)
+	}
+	return nil
+}
+
+// stepSoftDelete 给已有系统列的物理表补上 deleted_at（回收站标记）。
+func stepSoftDelete(ctx context.Context, pool *pgxpool.Pool) error {
+	rows, err := pool.Query(ctx, `
+		SELECT schema_name, table_name FROM lc_tables
+		WHERE COALESCE((config->>'system_columns')::boolean, false)`)
+	if err != nil {
+		return fmt.Errorf("stepSoftDelete: %w", err)
+	}
+	var tables []pgx.Identifier
+	for rows.Next() {
+		var schemaName, tableName string
+		if err := rows.Scan(&schemaName, &tableName); err != nil {
+			rows.Close()
+			return fmt.Errorf("stepSoftDelete: %w", err)
+		}
+		tables = append(tables, pgx.Identifier{schemaName, tableName})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("stepSoftDelete: %w", err)
+	}
+	for _, t := range tables {
+		if _, err := pool.Exec(ctx, `ALTER TABLE `+t.Sanitize()+` ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ`); err != nil {
+			return fmt.Errorf("stepSoftDelete: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepSeedRollupType 预置 rollup 虚拟列类型：按 relationship 聚合关联行的某一列。
+func stepSeedRollupType(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES ('rollup', 'rollup', 'jsonb', '{"kind":"rollup"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedRollupType: %w", err)
+	}
+	return nil
+}
+
+// stepSeedLookupType 预置 lookup 虚拟列类型：把关联行的某一列投影到当前行。
+func stepSeedLookupType(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES ('lookup', 'lookup', 'jsonb', '{"kind":"lookup"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedLookupType: %w", err)
+	}
+	return nil
+}
+
+// stepSeedSelectTypes 预置单选 / 多选类型，选项保存在列的 config.options 中。
+func stepSeedSelectTypes(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES
+		  ('single_select', 'single_select', 'text', '{"kind":"single_select"}'::jsonb),
+		  ('multi_select', 'multi_select', 'text[]', '{"kind":"multi_select"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedSelectTypes: %w", err)
+	}
+	return nil
+}
+
+// stepSeedAttachmentType 预置附件类型：单元格为 jsonb 数组，保存对象存储中文件的元数据。
+func stepSeedAttachmentType(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES ('attachment', 'attachment', 'jsonb', '{"kind":"attachment"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedAttachmentType: %w", err)
+	}
+	return nil
+}
+
+// stepSeedNumberFormatTypes 预置货币 / 百分比 / 时长 / 评分类型，config 中为默认的精度和显示选项，
+// 添加列时合并到列 config。
+func stepSeedNumberFormatTypes(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES
+		  ('currency', 'currency', 'numeric', '{"kind":"currency","precision":2,"currency_code":"USD","display":"symbol"}'::jsonb),
+		  ('percent', 'percent', 'numeric', '{"kind":"percent","precision":0}'::jsonb),
+		  ('duration', 'duration', 'numeric', '{"kind":"duration","precision":0,"format":"h:mm"}'::jsonb),
+		  ('rating', 'rating', 'smallint', '{"kind":"rating","max":5,"icon":"star"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedNumberFormatTypes: %w", err)
+	}
+	return nil
+}
+
+// stepSeedGeoTypes 预置 point / geometry 类型。postgis 可用时尝试安装扩展；没有权限或不可用时
+// 只登记类型，添加这类列时再检查扩展是否已安装。
+func stepSeedGeoTypes(ctx context.Context, pool *pgxpool.Pool) error {
+	var available bool
+	if err := pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'postgis')`).Scan(&available); err != nil {
+		return fmt.Errorf("stepSeedGeoTypes: %w", err)
+	}
+	if available {
+		_, _ = pool.Exec(ctx, `CREATE EXTENSION IF NOT EXISTS postgis`)
+	}
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES
+		  ('point', 'point', 'geometry(Point,4326)', '{"kind":"geo"}'::jsonb),
+		  ('geometry', 'geometry', 'geometry(Geometry,4326)', '{"kind":"geo"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedGeoTypes: %w", err)
+	}
+	return nil
+}
+
+// stepSeedListTypes 预置数组类型，单元格使用 list_value 读写。
+func stepSeedListTypes(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES
+		  ('text_list', 'text_list', 'text[]', '{"kind":"list"}'::jsonb),
+		  ('number_list', 'number_list', 'numeric[]', '{"kind":"list"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedListTypes: %w", err)
+	}
+	return nil
+}
+
+// stepSeedTextFormatTypes 预置 email / url / phone 类型，格式在写入时由服务校验。
+func stepSeedTextFormatTypes(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_types (id, name, pg_type, config)
+		VALUES
+		  ('email', 'email', 'text', '{"kind":"email"}'::jsonb),
+		  ('url', 'url', 'text', '{"kind":"url"}'::jsonb),
+		  ('phone', 'phone', 'text', '{"kind":"phone"}'::jsonb)
+		ON CONFLICT (id) DO NOTHING
+	`); err != nil {
+		return fmt.Errorf("stepSeedTextFormatTypes: %w", err)
+	}
+	return nil
+}
+
+// stepTypeVersions 为 lc_types 增加 version，并用 lc_type_versions 保存每个版本的定义；
+// 已有类型记为版本 1。
+func stepTypeVersions(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`ALTER TABLE lc_types ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1`,
+		`CREATE TABLE IF NOT EXISTS lc_type_versions (
+			type_id    TEXT NOT NULL REFERENCES lc_types(id) ON DELETE CASCADE,
+			version    INT NOT NULL,
+			pg_type    TEXT NOT NULL,
+			config     JSONB NOT NULL,
+			created_by TEXT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			PRIMARY KEY (type_id, version)
+		)`,
+		`INSERT INTO lc_type_versions (type_id, version, pg_type, config)
+		 SELECT id, version, pg_type, config FROM lc_types
+		 ON CONFLICT DO NOTHING`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepTypeVersions: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepTableMetadata 给 lc_tables 增加 description / icon，并让引用 lc_tables(name) 的外键随改名级联更新（UpdateTable）。
+func stepTableMetadata(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`ALTER TABLE lc_tables
+			ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+			ADD COLUMN IF NOT EXISTS icon TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE lc_columns
+			DROP CONSTRAINT IF EXISTS lc_columns_table_id_fkey,
+			ADD CONSTRAINT lc_columns_table_id_fkey FOREIGN KEY (table_id) REFERENCES lc_tables(name) ON DELETE CASCADE ON UPDATE CASCADE`,
+		`ALTER TABLE lc_indexes
+			DROP CONSTRAINT IF EXISTS lc_indexes_table_id_fkey,
+			ADD CONSTRAINT lc_indexes_table_id_fkey FOREIGN KEY (table_id) REFERENCES lc_tables(name) ON DELETE CASCADE ON UPDATE CASCADE`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepTableMetadata: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepTableArchive 给 lc_tables 增加归档状态：DeleteTable 默认只归档，archived_at 超过保留期后由后台任务清除。
+// original_name 记录归档前的表名，RestoreTable 据此改回。
+func stepTableArchive(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`ALTER TABLE lc_tables
+			ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ,
+			ADD COLUMN IF NOT EXISTS original_name TEXT NOT NULL DEFAULT ''`,
+		`CREATE INDEX IF NOT EXISTS lc_tables_archived_at_idx ON lc_tables (archived_at) WHERE archived_at IS NOT NULL`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepTableArchive: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepColumnFlags 给 lc_columns 增加 is_hidden / is_readonly：行接口默认不返回隐藏列，拒绝写入只读列。
+func stepColumnFlags(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `ALTER TABLE lc_columns
+		ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT false,
+		ADD COLUMN IF NOT EXISTS is_readonly BOOLEAN NOT NULL DEFAULT false`); err != nil {
+		return fmt.Errorf("stepColumnFlags: %w", err)
+	}
+	return nil
+}
+
+// stepColumnMetadata 给 lc_columns 增加 description / label / ui_hints，供生成表单等界面使用。
+func stepColumnMetadata(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `ALTER TABLE lc_columns
+		ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS label TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS ui_hints JSONB NOT NULL DEFAULT '{}'::jsonb`); err != nil {
+		return fmt.Errorf("stepColumnMetadata: %w", err)
+	}
+	return nil
+}
+
+// stepWorkspaces 增加 lc_workspaces，用于把表分组；删除 workspace 时其中的表变为未分组。
+func stepWorkspaces(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_workspaces (
+			id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			name        TEXT UNIQUE NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			icon        TEXT NOT NULL DEFAULT '',
+			created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+			updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`,
+		`ALTER TABLE lc_tables ADD COLUMN IF NOT EXISTS workspace_id UUID REFERENCES lc_workspaces(id) ON DELETE SET NULL`,
+		`CREATE INDEX IF NOT EXISTS lc_tables_workspace_id_idx ON lc_tables (workspace_id)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepWorkspaces: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepViews 增加 lc_views 保存表的视图定义。filter / sorts 是 RowFilter / SortSpec 的 protojson，
+// 表改名时随 lc_tables 级联更新。
+func stepViews(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `CREATE TABLE IF NOT EXISTS lc_views (
+		id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		table_id           TEXT NOT NULL REFERENCES lc_tables(name) ON DELETE CASCADE ON UPDATE CASCADE,
+		name               TEXT NOT NULL,
+		filter             JSONB,
+		sorts              JSONB NOT NULL DEFAULT '[]'::jsonb,
+		visible_column_ids UUID[] NOT NULL DEFAULT '{}',
+		page_size          INT NOT NULL DEFAULT 0,
+		created_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+		updated_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+		UNIQUE (table_id, name)
+	)`); err != nil {
+		return fmt.Errorf("stepViews: %w", err)
+	}
+	return nil
+}
+
+// stepIndexConfig 给 lc_indexes 增加 config，保存表达式索引的表达式和部分索引的条件。
+func stepIndexConfig(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `ALTER TABLE lc_indexes ADD COLUMN IF NOT EXISTS config JSONB NOT NULL DEFAULT '{}'::jsonb`); err != nil {
+		return fmt.Errorf("stepIndexConfig: %w", err)
+	}
+	return nil
+}
+
+// stepOperations 增加 lc_operations 记录后台执行的长时间操作。status 为 running / succeeded / failed / cancelled，
+// 执行中的操作定期刷新 updated_at 作为心跳，response 是原 RPC 响应的 JSON。
+func stepOperations(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_operations (
+			id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			kind             TEXT NOT NULL,
+			target           TEXT NOT NULL DEFAULT '',
+			status           TEXT NOT NULL DEFAULT 'running',
+			response         JSONB,
+			error_code       TEXT NOT NULL DEFAULT '',
+			error_message    TEXT NOT NULL DEFAULT '',
+			cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+			updated_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+			finished_at      TIMESTAMPTZ
+		)`,
+		`CREATE INDEX IF NOT EXISTS lc_operations_created_at_idx ON lc_operations (created_at DESC, id DESC)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepOperations: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepMembers 增加 lc_members 保存租户成员的角色（viewer / editor / builder / owner）。
+// pooled 模式下该表按 tenant_id 隔离，user_id 的唯一索引在安装隔离时改为 (tenant_id, user_id)。
+func stepMembers(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_members (
+			id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			user_id    TEXT NOT NULL,
+			role       TEXT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS lc_members_user_id_idx ON lc_members (user_id)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepMembers: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepPermissions 增加 lc_permissions 保存表 / 列级的读写规则。column_id 为空表示整张表；
+// principal_type 为 role / user，access 为 read / write，effect 为 allow / deny。规则随表、列一起删除。
+func stepPermissions(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_permissions (
+			id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			table_id       TEXT NOT NULL REFERENCES lc_tables(name) ON DELETE CASCADE ON UPDATE CASCADE,
+			column_id      UUID REFERENCES lc_columns(id) ON DELETE CASCADE,
+			principal_type TEXT NOT NULL,
+			principal      TEXT NOT NULL,
+			access         TEXT NOT NULL,
+			effect         TEXT NOT NULL,
+			created_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`,
+		`CREATE INDEX IF NOT EXISTS lc_permissions_table_id_idx ON lc_permissions (table_id)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepPermissions: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepAuditLog 增加 lc_audit_log 记录所有修改操作：调用方、方法、受影响的 id、结果、请求内容和行数据的变化。
+func stepAuditLog(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_audit_log (
+			id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+			user_id      TEXT NOT NULL DEFAULT '',
+			method       TEXT NOT NULL,
+			table_id     TEXT NOT NULL DEFAULT '',
+			resource_ids TEXT[] NOT NULL DEFAULT '{}',
+			code         TEXT NOT NULL,
+			error        TEXT NOT NULL DEFAULT '',
+			request      JSONB,
+			diff         JSONB
+		)`,
+		`CREATE INDEX IF NOT EXISTS lc_audit_log_created_at_idx ON lc_audit_log (created_at DESC, id DESC)`,
+		`CREATE INDEX IF NOT EXISTS lc_audit_log_resource_ids_idx ON lc_audit_log USING gin (resource_ids)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepAuditLog: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepRowHistory 增加 lc_row_history 保存行修改前的版本。row 为修改前的完整行（lowcode Row 的 protojson），
+// column_ids 为当时表中的非系统列，恢复时据此区分 NULL 和当时还不存在的列。action 为 update / delete / restore。
+func stepRowHistory(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_row_history (
+			id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			table_id   TEXT NOT NULL REFERENCES lc_tables(name) ON DELETE CASCADE ON UPDATE CASCADE,
+			row_id     UUID NOT NULL,
+			action     TEXT NOT NULL,
+			row        JSONB NOT NULL,
+			column_ids TEXT[] NOT NULL,
+			user_id    TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()
+		)`,
+		`CREATE INDEX IF NOT EXISTS lc_row_history_row_idx ON lc_row_history (table_id, row_id, created_at DESC, id DESC)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepRowHistory: %w", err)
+		}
+	}
+	return nil
+}
+
+// stepWebhooks 增加 lc_webhooks 保存 webhook 订阅，lc_webhook_deliveries 记录每次投递的结果。
+func stepWebhooks(ctx context.Context, pool *pgxpool.Pool) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS lc_webhooks (
+			id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			url        TEXT NOT NULL,
+			table_id   TEXT NOT NULL DEFAULT '',
+			methods    TEXT[] NOT NULL DEFAULT '{}',
+			secret     TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`,
+		`CREATE TABLE IF NOT EXISTS lc_webhook_deliveries (
+			id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+			webhook_id     UUID NOT NULL REFERENCES lc_webhooks(id) ON DELETE CASCADE,
+			url            TEXT NOT NULL,
+			audit_event_id UUID NOT NULL,
+			method         TEXT NOT NULL,
+			status_code    INT NOT NULL DEFAULT 0,
+			error          TEXT NOT NULL DEFAULT '',
+			duration_ms    INT NOT NULL DEFAULT 0
+		)`,
+		`CREATE INDEX IF NOT EXISTS lc_webhook_deliveries_created_at_idx ON lc_webhook_deliveries (created_at DESC, id DESC)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("stepWebhooks: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,557 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Adopt existing tables --------
+
+// existingTable 是从 pg_catalog 读出的一张已有物理表。
+type existingTable struct {
+	Schema string
+	Name   string
+	// LcName 是注册到 lc_tables 后的逻辑 name：public 下直接用表名，其它 schema 用 "<schema>_<table>"。
+	LcName string
+	Cols   []existingColumn
+}
+
+type existingColumn struct {
+	Name       string
+	PgType     string // format_type() 的结果，如 "character varying(64)"
+	IsNullable bool
+	TypeID     string // 指定的逻辑类型，为空时按 lcTypeForPgType 映射
+}
+
+type existingForeignKey struct {
+	FromSchema, FromTable, FromColumn string
+	ToSchema, ToTable                 string
+}
+
+// ImportDatabaseSchema 扫描 tenant 库中已有的用户表，注册到 lc_* 元数据中（不改动物理表），
+// 单列外键会映射成 relationship 列。表按外键依赖顺序注册，被引用的表在前。
+func (s *LowcodeService) ImportDatabaseSchema(ctx context.Context, req *lowcodev1.ImportDatabaseSchemaRequest) (*lowcodev1.ImportDatabaseSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemas := req.GetSchemas()
+	if len(schemas) == 0 {
+		schemas = []string{"public"}
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var resp lowcodev1.ImportDatabaseSchemaResponse
+
+	// 1. 候选表：排除 lc_ 开头的元数据表 / 物理表、已注册的表以及分区子表。
+	rows, err := tx.Query(ctx, `
+		SELECT n.nspname, c.relname
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.relkind IN ('r', 'p')
+		  AND NOT c.relispartition
+		  AND n.nspname = ANY($1)
+		  AND c.relname NOT LIKE 'lc\_%'
+		  AND NOT EXISTS (
+		    SELECT 1 FROM lc_tables t WHERE t.schema_name = n.nspname AND t.table_name = c.relname
+		  )
+		ORDER BY n.nspname, c.relname
+	`, schemas)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*existingTable
+	for rows.Next() {
+		t := &existingTable{}
+		if err := rows.Scan(&t.Schema, &t.Name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		t.LcName = t.Name
+		if t.Schema != "public" {
+			t.LcName = t.Schema + "_" + t.Name
+		}
+		candidates = append(candidates, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 2. 逐表检查主键并读取列；行接口要求主键是名为 id 的单列 uuid/text。
+	byKey := make(map[string]*existingTable)
+	var tables []*existingTable
+	for _, t := range candidates {
+		reason, err := inspectExistingTable(ctx, tx, t)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			resp.Skipped = append(resp.Skipped, &lowcodev1.SkippedTable{SchemaName: t.Schema, TableName: t.Name, Reason: reason})
+			continue
+		}
+		byKey[t.Schema+"."+t.Name] = t
+		tables = append(tables, t)
+	}
+
+	// 3. 单列外键，且只认指向目标表 id 的外键（relationship 按目标行 id 查询）。
+	fkRows, err := tx.Query(ctx, `
+		SELECT sn.nspname, sc.relname, sa.attname, tn.nspname, tc.relname
+		FROM pg_constraint con
+		JOIN pg_class sc ON sc.oid = con.conrelid
+		JOIN pg_namespace sn ON sn.oid = sc.relnamespace
+		JOIN pg_class tc ON tc.oid = con.confrelid
+		JOIN pg_namespace tn ON tn.oid = tc.relnamespace
+		JOIN pg_attribute sa ON sa.attrelid = con.conrelid AND sa.attnum = con.conkey[1]
+		JOIN pg_attribute ta ON ta.attrelid = con.confrelid AND ta.attnum = con.confkey[1]
+		WHERE con.contype = 'f'
+		  AND array_length(con.conkey, 1) = 1
+		  AND ta.attname = 'id'
+		  AND sn.nspname = ANY($1)
+		ORDER BY sn.nspname, sc.relname, sa.attname
+	`, schemas)
+	if err != nil {
+		return nil, err
+	}
+	var fks []existingForeignKey
+	for fkRows.Next() {
+		var fk existingForeignKey
+		if err := fkRows.Scan(&fk.FromSchema, &fk.FromTable, &fk.FromColumn, &fk.ToSchema, &fk.ToTable); err != nil {
+			fkRows.Close()
+			return nil, err
+		}
+		fks = append(fks, fk)
+	}
+	fkRows.Close()
+	if err := fkRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 4. 按依赖顺序注册表和列。
+	colIDs := make(map[string]string) // "<lc table>.<pg column>" -> lc column id
+	for _, t := range orderByForeignKeys(tables, fks) {
+		tbl, cols, err := adoptTable(ctx, tx, t)
+		if err != nil {
+			return nil, fmt.Errorf("adopt table %s.%s: %w", t.Schema, t.Name, err)
+		}
+		for _, c := range cols {
+			colIDs[t.LcName+"."+c.PgColumn] = c.Id
+		}
+		resp.Tables = append(resp.Tables, &lowcodev1.AdoptedTable{Table: tbl, Columns: cols})
+	}
+
+	// 5. 外键 -> relationship：引用方加多对一列，被引用方加一对多列。
+	adopted := make(map[string]*lowcodev1.AdoptedTable, len(resp.Tables))
+	for _, a := range resp.Tables {
+		adopted[a.Table.GetId()] = a
+	}
+	for _, fk := range fks {
+		from, to := byKey[fk.FromSchema+"."+fk.FromTable], byKey[fk.ToSchema+"."+fk.ToTable]
+		if from == nil {
+			continue
+		}
+		toName := ""
+		if to != nil {
+			toName = to.LcName
+		} else if err := tx.QueryRow(ctx, `SELECT name FROM lc_tables WHERE schema_name = $1 AND table_name = $2`,
+			fk.ToSchema, fk.ToTable).Scan(&toName); err != nil {
+			if err == pgx.ErrNoRows {
+				continue
+			}
+			return nil, err
+		}
+		fkColID := colIDs[from.LcName+"."+fk.FromColumn]
+		if fkColID == "" {
+			continue
+		}
+
+		manyToOne, oneToMany, err := adoptForeignKey(ctx, tx, from.LcName, toName, fk.FromColumn, fkColID)
+		if err != nil {
+			return nil, err
+		}
+		if manyToOne != nil {
+			adopted[from.LcName].Columns = append(adopted[from.LcName].Columns, manyToOne)
+		}
+		if oneToMany != nil && adopted[toName] != nil {
+			adopted[toName].Columns = append(adopted[toName].Columns, oneToMany)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ImportExistingTable 接管单张已有物理表，规则同 ImportDatabaseSchema；不满足行接口要求（主键不是单列 id）时
+// 返回 FailedPrecondition 和原因。该表与已注册表之间的单列外键（两个方向）映射为 relationship 列。
+func (s *LowcodeService) ImportExistingTable(ctx context.Context, req *lowcodev1.ImportExistingTableRequest) (*lowcodev1.ImportExistingTableResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableName() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_name is required")
+	}
+	t := &existingTable{Schema: req.GetSchemaName(), Name: req.GetTableName(), LcName: req.GetName()}
+	if t.Schema == "" {
+		t.Schema = "public"
+	}
+	if t.LcName == "" {
+		t.LcName = t.Name
+		if t.Schema != "public" {
+			t.LcName = t.Schema + "_" + t.Name
+		}
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var found, partition bool
+	if err := tx.QueryRow(ctx, `
+		SELECT EXISTS (
+		  SELECT 1 FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		  WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')
+		), EXISTS (
+		  SELECT 1 FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		  WHERE n.nspname = $1 AND c.relname = $2 AND c.relispartition
+		)`, t.Schema, t.Name).Scan(&found, &partition); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, apierr.New(lowcodev1.ErrorCode_TABLE_NOT_FOUND, codes.NotFound, "table %s.%s not found", t.Schema, t.Name)
+	}
+	if partition {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "%s.%s is a partition, import its parent table instead", t.Schema, t.Name)
+	}
+	var registered string
+	err = tx.QueryRow(ctx, `SELECT name FROM lc_tables WHERE name = $1 OR (schema_name = $2 AND table_name = $3) LIMIT 1`,
+		t.LcName, t.Schema, t.Name).Scan(&registered)
+	if err == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists", registered)
+	}
+	if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	reason, err := inspectExistingTable(ctx, tx, t)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s.%s cannot be imported: %s", t.Schema, t.Name, reason)
+	}
+	if err := applyColumnTypeOverrides(ctx, tx, t, req.GetColumnTypes()); err != nil {
+		return nil, err
+	}
+
+	var res lowcodev1.ImportExistingTableResponse
+	if res.Table, res.Columns, err = adoptTable(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	// 本表与已注册表之间的外键：本表引用别人，或别人引用本表
+	rows, err := tx.Query(ctx, `
+		SELECT ft.name, sa.attname, tt.name
+		FROM pg_constraint con
+		JOIN pg_class sc ON sc.oid = con.conrelid
+		JOIN pg_namespace sn ON sn.oid = sc.relnamespace
+		JOIN pg_class tc ON tc.oid = con.confrelid
+		JOIN pg_namespace tn ON tn.oid = tc.relnamespace
+		JOIN pg_attribute sa ON sa.attrelid = con.conrelid AND sa.attnum = con.conkey[1]
+		JOIN pg_attribute ta ON ta.attrelid = con.confrelid AND ta.attnum = con.confkey[1]
+		JOIN lc_tables ft ON ft.schema_name = sn.nspname AND ft.table_name = sc.relname
+		JOIN lc_tables tt ON tt.schema_name = tn.nspname AND tt.table_name = tc.relname
+		WHERE con.contype = 'f'
+		  AND array_length(con.conkey, 1) = 1
+		  AND ta.attname = 'id'
+		  AND $1 IN (ft.name, tt.name)
+		ORDER BY ft.name, sa.attname`, t.LcName)
+	if err != nil {
+		return nil, err
+	}
+	type foreignKey struct {
+		FromTable, FromColumn, ToTable string
+	}
+	fks, err := pgx.CollectRows(rows, pgx.RowToStructByPos[foreignKey])
+	if err != nil {
+		return nil, err
+	}
+	for _, fk := range fks {
+		var fkColID string
+		if err := tx.QueryRow(ctx, `SELECT id FROM lc_columns WHERE table_id = $1 AND pg_column = $2`, fk.FromTable, fk.FromColumn).Scan(&fkColID); err != nil {
+			if err == pgx.ErrNoRows {
+				continue
+			}
+			return nil, err
+		}
+		manyToOne, oneToMany, err := adoptForeignKey(ctx, tx, fk.FromTable, fk.ToTable, fk.FromColumn, fkColID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range []*lowcodev1.Column{manyToOne, oneToMany} {
+			switch {
+			case c == nil:
+			case c.GetTableId() == t.LcName:
+				res.Columns = append(res.Columns, c)
+			default:
+				res.RelatedColumns = append(res.RelatedColumns, c)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// applyColumnTypeOverrides 校验并记录调用方指定的列类型：必须是非虚拟类型，且与物理列属于同一类。
+func applyColumnTypeOverrides(ctx context.Context, tx pgx.Tx, t *existingTable, types map[string]string) error {
+	var violations []apierr.FieldViolation
+	for pgColumn, typeID := range types {
+		i := slices.IndexFunc(t.Cols, func(c existingColumn) bool { return c.Name == pgColumn })
+		if i < 0 {
+			violations = append(violations, apierr.FieldViolation{Field: "column_types." + pgColumn, Description: "column not found"})
+			continue
+		}
+		typ, err := resolveType(ctx, tx, typeID)
+		if err != nil {
+			return err
+		}
+		ec := &t.Cols[i]
+		if virtualKinds[typeKind(typ)] ||
+			lcTypeForPgType(typ.GetPgType()) != lcTypeForPgType(ec.PgType) ||
+			strings.HasSuffix(typ.GetPgType(), "[]") != strings.HasSuffix(ec.PgType, "[]") {
+			violations = append(violations, apierr.FieldViolation{
+				Field:       "column_types." + pgColumn,
+				Description: fmt.Sprintf("type %s (%s) is not compatible with column type %s", typ.GetId(), typ.GetPgType(), ec.PgType),
+			})
+			continue
+		}
+		ec.TypeID = typ.GetId()
+	}
+	if len(violations) > 0 {
+		slices.SortFunc(violations, func(a, b apierr.FieldViolation) int { return strings.Compare(a.Field, b.Field) })
+		return apierr.NewValidation(violations)
+	}
+	return nil
+}
+
+// inspectExistingTable 读取表的主键和列信息；返回非空 reason 表示该表不能被接管。
+func inspectExistingTable(ctx context.Context, tx pgx.Tx, t *existingTable) (string, error) {
+	rel := pgx.Identifier{t.Schema, t.Name}.Sanitize()
+
+	var pkCols []string
+	var pkType string
+	rows, err := tx.Query(ctx, `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod)
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND i.indisprimary
+	`, rel)
+	if err != nil {
+		return "", err
+	}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name, &pkType); err != nil {
+			rows.Close()
+			return "", err
+		}
+		pkCols = append(pkCols, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(pkCols) != 1 || pkCols[0] != "id" {
+		return "primary key must be a single column named id", nil
+	}
+	if pkType != "uuid" && pkType != "text" && !strings.HasPrefix(pkType, "character varying") {
+		return fmt.Sprintf("primary key type %s is not supported (uuid or text required)", pkType), nil
+	}
+
+	colRows, err := tx.Query(ctx, `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod), NOT a.attnotnull
+		FROM pg_attribute a
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped AND a.attname <> 'id'
+		ORDER BY a.attnum
+	`, rel)
+	if err != nil {
+		return "", err
+	}
+	defer colRows.Close()
+	for colRows.Next() {
+		var c existingColumn
+		if err := colRows.Scan(&c.Name, &c.PgType, &c.IsNullable); err != nil {
+			return "", err
+		}
+		t.Cols = append(t.Cols, c)
+	}
+	return "", colRows.Err()
+}
+
+// adoptTable 把一张已有物理表注册到 lc_tables / lc_columns，pg_column 直接使用原列名。
+func adoptTable(ctx context.Context, tx pgx.Tx, t *existingTable) (*lowcodev1.Table, []*lowcodev1.Column, error) {
+	tbl, err := scanTable(tx.QueryRow(ctx, `
+		INSERT INTO lc_tables (name, schema_name, table_name)
+		VALUES ($1, $2, $3)
+		RETURNING `+tableFieldsSQL, t.LcName, t.Schema, t.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cols []*lowcodev1.Column
+	for i, ec := range t.Cols {
+		typeID := ec.TypeID
+		if typeID == "" {
+			typeID = lcTypeForPgType(ec.PgType)
+		}
+		c, err := scanColumn(tx.QueryRow(ctx, `
+			INSERT INTO lc_columns (table_id, name, type_id, pg_column, is_nullable, position, config)
+			VALUES ($1, $2, $3, $2, $4, $5, '{}'::jsonb)
+			RETURNING `+columnFieldsSQL, t.LcName, ec.Name, typeID, ec.IsNullable, i+1))
+		if err != nil {
+			return nil, nil, err
+		}
+		cols = append(cols, c)
+	}
+	return tbl, cols, nil
+}
+
+// adoptForeignKey 把外键映射为一对 relationship 列：引用方加多对一列，被引用方加一对多列。
+// 同名列已存在时对应的返回值为 nil。
+func adoptForeignKey(ctx context.Context, tx pgx.Tx, fromTable, toTable, fkColumn, fkColID string) (manyToOne, oneToMany *lowcodev1.Column, err error) {
+	manyToOne, err = insertRelationshipColumn(ctx, tx, fromTable, relationshipName(fkColumn),
+		map[string]any{"target_table_id": toTable, "target_column_id": fkColID})
+	if err != nil {
+		return nil, nil, err
+	}
+	oneToMany, err = insertRelationshipColumn(ctx, tx, toTable, fromTable,
+		map[string]any{"target_table_id": fromTable, "link_column_id": fkColID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return manyToOne, oneToMany, nil
+}
+
+// insertRelationshipColumn 注册一个 relationship 虚拟列；同名列已存在时跳过并返回 nil。
+func insertRelationshipColumn(ctx context.Context, tx pgx.Tx, tableName, name string, cfg map[string]any) (*lowcodev1.Column, error) {
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_columns WHERE table_id = $1 AND name = $2)`,
+		tableName, name).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, nil
+	}
+	pgColumn := "v_" + strings.ReplaceAll(uuid.New().String()[:8], "-", "")
+	return scanColumn(tx.QueryRow(ctx, `
+		INSERT INTO lc_columns (table_id, name, type_id, pg_column, is_nullable, position, config)
+		VALUES ($1, $2, 'relationship', $3, TRUE,
+		        (SELECT COALESCE(MAX(position), 0) + 1 FROM lc_columns WHERE table_id = $1), $4)
+		RETURNING `+columnFieldsSQL, tableName, name, pgColumn, cfg))
+}
+
+// lcTypeForPgType 把已有列的 PG 类型映射到内置 lc_types，未知类型按 text 处理。
+func lcTypeForPgType(pgType string) string {
+	base := pgType
+	if i := strings.IndexByte(base, '('); i >= 0 {
+		base = base[:i]
+	}
+	switch strings.TrimSpace(base) {
+	case "smallint", "integer", "bigint", "numeric", "real", "double precision":
+		return "number"
+	case "boolean":
+		return "bool"
+	case "timestamp with time zone", "timestamp without time zone", "date":
+		return "timestamp"
+	case "json", "jsonb":
+		return "json"
+	default:
+		return "text"
+	}
+}
+
+// relationshipName 由外键列名生成 relationship 列名：customer_id -> customer。
+func relationshipName(fkColumn string) string {
+	if n := strings.TrimSuffix(fkColumn, "_id"); n != fkColumn && n != "" {
+		return n
+	}
+	return fkColumn + "_ref"
+}
+
+// orderByForeignKeys 拓扑排序：被引用的表排在引用它的表之前，存在环时按原顺序追加剩余表。
+func orderByForeignKeys(tables []*existingTable, fks []existingForeignKey) []*existingTable {
+	deps := make(map[string]map[string]bool)
+	for _, fk := range fks {
+		from, to := fk.FromSchema+"."+fk.FromTable, fk.ToSchema+"."+fk.ToTable
+		if from == to {
+			continue
+		}
+		if deps[from] == nil {
+			deps[from] = make(map[string]bool)
+		}
+		deps[from][to] = true
+	}
+
+	done := make(map[string]bool)
+	var out []*existingTable
+	for len(out) < len(tables) {
+		progressed := false
+		for _, t := range tables {
+			key := t.Schema + "." + t.Name
+			if done[key] {
+				continue
+			}
+			ready := true
+			for dep := range deps[key] {
+				if !done[dep] && containsTable(tables, dep) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				done[key] = true
+				out = append(out, t)
+				progressed = true
+			}
+		}
+		if !progressed {
+			for _, t := range tables {
+				if key := t.Schema + "." + t.Name; !done[key] {
+					done[key] = true
+					out = append(out, t)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func containsTable(tables []*existingTable, key string) bool {
+	for _, t := range tables {
+		if t.Schema+"."+t.Name == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Aggregation --------
+
+// numericPgTypes 是允许 SUM / AVG 的列类型。
+var numericPgTypes = map[string]bool{
+	"numeric": true, "decimal": true,
+	"integer": true, "int": true, "int4": true, "smallint": true, "int2": true, "bigint": true, "int8": true,
+	"real": true, "float4": true, "double precision": true, "float8": true,
+	"money": true,
+}
+
+// AggregateRows 按 group_by_column_ids 分组并计算聚合值，分组按分组列升序返回。
+func (s *LowcodeService) AggregateRows(ctx context.Context, req *lowcodev1.AggregateRowsRequest) (*lowcodev1.AggregateRowsResponse, error) {
+	pool, err := s.tenants.ReadPoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableID := req.GetTableId()
+	if tableID == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	if len(req.GetAggregations()) == 0 && len(req.GetGroupByColumnIds()) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "aggregations or group_by_column_ids is required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cols) == 0 {
+		return &lowcodev1.AggregateRowsResponse{}, nil
+	}
+	// 分组、聚合和过滤用到的列都要可读；COUNT(*) 不引用列
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkTableRead(); err != nil {
+		return nil, err
+	}
+	used := append(filterColumnIDs(req.GetFilter()), req.GetGroupByColumnIds()...)
+	for _, agg := range req.GetAggregations() {
+		if agg.GetColumnId() != "" {
+			used = append(used, agg.GetColumnId())
+		}
+	}
+	if err := acc.checkRead(used...); err != nil {
+		return nil, err
+	}
+	byID := make(map[string]columnMeta, len(cols))
+	for _, c := range cols {
+		byID[c.Id] = c
+	}
+
+	var groupSQL, selectSQL []string
+	for _, id := range req.GetGroupByColumnIds() {
+		colSQL, _, err := queryColumn(id, byID)
+		if err != nil {
+			return nil, err
+		}
+		groupSQL = append(groupSQL, colSQL)
+		selectSQL = append(selectSQL, colSQL)
+	}
+	for _, agg := range req.GetAggregations() {
+		expr, err := aggregateSQL(agg, byID)
+		if err != nil {
+			return nil, err
+		}
+		selectSQL = append(selectSQL, expr)
+	}
+
+	var a sqlArgs
+	where := ""
+	filterSQL, err := compileFilter(req.GetFilter(), byID, &a)
+	if err != nil {
+		return nil, err
+	}
+	var conds []string
+	if filterSQL != "" {
+		conds = append(conds, "("+filterSQL+")")
+	}
+	if softDeletes(cols) {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	groupBy := ""
+	if len(groupSQL) > 0 {
+		groupBy = "GROUP BY " + strings.Join(groupSQL, ", ") + " ORDER BY " + strings.Join(groupSQL, ", ")
+	}
+	query := fmt.Sprintf(`SELECT %s FROM %s.%s %s %s LIMIT %s`,
+		strings.Join(selectSQL, ", "),
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		where,
+		groupBy,
+		a.add(s.clampPageSize(req.GetLimit())),
+	)
+	rows, err := pool.Query(ctx, query, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nGroup := len(req.GetGroupByColumnIds())
+	var resp lowcodev1.AggregateRowsResponse
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, err
+		}
+		g := &lowcodev1.AggregateGroup{Keys: make(map[string]*lowcodev1.Value, nGroup)}
+		for i, id := range req.GetGroupByColumnIds() {
+			if values[i] != nil {
+				g.Keys[id] = anyToValue(values[i])
+			}
+		}
+		for _, v := range values[nGroup:] {
+			if v == nil {
+				// SUM / AVG / MIN / MAX 在没有非 NULL 值时为 NULL，保持下标对应
+				g.Values = append(g.Values, &lowcodev1.Value{Kind: &lowcodev1.Value_NullValue{}})
+				continue
+			}
+			g.Values = append(g.Values, anyToValue(v))
+		}
+		resp.Groups = append(resp.Groups, g)
+	}
+	return &resp, rows.Err()
+}
+
+func aggregateSQL(agg *lowcodev1.Aggregation, cols map[string]columnMeta) (string, error) {
+	fn := agg.GetFunction()
+	if agg.GetColumnId() == "" {
+		if fn == lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_COUNT {
+			return "COUNT(*)", nil
+		}
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s requires column_id", fn)
+	}
+	colSQL, pgType, err := queryColumn(agg.GetColumnId(), cols)
+	if err != nil {
+		return "", err
+	}
+	switch fn {
+	case lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_COUNT:
+		return "COUNT(" + colSQL + ")", nil
+	case lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_COUNT_DISTINCT:
+		return "COUNT(DISTINCT " + colSQL + ")", nil
+	case lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_MIN:
+		return "MIN(" + colSQL + ")", nil
+	case lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_MAX:
+		return "MAX(" + colSQL + ")", nil
+	case lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_SUM, lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_AVG:
+		if !numericPgTypes[pgType] {
+			return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s requires a numeric column, %s is %s", fn, agg.GetColumnId(), pgType)
+		}
+		if fn == lowcodev1.AggregateFunction_AGGREGATE_FUNCTION_SUM {
+			return "SUM(" + colSQL + ")", nil
+		}
+		return "AVG(" + colSQL + ")", nil
+	default:
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "unsupported aggregate function %s", fn)
+	}
+}
+
+// ListDistinctValues 返回列的去重取值（SELECT DISTINCT），可按前缀过滤。
+func (s *LowcodeService) ListDistinctValues(ctx context.Context, req *lowcodev1.ListDistinctValuesRequest) (*lowcodev1.ListDistinctValuesResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetColumnId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and column_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkColumnRead(ctx, pool, req.GetTableId(), req.GetColumnId()); err != nil {
+		return nil, err
+	}
+	byID := make(map[string]columnMeta, len(cols))
+	for _, c := range cols {
+		byID[c.Id] = c
+	}
+	colSQL, _, err := queryColumn(req.GetColumnId(), byID)
+	if err != nil {
+		return nil, err
+	}
+
+	var a sqlArgs
+	conds := []string{colSQL + " IS NOT NULL"}
+	if softDeletes(cols) {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	if req.GetSearch() != "" {
+		conds = append(conds, colSQL+"::text ILIKE "+a.add(escapeLike(req.GetSearch())+"%"))
+	}
+	query := fmt.Sprintf(`SELECT DISTINCT %s FROM %s.%s WHERE %s ORDER BY 1 LIMIT %s`,
+		colSQL,
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		strings.Join(conds, " AND "),
+		a.add(s.clampPageSize(req.GetLimit())),
+	)
+	rows, err := pool.Query(ctx, query, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resp lowcodev1.ListDistinctValuesResponse
+	for rows.Next() {
+		var v any
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		resp.Values = append(resp.Values, anyToValue(v))
+	}
+	return &resp, rows.Err()
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Table archive / restore / purge --------
+
+// archivedPhysPrefix 是归档时重命名后的物理表名前缀；RestoreTable 据此判断是否需要改回 lc_t_<name>。
+const archivedPhysPrefix = "lc_a_"
+
+// tableDropStatements 返回删除表所需的语句：物理表以及表上多对多 relationship 列的关联表。
+func tableDropStatements(ctx context.Context, q querier, name, schemaName, tableName string) ([]string, error) {
+	statements := []string{fmt.Sprintf(`DROP TABLE IF EXISTS %s.%s`,
+		pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{tableName}.Sanitize())}
+	joinRows, err := q.Query(ctx, `
+		SELECT config->>'join_schema', config->>'join_table'
+		FROM lc_columns
+		WHERE table_id = $1 AND COALESCE(config->>'join_table', '') <> ''`, name)
+	if err != nil {
+		return nil, err
+	}
+	joins, err := pgx.CollectRows(joinRows, func(r pgx.CollectableRow) (string, error) {
+		var js, jt string
+		err := r.Scan(&js, &jt)
+		return dropJoinTableSQL(js, jt), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(statements, joins...), nil
+}
+
+// archiveTable 把表改名为 <name>__archived_<随机后缀> 并记录 archived_at / original_name，原表名可以立即复用。
+// CreateTable 生成的非分区物理表同时改名为 lc_a_<后缀>，避免与同名新表的 lc_t_<name> 冲突；
+// 导入 / 接管的表和分区表保持原物理表名（同 UpdateTable）。
+func archiveTable(ctx context.Context, tx pgx.Tx, name, schemaName, physTable string, cfg map[string]any) (*lowcodev1.Table, error) {
+	suffix := strings.ReplaceAll(uuid.New().String()[:8], "-", "")
+	archivedName := name + "__archived_" + suffix
+	newPhysTable := physTable
+	if physTable == "lc_t_"+name && partitionFromConfig(cfg) == nil {
+		newPhysTable = archivedPhysPrefix + suffix
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`,
+			pgx.Identifier{schemaName, physTable}.Sanitize(), pgx.Identifier{newPhysTable}.Sanitize())); err != nil {
+			return nil, err
+		}
+	}
+	t, err := scanTable(tx.QueryRow(ctx, `
+		UPDATE lc_tables
+		SET name = $2, table_name = $3, archived_at = now(), original_name = $1, updated_at = now()
+		WHERE name = $1
+		RETURNING `+tableFieldsSQL, name, archivedName, newPhysTable))
+	if err != nil {
+		return nil, err
+	}
+	// 其它表的依赖列已在 DeleteTable 中处理，这里只剩表自身的 relationship 列
+	if _, err := tx.Exec(ctx, `
+		UPDATE lc_columns
+		SET config = jsonb_set(config, '{target_table_id}', to_jsonb($2::text)), updated_at = now()
+		WHERE config->>'target_table_id' = $1`, name, archivedName); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// RestoreTable 把归档的表改回原表名（或 name），物理表随之改回 lc_t_<name>。
+func (s *LowcodeService) RestoreTable(ctx context.Context, req *lowcodev1.RestoreTableRequest) (*lowcodev1.RestoreTableResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ref, err := lockArchivedTable(ctx, tx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	schemaName, physTable := ref.SchemaName, ref.PhysTable
+	name := req.GetName()
+	if name == "" {
+		name = ref.OriginalName
+	} else if err := validateTableName("name", name); err != nil {
+		return nil, err
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_tables WHERE name = $1)`, name).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists; restore under a different name", name)
+	}
+	newPhysTable := physTable
+	if strings.HasPrefix(physTable, archivedPhysPrefix) {
+		newPhysTable = "lc_t_" + name
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`,
+			pgx.Identifier{schemaName, physTable}.Sanitize(), pgx.Identifier{newPhysTable}.Sanitize())); err != nil {
+			return nil, err
+		}
+	}
+	t, err := scanTable(tx.QueryRow(ctx, `
+		UPDATE lc_tables
+		SET name = $2, table_name = $3, archived_at = NULL, original_name = '', updated_at = now()
+		WHERE name = $1
+		RETURNING `+tableFieldsSQL, ref.Name, name, newPhysTable))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE lc_columns
+		SET config = jsonb_set(config, '{target_table_id}', to_jsonb($2::text)), updated_at = now()
+		WHERE config->>'target_table_id' = $1`, ref.Name, name); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.RestoreTableResponse{Table: t}, nil
+}
+
+// PurgeTable 立即删除归档的表；未归档的表需要先 DeleteTable（或 DeleteTable 时传 purge）。
+func (s *LowcodeService) PurgeTable(ctx context.Context, req *lowcodev1.PurgeTableRequest) (*lowcodev1.PurgeTableResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ref, err := lockArchivedTable(ctx, tx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := purgeTable(ctx, tx, ref.Name, ref.SchemaName, ref.PhysTable); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.PurgeTableResponse{}, nil
+}
+
+// lockArchivedTable 用 lookupTable 锁定并读取归档的表；表不存在返回 TABLE_NOT_FOUND，未归档返回 FailedPrecondition。
+func lockArchivedTable(ctx context.Context, q querier, id string) (tableRef, error) {
+	t, err := lookupTable(ctx, q, id, true, true)
+	if err != nil {
+		return tableRef{}, err
+	}
+	if t.ArchivedAt == nil {
+		return tableRef{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is not archived", id)
+	}
+	return t, nil
+}
+
+// purgeTable 删除物理表、关联表和 lc_tables 记录（lc_columns / lc_indexes 随外键级联删除）。
+func purgeTable(ctx context.Context, tx pgx.Tx, name, schemaName, physTable string) error {
+	statements, err := tableDropStatements(ctx, tx, name, schemaName, physTable)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM lc_tables WHERE name = $1`, name)
+	return err
+}
+
+// RunTableRetention 定期清除所有已打开的 tenant 库中归档超过 retention 的表，直到 ctx 结束。
+func (s *LowcodeService) RunTableRetention(ctx context.Context, every, retention time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		s.tenants.ForEachPool(func(tenantID string, pool *pgxpool.Pool) {
+			if err := purgeExpiredTables(ctx, pool, time.Now().Add(-retention)); err != nil {
+				log.Printf("table retention (tenant %q): %v", tenantID, err)
+			}
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpiredTables 逐表清除 before 之前归档的表，每张表一个事务，单表失败不影响其它表。
+func purgeExpiredTables(ctx context.Context, pool *pgxpool.Pool, before time.Time) error {
+	rows, err := pool.Query(ctx, `SELECT name FROM lc_tables WHERE archived_at < $1 ORDER BY archived_at`, before)
+	if err != nil {
+		return err
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+			ref, err := lockArchivedTable(ctx, tx, name)
+			if err != nil {
+				return err
+			}
+			return purgeTable(ctx, tx, ref.Name, ref.SchemaName, ref.PhysTable)
+		})
+		if err != nil {
+			log.Printf("table retention: purge %s: %v", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/storage"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// -------- Attachments --------
+
+// attachmentKind 是附件列的 lc_types.config.kind。列为 jsonb，单元格是附件元数据的数组：
+//
+//	[ { "id", "filename", "content_type", "size", "key" }, ... ]
+//
+// 文件本身在对象存储中，key 由 CreateAttachmentUpload 生成，固定以 attachmentPrefix 开头。
+const attachmentKind = "attachment"
+
+// attachmentPrefix 是某个 tenant 某一列的对象 key 前缀。
+func attachmentPrefix(tenantID, tableID, columnID string) string {
+	if tenantID == "" {
+		tenantID = "_"
+	}
+	return tenantID + "/" + tableID + "/" + columnID + "/"
+}
+
+// sharedKeyColumnsKey 是附件列 config 中的字段：DuplicateTable 复制的列记录源列 id，
+// 复制过来的单元格仍引用源列生成的对象 key。
+const sharedKeyColumnsKey = "shared_key_columns"
+
+func sharedKeyColumns(c columnMeta) []any {
+	ids, _ := c.Config[sharedKeyColumnsKey].([]any)
+	return ids
+}
+
+// attachmentKeyOf 判断 key 是否由 CreateAttachmentUpload 为该 tenant 的这一列（或 shared_key_columns 中的列）生成。
+// 不比较 key 中的表名：表改名（UpdateTable）后已有附件的 key 保持原样。
+func attachmentKeyOf(key, tenantID string, c columnMeta) bool {
+	if tenantID == "" {
+		tenantID = "_"
+	}
+	parts := strings.SplitN(key, "/", 4)
+	if len(parts) != 4 || parts[0] != tenantID {
+		return false
+	}
+	if parts[2] == c.Id {
+		return true
+	}
+	for _, id := range sharedKeyColumns(c) {
+		if id == parts[2] {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidAttachments 校验写入附件列的值：必须是 list_value，每项为包含 id / filename / key 的对象。
+func invalidAttachments(v *lowcodev1.Value) string {
+	list, ok := v.GetKind().(*lowcodev1.Value_ListValue)
+	if !ok {
+		return "must be a list of attachments"
+	}
+	for i, e := range list.ListValue.GetValues() {
+		fields := e.GetJsonValue().GetFields()
+		for _, f := range []string{"id", "filename", "key"} {
+			if fields[f].GetStringValue() == "" {
+				return fmt.Sprintf("attachment %d is missing %s", i, f)
+			}
+		}
+	}
+	return ""
+}
+
+func (s *LowcodeService) requireStorage() (storage.Store, error) {
+	if s.storage == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "attachment storage is not configured (STORAGE_PROVIDER)")
+	}
+	return s.storage, nil
+}
+
+// attachmentColumn 在 cols 中找到 columnID 对应的附件列。
+func attachmentColumn(cols []columnMeta, tableID, columnID string) (columnMeta, error) {
+	for _, c := range cols {
+		if c.Id != columnID {
+			continue
+		}
+		if c.Kind != attachmentKind {
+			return columnMeta{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column %s is not an attachment column", columnID)
+		}
+		return c, nil
+	}
+	return columnMeta{}, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found in table %s", columnID, tableID)
+}
+
+// CreateAttachmentUpload 生成附件元数据和预签名上传 URL。服务不跟踪未完成的上传：
+// 客户端上传成功后把 attachment 追加到单元格（CreateRow / UpdateRow）才算写入。
+func (s *LowcodeService) CreateAttachmentUpload(ctx context.Context, req *lowcodev1.CreateAttachmentUploadRequest) (*lowcodev1.CreateAttachmentUploadResponse, error) {
+	store, err := s.requireStorage()
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetColumnId() == "" || req.GetFilename() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id, column_id and filename are required")
+	}
+	cols, _, _, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	col, err := attachmentColumn(cols, req.GetTableId(), req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkColumnWrite(ctx, pool, req.GetTableId(), req.GetColumnId()); err != nil {
+		return nil, err
+	}
+	if max, ok := col.Config["max_size"].(float64); ok && max > 0 && float64(req.GetSize()) > max {
+		return nil, apierr.NewValidation([]apierr.FieldViolation{{
+			Field:       "size",
+			Description: fmt.Sprintf("%s allows files up to %d bytes", col.Name, int64(max)),
+		}})
+	}
+
+	// key 中只保留文件名的最后一段，避免客户端构造路径
+	id := uuid.New().String()
+	name := path.Base(strings.ReplaceAll(req.GetFilename(), "\\", "/"))
+	att := &lowcodev1.Attachment{
+		Id:          id,
+		Filename:    req.GetFilename(),
+		ContentType: req.GetContentType(),
+		Size:        req.GetSize(),
+		Key:         attachmentPrefix(tenant.FromContext(ctx), req.GetTableId(), req.GetColumnId()) + id + "/" + name,
+	}
+	p, err := store.PresignUpload(ctx, att.Key, att.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.CreateAttachmentUploadResponse{Attachment: att, Upload: presignedURL(p)}, nil
+}
+
+// GetAttachmentUrl 为单元格中的附件生成预签名下载 URL。
+func (s *LowcodeService) GetAttachmentUrl(ctx context.Context, req *lowcodev1.GetAttachmentUrlRequest) (*lowcodev1.GetAttachmentUrlResponse, error) {
+	store, err := s.requireStorage()
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetRowId() == "" || req.GetColumnId() == "" || req.GetAttachmentId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id, row_id, column_id and attachment_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	col, err := attachmentColumn(cols, req.GetTableId(), req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkColumnRead(ctx, pool, req.GetTableId(), req.GetColumnId()); err != nil {
+		return nil, err
+	}
+	var cell []map[string]any
+	if err := pool.QueryRow(ctx, fmt.Sprintf(`SELECT COALESCE(%s, '[]'::jsonb) FROM %s.%s WHERE id = $1%s`,
+		pgx.Identifier{col.PgColumn}.Sanitize(),
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		andNotDeleted(cols),
+	), req.GetRowId()).Scan(&cell); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", req.GetRowId())
+		}
+		return nil, err
+	}
+
+	for _, m := range cell {
+		if id, _ := m["id"].(string); id != req.GetAttachmentId() {
+			continue
+		}
+		att := &lowcodev1.Attachment{Id: req.GetAttachmentId()}
+		att.Filename, _ = m["filename"].(string)
+		att.ContentType, _ = m["content_type"].(string)
+		att.Key, _ = m["key"].(string)
+		if size, ok := m["size"].(float64); ok {
+			att.Size = int64(size)
+		}
+		// 单元格内容可以被任意写入，只为本列生成的 key 签名
+		if !attachmentKeyOf(att.Key, tenant.FromContext(ctx), col) {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "attachment %s does not belong to column %s", att.Id, req.GetColumnId())
+		}
+		p, err := store.PresignDownload(ctx, att.Key, att.Filename)
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.GetAttachmentUrlResponse{Attachment: att, Download: presignedURL(p)}, nil
+	}
+	return nil, apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "attachment %s not found", req.GetAttachmentId())
+}
+
+func presignedURL(p *storage.Presigned) *lowcodev1.PresignedUrl {
+	return &lowcodev1.PresignedUrl{
+		Method:    p.Method,
+		Url:       p.URL,
+		Headers:   p.Headers,
+		ExpiresAt: timestamppb.New(p.ExpiresAt),
+	}
+}
@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+	"github.com/solat/lowcode-database/internal/db"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// -------- Audit --------
+
+const (
+	// maxAuditRequestBytes 是审计事件中记录的请求 JSON 上限，超过时只记录大小。
+	maxAuditRequestBytes = 64 << 10
+	maxAuditPageSize     = 1000
+)
+
+// readMethodPrefixes 是只读方法的名字前缀，这些方法不记录审计事件。
+var readMethodPrefixes = []string{"Get", "List", "Search", "Aggregate", "Export", "Stream", "Download", "Find"}
+
+// auditEvent 是一次修改操作的审计事件。拦截器创建后放进 context，服务在执行中补充受影响的 id 和行数据的变化。
+type auditEvent struct {
+	method      string
+	userID      string
+	tableID     string
+	request     []byte
+	mu          sync.Mutex
+	resourceIDs []string
+	diff        map[string]map[string]map[string]any
+}
+
+type auditKey struct{}
+
+func auditFromContext(ctx context.Context) *auditEvent {
+	ev, _ := ctx.Value(auditKey{}).(*auditEvent)
+	return ev
+}
+
+// auditResources 把 ids 加入当前审计事件的 resource_ids，没有审计事件时什么都不做。
+func auditResources(ctx context.Context, ids ...string) {
+	ev := auditFromContext(ctx)
+	if ev == nil {
+		return
+	}
+	ev.mu.Lock()
+	defer ev.mu.Unlock()
+	ev.resourceIDs = append(ev.resourceIDs, ids...)
+}
+
+// auditRowChange 记录一行中 columnIDs 列的变化，before 为 nil 表示新建的行。
+func auditRowChange(ctx context.Context, rowID string, before, after *lowcodev1.Row, columnIDs []string) {
+	ev := auditFromContext(ctx)
+	if ev == nil || rowID == "" {
+		return
+	}
+	change := make(map[string]map[string]any, len(columnIDs))
+	for _, id := range columnIDs {
+		c := map[string]any{"after": nil}
+		if v := after.GetCells()[id]; v != nil {
+			c["after"] = valueToJSON(v)
+		}
+		if before != nil {
+			c["before"] = nil
+			if v := before.GetCells()[id]; v != nil {
+				c["before"] = valueToJSON(v)
+			}
+		}
+		change[id] = c
+	}
+	ev.mu.Lock()
+	defer ev.mu.Unlock()
+	ev.resourceIDs = append(ev.resourceIDs, rowID)
+	if ev.diff == nil {
+		ev.diff = make(map[string]map[string]map[string]any)
+	}
+	ev.diff[rowID] = change
+}
+
+// cellColumnIDs 返回 cells 的列 id 和 cleared，用作 auditRowChange 的 columnIDs。
+func cellColumnIDs(cells map[string]*lowcodev1.Value, cleared []string) []string {
+	ids := make([]string, 0, len(cells)+len(cleared))
+	for id := range cells {
+		ids = append(ids, id)
+	}
+	return append(ids, cleared...)
+}
+
+// BeginAudit 为 fullMethod 的一次调用开始审计事件，返回带有事件的 ctx 和 finish；调用结束后用结果调用 finish
+// 写入 lc_audit_log。只读方法和其它 service 的方法返回原 ctx 和空的 finish。
+// req 为请求消息（streaming 方法为第一条消息或 nil），从中取出表、行、列等 id。
+func (s *LowcodeService) BeginAudit(ctx context.Context, fullMethod string, req any) (context.Context, func(error)) {
+	method, ok := strings.CutPrefix(fullMethod, "/"+lowcodev1.LowcodeService_ServiceDesc.ServiceName+"/")
+	if !ok {
+		return ctx, func(error) {}
+	}
+	for _, p := range readMethodPrefixes {
+		if strings.HasPrefix(method, p) {
+			return ctx, func(error) {}
+		}
+	}
+	ev := &auditEvent{method: method, userID: auth.UserIDFromContext(ctx)}
+	if r, ok := req.(interface{ GetTableId() string }); ok {
+		ev.tableID = r.GetTableId()
+	}
+	if r, ok := req.(interface{ GetId() string }); ok {
+		// 表的方法（UpdateTable、DeleteTable 等）用 id 指定表
+		if ev.tableID == "" && strings.HasSuffix(method, "Table") {
+			ev.tableID = r.GetId()
+		}
+		ev.resourceIDs = append(ev.resourceIDs, r.GetId())
+	}
+	// 新建表的方法用 name 指定表
+	if r, ok := req.(interface{ GetName() string }); ok && ev.tableID == "" && strings.HasPrefix(method, "CreateTable") {
+		ev.tableID = r.GetName()
+	}
+	ev.resourceIDs = append(ev.resourceIDs, ev.tableID)
+	if r, ok := req.(interface{ GetRowId() string }); ok {
+		ev.resourceIDs = append(ev.resourceIDs, r.GetRowId())
+	}
+	if r, ok := req.(interface{ GetColumnId() string }); ok {
+		ev.resourceIDs = append(ev.resourceIDs, r.GetColumnId())
+	}
+	if r, ok := req.(interface{ GetRowIds() []string }); ok {
+		ev.resourceIDs = append(ev.resourceIDs, r.GetRowIds()...)
+	}
+	if m, ok := req.(proto.Message); ok && m != nil {
+		b, err := protojson.Marshal(redactAuditRequest(m))
+		switch {
+		case err != nil:
+		case len(b) > maxAuditRequestBytes:
+			ev.request = []byte(fmt.Sprintf(`{"truncated": true, "bytes": %d}`, len(b)))
+		default:
+			ev.request = b
+		}
+	}
+	ctx = context.WithValue(ctx, auditKey{}, ev)
+	return ctx, func(err error) { s.writeAudit(ctx, ev, err) }
+}
+
+// redactedSecret 替换审计请求中的密钥字段，只保留是否设置过。
+const redactedSecret = "[REDACTED]"
+
+// redactAuditRequest 返回去掉密钥字段的请求副本，用于写入 lc_audit_log：tenant 的 dsn / replica_dsn 可能包含密码，
+// 注册表中是 KMS 加密保存的，审计日志中不能有明文。没有密钥字段的请求原样返回。
+func redactAuditRequest(m proto.Message) proto.Message {
+	redact := func(s *string) {
+		if s != nil && *s != "" {
+			*s = redactedSecret
+		}
+	}
+	switch r := m.(type) {
+	case *lowcodev1.CreateTenantRequest:
+		r = proto.Clone(r).(*lowcodev1.CreateTenantRequest)
+		redact(&r.Dsn)
+		return r
+	case *lowcodev1.UpdateTenantRequest:
+		r = proto.Clone(r).(*lowcodev1.UpdateTenantRequest)
+		redact(r.Dsn)
+		redact(r.ReplicaDsn)
+		return r
+	case *lowcodev1.CreateWebhookRequest:
+		r = proto.Clone(r).(*lowcodev1.CreateWebhookRequest)
+		redact(&r.Secret)
+		return r
+	}
+	return m
+}
+
+// writeAudit 写入审计事件。写入不受请求取消和 statement_timeout 影响；失败时事件记录到服务日志，不影响调用结果。
+// 成功的调用随后在后台投递给匹配的 webhook。
+func (s *LowcodeService) writeAudit(ctx context.Context, ev *auditEvent, callErr error) {
+	ctx = db.WithoutStatementTimeout(context.WithoutCancel(ctx))
+	code, msg := "OK", ""
+	if callErr != nil {
+		// 同返回给调用方的错误，不记录原始的 PG 错误（可能包含出错的值）
+		err := apierr.Annotate(callErr)
+		code, msg = apierr.CodeOf(err).String(), status.Convert(err).Message()
+	}
+	ev.mu.Lock()
+	defer ev.mu.Unlock()
+	var diff []byte
+	if len(ev.diff) > 0 {
+		diff, _ = json.Marshal(ev.diff)
+	}
+	resourceIDs := compactIDs(ev.resourceIDs)
+
+	pool, err := s.tenants.PoolFor(ctx)
+	var event *lowcodev1.AuditEvent
+	if err == nil {
+		event, err = scanAuditEvent(pool.QueryRow(ctx, `
+			INSERT INTO lc_audit_log (user_id, method, table_id, resource_ids, code, error, request, diff)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING `+auditEventFieldsSQL,
+			ev.userID, ev.method, ev.tableID, resourceIDs, code, msg, jsonbArg(ev.request), jsonbArg(diff)))
+	}
+	if err != nil {
+		log.Printf("audit (tenant %q): %s by %q on %v: %s %s: record event: %v",
+			tenant.FromContext(ctx), ev.method, ev.userID, resourceIDs, code, msg, err)
+		return
+	}
+	if callErr == nil {
+		go s.deliverWebhooks(ctx, pool, event)
+	}
+}
+
+// jsonbArg 把空的 JSON 转成 NULL。
+func jsonbArg(b []byte) any {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
+
+// compactIDs 按首次出现的顺序去掉空的和重复的 id。
+func compactIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// UnaryAuditor 为修改操作记录审计事件，放在角色检查之前，被拒绝的调用也会记录。
+func (s *LowcodeService) UnaryAuditor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, finish := s.BeginAudit(ctx, info.FullMethod, req)
+	resp, err := handler(ctx, req)
+	finish(err)
+	return resp, err
+}
+
+// StreamAuditor 为 streaming 修改操作（UploadCellContent、ImportTenant）记录审计事件。
+func (s *LowcodeService) StreamAuditor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, finish := s.BeginAudit(ss.Context(), info.FullMethod, nil)
+	err := handler(srv, &auditServerStream{ServerStream: ss, ctx: ctx})
+	finish(err)
+	return err
+}
+
+// auditServerStream 让 handler 看到带审计事件的 context。
+type auditServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *auditServerStream) Context() context.Context { return s.ctx }
+
+// auditEventFieldsSQL 是 scanAuditEvent 需要的 lc_audit_log 字段。
+const auditEventFieldsSQL = `id::text, created_at, user_id, method, table_id, resource_ids, code, error, request, diff`
+
+func scanAuditEvent(row pgx.Row, extra ...any) (*lowcodev1.AuditEvent, error) {
+	var ev lowcodev1.AuditEvent
+	var createdAt time.Time
+	var request, diff []byte
+	dest := append([]any{&ev.Id, &createdAt, &ev.UserId, &ev.Method, &ev.TableId, &ev.ResourceIds, &ev.Code, &ev.Error, &request, &diff}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	ev.CreatedAt = timestamppb.New(createdAt)
+	for _, f := range []struct {
+		raw []byte
+		dst **structpb.Struct
+	}{{request, &ev.Request}, {diff, &ev.Diff}} {
+		if len(f.raw) == 0 {
+			continue
+		}
+		*f.dst = &structpb.Struct{}
+		if err := protojson.Unmarshal(f.raw, *f.dst); err != nil {
+			return nil, fmt.Errorf("audit event %s: decode: %w", ev.Id, err)
+		}
+	}
+	return &ev, nil
+}
+
+func (s *LowcodeService) ListAuditEvents(ctx context.Context, req *lowcodev1.ListAuditEventsRequest) (*lowcodev1.ListAuditEventsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var a sqlArgs
+	conds := []string{"TRUE"}
+	if req.GetUserId() != "" {
+		conds = append(conds, "user_id = "+a.add(req.GetUserId()))
+	}
+	if req.GetMethod() != "" {
+		conds = append(conds, "method = "+a.add(req.GetMethod()))
+	}
+	if req.GetTableId() != "" {
+		conds = append(conds, "table_id = "+a.add(req.GetTableId()))
+	}
+	if req.GetResourceId() != "" {
+		conds = append(conds, "resource_ids @> ARRAY["+a.add(req.GetResourceId())+"::text]")
+	}
+	if req.GetStartTime() != nil {
+		conds = append(conds, "created_at >= "+a.add(req.GetStartTime().AsTime()))
+	}
+	if req.GetEndTime() != nil {
+		conds = append(conds, "created_at < "+a.add(req.GetEndTime().AsTime()))
+	}
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if len(token.Keys) != 1 || token.Keys[0] == nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid page_token")
+		}
+		conds = append(conds, fmt.Sprintf("(created_at, id) < (%s::timestamptz, %s::uuid)", a.add(*token.Keys[0]), a.add(token.ID)))
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+	// 多取一行用来判断是否还有下一页
+	q := fmt.Sprintf(`SELECT %s, created_at::text FROM lc_audit_log WHERE %s ORDER BY created_at DESC, id DESC LIMIT %s`,
+		auditEventFieldsSQL, strings.Join(conds, " AND "), a.add(pageSize+1))
+	rows, err := pool.Query(ctx, q, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListAuditEventsResponse
+	var lastKey string
+	for rows.Next() {
+		if len(res.Events) == int(pageSize) {
+			res.NextPageToken = encodePageToken(pageToken{ID: res.Events[len(res.Events)-1].GetId(), Keys: []*string{&lastKey}})
+			break
+		}
+		var key string
+		ev, err := scanAuditEvent(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+		lastKey = key
+		res.Events = append(res.Events, ev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/db"
+	"github.com/solat/lowcode-database/internal/storage"
+)
+
+// -------- Tenant backups --------
+
+// backupKeyPrefix 是备份归档在对象存储中的前缀，与 ExportTenant 的 tenant-archives/ 分开，保留期只作用于备份。
+const backupKeyPrefix = "tenant-backups/"
+
+// defaultBackupPageSize / maxBackupPageSize 是 ListBackups 的分页大小。
+const (
+	defaultBackupPageSize = 50
+	maxBackupPageSize     = 500
+)
+
+// BackupPolicy 是定期备份的配置（BACKUP_INTERVAL_HOURS、BACKUP_RETENTION_DAYS、BACKUP_KEEP_LAST）。
+type BackupPolicy struct {
+	Interval  time.Duration // 每个 active tenant 两次备份的间隔，<= 0 不做定期备份
+	Retention time.Duration // 备份超过后删除，<= 0 不删除
+	KeepLast  int           // 仍在注册表中的 tenant 至少保留的成功备份数，不受 Retention 限制
+}
+
+func backupToProto(b *db.BackupInfo) *lowcodev1.TenantBackup {
+	out := &lowcodev1.TenantBackup{
+		Id:         b.ID,
+		TenantId:   b.TenantID,
+		State:      lowcodev1.BackupState(lowcodev1.BackupState_value["BACKUP_STATE_"+strings.ToUpper(string(b.State))]),
+		Trigger:    lowcodev1.BackupTrigger(lowcodev1.BackupTrigger_value["BACKUP_TRIGGER_"+strings.ToUpper(string(b.Trigger))]),
+		StorageKey: b.StorageKey,
+		SizeBytes:  b.SizeBytes,
+		TableCount: b.TableCount,
+		RowCount:   b.RowCount,
+		Error:      b.Error,
+		StartedAt:  timestamppb.New(b.StartedAt),
+	}
+	if b.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*b.FinishedAt)
+	}
+	return out
+}
+
+// TriggerBackup 登记一个备份并在后台执行，立即返回 RUNNING 状态的记录。
+func (s *LowcodeService) TriggerBackup(ctx context.Context, req *lowcodev1.TriggerBackupRequest) (*lowcodev1.TriggerBackupResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	store, err := s.requireStorage()
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTenantId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "tenant_id is required")
+	}
+	b, err := s.tenants.StartBackup(ctx, req.GetTenantId(), db.BackupManual, 0)
+	if err != nil {
+		return nil, err
+	}
+	// 备份不随请求结束而取消
+	go s.runBackup(context.WithoutCancel(ctx), store, b)
+	return &lowcodev1.TriggerBackupResponse{Backup: backupToProto(b)}, nil
+}
+
+func (s *LowcodeService) ListBackups(ctx context.Context, req *lowcodev1.ListBackupsRequest) (*lowcodev1.ListBackupsResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	size := int(req.GetPageSize())
+	if size <= 0 {
+		size = defaultBackupPageSize
+	}
+	if size > maxBackupPageSize {
+		size = maxBackupPageSize
+	}
+	var state db.BackupState
+	if st := req.GetState(); st != lowcodev1.BackupState_BACKUP_STATE_UNSPECIFIED {
+		state = db.BackupState(strings.ToLower(strings.TrimPrefix(st.String(), "BACKUP_STATE_")))
+	}
+	backups, more, err := s.tenants.ListBackups(ctx, req.GetTenantId(), state, req.GetPageToken(), size)
+	if err != nil {
+		return nil, err
+	}
+	res := &lowcodev1.ListBackupsResponse{}
+	for _, b := range backups {
+		res.Backups = append(res.Backups, backupToProto(b))
+	}
+	if more {
+		res.NextPageToken = backups[len(backups)-1].ID
+	}
+	return res, nil
+}
+
+// runBackup 把 tenant 归档写入 tenant-backups/<tenant>/<时间>.tar.gz，并在 lc_tenant_backups 中记录结果。
+func (s *LowcodeService) runBackup(ctx context.Context, store storage.Store, b *db.BackupInfo) {
+	var info *lowcodev1.TenantArchiveInfo
+	pool, backupErr := s.tenants.OpenTenant(ctx, b.TenantID)
+	if backupErr == nil {
+		info, backupErr = uploadTenantArchive(ctx, store, pool, b.TenantID, backupKeyPrefix)
+		pool.Close()
+	}
+	if backupErr != nil {
+		log.Printf("backup %s (tenant %q): %v", b.ID, b.TenantID, backupErr)
+	}
+	if _, err := s.tenants.FinishBackup(ctx, b.ID, info.GetStorageKey(), info.GetSize(), info.GetTableCount(), info.GetRowCount(), backupErr); err != nil {
+		log.Printf("backup %s (tenant %q): record result: %v", b.ID, b.TenantID, err)
+	}
+}
+
+// RunBackups 每隔 every 为距上次备份超过 policy.Interval 的 active tenant 依次做备份，再按保留期删除旧备份，
+// 直到 ctx 结束。多个服务实例同时运行时，同一 tenant 同时只会有一个备份（见 db.TenantManager.StartBackup）。
+func (s *LowcodeService) RunBackups(ctx context.Context, every time.Duration, policy BackupPolicy) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		if policy.Interval > 0 {
+			s.backupDueTenants(ctx, policy.Interval)
+		}
+		if policy.Retention > 0 {
+			s.pruneBackups(ctx, policy.Retention, policy.KeepLast)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *LowcodeService) backupDueTenants(ctx context.Context, interval time.Duration) {
+	ids, err := s.tenants.BackupCandidates(ctx)
+	if err != nil {
+		log.Printf("scheduled backups: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		b, err := s.tenants.StartBackup(ctx, id, db.BackupScheduled, interval)
+		if err != nil {
+			log.Printf("scheduled backup (tenant %q): %v", id, err)
+			continue
+		}
+		if b != nil {
+			s.runBackup(ctx, s.storage, b)
+		}
+	}
+}
+
+// pruneBackups 删除过期的备份：先删除对象存储中的归档，成功后再删除记录，失败的留到下一轮。
+func (s *LowcodeService) pruneBackups(ctx context.Context, retention time.Duration, keepLast int) {
+	expired, err := s.tenants.ExpiredBackups(ctx, time.Now().Add(-retention), keepLast)
+	if err != nil {
+		log.Printf("backup retention: %v", err)
+		return
+	}
+	for _, b := range expired {
+		if b.StorageKey != "" {
+			if err := storage.Delete(ctx, s.storage, b.StorageKey); err != nil {
+				log.Printf("backup retention: %v", err)
+				continue
+			}
+		}
+		if err := s.tenants.DeleteBackup(ctx, b.ID); err != nil {
+			log.Printf("backup retention: delete %s: %v", b.ID, err)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Cell content (streaming) --------
+
+const (
+	defaultMaxCellBytes = 64 << 20
+	// cellChunkSize 是下载时每条消息 / 每次读取的字节数。
+	cellChunkSize = 256 << 10
+)
+
+// cellTarget 是一个可流式读写的单元格：bytea 列内容直接存在列里，jsonb 列存 lc_blobs 引用。
+type cellTarget struct {
+	Schema   string
+	Table    string
+	PgColumn string
+	PgType   string
+	RowID    string
+}
+
+func (s *LowcodeService) cellLimit() int64 {
+	if s.maxCellBytes > 0 {
+		return s.maxCellBytes
+	}
+	return defaultMaxCellBytes
+}
+
+func (s *LowcodeService) resolveCellTarget(ctx context.Context, pool *pgxpool.Pool, tableID, rowID, columnID string) (*cellTarget, error) {
+	if tableID == "" || rowID == "" || columnID == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id, row_id and column_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cols {
+		if c.Id != columnID {
+			continue
+		}
+		if c.PgType != "bytea" && c.PgType != "jsonb" {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+				"column %s (%s) does not support content upload, bytea or jsonb column required", columnID, c.PgType)
+		}
+		if c.encrypted() {
+			// 流式读写绕过加密，加密列只能经 CreateRow / UpdateRow 读写
+			return nil, encryptedColumnError(columnID)
+		}
+		t := &cellTarget{Schema: schemaName, Table: tableName, PgColumn: c.PgColumn, PgType: c.PgType, RowID: rowID}
+		var exists bool
+		q := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s.%s WHERE id = $1%s)`,
+			pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{tableName}.Sanitize(), andNotDeleted(cols))
+		if err := pool.QueryRow(ctx, q, rowID).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", rowID)
+		}
+		return t, nil
+	}
+	return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found in table %s", columnID, tableID)
+}
+
+// WriteCellContent 把 next 依次返回的分块写入单元格，next 返回 io.EOF 表示结束。
+// 分块先写入 lc_blob_chunks；bytea 列最后在库内拼接写回列并删除 blob，jsonb 列保存 blob 引用。
+func (s *LowcodeService) WriteCellContent(ctx context.Context, info *lowcodev1.CellContentInfo, next func() ([]byte, error)) (*lowcodev1.UploadCellContentResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.resolveCellTarget(ctx, pool, info.GetTableId(), info.GetRowId(), info.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkColumnWrite(ctx, pool, info.GetTableId(), info.GetColumnId()); err != nil {
+		return nil, err
+	}
+	auditResources(ctx, info.GetTableId(), info.GetRowId(), info.GetColumnId())
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var blobID string
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO lc_blobs (content_type, filename) VALUES ($1, $2) RETURNING id
+	`, info.GetContentType(), info.GetFilename()).Scan(&blobID); err != nil {
+		return nil, err
+	}
+
+	limit := s.cellLimit()
+	var size int64
+	for seq := 0; ; seq++ {
+		chunk, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(chunk) == 0 {
+			continue
+		}
+		size += int64(len(chunk))
+		if size > limit {
+			return nil, apierr.New(lowcodev1.ErrorCode_QUOTA_EXCEEDED, codes.ResourceExhausted,
+				"cell content exceeds limit of %d bytes", limit)
+		}
+		if _, err := tx.Exec(ctx, `INSERT INTO lc_blob_chunks (blob_id, seq, data) VALUES ($1, $2, $3)`, blobID, seq, chunk); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.Exec(ctx, `UPDATE lc_blobs SET size = $2 WHERE id = $1`, blobID, size); err != nil {
+		return nil, err
+	}
+
+	rel := pgx.Identifier{target.Schema, target.Table}.Sanitize()
+	col := pgx.Identifier{target.PgColumn}.Sanitize()
+	res := &lowcodev1.UploadCellContentResponse{Size: size}
+
+	if target.PgType == "bytea" {
+		update := fmt.Sprintf(`
+			UPDATE %s SET %s = COALESCE((SELECT string_agg(data, ''::bytea ORDER BY seq) FROM lc_blob_chunks WHERE blob_id = $1), ''::bytea)
+			WHERE id = $2
+		`, rel, col)
+		if _, err := tx.Exec(ctx, update, blobID, target.RowID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM lc_blobs WHERE id = $1`, blobID); err != nil {
+			return nil, err
+		}
+	} else {
+		// 替换前先记下旧引用，写入成功后删除旧 blob。
+		var oldBlobID *string
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT %s->>'blob_id' FROM %s WHERE id = $1`, col, rel), target.RowID).Scan(&oldBlobID); err != nil {
+			return nil, err
+		}
+		ref := map[string]any{
+			"blob_id":      blobID,
+			"size":         size,
+			"content_type": info.GetContentType(),
+			"filename":     info.GetFilename(),
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET %s = $1 WHERE id = $2`, rel, col), ref, target.RowID); err != nil {
+			return nil, err
+		}
+		if oldBlobID != nil && *oldBlobID != "" {
+			if _, err := tx.Exec(ctx, `DELETE FROM lc_blobs WHERE id::text = $1`, *oldBlobID); err != nil {
+				return nil, err
+			}
+		}
+		st, err := structpb.NewStruct(ref)
+		if err != nil {
+			return nil, err
+		}
+		res.Value = &lowcodev1.Value{Kind: &lowcodev1.Value_JsonValue{JsonValue: st}}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReadCellContent 先通过 onInfo 返回元信息，再按 cellChunkSize 分块回调 onChunk。
+func (s *LowcodeService) ReadCellContent(ctx context.Context, req *lowcodev1.DownloadCellContentRequest, onInfo func(*lowcodev1.CellContentInfo) error, onChunk func([]byte) error) error {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return err
+	}
+	target, err := s.resolveCellTarget(ctx, pool, req.GetTableId(), req.GetRowId(), req.GetColumnId())
+	if err != nil {
+		return err
+	}
+	if err := s.checkColumnRead(ctx, pool, req.GetTableId(), req.GetColumnId()); err != nil {
+		return err
+	}
+	rel := pgx.Identifier{target.Schema, target.Table}.Sanitize()
+	col := pgx.Identifier{target.PgColumn}.Sanitize()
+	info := &lowcodev1.CellContentInfo{
+		TableId:  req.GetTableId(),
+		RowId:    req.GetRowId(),
+		ColumnId: req.GetColumnId(),
+	}
+
+	if target.PgType == "bytea" {
+		var size *int64
+		if err := pool.QueryRow(ctx, fmt.Sprintf(`SELECT octet_length(%s) FROM %s WHERE id = $1`, col, rel), target.RowID).Scan(&size); err != nil {
+			return err
+		}
+		if size != nil {
+			info.Size = *size
+		}
+		info.ContentType = "application/octet-stream"
+		if err := onInfo(info); err != nil {
+			return err
+		}
+		q := fmt.Sprintf(`SELECT substring(%s FROM $2 FOR $3) FROM %s WHERE id = $1`, col, rel)
+		for off := int64(0); off < info.Size; off += cellChunkSize {
+			var chunk []byte
+			if err := pool.QueryRow(ctx, q, target.RowID, off+1, cellChunkSize).Scan(&chunk); err != nil {
+				return err
+			}
+			if err := onChunk(chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var blobID *string
+	if err := pool.QueryRow(ctx, fmt.Sprintf(`SELECT %s->>'blob_id' FROM %s WHERE id = $1`, col, rel), target.RowID).Scan(&blobID); err != nil {
+		return err
+	}
+	if blobID == nil || *blobID == "" {
+		return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "cell has no uploaded content")
+	}
+	if err := pool.QueryRow(ctx, `SELECT content_type, filename, size FROM lc_blobs WHERE id::text = $1`, *blobID).
+		Scan(&info.ContentType, &info.Filename, &info.Size); err != nil {
+		if err == pgx.ErrNoRows {
+			return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "blob %s not found", *blobID)
+		}
+		return err
+	}
+	if err := onInfo(info); err != nil {
+		return err
+	}
+	// 逐个 chunk 查询，避免一次把整个 blob 读进内存。
+	for seq := -1; ; {
+		var chunk []byte
+		err := pool.QueryRow(ctx, `
+			SELECT seq, data FROM lc_blob_chunks WHERE blob_id::text = $1 AND seq > $2 ORDER BY seq LIMIT 1
+		`, *blobID, seq).Scan(&seq, &chunk)
+		if err == pgx.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := onChunk(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *LowcodeService) UploadCellContent(stream lowcodev1.LowcodeService_UploadCellContentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "first message must carry info")
+	}
+	res, err := s.WriteCellContent(stream.Context(), info, func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if msg.GetInfo() != nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "info must only be sent once")
+		}
+		return msg.GetChunk(), nil
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+func (s *LowcodeService) DownloadCellContent(req *lowcodev1.DownloadCellContentRequest, stream lowcodev1.LowcodeService_DownloadCellContentServer) error {
+	return s.ReadCellContent(stream.Context(), req,
+		func(info *lowcodev1.CellContentInfo) error {
+			return stream.Send(&lowcodev1.DownloadCellContentResponse{Payload: &lowcodev1.DownloadCellContentResponse_Info{Info: info}})
+		},
+		func(chunk []byte) error {
+			return stream.Send(&lowcodev1.DownloadCellContentResponse{Payload: &lowcodev1.DownloadCellContentResponse_Chunk{Chunk: chunk}})
+		},
+	)
+}
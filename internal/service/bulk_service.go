@@ -6,8 +6,11 @@ import (
 	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/db"
 )
 
 // -------- Bulk --------
@@ -19,7 +22,7 @@ func (s *LowcodeService) BulkUpsertRows(ctx context.Context, req *lowcodev1.Bulk
 	}
 	tableID := req.GetTableId()
 	if tableID == "" {
-		return nil, fmt.Errorf("table_id is required")
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
 	}
 	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
 	if err != nil {
@@ -28,6 +31,45 @@ func (s *LowcodeService) BulkUpsertRows(ctx context.Context, req *lowcodev1.Bulk
 	if len(cols) == 0 {
 		return nil, fmt.Errorf("no columns for table")
 	}
+	onConflict, err := conflictTarget(cols, req.GetConflictColumnIds())
+	if err != nil {
+		return nil, err
+	}
+	if len(onConflict) > 0 {
+		// 按 tenant 隔离的表上唯一索引以 tenant 列开头，冲突目标要与之一致
+		scoped, err := tenantScoped(ctx, pool, schemaName, tableName)
+		if err != nil {
+			return nil, err
+		}
+		if scoped {
+			onConflict = append(upsertConflict{pgx.Identifier{db.TenantColumn}.Sanitize()}, onConflict...)
+		}
+	}
+
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range req.GetItems() {
+		if err := acc.checkWrite(item.GetCells(), nil); err != nil {
+			return nil, err
+		}
+	}
+
+	var violations []apierr.FieldViolation
+	for i, item := range req.GetItems() {
+		prefix := fmt.Sprintf("items[%d].", i)
+		violations = append(violations, validateCells(cols, item.GetCells(), nil, item.GetRowId() == "", prefix)...)
+	}
+	if len(violations) > 0 {
+		return nil, apierr.NewValidation(violations)
+	}
+	itemCells := make([]map[string]*lowcodev1.Value, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		if itemCells[i], err = s.sealCells(ctx, cols, item.GetCells()); err != nil {
+			return nil, err
+		}
+	}
 
 	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -35,76 +77,252 @@ func (s *LowcodeService) BulkUpsertRows(ctx context.Context, req *lowcodev1.Bulk
 	}
 	defer tx.Rollback(ctx)
 
-	var resp lowcodev1.BulkUpsertRowsResponse
-
+	// 按 row_id 更新的行先把修改前的值写入 lc_row_history
+	var updateIDs []string
 	for _, item := range req.GetItems() {
+		if item.GetRowId() != "" {
+			updateIDs = append(updateIDs, item.GetRowId())
+		}
+	}
+	before := make(map[string]*lowcodev1.Row, len(updateIDs))
+	if len(updateIDs) > 0 {
+		locked, err := lockRows(ctx, tx, cols, schemaName, tableName, "id = ANY($1)"+andNotDeleted(cols), updateIDs)
+		if err != nil {
+			return nil, err
+		}
+		if err := recordRowVersions(ctx, tx, tableID, cols, rowVersionUpdate, locked); err != nil {
+			return nil, err
+		}
+		for _, row := range locked {
+			before[row.Id] = row
+		}
+	}
+
+	// 按业务键 upsert 的 insert 可能覆盖已有的行（包括回收站中的行，upsert 会顺便恢复），
+	// 同样按冲突列锁住这些行并写入 lc_row_history。pooled 模式下 RLS 已经限定 tenant，不用再加 tenant 列。
+	if len(onConflict) > 0 {
+		var keys []string
+		a := &sqlArgs{}
+		lockConflicts := func() error {
+			if len(keys) == 0 {
+				return nil
+			}
+			locked, err := lockRows(ctx, tx, cols, schemaName, tableName, strings.Join(keys, " OR "), a.args...)
+			if err != nil {
+				return err
+			}
+			var fresh []*lowcodev1.Row
+			for _, row := range locked {
+				if before[row.Id] == nil {
+					before[row.Id] = row
+					fresh = append(fresh, row)
+				}
+			}
+			keys, a = nil, &sqlArgs{}
+			return recordRowVersions(ctx, tx, tableID, cols, rowVersionUpdate, fresh)
+		}
+		for i, item := range req.GetItems() {
+			if item.GetRowId() != "" {
+				continue
+			}
+			var conds []string
+			for _, id := range req.GetConflictColumnIds() {
+				val, ok := itemCells[i][id]
+				if !ok {
+					return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "items[%d] is missing conflict column %s", i, id)
+				}
+				for _, c := range cols {
+					if c.Id != id {
+						continue
+					}
+					arg, err := cellArg(c, val)
+					if err != nil {
+						return nil, err
+					}
+					conds = append(conds, pgx.Identifier{c.PgColumn}.Sanitize()+" = "+a.add(arg))
+				}
+			}
+			keys = append(keys, "("+strings.Join(conds, " AND ")+")")
+			// 每条语句的参数个数有上限，大批量时分段锁
+			if len(keys) == conflictLockChunk {
+				if err := lockConflicts(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := lockConflicts(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 所有语句放进一个 pgx.Batch，一次往返发送，而不是每个 item 一次。
+	// pending 记录每条语句对应的 row_id（insert 为空），用于读取结果时报错；pendingItems 为对应的 item 下标。
+	batch := &pgx.Batch{}
+	var pending []string
+	var pendingItems []int
+
+	for i, item := range req.GetItems() {
 		if item.GetRowId() == "" {
-			// insert
+			// insert；指定了 conflict_column_ids 时按业务键 upsert（冲突列已在上面加锁时检查）
 			var pgCols []string
 			var args []any
 			for _, c := range cols {
-				val, ok := item.Cells[c.Id]
-				if !ok {
+				val, ok := itemCells[i][c.Id]
+				if !ok || c.System {
 					continue
 				}
-				pgCols = append(pgCols, c.PgColumn)
-				args = append(args, valueToAnyForColumn(val, c.PgType))
+				arg, err := cellArg(c, val)
+				if err != nil {
+					return nil, err
+				}
+				pgCols = append(pgCols, pgx.Identifier{c.PgColumn}.Sanitize())
+				args = append(args, arg)
 			}
 			if len(pgCols) == 0 {
 				continue
 			}
+			auditCols, auditArgs := auditWrites(ctx, cols, true)
+			pgCols = append(pgCols, auditCols...)
+			args = append(args, auditArgs...)
 			colsSQL := strings.Join(pgCols, ", ")
 			params := make([]string, len(pgCols))
 			for i := range params {
 				params[i] = fmt.Sprintf("$%d", i+1)
 			}
 			paramSQL := strings.Join(params, ", ")
-			insert := fmt.Sprintf(`INSERT INTO %s.%s (%s) VALUES (%s) RETURNING id`,
+			insert := fmt.Sprintf(`INSERT INTO %s.%s (%s) VALUES (%s)%s RETURNING %s`,
 				pgx.Identifier{schemaName}.Sanitize(),
 				pgx.Identifier{tableName}.Sanitize(),
-				colsSQL, paramSQL)
-			var id string
-			if err := tx.QueryRow(ctx, insert, args...).Scan(&id); err != nil {
-				return nil, err
-			}
-			resp.Rows = append(resp.Rows, &lowcodev1.Row{Id: id, Cells: item.Cells})
+				colsSQL, paramSQL, onConflict.clause(pgCols, softDeletes(cols)), rowColumnsSQL(cols))
+			batch.Queue(insert, args...)
+			pending = append(pending, "")
+			pendingItems = append(pendingItems, i)
 		} else {
 			// update
 			var setParts []string
 			var args []any
 			argIdx := 1
 			for _, c := range cols {
-				val, ok := item.Cells[c.Id]
-				if !ok {
+				val, ok := itemCells[i][c.Id]
+				if !ok || c.System {
 					continue
 				}
-				setParts = append(setParts, fmt.Sprintf("%s = $%d", c.PgColumn, argIdx))
-				args = append(args, valueToAnyForColumn(val, c.PgType))
+				arg, err := cellArg(c, val)
+				if err != nil {
+					return nil, err
+				}
+				setParts = append(setParts, fmt.Sprintf("%s = $%d", pgx.Identifier{c.PgColumn}.Sanitize(), argIdx))
+				args = append(args, arg)
 				argIdx++
 			}
 			if len(setParts) == 0 {
 				continue
 			}
+			auditCols, auditArgs := auditWrites(ctx, cols, false)
+			for i, col := range auditCols {
+				setParts = append(setParts, fmt.Sprintf("%s = $%d", col, argIdx))
+				args = append(args, auditArgs[i])
+				argIdx++
+			}
 			args = append(args, item.GetRowId())
-			update := fmt.Sprintf(`UPDATE %s.%s SET %s WHERE id = $%d`,
+			update := fmt.Sprintf(`UPDATE %s.%s SET %s WHERE id = $%d%s RETURNING %s`,
 				pgx.Identifier{schemaName}.Sanitize(),
 				pgx.Identifier{tableName}.Sanitize(),
 				strings.Join(setParts, ", "),
 				argIdx,
+				andNotDeleted(cols),
+				rowColumnsSQL(cols),
 			)
-			if _, err := tx.Exec(ctx, update, args...); err != nil {
-				return nil, err
+			batch.Queue(update, args...)
+			pending = append(pending, item.GetRowId())
+			pendingItems = append(pendingItems, i)
+		}
+	}
+
+	var resp lowcodev1.BulkUpsertRowsResponse
+	if batch.Len() > 0 {
+		br := tx.SendBatch(ctx, batch)
+		for _, rowID := range pending {
+			row, err := scanRow(br.QueryRow(), cols)
+			if err != nil {
+				br.Close()
+				if err == pgx.ErrNoRows && rowID != "" {
+					return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", rowID)
+				}
+				return nil, checkViolation(err, cols)
 			}
-			resp.Rows = append(resp.Rows, &lowcodev1.Row{Id: item.GetRowId(), Cells: item.Cells})
+			resp.Rows = append(resp.Rows, row)
+		}
+		if err := br.Close(); err != nil {
+			return nil, err
 		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
+	for i, row := range resp.Rows {
+		auditRowChange(ctx, row.Id, before[row.Id], row, cellColumnIDs(req.GetItems()[pendingItems[i]].GetCells(), nil))
+	}
+	if err := s.openRows(ctx, cols, resp.Rows...); err != nil {
+		return nil, err
+	}
+	acc.redactRows(resp.Rows...)
 	return &resp, nil
 }
 
+// conflictLockChunk 是 BulkUpsertRows 按冲突列加锁时每条语句包含的 item 数。
+const conflictLockChunk = 1000
+
+// upsertConflict 是 BulkUpsertRows 的 ON CONFLICT 目标列（已转义的物理列名）。
+type upsertConflict []string
+
+func conflictTarget(cols []columnMeta, columnIDs []string) (upsertConflict, error) {
+	var target upsertConflict
+	for _, id := range columnIDs {
+		found := false
+		for _, c := range cols {
+			if c.Id == id && !c.System {
+				if c.encrypted() {
+					return nil, encryptedColumnError(id)
+				}
+				target = append(target, pgx.Identifier{c.PgColumn}.Sanitize())
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.InvalidArgument, "conflict column %s not found", id)
+		}
+	}
+	return target, nil
+}
+
+// clause 生成 ON CONFLICT ... DO UPDATE：用新值覆盖除冲突列和 created_by 以外的写入列，
+// 命中回收站中的行时顺便恢复。没有可更新的列时仍然 DO UPDATE，保证 RETURNING 有结果。
+func (t upsertConflict) clause(pgCols []string, softDelete bool) string {
+	if len(t) == 0 {
+		return ""
+	}
+	skip := map[string]bool{`"created_by"`: true}
+	for _, c := range t {
+		skip[c] = true
+	}
+	var sets []string
+	for _, c := range pgCols {
+		if !skip[c] {
+			sets = append(sets, c+" = EXCLUDED."+c)
+		}
+	}
+	if softDelete {
+		sets = append(sets, "deleted_at = NULL")
+	}
+	if len(sets) == 0 {
+		sets = append(sets, t[0]+" = EXCLUDED."+t[0])
+	}
+	return fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(t, ", "), strings.Join(sets, ", "))
+}
+
 func (s *LowcodeService) BulkDeleteRows(ctx context.Context, req *lowcodev1.BulkDeleteRowsRequest) (*lowcodev1.BulkDeleteRowsResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
@@ -112,15 +330,18 @@ func (s *LowcodeService) BulkDeleteRows(ctx context.Context, req *lowcodev1.Bulk
 	}
 	tableID := req.GetTableId()
 	if tableID == "" {
-		return nil, fmt.Errorf("table_id is required")
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
 	}
-	_, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
 	if err != nil {
 		return nil, err
 	}
 	if len(req.GetRowIds()) == 0 {
 		return &lowcodev1.BulkDeleteRowsResponse{}, nil
 	}
+	if err := s.checkTableWrite(ctx, pool, tableID); err != nil {
+		return nil, err
+	}
 
 	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -128,11 +349,7 @@ func (s *LowcodeService) BulkDeleteRows(ctx context.Context, req *lowcodev1.Bulk
 	}
 	defer tx.Rollback(ctx)
 
-	del := fmt.Sprintf(`DELETE FROM %s.%s WHERE id = ANY($1)`,
-		pgx.Identifier{schemaName}.Sanitize(),
-		pgx.Identifier{tableName}.Sanitize(),
-	)
-	if _, err := tx.Exec(ctx, del, req.GetRowIds()); err != nil {
+	if err := deleteRows(ctx, tx, tableID, cols, schemaName, tableName, "id = ANY($1)", req.GetRowIds()); err != nil {
 		return nil, err
 	}
 	if err := tx.Commit(ctx); err != nil {
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Column CHECK constraints --------
+
+// 列 config 中的 min / max（数值列）和 regex（文本列）除了在写入前校验（validate.go），
+// 还编译成物理列上的 CHECK 约束，绕过服务直接写库时同样生效。
+// 约束名为 lc_ck_<column id>_<rule>，违反约束（23514）时据此还原出列 id。
+const checkConstraintPrefix = "lc_ck_"
+
+type checkConstraint struct {
+	Name string
+	Expr string
+}
+
+func isNumericPgType(base string) bool {
+	switch base {
+	case "smallint", "int2", "integer", "int", "int4", "bigint", "int8",
+		"numeric", "decimal", "real", "float4", "double precision", "float8":
+		return true
+	}
+	return false
+}
+
+// columnChecks 按列 config 生成 CHECK 约束；规则与列类型不匹配（如文本列上的 min）时忽略。
+func columnChecks(columnID, pgColumn, pgType string, cfg map[string]any) ([]checkConstraint, error) {
+	col := pgx.Identifier{pgColumn}.Sanitize()
+	base := basePgType(pgType)
+	var out []checkConstraint
+	add := func(rule, expr string) {
+		out = append(out, checkConstraint{Name: checkConstraintPrefix + columnID + "_" + rule, Expr: expr})
+	}
+	invalid := func(format string, args ...any) error {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, format, args...)
+	}
+
+	if isNumericPgType(base) {
+		for _, rule := range []struct{ key, op string }{{"min", ">="}, {"max", "<="}} {
+			v, ok := cfg[rule.key]
+			if !ok {
+				continue
+			}
+			f, isNum := v.(float64)
+			if !isNum {
+				return nil, invalid("config.%s must be a number", rule.key)
+			}
+			add(rule.key, fmt.Sprintf("%s %s %s", col, rule.op, strconv.FormatFloat(f, 'f', -1, 64)))
+		}
+	}
+	if isTextPgType(base) {
+		if v, ok := cfg["regex"]; ok {
+			pattern, _ := v.(string)
+			if _, err := regexp.Compile(pattern); err != nil || pattern == "" {
+				return nil, invalid("config.regex must be a valid regular expression")
+			}
+			add("regex", fmt.Sprintf("%s ~ '%s'", col, strings.ReplaceAll(pattern, "'", "''")))
+		}
+	}
+	return out, nil
+}
+
+// syncColumnChecks 删除列上已有的 lc_ck_ 约束并按 cfg 重建。已有数据不满足新约束时 PG 返回 23514，
+// 调用方用 checkViolation 转换。
+func syncColumnChecks(ctx context.Context, tx pgx.Tx, schemaName, tableName, columnID, pgColumn, pgType string, cfg map[string]any) error {
+	checks, err := columnChecks(columnID, pgColumn, pgType, cfg)
+	if err != nil {
+		return err
+	}
+	table := pgx.Identifier{schemaName, tableName}.Sanitize()
+
+	rows, err := tx.Query(ctx, `
+		SELECT conname FROM pg_constraint
+		WHERE conrelid = $1::regclass AND contype = 'c' AND starts_with(conname, $2)`,
+		table, checkConstraintPrefix+columnID+"_")
+	if err != nil {
+		return err
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	for _, name := range existing {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT %s`, table, pgx.Identifier{name}.Sanitize())); err != nil {
+			return err
+		}
+	}
+	for _, c := range checks {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)`,
+			table, pgx.Identifier{c.Name}.Sanitize(), c.Expr)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkViolation 把违反 lc_ck_ 约束的 PG 错误转换为以列 id 为字段的校验错误，
+// Reason 为 CHECK_MIN / CHECK_MAX / CHECK_REGEX。其它错误原样返回。
+func checkViolation(err error, cols []columnMeta) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23514" || !strings.HasPrefix(pgErr.ConstraintName, checkConstraintPrefix) {
+		return err
+	}
+	for _, c := range cols {
+		rule, ok := strings.CutPrefix(pgErr.ConstraintName, checkConstraintPrefix+c.Id+"_")
+		if !ok {
+			continue
+		}
+		desc := fmt.Sprintf("%s does not match %v", c.Name, c.Config[rule])
+		switch rule {
+		case "min":
+			desc = fmt.Sprintf("%s must be >= %v", c.Name, c.Config[rule])
+		case "max":
+			desc = fmt.Sprintf("%s must be <= %v", c.Name, c.Config[rule])
+		}
+		return apierr.NewValidation([]apierr.FieldViolation{{
+			Field:       c.Id,
+			Description: desc,
+			Reason:      "CHECK_" + strings.ToUpper(rule),
+		}})
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Type coercion --------
+
+// coerceValue 按列的 pg_type 把 protobuf Value 转成 pgx 可以直接编码的 Go 值：
+// 整数列要求整数、日期/时间列解析字符串、uuid 列校验格式等。类型不匹配时返回错误，
+// 而不是把 float64 直接交给 PG 得到 "invalid input syntax"。
+// 未识别的类型按原样传递（valueToAnyRaw）。
+func coerceValue(v *lowcodev1.Value, pgType string) (any, error) {
+	raw := valueToAnyRaw(v)
+	if raw == nil {
+		return nil, nil
+	}
+	base := basePgType(pgType)
+	if elem, ok := strings.CutSuffix(base, "[]"); ok {
+		return coerceArray(v, elem)
+	}
+	if isTextPgType(base) {
+		return coerceText(v)
+	}
+	// 空字符串写入非文本列视为 NULL，避免 "invalid input syntax for type numeric: \"\""
+	if s, ok := raw.(string); ok && s == "" && base != "" && base != "json" && base != "jsonb" {
+		return nil, nil
+	}
+
+	switch base {
+	case "smallint", "int2", "integer", "int", "int4", "bigint", "int8", "smallserial", "serial", "bigserial":
+		return coerceInt(raw, base)
+	case "numeric", "decimal":
+		switch x := raw.(type) {
+		case float64:
+			return x, nil
+		case string:
+			var n pgtype.Numeric
+			if err := n.Scan(strings.TrimSpace(x)); err != nil {
+				return nil, fmt.Errorf("expected a number, got %q", x)
+			}
+			return n, nil
+		}
+	case "real", "float4", "double precision", "float8":
+		switch x := raw.(type) {
+		case float64:
+			return x, nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+			if err != nil {
+				return nil, fmt.Errorf("expected a number, got %q", x)
+			}
+			return f, nil
+		}
+	case "boolean", "bool":
+		switch x := raw.(type) {
+		case bool:
+			return x, nil
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(x))
+			if err != nil {
+				return nil, fmt.Errorf("expected a boolean, got %q", x)
+			}
+			return b, nil
+		}
+	case "timestamptz", "timestamp", "timestamp with time zone", "timestamp without time zone":
+		switch x := raw.(type) {
+		case time.Time:
+			return x, nil
+		case string:
+			return parseTime(x)
+		}
+	case "date":
+		switch x := raw.(type) {
+		case time.Time:
+			return pgtype.Date{Time: x.UTC().Truncate(24 * time.Hour), Valid: true}, nil
+		case string:
+			t, err := parseTime(x)
+			if err != nil {
+				return nil, err
+			}
+			return pgtype.Date{Time: t, Valid: true}, nil
+		}
+	case "uuid":
+		if s, ok := raw.(string); ok {
+			id, err := uuid.Parse(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("expected a uuid, got %q", s)
+			}
+			return id.String(), nil
+		}
+	case "bytea":
+		switch x := raw.(type) {
+		case []byte:
+			return x, nil
+		case string:
+			return []byte(x), nil
+		}
+	case "json", "jsonb":
+		// pgx 把 Go string 当作原始 JSON 文本写入；不是合法 JSON 的字符串按 JSON 字符串编码。
+		if s, ok := raw.(string); ok && !json.Valid([]byte(s)) {
+			b, _ := json.Marshal(s)
+			return string(b), nil
+		}
+		return valueToJSON(v), nil
+	case "geometry", "geography":
+		return coerceGeometry(v, pgType)
+	default:
+		return raw, nil
+	}
+	return nil, fmt.Errorf("cannot use %s value for %s column", valueKindName(v), base)
+}
+
+// coerceArray 把 list_value 按元素类型逐个转换，用于 numeric[] / text[] 等数组列。
+func coerceArray(v *lowcodev1.Value, elemType string) (any, error) {
+	list, ok := v.GetKind().(*lowcodev1.Value_ListValue)
+	if !ok {
+		return nil, fmt.Errorf("cannot use %s value for %s[] column", valueKindName(v), basePgType(elemType))
+	}
+	out := make([]any, len(list.ListValue.GetValues()))
+	for i, e := range list.ListValue.GetValues() {
+		x, err := coerceValue(e, elemType)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		out[i] = x
+	}
+	return out, nil
+}
+
+// basePgType 去掉类型修饰和大小写差异，如 "VARCHAR(255)" -> "varchar"、"numeric(10,2)" -> "numeric"。
+func basePgType(pgType string) string {
+	t := strings.ToLower(strings.TrimSpace(pgType))
+	if i := strings.IndexByte(t, '('); i >= 0 && !strings.HasSuffix(t, "[]") {
+		t = strings.TrimSpace(t[:i])
+	}
+	return t
+}
+
+func isTextPgType(base string) bool {
+	switch base {
+	case "text", "varchar", "character varying", "char", "character", "bpchar", "citext":
+		return true
+	}
+	return false
+}
+
+// coerceText 允许把标量写入文本列，数值不带多余的小数位。
+func coerceText(v *lowcodev1.Value) (any, error) {
+	switch x := v.GetKind().(type) {
+	case *lowcodev1.Value_StringValue:
+		return x.StringValue, nil
+	case *lowcodev1.Value_NumberValue:
+		return strconv.FormatFloat(x.NumberValue, 'f', -1, 64), nil
+	case *lowcodev1.Value_BoolValue:
+		return strconv.FormatBool(x.BoolValue), nil
+	case *lowcodev1.Value_TimestampValue:
+		return x.TimestampValue.AsTime().Format(time.RFC3339Nano), nil
+	}
+	return nil, fmt.Errorf("cannot use %s value for text column", valueKindName(v))
+}
+
+func coerceInt(raw any, base string) (any, error) {
+	var n int64
+	switch x := raw.(type) {
+	case float64:
+		if x != math.Trunc(x) || math.IsInf(x, 0) || x < math.MinInt64 || x >= math.MaxInt64 {
+			return nil, fmt.Errorf("expected an integer, got %v", x)
+		}
+		n = int64(x)
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer, got %q", x)
+		}
+		n = i
+	default:
+		return nil, fmt.Errorf("expected an integer, got %T", raw)
+	}
+	var lo, hi int64 = math.MinInt64, math.MaxInt64
+	switch base {
+	case "smallint", "int2", "smallserial":
+		lo, hi = math.MinInt16, math.MaxInt16
+	case "integer", "int", "int4", "serial":
+		lo, hi = math.MinInt32, math.MaxInt32
+	}
+	if n < lo || n > hi {
+		return nil, fmt.Errorf("%d is out of range for %s", n, base)
+	}
+	return n, nil
+}
+
+// parseTime 接受 RFC3339 时间戳或 YYYY-MM-DD 日期。
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected an RFC3339 timestamp or YYYY-MM-DD date, got %q", s)
+}
+
+func valueKindName(v *lowcodev1.Value) string {
+	switch v.GetKind().(type) {
+	case *lowcodev1.Value_StringValue:
+		return "string"
+	case *lowcodev1.Value_NumberValue:
+		return "number"
+	case *lowcodev1.Value_BoolValue:
+		return "bool"
+	case *lowcodev1.Value_TimestampValue:
+		return "timestamp"
+	case *lowcodev1.Value_BytesValue:
+		return "bytes"
+	case *lowcodev1.Value_JsonValue:
+		return "json"
+	case *lowcodev1.Value_ListValue:
+		return "list"
+	}
+	return "empty"
+}
+
+// cellArg 把写入列 c 的 cell 转成 SQL 参数，类型不匹配时返回带 column id 的 VALIDATION_FAILED。
+func cellArg(c columnMeta, v *lowcodev1.Value) (any, error) {
+	arg, err := coerceValue(v, c.PgType)
+	if err != nil {
+		return nil, apierr.NewValidation([]apierr.FieldViolation{{Field: c.Id, Description: err.Error()}})
+	}
+	return arg, nil
+}
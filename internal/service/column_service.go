@@ -3,14 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/codes"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
 )
 
 // -------- Column --------
@@ -26,28 +28,73 @@ func (s *LowcodeService) AddColumn(ctx context.Context, req *lowcodev1.AddColumn
 	}
 	defer tx.Rollback(ctx)
 
-	// 允许对外使用 table name 或内部 UUID 作为 table_id，这里解析出逻辑 name 和物理表信息。
-	var tableKey, schemaName, tableName string
-	if err := tx.QueryRow(ctx, `
-		SELECT name, schema_name, table_name
-		FROM lc_tables
-		WHERE name = $1`,
-		req.GetTableId(),
-	).Scan(&tableKey, &schemaName, &tableName); err != nil {
+	res, err := addColumn(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
+	return res, nil
+}
 
-	var typeID, pgType, kind string
-	if err := tx.QueryRow(ctx, `
-		SELECT id, pg_type, COALESCE(config->>'kind', '')
-		FROM lc_types
-		WHERE name = $1`,
-		req.GetTypeId(),
-	).Scan(&typeID, &pgType, &kind); err != nil {
+// addColumn 在 tx 中添加物理列（虚拟列除外）并登记到 lc_columns，AddColumn 和 CreateTableWithSchema 共用。
+func addColumn(ctx context.Context, tx pgx.Tx, req *lowcodev1.AddColumnRequest) (*lowcodev1.AddColumnResponse, error) {
+	t, err := lookupTable(ctx, tx, req.GetTableId(), false, false)
+	if err != nil {
 		return nil, err
 	}
+	if sqlViewFromConfig(t.Config) != nil {
+		return nil, sqlViewReadOnly(t.Name)
+	}
+	tableKey, schemaName, tableName := t.Name, t.SchemaName, t.PhysTable
 
-	isVirtual := kind == "formula" || kind == "relationship"
+	typ, err := resolveType(ctx, tx, req.GetTypeId())
+	if err != nil {
+		return nil, err
+	}
+	typeID, pgType, kind := typ.GetId(), typ.GetPgType(), typeKind(typ)
+	typeCfg := typ.GetConfig().AsMap()
+
+	isVirtual := virtualKinds[kind]
+	if req.GetIsUnique() && (isVirtual || kind == encryptedKind || strings.HasSuffix(basePgType(pgType), "[]")) {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s columns cannot be unique", typeID)
+	}
+
+	cfg := req.GetConfig().AsMap()
+	if cfg == nil {
+		cfg = map[string]any{}
+	}
+	if err := validateMaskConfig(cfg); err != nil {
+		return nil, err
+	}
+	if m2m, _ := cfg["kind"].(string); kind == "relationship" && m2m == relationshipManyToMany {
+		if err := createJoinTable(ctx, tx, tableKey, schemaName, cfg); err != nil {
+			return nil, err
+		}
+	}
+	switch kind {
+	case selectKindSingle, selectKindMulti:
+		if err := normalizeSelectOptions(cfg); err != nil {
+			return nil, err
+		}
+	case "currency", "percent", "duration", "rating":
+		if err := applyNumberFormat(kind, typeCfg, cfg); err != nil {
+			return nil, err
+		}
+	case geoKind:
+		if err := requirePostGIS(ctx, tx); err != nil {
+			return nil, err
+		}
+	case "rollup":
+		if err := validateRollupConfig(ctx, tx, tableKey, cfg); err != nil {
+			return nil, err
+		}
+	case "lookup":
+		if err := validateLookupConfig(ctx, tx, tableKey, cfg); err != nil {
+			return nil, err
+		}
+	}
 
 	// 为物理列生成真实 PG 列名；虚拟列则使用一个不会在 SQL 中引用的占位名。
 	pgColumn := "c_" + strings.ReplaceAll(uuid.New().String()[:8], "-", "")
@@ -70,37 +117,93 @@ func (s *LowcodeService) AddColumn(ctx context.Context, req *lowcodev1.AddColumn
 		}
 	}
 
+	// 先追加到末尾；指定了 position 时再插入到该位置，后面的列依次后移
+	order, err := columnOrder(ctx, tx, tableKey)
+	if err != nil {
+		return nil, err
+	}
 	const ins = `
-		INSERT INTO lc_columns (table_id, name, type_id, pg_column, is_nullable, position, config)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, table_id, name, type_id, pg_column, is_nullable, position, config, created_at, updated_at
-	`
-	row := tx.QueryRow(ctx, ins,
+		INSERT INTO lc_columns (table_id, name, type_id, pg_column, is_nullable, position, config, is_hidden, is_readonly, description, label, ui_hints)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING ` + columnFieldsSQL
+	c, err := scanColumn(tx.QueryRow(ctx, ins,
 		tableKey,
 		req.GetName(),
 		typeID,
 		pgColumn,
 		req.GetIsNullable(),
-		req.GetPosition(),
-		req.GetConfig().AsMap(),
-	)
-
-	var c lowcodev1.Column
-	var cfg map[string]any
-	var createdAt, updatedAt time.Time
-	if err := row.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgColumn, &c.IsNullable, &c.Position, &cfg, &createdAt, &updatedAt); err != nil {
+		len(order)+1,
+		cfg,
+		req.GetIsHidden(),
+		req.GetIsReadonly(),
+		req.GetDescription(),
+		req.GetLabel(),
+		req.GetUiHints().AsMap(),
+	))
+	if err != nil {
 		return nil, err
 	}
-	c.CreatedAt = timestamppb.New(createdAt)
-	c.UpdatedAt = timestamppb.New(updatedAt)
-	if cfg != nil {
-		c.Config = toStruct(cfg)
+	if p := req.GetPosition(); p > 0 && int(p) <= len(order) {
+		if err := writeColumnOrder(ctx, tx, tableKey, moveColumn(append(order, c.Id), c.Id, p)); err != nil {
+			return nil, err
+		}
+		c.Position = p
 	}
+	if !isVirtual {
+		if err := syncColumnChecks(ctx, tx, schemaName, tableName, c.Id, pgColumn, pgType, cfg); err != nil {
+			return nil, err
+		}
+	}
+	res := &lowcodev1.AddColumnResponse{Column: c}
+	if req.GetIsUnique() {
+		if res.UniqueIndex, err = createUniqueColumnIndex(ctx, tx, tableKey, schemaName, tableName, c); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-	if err := tx.Commit(ctx); err != nil {
+// pgColumnNameRe 限制可以手动指定的物理列名：小写字母、数字、下划线，不超过 PG 标识符长度上限。
+var pgColumnNameRe = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,62}$`)
+
+// validatePgColumnName 校验重命名后的物理列名，不能与 id 和系统列冲突。
+func validatePgColumnName(name string) error {
+	if !pgColumnNameRe.MatchString(name) {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"pg_column %q must start with a lowercase letter or underscore and contain only lowercase letters, digits and underscores", name)
+	}
+	if name == "id" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "pg_column %q is reserved", name)
+	}
+	for _, c := range systemColumns {
+		if c.PgColumn == name {
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "pg_column %q is reserved", name)
+		}
+	}
+	return nil
+}
+
+// createUniqueColumnIndex 为单列创建唯一索引并登记到 lc_indexes，与 CreateIndex 创建的索引一样可以被列出和删除。
+func createUniqueColumnIndex(ctx context.Context, tx pgx.Tx, tableKey, schemaName, tableName string, c *lowcodev1.Column) (*lowcodev1.Index, error) {
+	pgIndex := "lc_idx_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	keys, err := tenantUniqueKeys(ctx, tx, schemaName, tableName, []string{pgx.Identifier{c.PgColumn}.Sanitize()})
+	if err != nil {
 		return nil, err
 	}
-	return &lowcodev1.AddColumnResponse{Column: &c}, nil
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE UNIQUE INDEX %s ON %s.%s (%s)`,
+		pgx.Identifier{pgIndex}.Sanitize(),
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		strings.Join(keys, ", "),
+	)); err != nil {
+		return nil, err
+	}
+	return scanIndex(tx.QueryRow(ctx, `
+		INSERT INTO lc_indexes (table_id, name, pg_index, column_ids, is_unique)
+		VALUES ($1, $2, $3, $4, true)
+		RETURNING `+indexFieldsSQL,
+		tableKey, c.Name+"_unique", pgIndex, []string{c.Id},
+	))
 }
 
 func (s *LowcodeService) ListColumns(ctx context.Context, req *lowcodev1.ListColumnsRequest) (*lowcodev1.ListColumnsResponse, error) {
@@ -112,8 +215,8 @@ func (s *LowcodeService) ListColumns(ctx context.Context, req *lowcodev1.ListCol
 	if err != nil {
 		return nil, err
 	}
-	const q = `
-		SELECT id, table_id, name, type_id, pg_column, is_nullable, position, config, created_at, updated_at
+	q := `
+		SELECT ` + columnFieldsSQL + `
 		FROM lc_columns
 		WHERE table_id = $1
 		ORDER BY position
@@ -126,18 +229,11 @@ func (s *LowcodeService) ListColumns(ctx context.Context, req *lowcodev1.ListCol
 
 	var res lowcodev1.ListColumnsResponse
 	for rows.Next() {
-		var c lowcodev1.Column
-		var cfg map[string]any
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgColumn, &c.IsNullable, &c.Position, &cfg, &createdAt, &updatedAt); err != nil {
+		c, err := scanColumn(rows)
+		if err != nil {
 			return nil, err
 		}
-		c.CreatedAt = timestamppb.New(createdAt)
-		c.UpdatedAt = timestamppb.New(updatedAt)
-		if cfg != nil {
-			c.Config = toStruct(cfg)
-		}
-		res.Columns = append(res.Columns, &c)
+		res.Columns = append(res.Columns, c)
 	}
 	return &res, rows.Err()
 }
@@ -153,75 +249,308 @@ func (s *LowcodeService) DeleteColumn(ctx context.Context, req *lowcodev1.Delete
 	}
 	defer tx.Rollback(ctx)
 
-	var tableID, schemaName, tableName, pgColumn, kind string
+	res, err := deleteColumn(ctx, tx, req)
+	if err != nil || req.GetDryRun() {
+		return res, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// deleteColumn 在 tx 中删除列；dry_run 时只返回影响分析，调用方不应提交。
+func deleteColumn(ctx context.Context, tx pgx.Tx, req *lowcodev1.DeleteColumnRequest) (*lowcodev1.DeleteColumnResponse, error) {
+	var tableID, schemaName, tableName, pgColumn, kind, joinSchema, joinTable string
 	if err := tx.QueryRow(ctx, `
-		SELECT c.table_id, t.schema_name, t.table_name, c.pg_column, COALESCE(ty.config->>'kind', '')
+		SELECT c.table_id, t.schema_name, t.table_name, c.pg_column, COALESCE(ty.config->>'kind', ''),
+		       COALESCE(c.config->>'join_schema', ''), COALESCE(c.config->>'join_table', '')
 		FROM lc_columns c
 		JOIN lc_tables t ON c.table_id = t.name
 		JOIN lc_types ty ON c.type_id = ty.id
 		WHERE c.id = $1`,
 		req.GetId(),
-	).Scan(&tableID, &schemaName, &tableName, &pgColumn, &kind); err != nil {
+	).Scan(&tableID, &schemaName, &tableName, &pgColumn, &kind, &joinSchema, &joinTable); err != nil {
 		if err == pgx.ErrNoRows {
 			return &lowcodev1.DeleteColumnResponse{}, nil
 		}
 		return nil, err
 	}
+	if err := requireBaseTable(ctx, tx, tableID); err != nil {
+		return nil, err
+	}
 
-	isVirtual := kind == "formula" || kind == "relationship"
-	if !isVirtual {
-		drop := fmt.Sprintf(`ALTER TABLE %s.%s DROP COLUMN IF EXISTS %s`,
-			pgx.Identifier{schemaName}.Sanitize(),
-			pgx.Identifier{tableName}.Sanitize(),
-			pgx.Identifier{pgColumn}.Sanitize())
+	// 分区列是主键的一部分，不能删除。
+	var isPartitionKey bool
+	if err := tx.QueryRow(ctx, `SELECT COALESCE(config->'partition'->>'column_id', '') = $2 FROM lc_tables WHERE name = $1`,
+		tableID, req.GetId()).Scan(&isPartitionKey); err != nil {
+		return nil, err
+	}
+	if isPartitionKey {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "column %s is the partition key of table %s", req.GetId(), tableID)
+	}
+
+	isVirtual := virtualKinds[kind]
+	drop := fmt.Sprintf(`ALTER TABLE %s.%s DROP COLUMN IF EXISTS %s`,
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		pgx.Identifier{pgColumn}.Sanitize())
+	// 多对多 relationship 列的关联表随列一起删除
+	if kind == "relationship" && joinTable != "" {
+		drop = dropJoinTableSQL(joinSchema, joinTable)
+	}
+	if req.GetDryRun() {
+		var statements []string
+		if !isVirtual || joinTable != "" {
+			statements = append(statements, drop)
+		}
+		impact, err := columnImpact(ctx, tx, req.GetId(), schemaName, tableName, pgColumn, isVirtual, statements)
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.DeleteColumnResponse{Impact: impact}, nil
+	}
+	if !isVirtual || joinTable != "" {
 		if _, err := tx.Exec(ctx, drop); err != nil {
 			return nil, err
 		}
 	}
 
-	if _, err := tx.Exec(ctx, `DELETE FROM lc_columns WHERE id = $1`, req.GetId()); err != nil {
+	// DROP COLUMN 会连带删除包含该列（含部分索引条件中引用该列）的索引，登记也一起清理
+	if _, err := tx.Exec(ctx, `DELETE FROM lc_indexes WHERE $1 = ANY(column_ids) OR strpos(config::text, $1::text) > 0`, req.GetId()); err != nil {
 		return nil, err
 	}
-
-	if err := tx.Commit(ctx); err != nil {
+	if _, err := tx.Exec(ctx, `DELETE FROM lc_columns WHERE id = $1`, req.GetId()); err != nil {
 		return nil, err
 	}
 	return &lowcodev1.DeleteColumnResponse{}, nil
 }
 
-// 简化：UpdateColumn 目前只更新元数据，不做 PG 表 rename/alter。
+// UpdateColumn 在一个事务内更新列元数据和物理列：is_nullable 变化时 SET / DROP NOT NULL，
+// 指定 pg_column 时重命名物理列，config 变化时重建 CHECK 约束（见 check_constraint.go）。
+// 任一步失败（如已有 NULL 值、数据不满足新约束）整体回滚。虚拟列只更新元数据。
 func (s *LowcodeService) UpdateColumn(ctx context.Context, req *lowcodev1.UpdateColumnRequest) (*lowcodev1.UpdateColumnResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	c, err := updateColumn(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UpdateColumnResponse{Column: c}, nil
+}
+
+func updateColumn(ctx context.Context, tx pgx.Tx, req *lowcodev1.UpdateColumnRequest) (*lowcodev1.Column, error) {
+	var tableKey, schemaName, tableName, name, pgColumn, pgType, kind string
+	var nullable bool
+	if err := tx.QueryRow(ctx, `
+		SELECT t.name, t.schema_name, t.table_name, c.name, c.pg_column, c.is_nullable, ty.pg_type, COALESCE(ty.config->>'kind', '')
+		FROM lc_columns c
+		JOIN lc_tables t ON c.table_id = t.name
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.id = $1
+		FOR UPDATE OF c`,
+		req.GetId(),
+	).Scan(&tableKey, &schemaName, &tableName, &name, &pgColumn, &nullable, &pgType, &kind); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", req.GetId())
+		}
+		return nil, err
+	}
+	// SQL 视图的列只能改元数据（名字、说明、隐藏等），物理列由视图定义决定
+	if (req.GetPgColumn() != "" && req.GetPgColumn() != pgColumn) || (req.IsNullable != nil && req.GetIsNullable() != nullable) {
+		if err := requireBaseTable(ctx, tx, tableKey); err != nil {
+			return nil, err
+		}
+	}
+	isVirtual := virtualKinds[kind]
+	table := pgx.Identifier{schemaName, tableName}.Sanitize()
+
+	newPgColumn := pgColumn
+	if req.GetPgColumn() != "" && req.GetPgColumn() != pgColumn {
+		if isVirtual {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "virtual column %s has no physical column to rename", req.GetId())
+		}
+		if err := validatePgColumnName(req.GetPgColumn()); err != nil {
+			return nil, err
+		}
+		newPgColumn = req.GetPgColumn()
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s RENAME COLUMN %s TO %s`,
+			table, pgx.Identifier{pgColumn}.Sanitize(), pgx.Identifier{newPgColumn}.Sanitize())); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsNullable != nil && req.GetIsNullable() != nullable && !isVirtual {
+		col := pgx.Identifier{newPgColumn}.Sanitize()
+		alter := fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL`, table, col)
+		if !req.GetIsNullable() {
+			// 先检查已有 NULL 值，给出比 PG 23502 更明确的错误；回收站中的行同样受约束
+			var nulls int64
+			if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s IS NULL`, table, col)).Scan(&nulls); err != nil {
+				return nil, err
+			}
+			if nulls > 0 {
+				return nil, apierr.New(lowcodev1.ErrorCode_NOT_NULL_VIOLATION, codes.FailedPrecondition,
+					"column %s has %d rows with NULL values, fill them before making it NOT NULL", req.GetId(), nulls)
+			}
+			alter = fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s SET NOT NULL`, table, col)
+		}
+		if _, err := tx.Exec(ctx, alter); err != nil {
+			return nil, err
+		}
+	}
+
+	// 未传 config 时保持原值
+	var cfg map[string]any
+	if req.GetConfig() != nil {
+		cfg = req.GetConfig().AsMap()
+		if err := validateMaskConfig(cfg); err != nil {
+			return nil, err
+		}
+		if !isVirtual {
+			if err := syncColumnChecks(ctx, tx, schemaName, tableName, req.GetId(), newPgColumn, pgType, cfg); err != nil {
+				return nil, checkViolation(err, []columnMeta{{Id: req.GetId(), Name: name, Config: cfg}})
+			}
+		}
+	}
+
+	if req.GetPosition() > 0 {
+		order, err := columnOrder(ctx, tx, tableKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeColumnOrder(ctx, tx, tableKey, moveColumn(order, req.GetId(), req.GetPosition())); err != nil {
+			return nil, err
+		}
+	}
+
 	const q = `
 		UPDATE lc_columns
 		SET name = COALESCE(NULLIF($2, ''), name),
 		    is_nullable = COALESCE($3, is_nullable),
-		    position = COALESCE(NULLIF($4, 0), position),
-		    config = COALESCE($5, config),
+		    config = COALESCE($4, config),
+		    pg_column = $5,
+		    is_hidden = COALESCE($6, is_hidden),
+		    is_readonly = COALESCE($7, is_readonly),
+		    description = COALESCE($8, description),
+		    label = COALESCE($9, label),
+		    ui_hints = COALESCE($10, ui_hints),
 		    updated_at = now()
 		WHERE id = $1
-		RETURNING id, table_id, name, type_id, pg_column, is_nullable, position, config, created_at, updated_at
-	`
-	var c lowcodev1.Column
-	var cfgMap map[string]any
-	var isNullable *bool
-	if req.IsNullable {
-		v := req.GetIsNullable()
-		isNullable = &v
+		RETURNING ` + columnFieldsSQL
+	var uiHints map[string]any
+	if req.GetUiHints() != nil {
+		uiHints = req.GetUiHints().AsMap()
 	}
-	row := pool.QueryRow(ctx, q, req.GetId(), req.GetName(), isNullable, req.GetPosition(), req.GetConfig().AsMap())
-	var createdAt, updatedAt time.Time
-	if err := row.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgColumn, &c.IsNullable, &c.Position, &cfgMap, &createdAt, &updatedAt); err != nil {
+	return scanColumn(tx.QueryRow(ctx, q, req.GetId(), req.GetName(), req.IsNullable, cfg, newPgColumn, req.IsHidden, req.IsReadonly,
+		req.Description, req.Label, uiHints))
+}
+
+// -------- Column order --------
+
+// columnOrder 返回表的全部列 id，按 position 排序；position 相同（旧数据）时按创建时间。
+func columnOrder(ctx context.Context, q querier, tableID string) ([]string, error) {
+	rows, err := q.Query(ctx, `SELECT id::text FROM lc_columns WHERE table_id = $1 ORDER BY position, created_at, id`, tableID)
+	if err != nil {
 		return nil, err
 	}
-	c.CreatedAt = timestamppb.New(createdAt)
-	c.UpdatedAt = timestamppb.New(updatedAt)
-	if cfgMap != nil {
-		c.Config = toStruct(cfgMap)
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// moveColumn 把 id 移到第 position 位（从 1 开始，超出范围时放到末尾），返回新的顺序。
+func moveColumn(order []string, id string, position int32) []string {
+	out := make([]string, 0, len(order))
+	for _, x := range order {
+		if x != id {
+			out = append(out, x)
+		}
 	}
-	return &lowcodev1.UpdateColumnResponse{Column: &c}, nil
+	i := int(position) - 1
+	if i > len(out) {
+		i = len(out)
+	}
+	return slices.Insert(out, i, id)
+}
+
+// writeColumnOrder 按 ids 的顺序把 position 重写为 1..n，只更新位置有变化的列。
+func writeColumnOrder(ctx context.Context, tx pgx.Tx, tableID string, ids []string) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE lc_columns c
+		SET position = o.pos, updated_at = now()
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, pos)
+		WHERE c.id = o.id AND c.table_id = $1 AND c.position <> o.pos`, tableID, ids)
+	return err
 }
 
+// ReorderColumns 按 column_ids 的顺序重排列：列出的列排在最前，其余列保持原有相对顺序排在后面。
+// position 在一个事务内重写为 1..n。
+func (s *LowcodeService) ReorderColumns(ctx context.Context, req *lowcodev1.ReorderColumnsRequest) (*lowcodev1.ReorderColumnsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetColumnIds()) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column_ids is required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	tableName, err := s.resolveTableName(ctx, tx, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	// 锁住表的列，避免并发 AddColumn / 重排交错
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM lc_columns WHERE table_id = $1 FOR UPDATE`, tableName); err != nil {
+		return nil, err
+	}
+	current, err := columnOrder(ctx, tx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	var violations []apierr.FieldViolation
+	seen := make(map[string]bool, len(req.GetColumnIds()))
+	for i, id := range req.GetColumnIds() {
+		field := fmt.Sprintf("column_ids[%d]", i)
+		switch {
+		case seen[id]:
+			violations = append(violations, apierr.FieldViolation{Field: field, Description: fmt.Sprintf("duplicate column %s", id)})
+		case !slices.Contains(current, id):
+			violations = append(violations, apierr.FieldViolation{Field: field, Description: fmt.Sprintf("column %s not found in table %s", id, tableName)})
+		}
+		seen[id] = true
+	}
+	if len(violations) > 0 {
+		return nil, apierr.NewValidation(violations)
+	}
+	order := slices.Clone(req.GetColumnIds())
+	for _, id := range current {
+		if !seen[id] {
+			order = append(order, id)
+		}
+	}
+	if err := writeColumnOrder(ctx, tx, tableName, order); err != nil {
+		return nil, err
+	}
+
+	var res lowcodev1.ReorderColumnsResponse
+	if res.Columns, err = queryColumns(ctx, tx, columnSelectSQL+`WHERE c.table_id = $1 ORDER BY c.position`, tableName); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
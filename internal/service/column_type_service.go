@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Column type change --------
+
+// tryCastFuncSQL 创建事务内可见的转换函数：v 无法转换为 target 的类型时返回 NULL 而不是报错。
+// 用于统计无法转换的值（dry_run / FAIL_ON_ERROR）和 TRUNCATE 策略。
+const tryCastFuncSQL = `
+	CREATE OR REPLACE FUNCTION pg_temp.lc_try_cast(v text, target anyelement) RETURNS anyelement AS $$
+	BEGIN
+		EXECUTE format('SELECT %L::%s', v, pg_typeof(target)) INTO target;
+		RETURN target;
+	EXCEPTION WHEN others THEN
+		RETURN NULL;
+	END
+	$$ LANGUAGE plpgsql`
+
+// failedCastSamples 是 FAIL_ON_ERROR 报错时附带的示例行数。
+const failedCastSamples = 5
+
+// ChangeColumnType 把物理列转换为 new_type_id 的 pg_type，并在同一事务内更新 lc_columns.type_id。
+// 列上的 CHECK 约束在转换前删除、转换后按新类型重建；虚拟列和虚拟类型不支持。
+func (s *LowcodeService) ChangeColumnType(ctx context.Context, req *lowcodev1.ChangeColumnTypeRequest) (*lowcodev1.ChangeColumnTypeResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetColumnId() == "" || req.GetNewTypeId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column_id and new_type_id are required")
+	}
+	if req.GetAsync() {
+		if req.GetDryRun() {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "async and dry_run cannot be used together")
+		}
+		op, err := s.startOperation(ctx, pool, "ChangeColumnType", req.GetColumnId(), func(ctx context.Context) (proto.Message, error) {
+			return s.runChangeColumnType(ctx, pool, req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.ChangeColumnTypeResponse{Operation: op}, nil
+	}
+	return s.runChangeColumnType(ctx, pool, req)
+}
+
+func (s *LowcodeService) runChangeColumnType(ctx context.Context, pool *pgxpool.Pool, req *lowcodev1.ChangeColumnTypeRequest) (*lowcodev1.ChangeColumnTypeResponse, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := changeColumnType(ctx, tx, req)
+	if err != nil || req.GetDryRun() {
+		return res, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// changeColumnType 在 tx 中修改列类型；dry_run 时只做统计，调用方不应提交。
+func changeColumnType(ctx context.Context, tx pgx.Tx, req *lowcodev1.ChangeColumnTypeRequest) (*lowcodev1.ChangeColumnTypeResponse, error) {
+	var tableKey, schemaName, tableName, name, pgColumn, typeID, kind string
+	var cfg map[string]any
+	if err := tx.QueryRow(ctx, `
+		SELECT t.name, t.schema_name, t.table_name, c.name, c.pg_column, c.type_id, COALESCE(ty.config->>'kind', ''), c.config
+		FROM lc_columns c
+		JOIN lc_tables t ON c.table_id = t.name
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.id = $1
+		FOR UPDATE OF c`,
+		req.GetColumnId(),
+	).Scan(&tableKey, &schemaName, &tableName, &name, &pgColumn, &typeID, &kind, &cfg); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", req.GetColumnId())
+		}
+		return nil, err
+	}
+	if err := requireBaseTable(ctx, tx, tableKey); err != nil {
+		return nil, err
+	}
+	typ, err := resolveType(ctx, tx, req.GetNewTypeId())
+	if err != nil {
+		return nil, err
+	}
+	newKind := typeKind(typ)
+	if virtualKinds[kind] || virtualKinds[newKind] {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"cannot change column %s from %s to %s: virtual columns have no physical data", req.GetColumnId(), typeID, typ.GetId())
+	}
+	if (kind == encryptedKind) != (newKind == encryptedKind) {
+		// 加密和解密要用 tenant 的数据密钥，不能在 ALTER TABLE ... USING 中完成
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"cannot change column %s from %s to %s: add a new column and copy the values instead", req.GetColumnId(), typeID, typ.GetId())
+	}
+	if newKind == geoKind {
+		if err := requirePostGIS(ctx, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	strategy := req.GetCastStrategy()
+	if strategy == lowcodev1.CastStrategy_CAST_STRATEGY_UNSPECIFIED {
+		strategy = lowcodev1.CastStrategy_CAST_STRATEGY_CAST
+	}
+	pgType := typ.GetPgType()
+	table := pgx.Identifier{schemaName, tableName}.Sanitize()
+	col := pgx.Identifier{pgColumn}.Sanitize()
+	tryCast := fmt.Sprintf("pg_temp.lc_try_cast(%s::text, NULL::%s)", col, pgType)
+	using := fmt.Sprintf("%s::%s", col, pgType)
+	if strategy == lowcodev1.CastStrategy_CAST_STRATEGY_TRUNCATE {
+		using = fmt.Sprintf("%s::%s", tryCast, pgType)
+	}
+	alter := fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s`, table, col, pgType, using)
+
+	var res lowcodev1.ChangeColumnTypeResponse
+	// CAST 策略由 PG 在第一个无法转换的值上报错，不需要预先统计
+	if req.GetDryRun() || strategy != lowcodev1.CastStrategy_CAST_STRATEGY_CAST {
+		if _, err := tx.Exec(ctx, tryCastFuncSQL); err != nil {
+			return nil, err
+		}
+		failed := fmt.Sprintf(`%s IS NOT NULL AND %s IS NULL`, col, tryCast)
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s`, table, failed)).Scan(&res.FailedRows); err != nil {
+			return nil, err
+		}
+		if res.FailedRows > 0 && !req.GetDryRun() && strategy == lowcodev1.CastStrategy_CAST_STRATEGY_FAIL_ON_ERROR {
+			rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT id::text FROM %s WHERE %s ORDER BY id LIMIT %d`, table, failed, failedCastSamples))
+			if err != nil {
+				return nil, err
+			}
+			ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+			if err != nil {
+				return nil, err
+			}
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+				"%d values in column %s cannot be converted to %s (rows %s)", res.FailedRows, name, typ.GetId(), strings.Join(ids, ", "))
+		}
+	}
+	if req.GetDryRun() {
+		res.Impact, err = columnImpact(ctx, tx, req.GetColumnId(), schemaName, tableName, pgColumn, false, []string{alter})
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	}
+
+	// 旧约束的表达式可能不适用于新类型（如文本列上的 regex），先删除再按新类型重建
+	if err := syncColumnChecks(ctx, tx, schemaName, tableName, req.GetColumnId(), pgColumn, "", nil); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, alter); err != nil {
+		return nil, err
+	}
+	if err := syncColumnChecks(ctx, tx, schemaName, tableName, req.GetColumnId(), pgColumn, pgType, cfg); err != nil {
+		return nil, checkViolation(err, []columnMeta{{Id: req.GetColumnId(), Name: name, Config: cfg}})
+	}
+	res.Column, err = scanColumn(tx.QueryRow(ctx, `
+		UPDATE lc_columns SET type_id = $2, updated_at = now()
+		WHERE id = $1
+		RETURNING `+columnFieldsSQL,
+		req.GetColumnId(), typ.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
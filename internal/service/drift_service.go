@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/db"
+)
+
+// -------- Schema drift --------
+
+// physicalColumn 是 pg_attribute 中的一列。
+type physicalColumn struct {
+	Name       string
+	PgType     string // format_type() 的结果
+	IsNullable bool
+}
+
+// physicalIndex 是物理表上的一个索引；Columns 为空表示含表达式，无法按列登记。
+type physicalIndex struct {
+	Name     string
+	Columns  []string
+	IsUnique bool
+	Method   string // pg_am 名
+	Def      string // pg_get_indexdef
+}
+
+// tableDrift 对照 pg_catalog 检查表的元数据：缺失 / 多出的物理列、类型和可空性不一致、缺失 / 未登记的索引。
+func tableDrift(ctx context.Context, q querier, tableID, schemaName, physTable string) ([]*lowcodev1.SchemaDrift, error) {
+	rel := pgx.Identifier{schemaName, physTable}.Sanitize()
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, rel).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []*lowcodev1.SchemaDrift{{
+			Kind:    lowcodev1.DriftKind_DRIFT_KIND_MISSING_TABLE,
+			Message: fmt.Sprintf("physical table %s.%s does not exist", schemaName, physTable),
+		}}, nil
+	}
+
+	cols, err := sourceColumns(ctx, q, tableID)
+	if err != nil {
+		return nil, err
+	}
+	physCols, err := physicalColumns(ctx, q, rel)
+	if err != nil {
+		return nil, err
+	}
+	var hasSystem bool
+	if err := q.QueryRow(ctx, `SELECT COALESCE((config->>'system_columns')::boolean, false) FROM lc_tables WHERE name = $1`, tableID).
+		Scan(&hasSystem); err != nil {
+		return nil, err
+	}
+
+	var out []*lowcodev1.SchemaDrift
+	byPgColumn := make(map[string]physicalColumn, len(physCols))
+	for _, pc := range physCols {
+		byPgColumn[pc.Name] = pc
+	}
+	// tenant 列由 pooled 模式的隔离机制添加，不登记在 lc_columns 中
+	registered := map[string]bool{"id": true, db.TenantColumn: true}
+	if hasSystem {
+		for _, c := range systemColumns {
+			registered[c.PgColumn] = true
+		}
+	}
+	for _, c := range cols {
+		if virtualKinds[c.Kind] {
+			continue
+		}
+		registered[c.PgColumn] = true
+		pc, ok := byPgColumn[c.PgColumn]
+		if !ok {
+			out = append(out, &lowcodev1.SchemaDrift{
+				Kind:     lowcodev1.DriftKind_DRIFT_KIND_MISSING_COLUMN,
+				ColumnId: c.Id,
+				PgColumn: c.PgColumn,
+				Expected: c.PgType,
+				Message:  fmt.Sprintf("column %s (%s) has no physical column %s", c.Name, c.Id, c.PgColumn),
+			})
+			continue
+		}
+		if !sameTypeCategory(c.PgType, pc.PgType) {
+			out = append(out, &lowcodev1.SchemaDrift{
+				Kind:     lowcodev1.DriftKind_DRIFT_KIND_TYPE_MISMATCH,
+				ColumnId: c.Id,
+				PgColumn: c.PgColumn,
+				Expected: c.PgType,
+				Actual:   pc.PgType,
+				Message:  fmt.Sprintf("column %s is %s but the physical column is %s", c.Name, c.PgType, pc.PgType),
+			})
+		}
+		if c.IsNullable != pc.IsNullable {
+			out = append(out, &lowcodev1.SchemaDrift{
+				Kind:     lowcodev1.DriftKind_DRIFT_KIND_NULLABILITY_MISMATCH,
+				ColumnId: c.Id,
+				PgColumn: c.PgColumn,
+				Expected: nullabilitySQL(c.IsNullable),
+				Actual:   nullabilitySQL(pc.IsNullable),
+				Message:  fmt.Sprintf("column %s is_nullable=%t but the physical column is %s", c.Name, c.IsNullable, nullabilitySQL(pc.IsNullable)),
+			})
+		}
+	}
+	for _, pc := range physCols {
+		if !registered[pc.Name] {
+			out = append(out, &lowcodev1.SchemaDrift{
+				Kind:     lowcodev1.DriftKind_DRIFT_KIND_ORPHANED_COLUMN,
+				PgColumn: pc.Name,
+				Actual:   pc.PgType,
+				Message:  fmt.Sprintf("physical column %s is not registered in lc_columns", pc.Name),
+			})
+		}
+	}
+
+	rows, err := q.Query(ctx, `SELECT id, pg_index FROM lc_indexes WHERE table_id = $1 ORDER BY name`, tableID)
+	if err != nil {
+		return nil, err
+	}
+	type registeredIndex struct{ ID, PgIndex string }
+	indexes, err := pgx.CollectRows(rows, pgx.RowToStructByPos[registeredIndex])
+	if err != nil {
+		return nil, err
+	}
+	physIndexes, err := physicalIndexes(ctx, q, rel)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range indexes {
+		if !slices.ContainsFunc(physIndexes, func(pi physicalIndex) bool { return pi.Name == idx.PgIndex }) {
+			out = append(out, &lowcodev1.SchemaDrift{
+				Kind:    lowcodev1.DriftKind_DRIFT_KIND_MISSING_INDEX,
+				IndexId: idx.ID,
+				PgIndex: idx.PgIndex,
+				Message: fmt.Sprintf("index %s has no physical index %s", idx.ID, idx.PgIndex),
+			})
+		}
+	}
+	for _, pi := range physIndexes {
+		if !slices.ContainsFunc(indexes, func(idx registeredIndex) bool { return idx.PgIndex == pi.Name }) {
+			out = append(out, &lowcodev1.SchemaDrift{
+				Kind:    lowcodev1.DriftKind_DRIFT_KIND_ORPHANED_INDEX,
+				PgIndex: pi.Name,
+				Actual:  strings.Join(pi.Columns, ", "),
+				Message: fmt.Sprintf("physical index %s is not registered in lc_indexes", pi.Name),
+			})
+		}
+	}
+	return out, nil
+}
+
+// physicalColumns 返回物理表的全部列（不含已删除的列），按列序。
+func physicalColumns(ctx context.Context, q querier, rel string) ([]physicalColumn, error) {
+	rows, err := q.Query(ctx, `
+		SELECT a.attname::text, format_type(a.atttypid, a.atttypmod), NOT a.attnotnull
+		FROM pg_attribute a
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped
+		ORDER BY a.attnum`, rel)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[physicalColumn])
+}
+
+// physicalIndexes 返回物理表上除主键和约束自带索引之外的索引。
+func physicalIndexes(ctx context.Context, q querier, rel string) ([]physicalIndex, error) {
+	rows, err := q.Query(ctx, `
+		SELECT i.relname::text,
+		       CASE WHEN x.indexprs IS NULL THEN ARRAY(
+		           SELECT a.attname::text
+		           FROM unnest(x.indkey) WITH ORDINALITY k(attnum, ord)
+		           JOIN pg_attribute a ON a.attrelid = x.indrelid AND a.attnum = k.attnum
+		           ORDER BY k.ord) ELSE '{}' END,
+		       x.indisunique, am.amname::text, pg_get_indexdef(x.indexrelid)
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_am am ON am.oid = i.relam
+		WHERE x.indrelid = $1::regclass
+		  AND NOT x.indisprimary
+		  AND NOT EXISTS (SELECT 1 FROM pg_constraint con WHERE con.conindid = x.indexrelid)
+		ORDER BY i.relname`, rel)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[physicalIndex])
+}
+
+// sameTypeCategory 按 ImportExistingTable 的类别（lcTypeForPgType）比较两个 PG 类型，数组与非数组不同类。
+// lc_types.pg_type 使用 timestamptz 等简写，先换成 format_type() 的写法。
+func sameTypeCategory(declared, actual string) bool {
+	declared = strings.ToLower(strings.TrimSpace(declared))
+	if strings.HasSuffix(declared, "[]") != strings.HasSuffix(actual, "[]") {
+		return false
+	}
+	base := strings.TrimSuffix(declared, "[]")
+	if i := strings.IndexByte(base, '('); i >= 0 {
+		base = base[:i]
+	}
+	if full, ok := pgTypeAliases[base]; ok {
+		declared = full
+	}
+	return lcTypeForPgType(strings.TrimSuffix(declared, "[]")) == lcTypeForPgType(strings.TrimSuffix(actual, "[]"))
+}
+
+// pgTypeAliases 把常见的 PG 类型简写换成 format_type() 的写法。
+var pgTypeAliases = map[string]string{
+	"int":         "integer",
+	"int2":        "smallint",
+	"int4":        "integer",
+	"int8":        "bigint",
+	"float4":      "real",
+	"float8":      "double precision",
+	"decimal":     "numeric",
+	"bool":        "boolean",
+	"timestamptz": "timestamp with time zone",
+	"timestamp":   "timestamp without time zone",
+}
+
+func nullabilitySQL(nullable bool) string {
+	if nullable {
+		return "NULL"
+	}
+	return "NOT NULL"
+}
+
+// RepairTableSchema 在一个事务内按 tableDrift 的结果修复表：补建缺失的物理列（有数据时按可空列补建，
+// 并把 is_nullable 改为 true），drop_orphaned_columns 时删除未登记的物理列，删除物理索引已不存在的 lc_indexes
+// 登记，并登记按列建立、列都已登记的物理索引。类型和可空性不一致不自动修复，留在响应的 drift 中。
+func (s *LowcodeService) RepairTableSchema(ctx context.Context, req *lowcodev1.RepairTableSchemaRequest) (*lowcodev1.RepairTableSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ref, err := lookupTable(ctx, tx, req.GetTableId(), false, true)
+	if err != nil {
+		return nil, err
+	}
+	if sqlViewFromConfig(ref.Config) != nil {
+		return nil, sqlViewReadOnly(ref.Name)
+	}
+	tableID, schemaName, physTable := ref.Name, ref.SchemaName, ref.PhysTable
+	drift, err := tableDrift(ctx, tx, tableID, schemaName, physTable)
+	if err != nil {
+		return nil, err
+	}
+	if len(drift) == 1 && drift[0].GetKind() == lowcodev1.DriftKind_DRIFT_KIND_MISSING_TABLE {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"physical table %s.%s does not exist and cannot be repaired", schemaName, physTable)
+	}
+	plan, err := planRepair(ctx, tx, tableID, schemaName, physTable, drift, req.GetDropOrphanedColumns())
+	if err != nil {
+		return nil, err
+	}
+	var res lowcodev1.RepairTableSchemaResponse
+	for _, c := range plan {
+		res.Changes = append(res.Changes, c.change)
+	}
+	if req.GetDryRun() {
+		res.Drift = drift
+		return &res, nil
+	}
+	for _, c := range plan {
+		if err := c.apply(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", c.change.GetAction(), c.change.GetTarget(), err)
+		}
+	}
+	if res.Drift, err = tableDrift(ctx, tx, tableID, schemaName, physTable); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// planRepair 把差异转换成修复步骤，顺序为：补建列、删除列、注销索引、登记索引。
+func planRepair(ctx context.Context, tx pgx.Tx, tableID, schemaName, physTable string, drift []*lowcodev1.SchemaDrift, dropOrphaned bool) ([]schemaChange, error) {
+	rel := pgx.Identifier{schemaName, physTable}.Sanitize()
+	cols, err := sourceColumns(ctx, tx, tableID)
+	if err != nil {
+		return nil, err
+	}
+	var plan []schemaChange
+	add := func(action, target, detail string, apply func(ctx context.Context, tx pgx.Tx) error) {
+		plan = append(plan, schemaChange{
+			change: &lowcodev1.SchemaChange{Action: action, Target: target, Detail: detail},
+			apply:  apply,
+		})
+	}
+
+	for _, d := range drift {
+		if d.GetKind() != lowcodev1.DriftKind_DRIFT_KIND_MISSING_COLUMN {
+			continue
+		}
+		i := slices.IndexFunc(cols, func(c columnMeta) bool { return c.Id == d.GetColumnId() })
+		if i < 0 {
+			continue
+		}
+		c := cols[i]
+		add("add_physical_column", c.Name, c.PgColumn+" "+c.PgType, func(ctx context.Context, tx pgx.Tx) error {
+			var hasRows bool
+			if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s)`, rel)).Scan(&hasRows); err != nil {
+				return err
+			}
+			// 已有数据时 NOT NULL 列无法补建，按可空列补建并同步元数据
+			nullable := c.IsNullable || hasRows
+			if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s %s %s`,
+				rel, pgx.Identifier{c.PgColumn}.Sanitize(), c.PgType, nullabilitySQL(nullable))); err != nil {
+				return err
+			}
+			if nullable != c.IsNullable {
+				if _, err := tx.Exec(ctx, `UPDATE lc_columns SET is_nullable = true, updated_at = now() WHERE id = $1`, c.Id); err != nil {
+					return err
+				}
+			}
+			return syncColumnChecks(ctx, tx, schemaName, physTable, c.Id, c.PgColumn, c.PgType, c.Config)
+		})
+	}
+
+	if dropOrphaned {
+		for _, d := range drift {
+			if d.GetKind() != lowcodev1.DriftKind_DRIFT_KIND_ORPHANED_COLUMN {
+				continue
+			}
+			pgColumn := d.GetPgColumn()
+			add("drop_physical_column", pgColumn, d.GetActual(), func(ctx context.Context, tx pgx.Tx) error {
+				_, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s DROP COLUMN %s`, rel, pgx.Identifier{pgColumn}.Sanitize()))
+				return err
+			})
+		}
+	}
+
+	for _, d := range drift {
+		if d.GetKind() != lowcodev1.DriftKind_DRIFT_KIND_MISSING_INDEX {
+			continue
+		}
+		id := d.GetIndexId()
+		add("unregister_index", d.GetPgIndex(), "physical index not found", func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `DELETE FROM lc_indexes WHERE id = $1`, id)
+			return err
+		})
+	}
+
+	physIndexes, err := physicalIndexes(ctx, tx, rel)
+	if err != nil {
+		return nil, err
+	}
+	idByPgColumn := make(map[string]string, len(cols))
+	for _, c := range cols {
+		if !virtualKinds[c.Kind] {
+			idByPgColumn[c.PgColumn] = c.Id
+		}
+	}
+	for _, d := range drift {
+		if d.GetKind() != lowcodev1.DriftKind_DRIFT_KIND_ORPHANED_INDEX {
+			continue
+		}
+		i := slices.IndexFunc(physIndexes, func(pi physicalIndex) bool { return pi.Name == d.GetPgIndex() })
+		if i < 0 || len(physIndexes[i].Columns) == 0 {
+			continue
+		}
+		pi := physIndexes[i]
+		colIDs := make([]string, 0, len(pi.Columns))
+		for _, name := range pi.Columns {
+			if id, ok := idByPgColumn[name]; ok {
+				colIDs = append(colIDs, id)
+			}
+		}
+		// 含 id、系统列或未登记列的索引无法用列 id 表示，保持原样
+		if len(colIDs) != len(pi.Columns) {
+			continue
+		}
+		add("register_index", pi.Name, strings.Join(pi.Columns, ", "), func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO lc_indexes (table_id, name, pg_index, column_ids, is_unique)
+				VALUES ($1, $2, $2, $3, $4)`, tableID, pi.Name, colIDs, pi.IsUnique)
+			return err
+		})
+	}
+	return plan, nil
+}
+
+// SyncIndexes 在一个事务内按 pg_catalog 同步 lc_indexes：删除物理索引已不存在的登记，
+// 未登记的物理索引（包括表达式索引和引用未登记列的索引）以物理索引名登记为 external，config 中保存索引方法和定义。
+// 与 RepairTableSchema 不同，这里不修改列，也不要求索引能用列 id 表示。
+func (s *LowcodeService) SyncIndexes(ctx context.Context, req *lowcodev1.SyncIndexesRequest) (*lowcodev1.SyncIndexesResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ref, err := lookupTable(ctx, tx, req.GetTableId(), false, true)
+	if err != nil {
+		return nil, err
+	}
+	rel := pgx.Identifier{ref.SchemaName, ref.PhysTable}.Sanitize()
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, rel).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"physical table %s.%s does not exist", ref.SchemaName, ref.PhysTable)
+	}
+	physIndexes, err := physicalIndexes(ctx, tx, rel)
+	if err != nil {
+		return nil, err
+	}
+	const registeredSQL = `SELECT ` + indexFieldsSQL + ` FROM lc_indexes WHERE table_id = $1 ORDER BY name`
+	registered, err := queryIndexes(ctx, tx, registeredSQL, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := sourceColumns(ctx, tx, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	idByPgColumn := make(map[string]string, len(cols))
+	for _, c := range cols {
+		if !virtualKinds[c.Kind] {
+			idByPgColumn[c.PgColumn] = c.Id
+		}
+	}
+
+	var plan []schemaChange
+	add := func(action, target, detail string, apply func(ctx context.Context, tx pgx.Tx) error) {
+		plan = append(plan, schemaChange{
+			change: &lowcodev1.SchemaChange{Action: action, Target: target, Detail: detail},
+			apply:  apply,
+		})
+	}
+	for _, idx := range registered {
+		if slices.ContainsFunc(physIndexes, func(pi physicalIndex) bool { return pi.Name == idx.GetPgIndex() }) {
+			continue
+		}
+		id := idx.GetId()
+		add("unregister_index", idx.GetPgIndex(), "physical index not found", func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `DELETE FROM lc_indexes WHERE id = $1`, id)
+			return err
+		})
+	}
+	for _, pi := range physIndexes {
+		if slices.ContainsFunc(registered, func(idx *lowcodev1.Index) bool { return idx.GetPgIndex() == pi.Name }) {
+			continue
+		}
+		pi := pi
+		// 只记录能对应到已登记列的列；表达式索引的 column_ids 为空，定义见 definition
+		colIDs := make([]string, 0, len(pi.Columns))
+		for _, name := range pi.Columns {
+			if id, ok := idByPgColumn[name]; ok {
+				colIDs = append(colIDs, id)
+			}
+		}
+		name := pi.Name
+		if slices.ContainsFunc(registered, func(idx *lowcodev1.Index) bool { return idx.GetName() == name }) {
+			name = "external_" + pi.Name
+		}
+		cfg := indexConfig{Method: pi.Method, External: true, Definition: pi.Def}
+		add("register_index", pi.Name, pi.Def, func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO lc_indexes (table_id, name, pg_index, column_ids, is_unique, config)
+				VALUES ($1, $2, $3, $4, $5, $6)`, ref.Name, name, pi.Name, colIDs, pi.IsUnique, cfg)
+			return err
+		})
+	}
+
+	var res lowcodev1.SyncIndexesResponse
+	for _, c := range plan {
+		res.Changes = append(res.Changes, c.change)
+	}
+	if req.GetDryRun() {
+		res.Indexes = registered
+		return &res, nil
+	}
+	for _, c := range plan {
+		if err := c.apply(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", c.change.GetAction(), c.change.GetTarget(), err)
+		}
+	}
+	if res.Indexes, err = queryIndexes(ctx, tx, registeredSQL, ref.Name); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
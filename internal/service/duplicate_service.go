@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Table duplication --------
+
+// DuplicateTable 在一个事务内把表复制为新表 lc_t_<name>：物理列、列元数据、CHECK 约束、索引，
+// include_data 时还有行和多对多关联。列使用新的 id，列 config 中对源表列 id 的引用（formula / rollup 等）
+// 改写为新 id，指向源表自身的 relationship 改为指向新表。行 id 保持不变。
+func (s *LowcodeService) DuplicateTable(ctx context.Context, req *lowcodev1.DuplicateTableRequest) (*lowcodev1.DuplicateTableResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetName() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and name are required")
+	}
+	if err := validateTableName("name", req.GetName()); err != nil {
+		return nil, err
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ref, err := lookupTable(ctx, tx, req.GetTableId(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	schemaName, physTable, tableCfg := ref.SchemaName, ref.PhysTable, ref.Config
+	if sqlViewFromConfig(tableCfg) != nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "SQL view %s cannot be duplicated", req.GetTableId())
+	}
+	var description, icon string
+	var workspaceID *string
+	if err := tx.QueryRow(ctx, `SELECT description, icon, workspace_id FROM lc_tables WHERE name = $1`, ref.Name).Scan(&description, &icon, &workspaceID); err != nil {
+		return nil, err
+	}
+	if partitionFromConfig(tableCfg) != nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "partitioned table %s cannot be duplicated", req.GetTableId())
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_tables WHERE name = $1)`, req.GetName()).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists", req.GetName())
+	}
+
+	// LIKE 复制列、默认值和 NOT NULL；CHECK 约束名包含列 id，按新列重建；索引按 lc_indexes 重建
+	newPhysTable := "lc_t_" + req.GetName()
+	src := pgx.Identifier{schemaName, physTable}.Sanitize()
+	dst := pgx.Identifier{schemaName, newPhysTable}.Sanitize()
+	stmts := []string{
+		fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING GENERATED INCLUDING STORAGE INCLUDING COMMENTS)`, dst, src),
+		fmt.Sprintf(`ALTER TABLE %s ADD PRIMARY KEY (id)`, dst),
+	}
+	if system, _ := tableCfg["system_columns"].(bool); system {
+		stmts = append(stmts, fmt.Sprintf(`CREATE TRIGGER lc_touch_updated_at BEFORE UPDATE ON %s FOR EACH ROW EXECUTE FUNCTION lc_touch_updated_at()`, dst))
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	var res lowcodev1.DuplicateTableResponse
+	res.Table, err = scanTable(tx.QueryRow(ctx, `
+		INSERT INTO lc_tables (name, schema_name, table_name, config, description, icon, workspace_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING `+tableFieldsSQL, req.GetName(), schemaName, newPhysTable, tableCfg, description, icon, workspaceID))
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := sourceColumns(ctx, tx, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(cols))
+	for _, c := range cols {
+		ids[c.Id] = uuid.New().String()
+	}
+	for _, c := range cols {
+		cfg, err := remapColumnConfig(c.Config, ids, req.GetTableId(), req.GetName())
+		if err != nil {
+			return nil, err
+		}
+		if c.Kind == "relationship" && cfg["join_table"] != nil {
+			oldJoin := pgx.Identifier{fmt.Sprint(cfg["join_schema"]), fmt.Sprint(cfg["join_table"])}.Sanitize()
+			if err := createJoinTable(ctx, tx, req.GetName(), schemaName, cfg); err != nil {
+				return nil, err
+			}
+			if req.GetIncludeData() {
+				if _, err := tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s (source_id, target_id, created_at) SELECT source_id, target_id, created_at FROM %s`,
+					pgx.Identifier{fmt.Sprint(cfg["join_schema"]), fmt.Sprint(cfg["join_table"])}.Sanitize(), oldJoin)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if c.Kind == attachmentKind {
+			// 复制的单元格仍指向源列生成的对象 key
+			cfg[sharedKeyColumnsKey] = append(sharedKeyColumns(c), c.Id)
+		}
+		col, err := scanColumn(tx.QueryRow(ctx, `
+			INSERT INTO lc_columns (id, table_id, name, type_id, pg_column, is_nullable, position, config, is_hidden, is_readonly, description, label, ui_hints)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			RETURNING `+columnFieldsSQL,
+			ids[c.Id], req.GetName(), c.Name, c.TypeId, c.PgColumn, c.IsNullable, c.Position, cfg, c.IsHidden, c.IsReadonly,
+			c.Description, c.Label, c.UIHints))
+		if err != nil {
+			return nil, err
+		}
+		if !virtualKinds[c.Kind] {
+			if err := syncColumnChecks(ctx, tx, schemaName, newPhysTable, col.Id, c.PgColumn, c.PgType, cfg); err != nil {
+				return nil, err
+			}
+		}
+		res.Columns = append(res.Columns, col)
+	}
+
+	res.Indexes, err = duplicateIndexes(ctx, tx, req.GetTableId(), req.GetName(), schemaName, dst, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetIncludeData() {
+		where := ""
+		if system, _ := tableCfg["system_columns"].(bool); system {
+			where = " WHERE deleted_at IS NULL"
+		}
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s SELECT * FROM %s%s`, dst, src, where))
+		if err != nil {
+			return nil, err
+		}
+		res.CopiedRows = tag.RowsAffected()
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// sourceColumns 读取表的全部列（含虚拟列）。
+func sourceColumns(ctx context.Context, q querier, tableName string) ([]columnMeta, error) {
+	rows, err := q.Query(ctx, `
+		SELECT c.id, c.table_id, c.name, c.type_id, ty.pg_type, COALESCE(ty.config->>'kind', ''), c.pg_column, c.is_nullable, c.position, c.config, c.is_hidden, c.is_readonly,
+		       c.description, c.label, c.ui_hints
+		FROM lc_columns c
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.table_id = $1
+		ORDER BY c.position`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(r pgx.CollectableRow) (columnMeta, error) {
+		var c columnMeta
+		err := r.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgType, &c.Kind, &c.PgColumn, &c.IsNullable, &c.Position, &c.Config, &c.IsHidden, &c.IsReadonly,
+			&c.Description, &c.Label, &c.UIHints)
+		return c, err
+	})
+}
+
+// remapColumnConfig 把 config 中的源列 id 替换为新列 id，target_table_id 为源表时改为新表。
+// 列 id 是 UUID，直接在 JSON 文本上替换不会误伤其它内容。
+func remapColumnConfig(cfg map[string]any, ids map[string]string, fromTable, toTable string) (map[string]any, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	text := string(b)
+	for from, to := range ids {
+		text = strings.ReplaceAll(text, from, to)
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = map[string]any{}
+	}
+	if target, _ := out["target_table_id"].(string); target == fromTable {
+		out["target_table_id"] = toTable
+	}
+	return out, nil
+}
+
+// duplicateIndexes 按 pg_get_indexdef 在新表上重建源表登记的索引（保留 UNIQUE、索引方法和 WHERE 条件）。
+func duplicateIndexes(ctx context.Context, tx pgx.Tx, fromTable, toTable, schemaName, dst string, ids map[string]string) ([]*lowcodev1.Index, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT name, column_ids::text[], is_unique, config::text, pg_get_indexdef(format('%I.%I', $2::text, pg_index)::regclass)
+		FROM lc_indexes
+		WHERE table_id = $1
+		ORDER BY name`, fromTable, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	type sourceIndex struct {
+		Name      string
+		ColumnIDs []string
+		IsUnique  bool
+		Config    string
+		Def       string
+	}
+	src, err := pgx.CollectRows(rows, pgx.RowToStructByPos[sourceIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*lowcodev1.Index
+	for _, si := range src {
+		i := strings.Index(si.Def, " USING ")
+		if i < 0 {
+			return nil, fmt.Errorf("unexpected definition for index %s: %s", si.Name, si.Def)
+		}
+		pgIndex := "lc_idx_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+		head := "CREATE INDEX"
+		if si.IsUnique {
+			head = "CREATE UNIQUE INDEX"
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`%s %s ON %s%s`, head, pgx.Identifier{pgIndex}.Sanitize(), dst, si.Def[i:])); err != nil {
+			return nil, err
+		}
+		columnIDs := make([]string, len(si.ColumnIDs))
+		for j, id := range si.ColumnIDs {
+			columnIDs[j] = ids[id]
+		}
+		// config 中的表达式和条件引用列 id，同样换成新列；新索引由本服务创建，不再是外部索引
+		text := si.Config
+		for from, to := range ids {
+			text = strings.ReplaceAll(text, from, to)
+		}
+		var cfg indexConfig
+		if err := json.Unmarshal([]byte(text), &cfg); err != nil {
+			return nil, err
+		}
+		cfg.External, cfg.Definition = false, ""
+		idx, err := scanIndex(tx.QueryRow(ctx, `
+			INSERT INTO lc_indexes (table_id, name, pg_index, column_ids, is_unique, config)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING `+indexFieldsSQL,
+			toTable, si.Name, pgIndex, columnIDs, si.IsUnique, cfg,
+		))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, idx)
+	}
+	return out, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/kms"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// -------- Encrypted columns --------
+
+// encryptedKind 是加密列的类型（lc_types.config.kind，内置类型 encrypted_text）。物理列为 bytea，
+// 保存用 tenant 的数据密钥加密的密文：写入时在请求边界加密，返回给调用方前解密，
+// 库中、lc_row_history 和审计日志中都只有密文。库里没有明文，所以加密列不能过滤、排序、聚合、建索引或设为唯一。
+const encryptedKind = "encrypted"
+
+func (c columnMeta) encrypted() bool {
+	return c.Kind == encryptedKind
+}
+
+// encryptedColumnError 是对加密列执行需要明文的操作（过滤、排序、索引等）时的错误。
+func encryptedColumnError(columnID string) error {
+	return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column %s is encrypted", columnID)
+}
+
+// checkEncryptedType 校验自定义的加密类型：密文只能存放在 bytea 列中。
+func checkEncryptedType(name, pgType string, cfg map[string]any) error {
+	if kind, _ := cfg["kind"].(string); kind == encryptedKind && basePgType(pgType) != "bytea" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "type %s: encrypted types must use pg_type bytea, got %s", name, pgType)
+	}
+	return nil
+}
+
+// requireKeyring 返回 tenant 的 keyring，未配置 KMS_PROVIDER 时加密列不可用。
+func (s *LowcodeService) requireKeyring() (*kms.Keyring, error) {
+	k := s.tenants.Keyring()
+	if k == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "encrypted columns require KMS_PROVIDER")
+	}
+	return k, nil
+}
+
+// sealCells 返回 cells 的副本，其中加密列的字符串值换成当前 tenant 加密后的 bytes 值；没有加密列时原样返回。
+// 在 validateCells 之后、cellArg 之前调用，校验规则（required、max_length 等）作用于明文。
+func (s *LowcodeService) sealCells(ctx context.Context, cols []columnMeta, cells map[string]*lowcodev1.Value) (map[string]*lowcodev1.Value, error) {
+	var out map[string]*lowcodev1.Value
+	for _, c := range cols {
+		v, ok := cells[c.Id]
+		if !ok || !c.encrypted() || isEmptyValue(v) {
+			continue
+		}
+		sv, ok := v.GetKind().(*lowcodev1.Value_StringValue)
+		if !ok {
+			return nil, apierr.NewValidation([]apierr.FieldViolation{{Field: c.Id, Description: fmt.Sprintf("%s must be a string", c.Name)}})
+		}
+		k, err := s.requireKeyring()
+		if err != nil {
+			return nil, err
+		}
+		sealed, err := k.Seal(ctx, tenant.FromContext(ctx), []byte(sv.StringValue))
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			out = make(map[string]*lowcodev1.Value, len(cells))
+			for id, v := range cells {
+				out[id] = v
+			}
+		}
+		out[c.Id] = &lowcodev1.Value{Kind: &lowcodev1.Value_BytesValue{BytesValue: sealed}}
+	}
+	if out == nil {
+		return cells, nil
+	}
+	return out, nil
+}
+
+// openRows 把 rows 中加密列的密文解密为字符串值，在返回给调用方（redactRows）之前调用。
+func (s *LowcodeService) openRows(ctx context.Context, cols []columnMeta, rows ...*lowcodev1.Row) error {
+	var k *kms.Keyring
+	for _, c := range cols {
+		if !c.encrypted() {
+			continue
+		}
+		for _, row := range rows {
+			bv, ok := row.GetCells()[c.Id].GetKind().(*lowcodev1.Value_BytesValue)
+			if !ok {
+				continue
+			}
+			if k == nil {
+				var err error
+				if k, err = s.requireKeyring(); err != nil {
+					return err
+				}
+			}
+			plain, err := k.Open(ctx, tenant.FromContext(ctx), bv.BytesValue)
+			if err != nil {
+				return fmt.Errorf("decrypt column %s of row %s: %w", c.Id, row.Id, err)
+			}
+			row.Cells[c.Id] = &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: string(plain)}}
+		}
+	}
+	return nil
+}
+
+// encryptedColumn 是 reencryptColumns 要重写的一个加密列。
+type encryptedColumn struct {
+	id, pgColumn, schemaName, tableName, tableID string
+}
+
+// reencryptColumns 把 tx 所在库中全部加密列（含回收站中的行和已归档的表）以及 lc_row_history 中这些列的旧值
+// 从 from 的密文重新加密为 to 当前数据密钥的密文。轮换密钥时 from 与 to 相同；复制或导入 tenant 时
+// from 为源 tenant（密文绑定 tenant id，不重新加密新 tenant 无法解密）。返回重写的单元格数和历史版本数。
+func reencryptColumns(ctx context.Context, tx pgx.Tx, k *kms.Keyring, from, to string) (cells, versions int64, err error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.id::text, c.pg_column, t.schema_name, t.table_name, t.name
+		FROM lc_columns c
+		JOIN lc_tables t ON t.name = c.table_id
+		JOIN lc_types ty ON ty.id = c.type_id
+		WHERE ty.config->>'kind' = $1 AND NOT t.config ? 'sql_view'
+		ORDER BY t.name, c.position`, encryptedKind)
+	if err != nil {
+		return 0, 0, err
+	}
+	cols, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (encryptedColumn, error) {
+		var c encryptedColumn
+		err := r.Scan(&c.id, &c.pgColumn, &c.schemaName, &c.tableName, &c.tableID)
+		return c, err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(cols) > 0 && k == nil {
+		return 0, 0, errors.New("encrypted columns require KMS_PROVIDER")
+	}
+	for _, c := range cols {
+		n, err := reencryptColumn(ctx, tx, k, c, from, to)
+		if err != nil {
+			return cells, versions, fmt.Errorf("column %s: %w", c.id, err)
+		}
+		cells += n
+		n, err = reencryptColumnHistory(ctx, tx, k, c, from, to)
+		if err != nil {
+			return cells, versions, fmt.Errorf("history of column %s: %w", c.id, err)
+		}
+		versions += n
+	}
+	return cells, versions, nil
+}
+
+func reencryptColumn(ctx context.Context, tx pgx.Tx, k *kms.Keyring, c encryptedColumn, from, to string) (int64, error) {
+	table := pgx.Identifier{c.schemaName, c.tableName}.Sanitize()
+	col := pgx.Identifier{c.pgColumn}.Sanitize()
+	rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT id::text, %s FROM %s WHERE %s IS NOT NULL FOR UPDATE`, col, table, col))
+	if err != nil {
+		return 0, err
+	}
+	type cell struct {
+		id     string
+		sealed []byte
+	}
+	cells, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (cell, error) {
+		var x cell
+		err := r.Scan(&x.id, &x.sealed)
+		return x, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	batch := &pgx.Batch{}
+	for _, x := range cells {
+		sealed, err := k.Reseal(ctx, from, to, x.sealed)
+		if err != nil {
+			return 0, fmt.Errorf("row %s: %w", x.id, err)
+		}
+		batch.Queue(fmt.Sprintf(`UPDATE %s SET %s = $2 WHERE id = $1`, table, col), x.id, sealed)
+	}
+	if batch.Len() == 0 {
+		return 0, nil
+	}
+	return int64(len(cells)), tx.SendBatch(ctx, batch).Close()
+}
+
+// reencryptColumnHistory 重写 lc_row_history.row（行的 protojson）中该列的 bytesValue。
+func reencryptColumnHistory(ctx context.Context, tx pgx.Tx, k *kms.Keyring, c encryptedColumn, from, to string) (int64, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id::text, row->'cells'->$2->>'bytesValue' FROM lc_row_history
+		WHERE table_id = $1 AND row->'cells'->$2 ? 'bytesValue'
+		FOR UPDATE`, c.tableID, c.id)
+	if err != nil {
+		return 0, err
+	}
+	type version struct {
+		id, sealed string
+	}
+	vs, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (version, error) {
+		var v version
+		err := r.Scan(&v.id, &v.sealed)
+		return v, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	batch := &pgx.Batch{}
+	for _, v := range vs {
+		raw, err := base64.StdEncoding.DecodeString(v.sealed)
+		if err != nil {
+			return 0, fmt.Errorf("version %s: %w", v.id, err)
+		}
+		sealed, err := k.Reseal(ctx, from, to, raw)
+		if err != nil {
+			return 0, fmt.Errorf("version %s: %w", v.id, err)
+		}
+		batch.Queue(`UPDATE lc_row_history SET row = jsonb_set(row, ARRAY['cells', $2, 'bytesValue'], to_jsonb($3::text)) WHERE id = $1`,
+			v.id, c.id, base64.StdEncoding.EncodeToString(sealed))
+	}
+	if batch.Len() == 0 {
+		return 0, nil
+	}
+	return int64(len(vs)), tx.SendBatch(ctx, batch).Close()
+}
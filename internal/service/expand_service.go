@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Relationship expansion --------
+
+// maxExpandDepth 限制 expand 路径的层数（"a.b.c" 为 3 层），避免一次请求展开过多关联表。
+const maxExpandDepth = 3
+
+// expandTree 是解析后的 expand 路径：key 为当前表的 relationship 列 id，value 为在目标表上继续展开的路径。
+type expandTree map[string]expandTree
+
+// parseExpandPaths 解析 expand_column_ids。每一项可以是单个列 id，也可以是用 "." 连接的多级路径，
+// 如 "<order 列>.<customer 列>"：先展开当前表的 order 列，再在 order 表上展开 customer 列。
+func parseExpandPaths(paths []string) (expandTree, error) {
+	tree := expandTree{}
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		segs := strings.Split(p, ".")
+		if len(segs) > maxExpandDepth {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+				"expand path %q is deeper than %d levels", p, maxExpandDepth)
+		}
+		node := tree
+		for _, seg := range segs {
+			if seg == "" {
+				return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid expand path %q", p)
+			}
+			child, ok := node[seg]
+			if !ok {
+				child = expandTree{}
+				node[seg] = child
+			}
+			node = child
+		}
+	}
+	return tree, nil
+}
+
+func (t expandTree) columnIDs() []string {
+	ids := make([]string, 0, len(t))
+	for id := range t {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// expandRows 按 tree 展开同一张表的 rows：relationship 列的 cell 值为 json_value
+// { "rows": [ { "id", "cells" }, ... ] }，关联行上再按子路径递归展开。
+// 每个 relationship 列只发一条批量查询（= ANY($1)），再按外键分发回各行，避免 N+1。
+// 不存在或不是 relationship 的列 id 会被忽略。
+func (s *LowcodeService) expandRows(ctx context.Context, pool *pgxpool.Pool, tableID string, tree expandTree, rows []*lowcodev1.Row) error {
+	if len(tree) == 0 || len(rows) == 0 {
+		return nil
+	}
+	relCols, err := s.loadRelationshipColumns(ctx, pool, tableID, tree.columnIDs())
+	if err != nil {
+		return err
+	}
+	for _, rel := range relCols {
+		related, all, err := s.fetchRelatedRows(ctx, pool, rel, rows)
+		if err != nil {
+			return err
+		}
+		if err := s.expandRows(ctx, pool, rel.TargetTableId, tree[rel.Id], all); err != nil {
+			return err
+		}
+		// 关联行按目标表的权限去掉不可读的列；整张目标表不可读时不返回关联行
+		acc, err := s.tableAccess(ctx, pool, rel.TargetTableId)
+		if err != nil {
+			return err
+		}
+		if !acc.readable() {
+			related = nil
+		}
+		acc.redactRows(all...)
+		for _, row := range rows {
+			v, err := relatedRowsValue(related[row.Id])
+			if err != nil {
+				return err
+			}
+			if row.Cells == nil {
+				row.Cells = make(map[string]*lowcodev1.Value)
+			}
+			row.Cells[rel.Id] = v
+		}
+	}
+	return nil
+}
+
+// relatedRowsValue 把关联行转成 expand 的 cell 值。
+func relatedRowsValue(rows []*lowcodev1.Row) (*lowcodev1.Value, error) {
+	list := make([]*structpb.Value, 0, len(rows))
+	for _, r := range rows {
+		cellsMap := make(map[string]any, len(r.Cells))
+		for id, v := range r.Cells {
+			cellsMap[id] = valueToJSON(v)
+		}
+		st, err := structpb.NewStruct(map[string]any{"id": r.Id, "cells": cellsMap})
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, structpb.NewStructValue(st))
+	}
+	listVal := &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{Values: list}}}
+	expandStruct := &structpb.Struct{Fields: map[string]*structpb.Value{"rows": listVal}}
+	return &lowcodev1.Value{Kind: &lowcodev1.Value_JsonValue{JsonValue: expandStruct}}, nil
+}
+
+// fetchRelatedRows 用一条查询取出 rows 的所有关联行。返回按父行 id 分组的结果，以及去重后的全部关联行（用于继续展开）。
+func (s *LowcodeService) fetchRelatedRows(ctx context.Context, pool *pgxpool.Pool, rel relationshipColumn, rows []*lowcodev1.Row) (map[string][]*lowcodev1.Row, []*lowcodev1.Row, error) {
+	targetCols, targetSchema, targetTable, err := s.loadColumns(ctx, pool, rel.TargetTableId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(targetCols) == 0 {
+		return nil, nil, nil
+	}
+	if rel.JoinTable != "" {
+		return s.fetchLinkedRows(ctx, pool, rel, rows, targetCols, targetSchema, targetTable)
+	}
+
+	var query string
+	var keys []string
+	// keyOf 取关联行上用来匹配父行的值，parentKey 取父行上对应的值
+	var keyOf, parentKey func(r *lowcodev1.Row) string
+
+	if rel.LinkColumnId != "" {
+		// 一对多：子表中外键列 = 当前行 id
+		var linkPgCol string
+		if err := pool.QueryRow(ctx, `SELECT pg_column FROM lc_columns WHERE id = $1`, rel.LinkColumnId).Scan(&linkPgCol); err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, nil, nil
+			}
+			return nil, nil, err
+		}
+		for _, r := range rows {
+			keys = append(keys, r.Id)
+		}
+		query = fmt.Sprintf(`SELECT %s FROM %s.%s WHERE %s = ANY($1)%s ORDER BY id`,
+			rowColumnsSQL(targetCols),
+			pgx.Identifier{targetSchema}.Sanitize(),
+			pgx.Identifier{targetTable}.Sanitize(),
+			pgx.Identifier{linkPgCol}.Sanitize(),
+			andNotDeleted(targetCols),
+		)
+		keyOf = func(r *lowcodev1.Row) string { return cellString(r.Cells, rel.LinkColumnId) }
+		parentKey = func(r *lowcodev1.Row) string { return r.Id }
+	} else {
+		// 多对一/一对一：当前行某列存目标行 id，查目标表 by id
+		seen := make(map[string]bool)
+		for _, r := range rows {
+			id := cellString(r.Cells, rel.TargetColumnId)
+			if _, err := uuid.Parse(id); err != nil || seen[id] {
+				continue
+			}
+			seen[id] = true
+			keys = append(keys, id)
+		}
+		query = fmt.Sprintf(`SELECT %s FROM %s.%s WHERE id = ANY($1)%s`,
+			rowColumnsSQL(targetCols),
+			pgx.Identifier{targetSchema}.Sanitize(),
+			pgx.Identifier{targetTable}.Sanitize(),
+			andNotDeleted(targetCols),
+		)
+		keyOf = func(r *lowcodev1.Row) string { return r.Id }
+		parentKey = func(r *lowcodev1.Row) string { return cellString(r.Cells, rel.TargetColumnId) }
+	}
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	all, err := s.queryRows(ctx, pool, targetCols, query, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	byKey := make(map[string][]*lowcodev1.Row)
+	for _, r := range all {
+		byKey[keyOf(r)] = append(byKey[keyOf(r)], r)
+	}
+
+	related := make(map[string][]*lowcodev1.Row, len(rows))
+	for _, r := range rows {
+		if k := parentKey(r); k != "" {
+			related[r.Id] = byKey[k]
+		}
+	}
+	return related, all, nil
+}
+
+// queryRows 执行 SELECT rowColumnsSQL(cols) ... 查询并扫描出所有行，加密列已解密。
+func (s *LowcodeService) queryRows(ctx context.Context, pool *pgxpool.Pool, cols []columnMeta, query string, args ...any) ([]*lowcodev1.Row, error) {
+	res, err := pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var out []*lowcodev1.Row
+	for res.Next() {
+		r, err := scanRow(res, cols)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	return out, s.openRows(ctx, cols, out...)
+}
+
+// cellString 返回字符串类型 cell 的值，不存在或不是字符串时为空。
+func cellString(cells map[string]*lowcodev1.Value, columnID string) string {
+	if sv, ok := cells[columnID].GetKind().(*lowcodev1.Value_StringValue); ok {
+		return sv.StringValue
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Geospatial (PostGIS) --------
+
+// geoKind 是 point / geometry 类型的 lc_types.config.kind。列为 PostGIS geometry（SRID 4326），
+// 单元格读写都使用 GeoJSON：写入时转成 EWKT 交给 PG 解析，读取时用 ST_AsGeoJSON 输出 json_value。
+const geoKind = "geo"
+
+// geoSRID 是经纬度（WGS 84）坐标系。
+const geoSRID = 4326
+
+func isGeometryPgType(pgType string) bool {
+	switch basePgType(pgType) {
+	case "geometry", "geography":
+		return true
+	}
+	return false
+}
+
+// requirePostGIS 检查 tenant 库是否已安装 postgis 扩展。
+func requirePostGIS(ctx context.Context, q querier) error {
+	var ok bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis')`).Scan(&ok); err != nil {
+		return err
+	}
+	if !ok {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"geospatial columns require the postgis extension, which is not installed in this database")
+	}
+	return nil
+}
+
+// coerceGeometry 把 GeoJSON（json_value 或 JSON 字符串）转成 EWKT。geometry(Point,...) 列只接受 Point。
+func coerceGeometry(v *lowcodev1.Value, pgType string) (any, error) {
+	var g map[string]any
+	switch x := v.GetKind().(type) {
+	case *lowcodev1.Value_JsonValue:
+		g = x.JsonValue.AsMap()
+	case *lowcodev1.Value_StringValue:
+		if err := json.Unmarshal([]byte(x.StringValue), &g); err != nil {
+			return nil, fmt.Errorf("expected a GeoJSON geometry, got %q", x.StringValue)
+		}
+	default:
+		return nil, fmt.Errorf("cannot use %s value for geometry column, GeoJSON required", valueKindName(v))
+	}
+	// Feature 取其中的 geometry
+	if t, _ := g["type"].(string); t == "Feature" {
+		g, _ = g["geometry"].(map[string]any)
+	}
+	wkt, typ, err := geoJSONToWKT(g)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(strings.ToLower(pgType), "(point") && typ != "Point" {
+		return nil, fmt.Errorf("expected a GeoJSON Point, got %s", typ)
+	}
+	return fmt.Sprintf("SRID=%d;%s", geoSRID, wkt), nil
+}
+
+// geoJSONToWKT 支持 Point / MultiPoint / LineString / MultiLineString / Polygon / MultiPolygon。
+func geoJSONToWKT(g map[string]any) (string, string, error) {
+	typ, _ := g["type"].(string)
+	coords := g["coordinates"]
+	var body string
+	var err error
+	switch typ {
+	case "Point":
+		body, err = wktPosition(coords)
+	case "MultiPoint", "LineString":
+		body, err = wktList(coords, wktPosition)
+	case "MultiLineString", "Polygon":
+		body, err = wktList(coords, func(c any) (string, error) { return wktList(c, wktPosition) })
+	case "MultiPolygon":
+		body, err = wktList(coords, func(c any) (string, error) {
+			return wktList(c, func(r any) (string, error) { return wktList(r, wktPosition) })
+		})
+	default:
+		return "", "", fmt.Errorf("unsupported GeoJSON geometry type %q", typ)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("invalid GeoJSON %s: %w", typ, err)
+	}
+	if typ == "Point" {
+		return "POINT(" + body + ")", typ, nil
+	}
+	return strings.ToUpper(typ) + body, typ, nil
+}
+
+// wktPosition 把 [lng, lat] 转成 "lng lat"，并检查经纬度范围。
+func wktPosition(c any) (string, error) {
+	pos, ok := c.([]any)
+	if !ok || len(pos) < 2 {
+		return "", fmt.Errorf("position must be [longitude, latitude]")
+	}
+	lng, ok1 := pos[0].(float64)
+	lat, ok2 := pos[1].(float64)
+	if !ok1 || !ok2 {
+		return "", fmt.Errorf("position must be [longitude, latitude]")
+	}
+	if lng < -180 || lng > 180 || lat < -90 || lat > 90 {
+		return "", fmt.Errorf("position [%v, %v] is out of range", lng, lat)
+	}
+	return strconv.FormatFloat(lng, 'f', -1, 64) + " " + strconv.FormatFloat(lat, 'f', -1, 64), nil
+}
+
+// wktList 把数组的每个元素用 item 转换后以 "(a, b, ...)" 拼接。
+func wktList(c any, item func(any) (string, error)) (string, error) {
+	list, ok := c.([]any)
+	if !ok || len(list) == 0 {
+		return "", fmt.Errorf("coordinates must be a non-empty array")
+	}
+	parts := make([]string, len(list))
+	for i, e := range list {
+		s, err := item(e)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = s
+	}
+	return "(" + strings.Join(parts, ", ") + ")", nil
+}
+
+// geoColumnSQL 读取 geometry 列时输出 GeoJSON（jsonb），其它列原样返回。
+func geoColumnSQL(c columnMeta) string {
+	col := pgx.Identifier{c.PgColumn}.Sanitize()
+	if isGeometryPgType(c.PgType) {
+		return fmt.Sprintf("ST_AsGeoJSON(%s)::jsonb", col)
+	}
+	return col
+}
+
+// withinRadiusSQL 编译 WITHIN_RADIUS 过滤：value 为 GeoJSON Point，radius_meters 为半径（米），按球面距离计算。
+func withinRadiusSQL(colSQL, pgType string, c *lowcodev1.FilterCondition, a *sqlArgs) (string, error) {
+	if !isGeometryPgType(pgType) {
+		return "", filterError("WITHIN_RADIUS requires a geospatial column, %s is %s", c.GetColumnId(), pgType)
+	}
+	if c.GetRadiusMeters() <= 0 {
+		return "", filterError("WITHIN_RADIUS on column %s requires radius_meters > 0", c.GetColumnId())
+	}
+	center, err := coerceGeometry(c.GetValue(), "geometry(Point)")
+	if err != nil {
+		return "", filterError("filter on column %s: %v", c.GetColumnId(), err)
+	}
+	return fmt.Sprintf("ST_DWithin(%s::geography, %s::geometry::geography, %s)", colSQL, a.add(center), a.add(c.GetRadiusMeters())), nil
+}
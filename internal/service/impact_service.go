@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+// -------- Impact analysis (dry_run) --------
+
+// querier 同时被 *pgxpool.Pool 和 pgx.Tx 实现，影响分析既可以在事务内也可以直接在池上执行。
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+const columnSelectSQL = `
+	SELECT c.id, c.table_id, c.name, c.type_id, c.pg_column, c.is_nullable, c.position, c.config, c.created_at, c.updated_at, c.is_hidden, c.is_readonly,
+	       c.description, c.label, c.ui_hints
+	FROM lc_columns c
+`
+
+// tableImpact 分析删除整张表的影响：行数、其它表中引用它的 relationship / formula 列、索引和视图。
+func tableImpact(ctx context.Context, q querier, tableName, schemaName, physTable string, statements []string) (*lowcodev1.SchemaImpact, error) {
+	impact := &lowcodev1.SchemaImpact{Statements: statements}
+	rel := pgx.Identifier{schemaName, physTable}.Sanitize()
+
+	if err := q.QueryRow(ctx, fmt.Sprintf(`SELECT count(*) FROM %s`, rel)).Scan(&impact.AffectedRows); err != nil {
+		return nil, err
+	}
+
+	var err error
+	impact.DependentColumns, err = queryColumns(ctx, q, columnSelectSQL+`
+		WHERE c.table_id <> $1
+		  AND (c.config->>'target_table_id' = $1
+		       OR EXISTS (SELECT 1 FROM lc_columns x WHERE x.table_id = $1 AND strpos(c.config::text, x.id::text) > 0))
+		ORDER BY c.table_id, c.position
+	`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	impact.DependentIndexes, err = queryIndexes(ctx, q, `
+		SELECT `+indexFieldsSQL+`
+		FROM lc_indexes
+		WHERE table_id = $1
+		ORDER BY name
+	`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	impact.DependentViews, err = dependentViews(ctx, q, rel, "")
+	if err != nil {
+		return nil, err
+	}
+	return impact, nil
+}
+
+// columnImpact 分析删除（或修改类型）一列的影响。虚拟列没有物理数据，只统计依赖它的列。
+func columnImpact(ctx context.Context, q querier, columnID, schemaName, physTable, pgColumn string, isVirtual bool, statements []string) (*lowcodev1.SchemaImpact, error) {
+	impact := &lowcodev1.SchemaImpact{Statements: statements}
+	rel := pgx.Identifier{schemaName, physTable}.Sanitize()
+
+	if !isVirtual {
+		countSQL := fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s IS NOT NULL`, rel, pgx.Identifier{pgColumn}.Sanitize())
+		if err := q.QueryRow(ctx, countSQL).Scan(&impact.AffectedRows); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	impact.DependentColumns, err = queryColumns(ctx, q, columnSelectSQL+`
+		WHERE c.id::text <> $1 AND strpos(c.config::text, $1) > 0
+		ORDER BY c.table_id, c.position
+	`, columnID)
+	if err != nil {
+		return nil, err
+	}
+	impact.DependentIndexes, err = queryIndexes(ctx, q, `
+		SELECT `+indexFieldsSQL+`
+		FROM lc_indexes
+		WHERE $1::uuid = ANY(column_ids) OR strpos(config::text, $1::text) > 0
+		ORDER BY name
+	`, columnID)
+	if err != nil {
+		return nil, err
+	}
+	if !isVirtual {
+		impact.DependentViews, err = dependentViews(ctx, q, rel, pgColumn)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return impact, nil
+}
+
+// indexImpact 分析删除一个索引的影响：被删除的索引本身和 DROP INDEX 语句（SyncIndexes 登记的外部索引只撤销登记，没有语句）。
+func indexImpact(ctx context.Context, q querier, indexID string) (*lowcodev1.SchemaImpact, error) {
+	var schemaName, pgIndex string
+	var external bool
+	if err := q.QueryRow(ctx, `
+		SELECT t.schema_name, i.pg_index, COALESCE((i.config->>'external')::boolean, false)
+		FROM lc_indexes i
+		JOIN lc_tables t ON i.table_id = t.name
+		WHERE i.id = $1`, indexID).Scan(&schemaName, &pgIndex, &external); err != nil {
+		return nil, err
+	}
+	impact := &lowcodev1.SchemaImpact{}
+	if !external {
+		impact.Statements = []string{fmt.Sprintf(`DROP INDEX IF EXISTS %s.%s`, pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{pgIndex}.Sanitize())}
+	}
+	var err error
+	impact.DependentIndexes, err = queryIndexes(ctx, q, `SELECT `+indexFieldsSQL+` FROM lc_indexes WHERE id = $1`, indexID)
+	if err != nil {
+		return nil, err
+	}
+	return impact, nil
+}
+
+// mergeImpact 把 add 合并进 total 并返回合计（total 为 nil 时返回 add 的副本）：行数相加，
+// 依赖的列 / 索引按 id、视图按名字去重，语句按顺序追加。多个步骤影响同一行时行数会重复计算。
+func mergeImpact(total, add *lowcodev1.SchemaImpact) *lowcodev1.SchemaImpact {
+	if total == nil {
+		total = &lowcodev1.SchemaImpact{}
+	}
+	total.AffectedRows += add.GetAffectedRows()
+	for _, c := range add.GetDependentColumns() {
+		if !slices.ContainsFunc(total.DependentColumns, func(x *lowcodev1.Column) bool { return x.GetId() == c.GetId() }) {
+			total.DependentColumns = append(total.DependentColumns, c)
+		}
+	}
+	for _, idx := range add.GetDependentIndexes() {
+		if !slices.ContainsFunc(total.DependentIndexes, func(x *lowcodev1.Index) bool { return x.GetId() == idx.GetId() }) {
+			total.DependentIndexes = append(total.DependentIndexes, idx)
+		}
+	}
+	for _, v := range add.GetDependentViews() {
+		if !slices.Contains(total.DependentViews, v) {
+			total.DependentViews = append(total.DependentViews, v)
+		}
+	}
+	total.Statements = append(total.Statements, add.GetStatements()...)
+	return total
+}
+
+// dependentViews 通过 pg_depend / pg_rewrite 找出引用该表（或该列）的视图。
+func dependentViews(ctx context.Context, q querier, rel, pgColumn string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+		SELECT DISTINCT vn.nspname || '.' || v.relname
+		FROM pg_depend d
+		JOIN pg_rewrite r ON r.oid = d.objid
+		JOIN pg_class v ON v.oid = r.ev_class
+		JOIN pg_namespace vn ON vn.oid = v.relnamespace
+		WHERE d.classid = 'pg_rewrite'::regclass
+		  AND d.refobjid = $1::regclass
+		  AND v.oid <> d.refobjid
+		  AND ($2 = '' OR d.refobjsubid = (
+		    SELECT attnum FROM pg_attribute WHERE attrelid = $1::regclass AND attname = $2
+		  ))
+		ORDER BY 1
+	`, rel, pgColumn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var views []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		views = append(views, v)
+	}
+	return views, rows.Err()
+}
+
+func queryColumns(ctx context.Context, q querier, sql string, args ...any) ([]*lowcodev1.Column, error) {
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cols []*lowcodev1.Column
+	for rows.Next() {
+		c, err := scanColumn(rows)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, c)
+	}
+	return cols, rows.Err()
+}
+
+func queryIndexes(ctx context.Context, q querier, sql string, args ...any) ([]*lowcodev1.Index, error) {
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*lowcodev1.Index
+	for rows.Next() {
+		idx, err := scanIndex(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, idx)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/importer"
+)
+
+// -------- Import --------
+
+// ImportExternalTables 从 Airtable / Notion 导出创建表并导入数据。
+// 步骤：解析全部导出 -> 匹配表间 link -> 建表建列 -> 写入行 -> 用新行 id 回填 link 列并创建 relationship 列。
+// 各步骤分别提交，任何一步失败时删除（purge）本次已创建的表，不留下导入了一半的表。
+func (s *LowcodeService) ImportExternalTables(ctx context.Context, req *lowcodev1.ImportExternalTablesRequest) (_ *lowcodev1.ImportExternalTablesResponse, err error) {
+	source := strings.ToLower(req.GetSource())
+	if source != importer.SourceAirtable && source != importer.SourceNotion {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "source must be %q or %q", importer.SourceAirtable, importer.SourceNotion)
+	}
+	if len(req.GetTables()) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "tables is empty")
+	}
+
+	var parsed []*importer.Table
+	for _, exp := range req.GetTables() {
+		if exp.GetName() == "" {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table name is required")
+		}
+		t, err := importer.Parse(source, strings.ToLower(exp.GetFormat()), exp.GetName(), exp.GetData())
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, t)
+	}
+	importer.ResolveLinks(parsed)
+
+	var created []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		// 按创建的逆序删除；cascade 一并删掉其它导入表中指向它的 relationship 列
+		cleanupCtx := context.WithoutCancel(ctx)
+		for i := len(created) - 1; i >= 0; i-- {
+			if _, derr := s.DeleteTable(cleanupCtx, &lowcodev1.DeleteTableRequest{Id: created[i], Cascade: true, Purge: true}); derr != nil {
+				log.Printf("import: drop table %s after failed import: %v", created[i], derr)
+			}
+		}
+	}()
+
+	var resp lowcodev1.ImportExternalTablesResponse
+	// "<表名>/<源记录 id>" -> 新行 id。记录 id 只在表内唯一（CSV 的 id 是行号），所以带上表名。
+	rowIDs := make(map[string]string)
+	// 每张表 field name -> column id。
+	fieldCols := make([]map[string]string, len(parsed))
+
+	for i, t := range parsed {
+		tblResp, err := s.CreateTable(ctx, &lowcodev1.CreateTableRequest{Name: t.Name})
+		if err != nil {
+			return nil, fmt.Errorf("create table %s: %w", t.Name, err)
+		}
+		created = append(created, tblResp.GetTable().GetId())
+		out := &lowcodev1.ImportedTable{Table: tblResp.GetTable()}
+		fieldCols[i] = make(map[string]string)
+
+		for pos, f := range t.Fields {
+			if f.Kind == importer.KindLink {
+				continue
+			}
+			var cfg *structpb.Struct
+			if f.Kind == importer.KindSingleSelect || f.Kind == importer.KindMultiSelect {
+				options := make([]any, len(f.Options))
+				for j, o := range f.Options {
+					options[j] = o
+				}
+				if cfg, err = structpb.NewStruct(map[string]any{"options": options}); err != nil {
+					return nil, err
+				}
+			}
+			colResp, err := s.AddColumn(ctx, &lowcodev1.AddColumnRequest{
+				TableId:    t.Name,
+				Name:       f.Name,
+				TypeId:     f.Kind,
+				IsNullable: true,
+				Position:   int32(pos + 1),
+				Config:     cfg,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("add column %s.%s: %w", t.Name, f.Name, err)
+			}
+			fieldCols[i][f.Name] = colResp.GetColumn().GetId()
+			out.Columns = append(out.Columns, colResp.GetColumn())
+		}
+
+		items := make([]*lowcodev1.BulkUpsertRowItem, 0, len(t.Records))
+		for _, rec := range t.Records {
+			cells := make(map[string]*lowcodev1.Value)
+			for _, f := range t.Fields {
+				colID, ok := fieldCols[i][f.Name]
+				if !ok {
+					continue
+				}
+				if v := importValue(f.Kind, rec.Values[f.Name]); v != nil {
+					cells[colID] = v
+				}
+			}
+			items = append(items, &lowcodev1.BulkUpsertRowItem{Cells: cells})
+		}
+		if len(items) > 0 {
+			bulkResp, err := s.BulkUpsertRows(ctx, &lowcodev1.BulkUpsertRowsRequest{TableId: t.Name, Items: items})
+			if err != nil {
+				return nil, fmt.Errorf("import rows into %s: %w", t.Name, err)
+			}
+			// BulkUpsertRows 会跳过没有任何 cell 的记录，因此只能按非空记录依次对应。
+			n := 0
+			for j, rec := range t.Records {
+				if len(items[j].Cells) == 0 {
+					continue
+				}
+				if n < len(bulkResp.GetRows()) {
+					rowIDs[t.Name+"/"+rec.ID] = bulkResp.GetRows()[n].GetId()
+					n++
+				}
+			}
+			out.RowCount = int32(n)
+		}
+		resp.Tables = append(resp.Tables, out)
+	}
+
+	// link 字段：单值 -> text 外键列 + relationship 列（多对一）；多值 -> json 列保存目标行 id 列表。
+	for i, t := range parsed {
+		for pos, f := range t.Fields {
+			if f.Kind != importer.KindLink {
+				continue
+			}
+			storeName, storeType := f.Name, "json"
+			if !f.Multiple {
+				storeName, storeType = f.Name+" id", "text"
+			}
+			colResp, err := s.AddColumn(ctx, &lowcodev1.AddColumnRequest{
+				TableId:    t.Name,
+				Name:       storeName,
+				TypeId:     storeType,
+				IsNullable: true,
+				Position:   int32(pos + 1),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("add link column %s.%s: %w", t.Name, f.Name, err)
+			}
+			storeCol := colResp.GetColumn()
+			resp.Tables[i].Columns = append(resp.Tables[i].Columns, storeCol)
+
+			var items []*lowcodev1.BulkUpsertRowItem
+			for _, rec := range t.Records {
+				rowID, ok := rowIDs[t.Name+"/"+rec.ID]
+				if !ok {
+					continue
+				}
+				ids, _ := rec.Values[f.Name].([]string)
+				var targets []any
+				for _, id := range ids {
+					if newID, ok := rowIDs[f.Target+"/"+id]; ok {
+						targets = append(targets, newID)
+					}
+				}
+				if len(targets) == 0 {
+					continue
+				}
+				var v *lowcodev1.Value
+				if f.Multiple {
+					v = importValue(importer.KindJSON, targets)
+				} else {
+					v = &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: targets[0].(string)}}
+				}
+				items = append(items, &lowcodev1.BulkUpsertRowItem{
+					RowId: rowID,
+					Cells: map[string]*lowcodev1.Value{storeCol.GetId(): v},
+				})
+			}
+			if len(items) > 0 {
+				if _, err := s.BulkUpsertRows(ctx, &lowcodev1.BulkUpsertRowsRequest{TableId: t.Name, Items: items}); err != nil {
+					return nil, fmt.Errorf("import links %s.%s: %w", t.Name, f.Name, err)
+				}
+			}
+			if f.Multiple {
+				continue
+			}
+
+			relCfg, err := structpb.NewStruct(map[string]any{
+				"target_table_id":  f.Target,
+				"target_column_id": storeCol.GetId(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			relResp, err := s.AddColumn(ctx, &lowcodev1.AddColumnRequest{
+				TableId:    t.Name,
+				Name:       f.Name,
+				TypeId:     "relationship",
+				IsNullable: true,
+				Position:   int32(pos + 1),
+				Config:     relCfg,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("add relationship column %s.%s: %w", t.Name, f.Name, err)
+			}
+			resp.Tables[i].Columns = append(resp.Tables[i].Columns, relResp.GetColumn())
+		}
+	}
+
+	return &resp, nil
+}
+
+// importValue 把导出文件中的原始值按推断出的类型转换成 Value，无法转换时返回 nil（写入 NULL）。
+func importValue(kind string, v any) *lowcodev1.Value {
+	if v == nil {
+		return nil
+	}
+	switch kind {
+	case importer.KindNumber:
+		switch x := v.(type) {
+		case float64:
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_NumberValue{NumberValue: x}}
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(x), 64); err == nil {
+				return &lowcodev1.Value{Kind: &lowcodev1.Value_NumberValue{NumberValue: f}}
+			}
+		}
+		return nil
+	case importer.KindBool:
+		switch x := v.(type) {
+		case bool:
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_BoolValue{BoolValue: x}}
+		case string:
+			switch strings.ToLower(strings.TrimSpace(x)) {
+			case "true", "checked", "yes":
+				return &lowcodev1.Value{Kind: &lowcodev1.Value_BoolValue{BoolValue: true}}
+			case "false", "no":
+				return &lowcodev1.Value{Kind: &lowcodev1.Value_BoolValue{BoolValue: false}}
+			}
+		}
+		return nil
+	case importer.KindTimestamp:
+		if x, ok := v.(string); ok {
+			if t := importer.ParseTime(x); t != nil {
+				return &lowcodev1.Value{Kind: &lowcodev1.Value_TimestampValue{TimestampValue: timestamppb.New(*t)}}
+			}
+		}
+		return nil
+	case importer.KindJSON:
+		// json_value 只能是对象，数组（多选、附件等）包一层 {"items": [...]}。
+		m, ok := v.(map[string]any)
+		if !ok {
+			m = map[string]any{"items": v}
+		}
+		st, err := structpb.NewStruct(m)
+		if err != nil {
+			return nil
+		}
+		return &lowcodev1.Value{Kind: &lowcodev1.Value_JsonValue{JsonValue: st}}
+	case importer.KindSingleSelect:
+		if x, ok := v.(string); ok && x != "" {
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: x}}
+		}
+		return nil
+	case importer.KindMultiSelect:
+		list, _ := v.([]any)
+		labels := &lowcodev1.ValueList{}
+		for _, e := range list {
+			if x, ok := e.(string); ok && x != "" {
+				labels.Values = append(labels.Values, &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: x}})
+			}
+		}
+		if len(labels.Values) == 0 {
+			return nil
+		}
+		return &lowcodev1.Value{Kind: &lowcodev1.Value_ListValue{ListValue: labels}}
+	default:
+		switch x := v.(type) {
+		case string:
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: x}}
+		case float64:
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: strconv.FormatFloat(x, 'f', -1, 64)}}
+		default:
+			b, err := json.Marshal(x)
+			if err != nil {
+				return nil
+			}
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: string(b)}}
+		}
+	}
+}
@@ -2,15 +2,25 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
 )
 
 // -------- Index --------
@@ -20,33 +30,169 @@ func (s *LowcodeService) CreateIndex(ctx context.Context, req *lowcodev1.CreateI
 	if err != nil {
 		return nil, err
 	}
-	tableIdentifier := req.GetTableId()
-	if tableIdentifier == "" {
-		return nil, fmt.Errorf("table_id is required")
+	if req.GetAsync() {
+		// 表不存在时直接报错，不登记操作
+		ref, err := lookupTable(ctx, pool, req.GetTableId(), false, false)
+		if err != nil {
+			return nil, err
+		}
+		op, err := s.startOperation(ctx, pool, "CreateIndex", ref.Name, func(ctx context.Context) (proto.Message, error) {
+			return s.runCreateIndex(ctx, pool, req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.CreateIndexResponse{Operation: op}, nil
 	}
-	// loadColumns 会内部解析成逻辑 table name。
-	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableIdentifier)
+	return s.runCreateIndex(ctx, pool, req)
+}
+
+func (s *LowcodeService) runCreateIndex(ctx context.Context, pool *pgxpool.Pool, req *lowcodev1.CreateIndexRequest) (*lowcodev1.CreateIndexResponse, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
 
-	colIDSet := make(map[string]struct{}, len(req.GetColumnIds()))
-	for _, id := range req.GetColumnIds() {
-		colIDSet[id] = struct{}{}
+	idx, err := s.createIndex(ctx, tx, req)
+	if err != nil {
+		return nil, err
 	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.CreateIndexResponse{Index: idx}, nil
+}
 
-	var pgColumns []string
+// indexFieldsSQL 是 scanIndex 需要的 lc_indexes 字段，SELECT / RETURNING 共用。
+const indexFieldsSQL = `id, table_id, name, pg_index, column_ids, is_unique, created_at, updated_at, config`
+
+// indexConfig 是 lc_indexes.config：表达式索引的表达式和部分索引的条件（protojson）、索引方法（pg_am 名），
+// 以及 SyncIndexes 登记的外部索引的标记和定义。
+type indexConfig struct {
+	Expression json.RawMessage `json:"expression,omitempty"`
+	Where      json.RawMessage `json:"where,omitempty"`
+	Method     string          `json:"method,omitempty"`
+	External   bool            `json:"external,omitempty"`
+	Definition string          `json:"definition,omitempty"`
+}
+
+func scanIndex(row pgx.Row) (*lowcodev1.Index, error) {
+	var idx lowcodev1.Index
+	var createdAt, updatedAt time.Time
+	var cfg indexConfig
+	if err := row.Scan(&idx.Id, &idx.TableId, &idx.Name, &idx.PgIndex, &idx.ColumnIds, &idx.IsUnique, &createdAt, &updatedAt, &cfg); err != nil {
+		return nil, err
+	}
+	if len(cfg.Expression) > 0 {
+		idx.Expression = &lowcodev1.IndexExpression{}
+		if err := protojson.Unmarshal(cfg.Expression, idx.Expression); err != nil {
+			return nil, fmt.Errorf("index %s: decode expression: %w", idx.Id, err)
+		}
+	}
+	if len(cfg.Where) > 0 {
+		idx.Where = &lowcodev1.RowFilter{}
+		if err := protojson.Unmarshal(cfg.Where, idx.Where); err != nil {
+			return nil, fmt.Errorf("index %s: decode where: %w", idx.Id, err)
+		}
+	}
+	idx.IndexMethod = indexMethodFromName(cfg.Method)
+	idx.External = cfg.External
+	idx.Definition = cfg.Definition
+	idx.CreatedAt = timestamppb.New(createdAt)
+	idx.UpdatedAt = timestamppb.New(updatedAt)
+	return &idx, nil
+}
+
+// createIndex 在 tx 中创建索引并登记到 lc_indexes，CreateIndex 和 CreateTableWithSchema 共用。
+func (s *LowcodeService) createIndex(ctx context.Context, tx pgx.Tx, req *lowcodev1.CreateIndexRequest) (*lowcodev1.Index, error) {
+	ref, err := lookupTable(ctx, tx, req.GetTableId(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	// 物化视图可以建索引，普通视图不行
+	if v := sqlViewFromConfig(ref.Config); v != nil && !v.Materialized {
+		return nil, sqlViewReadOnly(ref.Name)
+	}
+	tableIdentifier := ref.Name
+	cols, schemaName, tableName, err := s.loadColumns(ctx, tx, tableIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]columnMeta, len(cols))
 	for _, c := range cols {
-		if _, ok := colIDSet[c.Id]; ok {
-			pgColumns = append(pgColumns, c.PgColumn)
+		byID[c.Id] = c
+	}
+
+	var cfg indexConfig
+	var pgColumns []string
+	var keys []indexKey
+	columnIDs := req.GetColumnIds()
+	if expr := req.GetExpression(); expr != nil {
+		if len(columnIDs) > 0 {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column_ids and expression cannot be used together")
+		}
+		exprSQL, exprType, err := indexExpressionSQL(expr, byID)
+		if err != nil {
+			return nil, err
+		}
+		pgColumns = []string{"(" + exprSQL + ")"}
+		keys = []indexKey{{name: expr.GetFunction().String(), pgType: exprType}}
+		columnIDs = []string{expr.GetColumnId()}
+		if cfg.Expression, err = protojson.Marshal(expr); err != nil {
+			return nil, err
+		}
+	} else {
+		colIDSet := make(map[string]struct{}, len(columnIDs))
+		for _, id := range columnIDs {
+			colIDSet[id] = struct{}{}
 		}
+		for _, c := range cols {
+			if _, ok := colIDSet[c.Id]; ok && !c.System {
+				if c.encrypted() {
+					return nil, encryptedColumnError(c.Id)
+				}
+				pgColumns = append(pgColumns, pgx.Identifier{c.PgColumn}.Sanitize())
+				keys = append(keys, indexKey{name: c.Id, pgType: c.PgType})
+			}
+		}
+		if len(pgColumns) == 0 {
+			return nil, fmt.Errorf("no valid columns for index")
+		}
+	}
+	method, err := resolveIndexMethod(req.GetIndexMethod(), keys, req.GetIsUnique())
+	if err != nil {
+		return nil, err
 	}
-	if len(pgColumns) == 0 {
-		return nil, fmt.Errorf("no valid columns for index")
+	cfg.Method = method
+	if req.GetIsUnique() {
+		if pgColumns, err = tenantUniqueKeys(ctx, tx, schemaName, tableName, pgColumns); err != nil {
+			return nil, err
+		}
+	}
+
+	rel := pgx.Identifier{schemaName, tableName}.Sanitize()
+	where := ""
+	if req.GetWhere() != nil {
+		var a sqlArgs
+		pred, err := compileFilter(req.GetWhere(), byID, &a)
+		if err != nil {
+			return nil, err
+		}
+		if pred != "" {
+			// 索引条件是 DDL 的一部分，不能带参数
+			if pred, err = inlineArgs(ctx, tx, `SELECT 1 FROM `+rel+` WHERE `+pred, pred, a.args); err != nil {
+				return nil, err
+			}
+			where = " WHERE " + pred
+			if cfg.Where, err = protojson.Marshal(req.GetWhere()); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	pgIndex := "lc_idx_" + strings.ReplaceAll(uuid.New().String(), "-", "")
-	indexSQL := fmt.Sprintf(`CREATE %s INDEX %s ON %s.%s (%s)`,
+	indexSQL := fmt.Sprintf(`CREATE %s INDEX %s ON %s %s(%s)%s`,
 		func() string {
 			if req.GetIsUnique() {
 				return "UNIQUE"
@@ -54,45 +200,307 @@ func (s *LowcodeService) CreateIndex(ctx context.Context, req *lowcodev1.CreateI
 			return ""
 		}(),
 		pgx.Identifier{pgIndex}.Sanitize(),
-		pgx.Identifier{schemaName}.Sanitize(),
-		pgx.Identifier{tableName}.Sanitize(),
+		rel,
+		"USING "+method+" ",
 		strings.Join(pgColumns, ", "),
+		where,
 	)
 
-	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback(ctx)
-
 	if _, err := tx.Exec(ctx, indexSQL); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "42P17" {
+			// 如对时间列做文本匹配：结果依赖会话时区，PG 不允许用作索引条件
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "where cannot be used as an index predicate: %s", pgErr.Message)
+		}
 		return nil, err
 	}
-	const ins = `
-		INSERT INTO lc_indexes (table_id, name, pg_index, column_ids, is_unique)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, table_id, name, pg_index, column_ids, is_unique, created_at, updated_at
-	`
-	row := tx.QueryRow(ctx, ins,
+	return scanIndex(tx.QueryRow(ctx, `
+		INSERT INTO lc_indexes (table_id, name, pg_index, column_ids, is_unique, config)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+indexFieldsSQL,
 		tableIdentifier,
 		req.GetName(),
 		pgIndex,
-		req.GetColumnIds(),
+		columnIDs,
 		req.GetIsUnique(),
-	)
+		cfg,
+	))
+}
 
-	var idx lowcodev1.Index
-	var createdAt, updatedAt time.Time
-	if err := row.Scan(&idx.Id, &idx.TableId, &idx.Name, &idx.PgIndex, &idx.ColumnIds, &idx.IsUnique, &createdAt, &updatedAt); err != nil {
+// indexExpressionSQL 把 IndexExpression 编译成索引键表达式（必须是 IMMUTABLE 的），同时返回表达式的类型。
+func indexExpressionSQL(e *lowcodev1.IndexExpression, cols map[string]columnMeta) (string, string, error) {
+	c, ok := cols[e.GetColumnId()]
+	if !ok {
+		return "", "", apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.InvalidArgument, "column %s not found", e.GetColumnId())
+	}
+	col := pgx.Identifier{c.PgColumn}.Sanitize()
+	base := basePgType(c.PgType)
+	mismatch := func(what string) error {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"%s requires a %s column, column %s is %s", e.GetFunction(), what, e.GetColumnId(), c.PgType)
+	}
+	switch e.GetFunction() {
+	case lowcodev1.IndexFunction_INDEX_FUNCTION_LOWER, lowcodev1.IndexFunction_INDEX_FUNCTION_UPPER:
+		if !isTextPgType(base) {
+			return "", "", mismatch("text")
+		}
+		if e.GetFunction() == lowcodev1.IndexFunction_INDEX_FUNCTION_LOWER {
+			return "lower(" + col + ")", "text", nil
+		}
+		return "upper(" + col + ")", "text", nil
+	case lowcodev1.IndexFunction_INDEX_FUNCTION_JSON_FIELD:
+		if base != "json" && base != "jsonb" {
+			return "", "", mismatch("json")
+		}
+		if e.GetJsonKey() == "" {
+			return "", "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "expression.json_key is required")
+		}
+		return col + " ->> " + quoteLiteral(e.GetJsonKey()), "text", nil
+	case lowcodev1.IndexFunction_INDEX_FUNCTION_DATE:
+		switch base {
+		case "timestamptz", "timestamp with time zone":
+			// timestamptz::date 依赖会话时区，不是 IMMUTABLE
+			return "(" + col + " AT TIME ZONE 'UTC')::date", "date", nil
+		case "timestamp", "timestamp without time zone":
+			return col + "::date", "date", nil
+		}
+		return "", "", mismatch("timestamp")
+	default:
+		return "", "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "expression.function is required")
+	}
+}
+
+// indexKey 是索引的一个键：列 id（表达式索引为函数名）和键的 PG 类型，用于校验索引方法。
+type indexKey struct {
+	name   string
+	pgType string
+}
+
+// indexMethodNames 是 IndexMethod 对应的 pg_am 名。
+var indexMethodNames = map[lowcodev1.IndexMethod]string{
+	lowcodev1.IndexMethod_INDEX_METHOD_BTREE: "btree",
+	lowcodev1.IndexMethod_INDEX_METHOD_HASH:  "hash",
+	lowcodev1.IndexMethod_INDEX_METHOD_GIN:   "gin",
+	lowcodev1.IndexMethod_INDEX_METHOD_GIST:  "gist",
+	lowcodev1.IndexMethod_INDEX_METHOD_BRIN:  "brin",
+}
+
+// indexMethodFromName 把 pg_am 名转回 IndexMethod，未知或空为 UNSPECIFIED。
+func indexMethodFromName(name string) lowcodev1.IndexMethod {
+	for m, n := range indexMethodNames {
+		if n == name {
+			return m
+		}
+	}
+	return lowcodev1.IndexMethod_INDEX_METHOD_UNSPECIFIED
+}
+
+// resolveIndexMethod 校验索引方法与各键类型、唯一性是否匹配，返回 pg_am 名。
+// 在执行 DDL 之前检查，避免把 PG 的 "operator class" 错误直接抛给调用方。
+// 未指定时保持原有行为：全是数组列用 GIN（支持 ARRAY_CONTAINS / ARRAY_OVERLAPS），否则用 btree。
+func resolveIndexMethod(m lowcodev1.IndexMethod, keys []indexKey, unique bool) (string, error) {
+	if m == lowcodev1.IndexMethod_INDEX_METHOD_UNSPECIFIED {
+		arrays := 0
+		for _, k := range keys {
+			if strings.HasSuffix(basePgType(k.pgType), "[]") {
+				arrays++
+			}
+		}
+		if arrays == 0 {
+			m = lowcodev1.IndexMethod_INDEX_METHOD_BTREE
+		} else if arrays == len(keys) && !unique {
+			m = lowcodev1.IndexMethod_INDEX_METHOD_GIN
+		} else {
+			return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+				"array columns can only be indexed together with other array columns and cannot be unique")
+		}
+	}
+	name, ok := indexMethodNames[m]
+	if !ok {
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "unknown index_method %s", m)
+	}
+	if unique && m != lowcodev1.IndexMethod_INDEX_METHOD_BTREE {
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s indexes cannot be unique", name)
+	}
+	if m == lowcodev1.IndexMethod_INDEX_METHOD_HASH && len(keys) != 1 {
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "hash indexes support exactly one column")
+	}
+	for _, k := range keys {
+		if !indexMethodSupports(m, k.pgType) {
+			return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+				"index_method %s does not support %s (%s)", name, k.name, k.pgType)
+		}
+	}
+	return name, nil
+}
+
+// indexMethodSupports 判断索引方法是否有适用于该类型的默认 operator class（只考虑内置类型和 PostGIS）。
+func indexMethodSupports(m lowcodev1.IndexMethod, pgType string) bool {
+	base := basePgType(pgType)
+	array := strings.HasSuffix(base, "[]")
+	switch m {
+	case lowcodev1.IndexMethod_INDEX_METHOD_BTREE:
+		return base != "json"
+	case lowcodev1.IndexMethod_INDEX_METHOD_HASH:
+		return base != "json" && !isGeometryPgType(base)
+	case lowcodev1.IndexMethod_INDEX_METHOD_GIN:
+		return array || base == "jsonb" || base == "tsvector"
+	case lowcodev1.IndexMethod_INDEX_METHOD_GIST:
+		return isGeometryPgType(base) || base == "tsvector" || strings.HasSuffix(base, "range")
+	case lowcodev1.IndexMethod_INDEX_METHOD_BRIN:
+		if isGeometryPgType(base) || isTextPgType(base) {
+			return true
+		}
+		switch base {
+		case "smallint", "integer", "int", "int2", "int4", "int8", "bigint", "numeric", "decimal", "real", "double precision", "float4", "float8",
+			"date", "timestamp", "timestamptz", "timestamp with time zone", "timestamp without time zone", "time", "uuid":
+			return true
+		}
+	}
+	return false
+}
+
+// placeholderRe 匹配 sqlArgs 生成的 $n 占位符。
+var placeholderRe = regexp.MustCompile(`\$[0-9]+`)
+
+// inlineArgs 把 fragment 中的 $n 替换为字面量。参数类型由 PG 解析 probe（包含 fragment 的完整语句）推断，
+// 字面量由 quote_nullable 按该类型生成，避免在 Go 里重复实现各类型的文本格式。
+// 只替换一遍，字面量中出现的 "$n" 不会被再次替换。
+func inlineArgs(ctx context.Context, tx pgx.Tx, probe, fragment string, args []any) (string, error) {
+	if len(args) == 0 {
+		return fragment, nil
+	}
+	sd, err := tx.Conn().PgConn().Prepare(ctx, "", probe, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(sd.ParamOIDs) != len(args) {
+		return "", fmt.Errorf("inline args: expected %d parameters, got %d", len(args), len(sd.ParamOIDs))
+	}
+	literals := make([]string, len(args))
+	for i, arg := range args {
+		var typ string
+		if err := tx.QueryRow(ctx, `SELECT format_type($1::oid, NULL)`, sd.ParamOIDs[i]).Scan(&typ); err != nil {
+			return "", err
+		}
+		var lit string
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT quote_nullable($1::%s)`, typ), arg).Scan(&lit); err != nil {
+			return "", err
+		}
+		literals[i] = "(" + lit + "::" + typ + ")"
+	}
+	return placeholderRe.ReplaceAllStringFunc(fragment, func(m string) string {
+		n, _ := strconv.Atoi(m[1:])
+		return literals[n-1]
+	}), nil
+}
+
+func indexNotFound(id string) error {
+	return apierr.New(lowcodev1.ErrorCode_INDEX_NOT_FOUND, codes.NotFound, "index %s not found", id)
+}
+
+// lookupIndex 按 id 读取索引登记，id 不是 UUID 时同样视为不存在。
+func lookupIndex(ctx context.Context, q querier, id string) (*lowcodev1.Index, error) {
+	if id == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, indexNotFound(id)
+	}
+	idx, err := scanIndex(q.QueryRow(ctx, `SELECT `+indexFieldsSQL+` FROM lc_indexes WHERE id = $1`, id))
+	if err == pgx.ErrNoRows {
+		return nil, indexNotFound(id)
+	}
+	return idx, err
+}
+
+// checkIndexName 检查索引名在表内是否已被其它索引使用。
+func checkIndexName(ctx context.Context, q querier, tableID, name, exceptID string) error {
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_indexes WHERE table_id = $1 AND name = $2 AND id::text <> $3)`, tableID, name, exceptID).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "index %s already exists in table %s", name, tableID)
+	}
+	return nil
+}
+
+// UpdateIndex 改名只修改 lc_indexes.name；rebuild 时对物理索引执行 REINDEX 并刷新 updated_at。
+func (s *LowcodeService) UpdateIndex(ctx context.Context, req *lowcodev1.UpdateIndexRequest) (*lowcodev1.UpdateIndexResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
 		return nil, err
 	}
-	idx.CreatedAt = timestamppb.New(createdAt)
-	idx.UpdatedAt = timestamppb.New(updatedAt)
+	if (req.GetConcurrently() || req.GetAsync()) && !req.GetRebuild() {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "concurrently and async require rebuild")
+	}
+	idx, err := lookupIndex(ctx, pool, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if name := req.GetName(); name != "" && name != idx.GetName() {
+		if err := checkIndexName(ctx, pool, idx.GetTableId(), name, idx.GetId()); err != nil {
+			return nil, err
+		}
+		if idx, err = scanIndex(pool.QueryRow(ctx, `
+			UPDATE lc_indexes SET name = $2, updated_at = now()
+			WHERE id = $1
+			RETURNING `+indexFieldsSQL, idx.GetId(), name)); err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, indexNotFound(req.GetId())
+			}
+			return nil, err
+		}
+	}
+	if !req.GetRebuild() {
+		return &lowcodev1.UpdateIndexResponse{Index: idx}, nil
+	}
+	if idx.GetExternal() {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "index %s is managed outside the API and cannot be rebuilt", idx.GetId())
+	}
+	if req.GetAsync() {
+		op, err := s.startOperation(ctx, pool, "UpdateIndex", idx.GetTableId(), func(ctx context.Context) (proto.Message, error) {
+			return rebuildIndex(ctx, pool, idx, req.GetConcurrently())
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.UpdateIndexResponse{Index: idx, Operation: op}, nil
+	}
+	return rebuildIndex(ctx, pool, idx, req.GetConcurrently())
+}
 
-	if err := tx.Commit(ctx); err != nil {
+// rebuildIndex 对登记的物理索引执行 REINDEX。REINDEX CONCURRENTLY 不能在事务中执行，这里统一不开事务。
+func rebuildIndex(ctx context.Context, pool *pgxpool.Pool, idx *lowcodev1.Index, concurrently bool) (*lowcodev1.UpdateIndexResponse, error) {
+	ref, err := lookupTable(ctx, pool, idx.GetTableId(), true, false)
+	if err != nil {
+		return nil, err
+	}
+	rel := pgx.Identifier{ref.SchemaName, idx.GetPgIndex()}.Sanitize()
+	var exists bool
+	if err := pool.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, rel).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, apierr.New(lowcodev1.ErrorCode_INDEX_NOT_FOUND, codes.FailedPrecondition,
+			"physical index %s of index %s no longer exists; delete the index or run RepairTableSchema", idx.GetPgIndex(), idx.GetId())
+	}
+	reindex := `REINDEX INDEX `
+	if concurrently {
+		reindex += `CONCURRENTLY `
+	}
+	if _, err := pool.Exec(ctx, reindex+rel); err != nil {
+		return nil, err
+	}
+	out, err := scanIndex(pool.QueryRow(ctx, `UPDATE lc_indexes SET updated_at = now() WHERE id = $1 RETURNING `+indexFieldsSQL, idx.GetId()))
+	if err == pgx.ErrNoRows {
+		return nil, indexNotFound(idx.GetId())
+	}
+	if err != nil {
 		return nil, err
 	}
-	return &lowcodev1.CreateIndexResponse{Index: &idx}, nil
+	return &lowcodev1.UpdateIndexResponse{Index: out}, nil
 }
 
 func (s *LowcodeService) DeleteIndex(ctx context.Context, req *lowcodev1.DeleteIndexRequest) (*lowcodev1.DeleteIndexResponse, error) {
@@ -106,18 +514,34 @@ func (s *LowcodeService) DeleteIndex(ctx context.Context, req *lowcodev1.DeleteI
 	}
 	defer tx.Rollback(ctx)
 
+	if err := deleteIndex(ctx, tx, req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.DeleteIndexResponse{}, nil
+}
+
+// deleteIndex 在 tx 中删除索引及其登记，索引不存在时什么也不做；外部索引只删除登记。
+func deleteIndex(ctx context.Context, tx pgx.Tx, id string) error {
 	var schemaName, tableName, pgIndex string
+	var external bool
 	if err := tx.QueryRow(ctx, `
-		SELECT t.schema_name, t.table_name, i.pg_index
+		SELECT t.schema_name, t.table_name, i.pg_index, COALESCE((i.config->>'external')::boolean, false)
 		FROM lc_indexes i
 		JOIN lc_tables t ON i.table_id = t.name
 		WHERE i.id = $1`,
-		req.GetId(),
-	).Scan(&schemaName, &tableName, &pgIndex); err != nil {
+		id,
+	).Scan(&schemaName, &tableName, &pgIndex, &external); err != nil {
 		if err == pgx.ErrNoRows {
-			return &lowcodev1.DeleteIndexResponse{}, nil
+			return nil
 		}
-		return nil, err
+		return err
+	}
+	if external {
+		_, err := tx.Exec(ctx, `DELETE FROM lc_indexes WHERE id = $1`, id)
+		return err
 	}
 
 	drop := fmt.Sprintf(`DROP INDEX IF EXISTS %s.%s`,
@@ -125,15 +549,10 @@ func (s *LowcodeService) DeleteIndex(ctx context.Context, req *lowcodev1.DeleteI
 		pgx.Identifier{pgIndex}.Sanitize(),
 	)
 	if _, err := tx.Exec(ctx, drop); err != nil {
-		return nil, err
-	}
-	if _, err := tx.Exec(ctx, `DELETE FROM lc_indexes WHERE id = $1`, req.GetId()); err != nil {
-		return nil, err
+		return err
 	}
-	if err := tx.Commit(ctx); err != nil {
-		return nil, err
-	}
-	return &lowcodev1.DeleteIndexResponse{}, nil
+	_, err := tx.Exec(ctx, `DELETE FROM lc_indexes WHERE id = $1`, id)
+	return err
 }
 
 func (s *LowcodeService) ListIndexes(ctx context.Context, req *lowcodev1.ListIndexesRequest) (*lowcodev1.ListIndexesResponse, error) {
@@ -141,14 +560,11 @@ func (s *LowcodeService) ListIndexes(ctx context.Context, req *lowcodev1.ListInd
 	if err != nil {
 		return nil, err
 	}
-	tableID := req.GetTableId()
-	const q = `
-		SELECT id, table_id, name, pg_index, column_ids, is_unique, created_at, updated_at
-		FROM lc_indexes
-		WHERE table_id = $1
-		ORDER BY name
-	`
-	rows, err := pool.Query(ctx, q, tableID)
+	tableID, err := s.resolveTableName(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, `SELECT `+indexFieldsSQL+` FROM lc_indexes WHERE table_id = $1 ORDER BY name`, tableID)
 	if err != nil {
 		return nil, err
 	}
@@ -156,14 +572,11 @@ func (s *LowcodeService) ListIndexes(ctx context.Context, req *lowcodev1.ListInd
 
 	var resp lowcodev1.ListIndexesResponse
 	for rows.Next() {
-		var idx lowcodev1.Index
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&idx.Id, &idx.TableId, &idx.Name, &idx.PgIndex, &idx.ColumnIds, &idx.IsUnique, &createdAt, &updatedAt); err != nil {
+		idx, err := scanIndex(rows)
+		if err != nil {
 			return nil, err
 		}
-		idx.CreatedAt = timestamppb.New(createdAt)
-		idx.UpdatedAt = timestamppb.New(updatedAt)
-		resp.Indexes = append(resp.Indexes, &idx)
+		resp.Indexes = append(resp.Indexes, idx)
 	}
 	return &resp, rows.Err()
 }
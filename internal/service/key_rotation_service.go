@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/kms"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// -------- Key rotation --------
+
+// RotateTenantKeys 轮换当前 tenant 的数据密钥，并在后台用新密钥重写注册表中的专属 DSN 和全部加密列。
+// 每段密文都带着包裹后的旧密钥，重写完成前后读取都不受影响。
+func (s *LowcodeService) RotateTenantKeys(ctx context.Context, _ *lowcodev1.RotateTenantKeysRequest) (*lowcodev1.RotateTenantKeysResponse, error) {
+	k, err := s.requireKeyring()
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenantID := tenant.FromContext(ctx)
+	op, err := s.startOperation(ctx, pool, "RotateTenantKeys", tenantID, func(ctx context.Context) (proto.Message, error) {
+		return s.rotateTenantKeys(ctx, pool, k, tenantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.RotateTenantKeysResponse{Operation: op}, nil
+}
+
+// rotateTenantKeys 丢弃本进程缓存的当前数据密钥，之后的 Seal（包括下面的重新加密）会向 KMS 申请新密钥。
+// 加密列在一个事务中重写，失败时全部回滚，可以重新执行。
+func (s *LowcodeService) rotateTenantKeys(ctx context.Context, pool *pgxpool.Pool, k *kms.Keyring, tenantID string) (*lowcodev1.RotateTenantKeysResponse, error) {
+	k.Rotate(tenantID)
+	var res lowcodev1.RotateTenantKeysResponse
+	n, err := s.tenants.ResealTenantDSN(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	res.ResealedDsns = int32(n)
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	if res.ReencryptedCells, res.ReencryptedVersions, err = reencryptColumns(ctx, tx, k, tenantID, tenantID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Request limits --------
+
+// Limits 限制写入行的请求大小，超过时返回 PAYLOAD_TOO_LARGE；<= 0 的字段不限制。
+// 只检查外部请求（见 UnaryLimiter），导入等内部调用的 BulkUpsertRows 不受 MaxBulkItems 限制。
+type Limits struct {
+	MaxBulkItems   int // BulkUpsertRows 的 items 数
+	MaxCellsPerRow int // 一行的 cells 数，UpdateRow 含 clear_column_ids
+	MaxValueBytes  int // 单个单元格值（Value 消息）序列化后的字节数
+}
+
+// SetLimits 设置请求大小限制（MAX_BULK_ITEMS、MAX_CELLS_PER_ROW、MAX_VALUE_BYTES）。
+func (s *LowcodeService) SetLimits(l Limits) {
+	s.limits = l
+}
+
+// checkLimits 检查 CreateRow / UpdateRow / BulkUpsertRows 的请求大小，其它请求只受消息大小限制。
+func (s *LowcodeService) checkLimits(req any) error {
+	switch r := req.(type) {
+	case *lowcodev1.CreateRowRequest:
+		return s.checkCellLimits("", r.GetCells(), 0)
+	case *lowcodev1.UpdateRowRequest:
+		return s.checkCellLimits("", r.GetCells(), len(r.GetClearColumnIds()))
+	case *lowcodev1.BulkUpsertRowsRequest:
+		if n, max := len(r.GetItems()), s.limits.MaxBulkItems; max > 0 && n > max {
+			return apierr.NewPayloadTooLarge("items", max, n, "%d items exceed the limit of %d per request, split them into smaller batches", n, max)
+		}
+		for i, item := range r.GetItems() {
+			if err := s.checkCellLimits(fmt.Sprintf("items[%d].", i), item.GetCells(), 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkCellLimits 检查一行的 cells 数和每个值的大小；prefix 同 validateCells，用于错误中的字段名。
+func (s *LowcodeService) checkCellLimits(prefix string, cells map[string]*lowcodev1.Value, cleared int) error {
+	if n, max := len(cells)+cleared, s.limits.MaxCellsPerRow; max > 0 && n > max {
+		return apierr.NewPayloadTooLarge(prefix+"cells", max, n, "%d cells exceed the limit of %d per row", n, max)
+	}
+	max := s.limits.MaxValueBytes
+	if max <= 0 {
+		return nil
+	}
+	for id, v := range cells {
+		if size := proto.Size(v); size > max {
+			return apierr.NewPayloadTooLarge(prefix+id, max, size,
+				"value of column %s is %d bytes, exceeding the limit of %d; upload large content with UploadCellContent", id, size, max)
+		}
+	}
+	return nil
+}
+
+// UnaryLimiter 在 unary RPC 执行前调用 checkLimits，放在解析 tenant 和认证的拦截器之后。
+func (s *LowcodeService) UnaryLimiter(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.checkLimits(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Many-to-many links --------
+
+// relationshipManyToMany 是 relationship 列 config.kind 的取值：关联保存在服务管理的关联表中，
+// 两边的物理表都不需要外键列。
+const relationshipManyToMany = "many_to_many"
+
+// createJoinTable 为多对多 relationship 列创建关联表，并把位置写回 cfg（join_schema / join_table）。
+// 关联表不加外键：分区表的 id 不是单独的主键，无法被引用；悬空的关联在展开时会被忽略。
+func createJoinTable(ctx context.Context, tx pgx.Tx, tableKey, schemaName string, cfg map[string]any) error {
+	target, _ := cfg["target_table_id"].(string)
+	if target == "" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "many_to_many relationship requires config.target_table_id")
+	}
+	ref, err := lookupTable(ctx, tx, target, false, false)
+	if err != nil {
+		if apierr.CodeOf(err) == lowcodev1.ErrorCode_TABLE_NOT_FOUND {
+			return apierr.New(lowcodev1.ErrorCode_TABLE_NOT_FOUND, codes.NotFound, "target table %s not found", target)
+		}
+		return err
+	}
+	cfg["target_table_id"] = ref.Name
+
+	joinTable := "lc_j_" + strings.ReplaceAll(uuid.New().String()[:8], "-", "")
+	ident := pgx.Identifier{schemaName, joinTable}.Sanitize()
+	stmts := []string{
+		fmt.Sprintf(`CREATE TABLE %s (
+			source_id  UUID NOT NULL,
+			target_id  UUID NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			PRIMARY KEY (source_id, target_id)
+		)`, ident),
+		fmt.Sprintf(`CREATE INDEX ON %s (target_id)`, ident),
+		fmt.Sprintf(`COMMENT ON TABLE %s IS %s`, ident, quoteLiteral("lowcode many_to_many links for "+tableKey)),
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	cfg["join_schema"] = schemaName
+	cfg["join_table"] = joinTable
+	return nil
+}
+
+// dropJoinTableSQL 返回删除多对多关联表的语句。
+func dropJoinTableSQL(joinSchema, joinTable string) string {
+	return fmt.Sprintf(`DROP TABLE IF EXISTS %s`, pgx.Identifier{joinSchema, joinTable}.Sanitize())
+}
+
+// fetchLinkedRows 是 fetchRelatedRows 的多对多分支：先查关联表得到 (source_id, target_id)，
+// 再用一条 id = ANY($1) 查询取出目标行，按关联创建顺序分发回各行。
+func (s *LowcodeService) fetchLinkedRows(ctx context.Context, pool *pgxpool.Pool, rel relationshipColumn, rows []*lowcodev1.Row,
+	targetCols []columnMeta, targetSchema, targetTable string) (map[string][]*lowcodev1.Row, []*lowcodev1.Row, error) {
+	sourceIDs := make([]string, len(rows))
+	for i, r := range rows {
+		sourceIDs[i] = r.Id
+	}
+	links, err := pool.Query(ctx, fmt.Sprintf(`SELECT source_id::text, target_id::text FROM %s WHERE source_id = ANY($1) ORDER BY created_at, target_id`,
+		pgx.Identifier{rel.JoinSchema, rel.JoinTable}.Sanitize()), sourceIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	type link struct{ source, target string }
+	pairs, err := pgx.CollectRows(links, func(r pgx.CollectableRow) (link, error) {
+		var l link
+		err := r.Scan(&l.source, &l.target)
+		return l, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(pairs) == 0 {
+		return nil, nil, nil
+	}
+
+	var targetIDs []string
+	seen := make(map[string]bool)
+	for _, l := range pairs {
+		if !seen[l.target] {
+			seen[l.target] = true
+			targetIDs = append(targetIDs, l.target)
+		}
+	}
+	all, err := s.queryRows(ctx, pool, targetCols, fmt.Sprintf(`SELECT %s FROM %s.%s WHERE id = ANY($1)%s`,
+		rowColumnsSQL(targetCols),
+		pgx.Identifier{targetSchema}.Sanitize(),
+		pgx.Identifier{targetTable}.Sanitize(),
+		andNotDeleted(targetCols),
+	), targetIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[string]*lowcodev1.Row, len(all))
+	for _, r := range all {
+		byID[r.Id] = r
+	}
+	related := make(map[string][]*lowcodev1.Row, len(rows))
+	for _, l := range pairs {
+		if r, ok := byID[l.target]; ok {
+			related[l.source] = append(related[l.source], r)
+		}
+	}
+	return related, all, nil
+}
+
+// LinkRows 在多对多关联表中添加 row_id -> target_row_ids 的关联，已存在的关联保持不变。
+func (s *LowcodeService) LinkRows(ctx context.Context, req *lowcodev1.LinkRowsRequest) (*lowcodev1.LinkRowsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := s.loadManyToMany(ctx, pool, req.GetTableId(), req.GetRowId(), req.GetColumnId(), req.GetTargetRowIds())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetTargetRowIds()) == 0 {
+		return &lowcodev1.LinkRowsResponse{}, nil
+	}
+	auditResources(ctx, req.GetTargetRowIds()...)
+
+	// 当前行和目标行都必须存在且不在回收站中
+	if missing, err := s.missingRows(ctx, pool, req.GetTableId(), []string{req.GetRowId()}); err != nil {
+		return nil, err
+	} else if len(missing) > 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", req.GetRowId())
+	}
+	if missing, err := s.missingRows(ctx, pool, rel.TargetTableId, req.GetTargetRowIds()); err != nil {
+		return nil, err
+	} else if len(missing) > 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "rows not found in %s: %s", rel.TargetTableId, strings.Join(missing, ", "))
+	}
+
+	tag, err := pool.Exec(ctx, fmt.Sprintf(`INSERT INTO %s (source_id, target_id) SELECT $1, unnest($2::uuid[]) ON CONFLICT DO NOTHING`,
+		pgx.Identifier{rel.JoinSchema, rel.JoinTable}.Sanitize()), req.GetRowId(), req.GetTargetRowIds())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.LinkRowsResponse{Linked: tag.RowsAffected()}, nil
+}
+
+// UnlinkRows 移除 row_id 与 target_row_ids 之间的关联，不存在的关联忽略。
+func (s *LowcodeService) UnlinkRows(ctx context.Context, req *lowcodev1.UnlinkRowsRequest) (*lowcodev1.UnlinkRowsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := s.loadManyToMany(ctx, pool, req.GetTableId(), req.GetRowId(), req.GetColumnId(), req.GetTargetRowIds())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetTargetRowIds()) == 0 {
+		return &lowcodev1.UnlinkRowsResponse{}, nil
+	}
+	auditResources(ctx, req.GetTargetRowIds()...)
+	tag, err := pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE source_id = $1 AND target_id = ANY($2::uuid[])`,
+		pgx.Identifier{rel.JoinSchema, rel.JoinTable}.Sanitize()), req.GetRowId(), req.GetTargetRowIds())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UnlinkRowsResponse{Unlinked: tag.RowsAffected()}, nil
+}
+
+// loadManyToMany 校验 LinkRows / UnlinkRows 的参数并返回对应的多对多 relationship 列。
+func (s *LowcodeService) loadManyToMany(ctx context.Context, pool *pgxpool.Pool, tableID, rowID, columnID string, targetRowIDs []string) (relationshipColumn, error) {
+	if tableID == "" || rowID == "" || columnID == "" {
+		return relationshipColumn{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id, row_id and column_id are required")
+	}
+	for _, id := range append([]string{rowID}, targetRowIDs...) {
+		if _, err := uuid.Parse(id); err != nil {
+			return relationshipColumn{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid row id %q", id)
+		}
+	}
+	rels, err := s.loadRelationshipColumns(ctx, pool, tableID, []string{columnID})
+	if err != nil {
+		return relationshipColumn{}, err
+	}
+	if len(rels) == 0 {
+		return relationshipColumn{}, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "relationship column %s not found in table %s", columnID, tableID)
+	}
+	if rels[0].JoinTable == "" {
+		return relationshipColumn{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "column %s is not a many_to_many relationship", columnID)
+	}
+	var readonly bool
+	if err := pool.QueryRow(ctx, `SELECT is_readonly FROM lc_columns WHERE id = $1`, columnID).Scan(&readonly); err != nil {
+		return relationshipColumn{}, err
+	}
+	if readonly {
+		return relationshipColumn{}, apierr.NewValidation([]apierr.FieldViolation{{Field: "column_id", Description: fmt.Sprintf("column %s is read-only", columnID), Reason: "READONLY"}})
+	}
+	if err := s.checkColumnWrite(ctx, pool, tableID, columnID); err != nil {
+		return relationshipColumn{}, err
+	}
+	return rels[0], nil
+}
+
+// missingRows 返回 ids 中在表里不存在（或已在回收站中）的行 id。
+func (s *LowcodeService) missingRows(ctx context.Context, pool *pgxpool.Pool, tableID string, ids []string) ([]string, error) {
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, fmt.Sprintf(`SELECT id::text FROM %s.%s WHERE id = ANY($1::uuid[])%s`,
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		andNotDeleted(cols),
+	), ids)
+	if err != nil {
+		return nil, err
+	}
+	found, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+	have := make(map[string]bool, len(found))
+	for _, id := range found {
+		have[id] = true
+	}
+	var missing []string
+	for _, id := range ids {
+		if !have[strings.ToLower(id)] {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Lookup columns --------
+
+// lookup 列的 config：
+//
+//	relationship_column_id  本表的 relationship 列
+//	column_id               关联表中要投影的列
+//
+// 多对一 / 一对一时值为关联行该列的值；一对多 / 多对多时为 list_value。
+// 关联行通过 fetchRelatedRows 批量取出，同一个 relationship 上的多个 lookup 列共用一次查询。
+// 值按调用方对关联表的列权限处理：不可读的列为空，脱敏列返回脱敏后的值。
+
+type lookupColumn struct {
+	Id       string
+	RelId    string
+	ColumnId string
+}
+
+// validateLookupConfig 在 AddColumn 时校验 lookup 配置。
+func validateLookupConfig(ctx context.Context, tx pgx.Tx, tableKey string, cfg map[string]any) error {
+	relID, _ := cfg["relationship_column_id"].(string)
+	colID, _ := cfg["column_id"].(string)
+	if relID == "" || colID == "" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"lookup requires config.relationship_column_id and config.column_id")
+	}
+	_, _, err := relatedColumnType(ctx, tx, tableKey, relID, colID)
+	return err
+}
+
+// computeLookups 为 rows 填充 lookup 列。
+func (s *LowcodeService) computeLookups(ctx context.Context, pool *pgxpool.Pool, tableID string, rows []*lowcodev1.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	res, err := pool.Query(ctx, `
+		SELECT c.id, COALESCE(c.config->>'relationship_column_id', ''), COALESCE(c.config->>'column_id', '')
+		FROM lc_columns c
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.table_id = $1 AND COALESCE(ty.config->>'kind', '') = 'lookup'
+		ORDER BY c.position`, tableID)
+	if err != nil {
+		return err
+	}
+	lookups, err := pgx.CollectRows(res, func(r pgx.CollectableRow) (lookupColumn, error) {
+		var lc lookupColumn
+		err := r.Scan(&lc.Id, &lc.RelId, &lc.ColumnId)
+		return lc, err
+	})
+	if err != nil || len(lookups) == 0 {
+		return err
+	}
+
+	byRel := make(map[string][]lookupColumn)
+	for _, lc := range lookups {
+		byRel[lc.RelId] = append(byRel[lc.RelId], lc)
+	}
+	relIDs := make([]string, 0, len(byRel))
+	for id := range byRel {
+		relIDs = append(relIDs, id)
+	}
+	rels, err := s.loadRelationshipColumns(ctx, pool, tableID, relIDs)
+	if err != nil {
+		return err
+	}
+	for _, rel := range rels {
+		related, all, err := s.fetchRelatedRows(ctx, pool, rel, rows)
+		if err != nil {
+			return err
+		}
+		// 同 expandRows：按目标表的权限去掉不可读的列并脱敏，lookup 不能绕过目标表的列权限
+		acc, err := s.tableAccess(ctx, pool, rel.TargetTableId)
+		if err != nil {
+			return err
+		}
+		if !acc.readable() {
+			related = nil
+		}
+		acc.redactRows(all...)
+		single := rel.TargetColumnId != "" && rel.JoinTable == ""
+		for _, row := range rows {
+			for _, lc := range byRel[rel.Id] {
+				v := lookupValue(related[row.Id], lc.ColumnId, single)
+				if v == nil {
+					continue
+				}
+				if row.Cells == nil {
+					row.Cells = make(map[string]*lowcodev1.Value)
+				}
+				row.Cells[lc.Id] = v
+			}
+		}
+	}
+	return nil
+}
+
+// lookupValue 从关联行中取出 columnID 的值；single 时返回第一行的值，否则返回 list_value（跳过空值）。
+func lookupValue(related []*lowcodev1.Row, columnID string, single bool) *lowcodev1.Value {
+	if single {
+		if len(related) == 0 {
+			return nil
+		}
+		return related[0].Cells[columnID]
+	}
+	list := &lowcodev1.ValueList{}
+	for _, r := range related {
+		if v, ok := r.Cells[columnID]; ok {
+			list.Values = append(list.Values, v)
+		}
+	}
+	return &lowcodev1.Value{Kind: &lowcodev1.Value_ListValue{ListValue: list}}
+}
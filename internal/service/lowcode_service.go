@@ -1,7 +1,11 @@
 package service
 
 import (
+	"net/http"
+	"sync"
+
 	"github.com/solat/lowcode-database/internal/db"
+	"github.com/solat/lowcode-database/internal/storage"
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
 )
 
@@ -12,11 +16,35 @@ type LowcodeService struct {
 	// maxRow controls the default and maximum rows returned by ListRows.
 	// If <= 0, ListRows falls back to its internal defaults.
 	maxRow int32
+
+	// maxCellBytes limits streamed cell content uploads. If <= 0, defaultMaxCellBytes is used.
+	maxCellBytes int64
+
+	// limits bounds the size of CreateRow / UpdateRow / BulkUpsertRows requests, see UnaryLimiter.
+	limits Limits
+
+	// storage backs attachment columns. If nil, attachment uploads are rejected.
+	storage storage.Store
+
+	// bootstrap is written into new tenants by CreateTenant unless the request overrides it (TENANT_BOOTSTRAP_FILE).
+	bootstrap *lowcodev1.TenantBootstrap
+
+	// operations maps the id of each operation running in this process to its context.CancelFunc.
+	operations sync.Map
+
+	// webhookClient delivers audit events to webhooks, see deliverWebhooks.
+	webhookClient *http.Client
+
+	// memberRoles caches the caller's role per "tenant/user" (memberRole) for Authorize.
+	memberRoles sync.Map
 }
 
-func NewLowcodeService(tenants *db.TenantManager, maxRow int) *LowcodeService {
+func NewLowcodeService(tenants *db.TenantManager, maxRow int, maxCellBytes int64, store storage.Store) *LowcodeService {
 	s := &LowcodeService{
-		tenants: tenants,
+		tenants:       tenants,
+		maxCellBytes:  maxCellBytes,
+		storage:       store,
+		webhookClient: &http.Client{Timeout: webhookTimeout},
 	}
 	if maxRow > 0 {
 		s.maxRow = int32(maxRow)
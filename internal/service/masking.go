@@ -0,0 +1,96 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- PII masking --------
+
+// 列 config.mask 的 mode：
+//   - full：整个值替换为 "****"
+//   - partial：只保留最后 visible 个字符（默认 4），如 "****1234"
+//   - hash：值的 SHA-256（十六进制），相同的值脱敏后仍相同，可以用来比较和去重
+const (
+	maskFull    = "full"
+	maskPartial = "partial"
+	maskHash    = "hash"
+
+	maskPlaceholder    = "****"
+	defaultMaskVisible = 4
+)
+
+// maskRule 是列 config.mask 解析后的脱敏规则。
+type maskRule struct {
+	mode    string
+	visible int
+}
+
+// parseMaskRule 解析列 config 中的 mask，没有配置时 ok 为 false。
+func parseMaskRule(cfg map[string]any) (rule maskRule, ok bool, err error) {
+	raw, exists := cfg["mask"]
+	if !exists || raw == nil {
+		return maskRule{}, false, nil
+	}
+	m, isMap := raw.(map[string]any)
+	if !isMap {
+		return maskRule{}, false, fmt.Errorf("mask must be an object")
+	}
+	rule.mode, _ = m["mode"].(string)
+	switch rule.mode {
+	case maskFull, maskHash:
+	case maskPartial:
+		rule.visible = defaultMaskVisible
+		if v, exists := m["visible"]; exists {
+			n, isNum := v.(float64)
+			if !isNum || n < 1 || n != float64(int(n)) {
+				return maskRule{}, false, fmt.Errorf("mask.visible must be a positive integer")
+			}
+			rule.visible = int(n)
+		}
+	default:
+		return maskRule{}, false, fmt.Errorf("mask.mode must be one of full, partial, hash")
+	}
+	return rule, true, nil
+}
+
+// validateMaskConfig 在添加 / 修改列时检查 config.mask。
+func validateMaskConfig(cfg map[string]any) error {
+	if _, _, err := parseMaskRule(cfg); err != nil {
+		return apierr.NewValidation([]apierr.FieldViolation{{Field: "config.mask", Description: err.Error()}})
+	}
+	return nil
+}
+
+// apply 返回脱敏后的值，结果总是文本。
+func (r maskRule) apply(v *lowcodev1.Value) *lowcodev1.Value {
+	var out string
+	switch r.mode {
+	case maskPartial:
+		s := []rune(valueText(v))
+		if len(s) > r.visible {
+			s = s[len(s)-r.visible:]
+		} else {
+			// 值不长于 visible 时全部遮住，避免原样返回短值
+			s = nil
+		}
+		out = maskPlaceholder + string(s)
+	case maskHash:
+		sum := sha256.Sum256([]byte(valueText(v)))
+		out = hex.EncodeToString(sum[:])
+	default:
+		out = maskPlaceholder
+	}
+	return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: out}}
+}
+
+// maskedColumnError 是对脱敏列执行过滤、排序等会泄露原值的操作时的错误。
+func maskedColumnError(columnID string) error {
+	return apierr.New(lowcodev1.ErrorCode_PERMISSION_DENIED, codes.PermissionDenied, "column %s is masked", columnID)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+)
+
+// -------- Member --------
+
+// memberFieldsSQL 是 scanMember 需要的 lc_members 字段，SELECT / RETURNING 共用。
+const memberFieldsSQL = `user_id, role, created_at, updated_at`
+
+func scanMember(row pgx.Row) (*lowcodev1.Member, error) {
+	var m lowcodev1.Member
+	var role string
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&m.UserId, &role, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	m.Role = parseRole(role)
+	m.CreatedAt = timestamppb.New(createdAt)
+	m.UpdatedAt = timestamppb.New(updatedAt)
+	return &m, nil
+}
+
+func (s *LowcodeService) ListMembers(ctx context.Context, _ *lowcodev1.ListMembersRequest) (*lowcodev1.ListMembersResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, `SELECT `+memberFieldsSQL+` FROM lc_members ORDER BY user_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListMembersResponse
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Members = append(res.Members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) SetMember(ctx context.Context, req *lowcodev1.SetMemberRequest) (*lowcodev1.SetMemberResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "user_id is required")
+	}
+	if _, ok := lowcodev1.Role_name[int32(req.GetRole())]; !ok || req.GetRole() == lowcodev1.Role_ROLE_UNSPECIFIED {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "role is required")
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	if err := lockOwners(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	m, err := scanMember(tx.QueryRow(ctx, `
+		UPDATE lc_members SET role = $2, updated_at = now() WHERE user_id = $1
+		RETURNING `+memberFieldsSQL, req.GetUserId(), roleName(req.GetRole())))
+	if errors.Is(err, pgx.ErrNoRows) {
+		m, err = scanMember(tx.QueryRow(ctx, `
+			INSERT INTO lc_members (user_id, role) VALUES ($1, $2)
+			RETURNING `+memberFieldsSQL, req.GetUserId(), roleName(req.GetRole())))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOwnerRemains(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	s.forgetMemberRole(ctx, req.GetUserId())
+	return &lowcodev1.SetMemberResponse{Member: m}, nil
+}
+
+func (s *LowcodeService) DeleteMember(ctx context.Context, req *lowcodev1.DeleteMemberRequest) (*lowcodev1.DeleteMemberResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "user_id is required")
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	if err := lockOwners(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM lc_members WHERE user_id = $1`, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "member %s not found", req.GetUserId())
+	}
+	if err := checkOwnerRemains(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	s.forgetMemberRole(ctx, req.GetUserId())
+	return &lowcodev1.DeleteMemberResponse{}, nil
+}
+
+// lockOwners 锁住现有的 owner 行，并发修改成员时 checkOwnerRemains 看到的是其它事务提交后的结果。
+func lockOwners(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, `SELECT 1 FROM lc_members WHERE role = 'owner' FOR UPDATE`)
+	return err
+}
+
+// checkOwnerRemains 拒绝移除 tenant 的最后一个 owner，避免 owner 把自己和其他人一起锁在成员管理之外。
+// 平台管理员不受限制。
+func checkOwnerRemains(ctx context.Context, tx pgx.Tx) error {
+	if id := auth.IdentityFromContext(ctx); id == nil || id.IsAdmin() {
+		return nil
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_members WHERE role = 'owner')`).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "tenant must keep at least one owner")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Formatted numbers --------
+
+// 带格式的数值类型（lc_types.config.kind）。类型 config 给出默认值，AddColumn 时合并到列 config，列上可以覆盖：
+//
+//	currency  precision（小数位）、currency_code（ISO 4217）、display（symbol / code）
+//	percent   precision 为显示百分数的小数位；单元格存比例（0.125 = 12.5%），因此允许 precision+2 位小数
+//	duration  precision 为秒的小数位，format 为显示格式（如 h:mm、h:mm:ss）
+//	rating    max（1-10）、icon；单元格为 0..max 的整数
+//
+// 写入时按 precision 校验，不做四舍五入。
+var numberFormatKinds = map[string]bool{"currency": true, "percent": true, "duration": true, "rating": true}
+
+// maxNumberPrecision 是 precision 的上限，超过时 float64 已无法精确表示。
+const maxNumberPrecision = 10
+
+var currencyCodeRe = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// applyNumberFormat 把类型 config 中的默认值合并到列 config 并校验。
+func applyNumberFormat(kind string, typeCfg, cfg map[string]any) error {
+	for k, v := range typeCfg {
+		if _, ok := cfg[k]; !ok && k != "kind" {
+			cfg[k] = v
+		}
+	}
+	invalid := func(format string, args ...any) error {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s column: "+format, append([]any{kind}, args...)...)
+	}
+	if kind == "rating" {
+		max, ok := cfg["max"].(float64)
+		if !ok || max != math.Trunc(max) || max < 1 || max > 10 {
+			return invalid("config.max must be an integer between 1 and 10")
+		}
+		return nil
+	}
+	if p, ok := cfg["precision"]; ok {
+		f, isNum := p.(float64)
+		if !isNum || f != math.Trunc(f) || f < 0 || f > maxNumberPrecision {
+			return invalid("config.precision must be an integer between 0 and %d", maxNumberPrecision)
+		}
+	}
+	if kind == "currency" {
+		if code, _ := cfg["currency_code"].(string); !currencyCodeRe.MatchString(code) {
+			return invalid("config.currency_code must be an ISO 4217 code such as USD")
+		}
+	}
+	return nil
+}
+
+// numberFormatViolation 按列 config 校验带格式数值的取值，返回空字符串表示合法。
+func numberFormatViolation(c columnMeta, x float64) string {
+	if c.Kind == "rating" {
+		max, _ := c.Config["max"].(float64)
+		if x != math.Trunc(x) || x < 0 || (max > 0 && x > max) {
+			return fmt.Sprintf("%s must be an integer between 0 and %v", c.Name, max)
+		}
+		return ""
+	}
+	p, ok := c.Config["precision"].(float64)
+	if !ok {
+		return ""
+	}
+	digits := int(p)
+	if c.Kind == "percent" {
+		digits += 2
+	}
+	if !hasPrecision(x, digits) {
+		return fmt.Sprintf("%s allows at most %d decimal places", c.Name, digits)
+	}
+	return ""
+}
+
+// hasPrecision 判断 x 是否最多有 digits 位小数，容忍 float64 的表示误差（如 0.1+0.2）。
+func hasPrecision(x float64, digits int) bool {
+	scaled := x * math.Pow10(digits)
+	return math.Abs(scaled-math.Round(scaled)) <= 1e-9*math.Max(1, math.Abs(scaled))
+}
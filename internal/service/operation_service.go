@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/db"
+)
+
+// -------- Operation --------
+
+const (
+	// operationHeartbeat 是执行中的操作刷新 updated_at、检查 cancel_requested 的间隔。
+	operationHeartbeat = 5 * time.Second
+	// operationStaleAfter 之内没有心跳的 running 操作视为已中断（如执行它的进程退出）。
+	operationStaleAfter = time.Minute
+	// operationFinishTimeout 限制记录操作结果的时间，操作本身已被取消时也要能写入。
+	operationFinishTimeout = 30 * time.Second
+	// maxOperationPageSize 是 ListOperations 的 page_size 上限。
+	maxOperationPageSize = 1000
+)
+
+// operationFieldsSQL 是 scanOperation 需要的 lc_operations 字段，SELECT / RETURNING 共用。
+const operationFieldsSQL = `id, kind, target, status, response, error_code, error_message, cancel_requested, created_at, updated_at, finished_at`
+
+// scanOperation 扫描 operationFieldsSQL 对应的一行，extra 接收其后的附加列。
+func scanOperation(row pgx.Row, extra ...any) (*lowcodev1.Operation, error) {
+	var op lowcodev1.Operation
+	var st, errCode string
+	var response []byte
+	var createdAt, updatedAt time.Time
+	var finishedAt *time.Time
+	dest := append([]any{&op.Id, &op.Kind, &op.Target, &st, &response, &errCode, &op.ErrorMessage, &op.CancelRequested, &createdAt, &updatedAt, &finishedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	op.Status = lowcodev1.OperationStatus(lowcodev1.OperationStatus_value["OPERATION_STATUS_"+strings.ToUpper(st)])
+	op.Done = op.Status != lowcodev1.OperationStatus_OPERATION_STATUS_RUNNING
+	op.ErrorCode = lowcodev1.ErrorCode(lowcodev1.ErrorCode_value[errCode])
+	if len(response) > 0 {
+		op.Response = &structpb.Struct{}
+		if err := protojson.Unmarshal(response, op.Response); err != nil {
+			return nil, fmt.Errorf("operation %s: decode response: %w", op.Id, err)
+		}
+	}
+	op.CreatedAt = timestamppb.New(createdAt)
+	op.UpdatedAt = timestamppb.New(updatedAt)
+	if finishedAt != nil {
+		op.FinishedAt = timestamppb.New(*finishedAt)
+	}
+	return &op, nil
+}
+
+func operationNotFound(id string) error {
+	return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "operation %s not found", id)
+}
+
+// startOperation 登记一个操作并在后台执行 run，立即返回 RUNNING 的 Operation。
+// run 的 ctx 与请求分离（保留 tenant / user 等值），操作被取消时随之取消，进行中的 SQL 被中断、事务回滚。
+// 操作本来就是长时间执行的，不受 STATEMENT_TIMEOUT_SECONDS 限制。
+func (s *LowcodeService) startOperation(ctx context.Context, pool *pgxpool.Pool, kind, target string, run func(ctx context.Context) (proto.Message, error)) (*lowcodev1.Operation, error) {
+	op, err := scanOperation(pool.QueryRow(ctx, `
+		INSERT INTO lc_operations (kind, target)
+		VALUES ($1, $2)
+		RETURNING `+operationFieldsSQL, kind, target))
+	if err != nil {
+		return nil, err
+	}
+	detached := db.WithoutStatementTimeout(context.WithoutCancel(ctx))
+	runCtx, cancel := context.WithCancel(detached)
+	s.operations.Store(op.Id, cancel)
+	go func() {
+		defer s.operations.Delete(op.Id)
+		defer cancel()
+		stop := make(chan struct{})
+		go watchOperation(runCtx, pool, op.Id, cancel, stop)
+		resp, runErr := run(runCtx)
+		close(stop)
+
+		finishCtx, cancelFinish := context.WithTimeout(detached, operationFinishTimeout)
+		defer cancelFinish()
+		if err := finishOperation(finishCtx, pool, op.Id, resp, runErr, runCtx.Err() != nil); err != nil {
+			log.Printf("operation %s (%s): record result: %v", op.Id, kind, err)
+		}
+	}()
+	return op, nil
+}
+
+// watchOperation 定期刷新心跳；其它进程通过 CancelOperation 设置 cancel_requested 后，在这里取消本进程中的执行。
+func watchOperation(ctx context.Context, pool *pgxpool.Pool, id string, cancel context.CancelFunc, stop <-chan struct{}) {
+	ticker := time.NewTicker(operationHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		var cancelRequested bool
+		if err := pool.QueryRow(ctx, `UPDATE lc_operations SET updated_at = now() WHERE id = $1 RETURNING cancel_requested`, id).Scan(&cancelRequested); err != nil {
+			if ctx.Err() == nil {
+				log.Printf("operation %s: heartbeat: %v", id, err)
+			}
+			continue
+		}
+		if cancelRequested {
+			cancel()
+			return
+		}
+	}
+}
+
+// finishOperation 记录操作结果：cancelled 表示执行 ctx 已被取消，此时的错误只是取消的结果。
+func finishOperation(ctx context.Context, pool *pgxpool.Pool, id string, resp proto.Message, runErr error, cancelled bool) error {
+	st := "succeeded"
+	var response []byte
+	var errCode, errMsg string
+	switch {
+	case runErr != nil && cancelled:
+		st = "cancelled"
+	case runErr != nil:
+		st = "failed"
+		err := apierr.Annotate(runErr)
+		errCode = apierr.CodeOf(err).String()
+		errMsg = status.Convert(err).Message()
+	default:
+		b, err := protojson.Marshal(resp)
+		if err != nil {
+			return err
+		}
+		response = b
+	}
+	_, err := pool.Exec(ctx, `
+		UPDATE lc_operations
+		SET status = $2, response = $3, error_code = $4, error_message = $5, updated_at = now(), finished_at = now()
+		WHERE id = $1`, id, st, response, errCode, errMsg)
+	return err
+}
+
+// expireOperations 把长时间没有心跳的 running 操作标记为失败，查询前调用。
+func expireOperations(ctx context.Context, pool *pgxpool.Pool) error {
+	_, err := pool.Exec(ctx, `
+		UPDATE lc_operations
+		SET status = 'failed', error_code = $1, error_message = 'operation was interrupted', updated_at = now(), finished_at = now()
+		WHERE status = 'running' AND updated_at < now() - make_interval(secs => $2)`,
+		lowcodev1.ErrorCode_INTERNAL.String(), operationStaleAfter.Seconds())
+	return err
+}
+
+func (s *LowcodeService) GetOperation(ctx context.Context, req *lowcodev1.GetOperationRequest) (*lowcodev1.GetOperationResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, operationNotFound(req.GetId())
+	}
+	if err := expireOperations(ctx, pool); err != nil {
+		return nil, err
+	}
+	op, err := scanOperation(pool.QueryRow(ctx, `SELECT `+operationFieldsSQL+` FROM lc_operations WHERE id = $1`, req.GetId()))
+	if err == pgx.ErrNoRows {
+		return nil, operationNotFound(req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.GetOperationResponse{Operation: op}, nil
+}
+
+// ListOperations 按创建时间倒序做 keyset 分页。
+func (s *LowcodeService) ListOperations(ctx context.Context, req *lowcodev1.ListOperationsRequest) (*lowcodev1.ListOperationsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := expireOperations(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	var a sqlArgs
+	conds := []string{"TRUE"}
+	if req.GetKind() != "" {
+		conds = append(conds, "kind = "+a.add(req.GetKind()))
+	}
+	if req.GetTarget() != "" {
+		conds = append(conds, "target = "+a.add(req.GetTarget()))
+	}
+	if st := req.GetStatus(); st != lowcodev1.OperationStatus_OPERATION_STATUS_UNSPECIFIED {
+		conds = append(conds, "status = "+a.add(strings.ToLower(strings.TrimPrefix(st.String(), "OPERATION_STATUS_"))))
+	}
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if len(token.Keys) != 1 || token.Keys[0] == nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid page_token")
+		}
+		conds = append(conds, fmt.Sprintf("(created_at, id) < (%s::timestamptz, %s::uuid)", a.add(*token.Keys[0]), a.add(token.ID)))
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > maxOperationPageSize {
+		pageSize = maxOperationPageSize
+	}
+	// 多取一行用来判断是否还有下一页
+	q := fmt.Sprintf(`SELECT %s, created_at::text FROM lc_operations WHERE %s ORDER BY created_at DESC, id DESC LIMIT %s`,
+		operationFieldsSQL, strings.Join(conds, " AND "), a.add(pageSize+1))
+	rows, err := pool.Query(ctx, q, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListOperationsResponse
+	var lastKey string
+	for rows.Next() {
+		if len(res.Operations) == int(pageSize) {
+			res.NextPageToken = encodePageToken(pageToken{ID: res.Operations[len(res.Operations)-1].GetId(), Keys: []*string{&lastKey}})
+			break
+		}
+		var key string
+		op, err := scanOperation(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+		lastKey = key
+		res.Operations = append(res.Operations, op)
+	}
+	return &res, rows.Err()
+}
+
+// CancelOperation 设置 cancel_requested：本进程中执行的操作立即取消，其它进程在下一次心跳时取消。
+// 已结束的操作原样返回。
+func (s *LowcodeService) CancelOperation(ctx context.Context, req *lowcodev1.CancelOperationRequest) (*lowcodev1.CancelOperationResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, operationNotFound(req.GetId())
+	}
+	if err := expireOperations(ctx, pool); err != nil {
+		return nil, err
+	}
+	if _, err := pool.Exec(ctx, `UPDATE lc_operations SET cancel_requested = TRUE, updated_at = now() WHERE id = $1 AND status = 'running'`, req.GetId()); err != nil {
+		return nil, err
+	}
+	if cancel, ok := s.operations.Load(req.GetId()); ok {
+		cancel.(context.CancelFunc)()
+	}
+	op, err := scanOperation(pool.QueryRow(ctx, `SELECT `+operationFieldsSQL+` FROM lc_operations WHERE id = $1`, req.GetId()))
+	if err == pgx.ErrNoRows {
+		return nil, operationNotFound(req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.CancelOperationResponse{Operation: op}, nil
+}
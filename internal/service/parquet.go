@@ -0,0 +1,501 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"strings"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+)
+
+// -------- Parquet --------
+
+// 只实现导出需要的子集：平铺的 schema（无嵌套），PLAIN 编码，不压缩，每个 column chunk 一个 data page（v1），
+// 可选列的 definition level 用 RLE。文件的 footer（FileMetaData）和 page header 用 thrift compact protocol 编码。
+
+// parquetMagic 出现在文件的开头和结尾。
+const parquetMagic = "PAR1"
+
+// parquetRowGroupBytes 是一个 row group 在内存中缓冲的数据量上限，超过时写出。
+const parquetRowGroupBytes = 64 << 20
+
+// parquetKind 是导出列在 Parquet 中的类型，决定物理类型和逻辑类型注解。
+type parquetKind int
+
+const (
+	parquetString    parquetKind = iota // BYTE_ARRAY，STRING
+	parquetJSON                         // BYTE_ARRAY，JSON
+	parquetBytes                        // BYTE_ARRAY，无注解
+	parquetBool                         // BOOLEAN
+	parquetInt64                        // INT64
+	parquetDouble                       // DOUBLE
+	parquetTimestamp                    // INT64，TIMESTAMP(UTC, MICROS)
+	parquetDate                         // INT32，DATE
+)
+
+// parquet.thrift 中的枚举值。
+const (
+	pqTypeBoolean   = 0
+	pqTypeInt32     = 1
+	pqTypeInt64     = 2
+	pqTypeDouble    = 5
+	pqTypeByteArray = 6
+
+	pqRequired = 0
+	pqOptional = 1
+
+	pqConvertedUTF8            = 0
+	pqConvertedDate            = 6
+	pqConvertedTimestampMicros = 10
+	pqConvertedJSON            = 19
+
+	pqEncodingPlain = 0
+	pqEncodingRLE   = 3
+)
+
+func (k parquetKind) physical() int32 {
+	switch k {
+	case parquetBool:
+		return pqTypeBoolean
+	case parquetInt64, parquetTimestamp:
+		return pqTypeInt64
+	case parquetDouble:
+		return pqTypeDouble
+	case parquetDate:
+		return pqTypeInt32
+	}
+	return pqTypeByteArray
+}
+
+// parquetColumn 是一列的定义和当前 row group 的缓冲。
+type parquetColumn struct {
+	name     string
+	kind     parquetKind
+	required bool
+
+	defs  []byte // 每行一个 definition level：1 有值，0 为 null
+	data  bytes.Buffer
+	nbool int // 当前 row group 中已写入的 BOOLEAN 值个数，PLAIN 编码按位打包
+}
+
+// parquetChunk 是写出的 column chunk 在 footer 中需要的信息。
+type parquetChunk struct {
+	offset int64
+	size   int64
+	values int64
+}
+
+type parquetRowGroup struct {
+	chunks []parquetChunk
+	rows   int64
+	bytes  int64
+}
+
+// parquetWriter 以流的方式写出 Parquet 文件：行在内存中缓冲为一个 row group，达到 parquetRowGroupBytes 时写出。
+type parquetWriter struct {
+	w        *countingWriter
+	cols     []*parquetColumn
+	rows     int64 // 当前 row group 的行数
+	buffered int
+	groups   []parquetRowGroup
+}
+
+func newParquetWriter(w io.Writer, cols []*parquetColumn) (*parquetWriter, error) {
+	p := &parquetWriter{w: &countingWriter{w: w}, cols: cols}
+	if _, err := io.WriteString(p.w, parquetMagic); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// writeRow 写出一行，values 与列一一对应。值的类型与列不符时（不应出现）写为 null。
+func (p *parquetWriter) writeRow(values []*lowcodev1.Value) error {
+	for i, c := range p.cols {
+		before := c.data.Len()
+		c.append(values[i])
+		p.buffered += c.data.Len() - before + 1
+	}
+	p.rows++
+	if p.buffered >= parquetRowGroupBytes {
+		return p.flush()
+	}
+	return nil
+}
+
+func (c *parquetColumn) append(v *lowcodev1.Value) {
+	if !c.appendValue(v) {
+		c.defs = append(c.defs, 0)
+		return
+	}
+	c.defs = append(c.defs, 1)
+}
+
+// appendValue 按 PLAIN 编码写入非 null 的值，返回 false 表示值为 null。
+func (c *parquetColumn) appendValue(v *lowcodev1.Value) bool {
+	var b [8]byte
+	switch c.kind {
+	case parquetBool:
+		x, ok := v.GetKind().(*lowcodev1.Value_BoolValue)
+		if !ok {
+			return false
+		}
+		if c.nbool%8 == 0 {
+			c.data.WriteByte(0)
+		}
+		if x.BoolValue {
+			c.data.Bytes()[c.data.Len()-1] |= 1 << (c.nbool % 8)
+		}
+		c.nbool++
+	case parquetInt64:
+		x, ok := v.GetKind().(*lowcodev1.Value_NumberValue)
+		if !ok {
+			return false
+		}
+		binary.LittleEndian.PutUint64(b[:], uint64(int64(math.Round(x.NumberValue))))
+		c.data.Write(b[:8])
+	case parquetDouble:
+		x, ok := v.GetKind().(*lowcodev1.Value_NumberValue)
+		if !ok {
+			return false
+		}
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(x.NumberValue))
+		c.data.Write(b[:8])
+	case parquetTimestamp:
+		x, ok := v.GetKind().(*lowcodev1.Value_TimestampValue)
+		if !ok {
+			return false
+		}
+		binary.LittleEndian.PutUint64(b[:], uint64(x.TimestampValue.AsTime().UnixMicro()))
+		c.data.Write(b[:8])
+	case parquetDate:
+		x, ok := v.GetKind().(*lowcodev1.Value_TimestampValue)
+		if !ok {
+			return false
+		}
+		// 自 1970-01-01 起的天数，向下取整
+		sec := x.TimestampValue.AsTime().Unix()
+		days := sec / 86400
+		if sec%86400 < 0 {
+			days--
+		}
+		binary.LittleEndian.PutUint32(b[:], uint32(int32(days)))
+		c.data.Write(b[:4])
+	default:
+		switch v.GetKind().(type) {
+		case nil, *lowcodev1.Value_NullValue:
+			return false
+		}
+		var s []byte
+		switch x, isBytes := v.GetKind().(*lowcodev1.Value_BytesValue); {
+		case c.kind == parquetBytes && isBytes:
+			s = x.BytesValue
+		case c.kind == parquetJSON:
+			var err error
+			if s, err = json.Marshal(valueToJSON(v)); err != nil {
+				return false
+			}
+		default:
+			s = []byte(valueText(v))
+		}
+		binary.LittleEndian.PutUint32(b[:], uint32(len(s)))
+		c.data.Write(b[:4])
+		c.data.Write(s)
+	}
+	return true
+}
+
+// flush 把缓冲的行写成一个 row group。
+func (p *parquetWriter) flush() error {
+	if p.rows == 0 {
+		return nil
+	}
+	g := parquetRowGroup{rows: p.rows}
+	for _, c := range p.cols {
+		var page bytes.Buffer
+		if !c.required {
+			levels := rleLevels(c.defs)
+			var n [4]byte
+			binary.LittleEndian.PutUint32(n[:], uint32(len(levels)))
+			page.Write(n[:])
+			page.Write(levels)
+		}
+		page.Write(c.data.Bytes())
+
+		var t thriftWriter
+		t.i32(1, 0) // DATA_PAGE
+		t.i32(2, int32(page.Len()))
+		t.i32(3, int32(page.Len()))
+		t.structBegin(5)
+		t.i32(1, int32(p.rows))
+		t.i32(2, pqEncodingPlain)
+		t.i32(3, pqEncodingRLE)
+		t.i32(4, pqEncodingRLE)
+		t.structEnd()
+		t.stop()
+
+		chunk := parquetChunk{offset: p.w.n, values: p.rows}
+		if _, err := p.w.Write(t.buf); err != nil {
+			return err
+		}
+		if _, err := p.w.Write(page.Bytes()); err != nil {
+			return err
+		}
+		chunk.size = p.w.n - chunk.offset
+		g.bytes += chunk.size
+		g.chunks = append(g.chunks, chunk)
+
+		c.defs = c.defs[:0]
+		c.data.Reset()
+		c.nbool = 0
+	}
+	p.groups = append(p.groups, g)
+	p.rows, p.buffered = 0, 0
+	return nil
+}
+
+// rleLevels 用 RLE / bit-packing 混合编码（只用 RLE run）编码 bit width 为 1 的 definition level。
+func rleLevels(levels []byte) []byte {
+	var out []byte
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		out = binary.AppendUvarint(out, uint64(j-i)<<1)
+		out = append(out, levels[i])
+		i = j
+	}
+	return out
+}
+
+// close 写出剩余的行和 footer。
+func (p *parquetWriter) close() error {
+	if err := p.flush(); err != nil {
+		return err
+	}
+	var total int64
+	for _, g := range p.groups {
+		total += g.rows
+	}
+
+	var t thriftWriter
+	t.i32(1, 1) // version
+	t.listBegin(2, thriftStruct, len(p.cols)+1)
+	t.elemBegin()
+	t.str(4, "schema")
+	t.i32(5, int32(len(p.cols)))
+	t.elemEnd()
+	for _, c := range p.cols {
+		t.elemBegin()
+		t.i32(1, c.kind.physical())
+		if c.required {
+			t.i32(3, pqRequired)
+		} else {
+			t.i32(3, pqOptional)
+		}
+		t.str(4, c.name)
+		c.kind.annotate(&t)
+		t.elemEnd()
+	}
+	t.i64(3, total)
+	t.listBegin(4, thriftStruct, len(p.groups))
+	for _, g := range p.groups {
+		t.elemBegin()
+		t.listBegin(1, thriftStruct, len(g.chunks))
+		for i, chunk := range g.chunks {
+			c := p.cols[i]
+			t.elemBegin()
+			t.i64(2, chunk.offset)
+			t.structBegin(3)
+			t.i32(1, c.kind.physical())
+			t.listBegin(2, thriftI32, 2)
+			t.elemI32(pqEncodingPlain)
+			t.elemI32(pqEncodingRLE)
+			t.listBegin(3, thriftBinary, 1)
+			t.elemStr(c.name)
+			t.i32(4, 0) // UNCOMPRESSED
+			t.i64(5, chunk.values)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			t.structEnd()
+			t.elemEnd()
+		}
+		t.i64(2, g.bytes)
+		t.i64(3, g.rows)
+		t.elemEnd()
+	}
+	t.str(6, "lowcode-database")
+	t.stop()
+
+	var n [4]byte
+	binary.LittleEndian.PutUint32(n[:], uint32(len(t.buf)))
+	for _, b := range [][]byte{t.buf, n[:], []byte(parquetMagic)} {
+		if _, err := p.w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// annotate 写出 SchemaElement 的 converted_type 和 logicalType，旧的读取方只认 converted_type。
+func (k parquetKind) annotate(t *thriftWriter) {
+	switch k {
+	case parquetString:
+		t.i32(6, pqConvertedUTF8)
+		t.structBegin(10)
+		t.structBegin(1) // STRING
+		t.structEnd()
+		t.structEnd()
+	case parquetJSON:
+		t.i32(6, pqConvertedJSON)
+		t.structBegin(10)
+		t.structBegin(12) // JSON
+		t.structEnd()
+		t.structEnd()
+	case parquetDate:
+		t.i32(6, pqConvertedDate)
+		t.structBegin(10)
+		t.structBegin(6) // DATE
+		t.structEnd()
+		t.structEnd()
+	case parquetTimestamp:
+		t.i32(6, pqConvertedTimestampMicros)
+		t.structBegin(10)
+		t.structBegin(8) // TIMESTAMP
+		t.boolean(1, true)
+		t.structBegin(2)
+		t.structBegin(2) // MICROS
+		t.structEnd()
+		t.structEnd()
+		t.structEnd()
+		t.structEnd()
+	}
+}
+
+// parquetKindFor 按列的 pg_type 选择 Parquet 类型：整数为 INT64，浮点和 numeric 为 DOUBLE，
+// 时间为微秒精度的 UTC 时间戳，json / 数组为 JSON 文本，其它为字符串。虚拟列（公式、rollup 等）没有固定的类型，按 JSON 导出。
+func parquetKindFor(c columnMeta) parquetKind {
+	if virtualKinds[c.Kind] {
+		return parquetJSON
+	}
+	t := strings.ToLower(strings.TrimSpace(c.PgType))
+	if strings.HasSuffix(t, "[]") {
+		return parquetJSON
+	}
+	if i := strings.IndexByte(t, '('); i >= 0 {
+		t = strings.TrimSpace(t[:i])
+	}
+	switch t {
+	case "boolean", "bool":
+		return parquetBool
+	case "smallint", "integer", "int", "bigint", "int2", "int4", "int8", "smallserial", "serial", "bigserial":
+		return parquetInt64
+	case "real", "double precision", "float4", "float8", "numeric", "decimal":
+		return parquetDouble
+	case "timestamptz", "timestamp", "timestamp with time zone", "timestamp without time zone":
+		return parquetTimestamp
+	case "date":
+		return parquetDate
+	case "json", "jsonb":
+		return parquetJSON
+	case "bytea":
+		return parquetBytes
+	}
+	return parquetString
+}
+
+// -------- Thrift compact protocol --------
+
+// thrift compact protocol 的类型编号。
+const (
+	thriftBoolTrue  = 1
+	thriftBoolFalse = 2
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftStruct    = 12
+)
+
+// thriftWriter 按 thrift compact protocol 编码结构体，字段必须按 id 递增的顺序写入。
+type thriftWriter struct {
+	buf   []byte
+	last  int16
+	stack []int16
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	if d := id - t.last; d > 0 && d <= 15 {
+		t.buf = append(t.buf, byte(d)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.buf = binary.AppendVarint(t.buf, int64(id))
+	}
+	t.last = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.buf = binary.AppendVarint(t.buf, int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.buf = binary.AppendVarint(t.buf, v)
+}
+
+func (t *thriftWriter) str(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.elemStr(s)
+}
+
+func (t *thriftWriter) boolean(id int16, v bool) {
+	if v {
+		t.field(id, thriftBoolTrue)
+	} else {
+		t.field(id, thriftBoolFalse)
+	}
+}
+
+func (t *thriftWriter) structBegin(id int16) {
+	t.field(id, thriftStruct)
+	t.elemBegin()
+}
+
+func (t *thriftWriter) structEnd() { t.elemEnd() }
+
+func (t *thriftWriter) listBegin(id int16, elem byte, n int) {
+	t.field(id, thriftList)
+	if n < 15 {
+		t.buf = append(t.buf, byte(n)<<4|elem)
+	} else {
+		t.buf = append(t.buf, 0xf0|elem)
+		t.buf = binary.AppendUvarint(t.buf, uint64(n))
+	}
+}
+
+// elemBegin / elemEnd 包围一个结构体（字段值或列表元素），字段 id 的增量从 0 重新计算。
+func (t *thriftWriter) elemBegin() {
+	t.stack = append(t.stack, t.last)
+	t.last = 0
+}
+
+func (t *thriftWriter) elemEnd() {
+	t.stop()
+	t.last = t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+func (t *thriftWriter) elemI32(v int32) { t.buf = binary.AppendVarint(t.buf, int64(v)) }
+
+func (t *thriftWriter) elemStr(s string) {
+	t.buf = binary.AppendUvarint(t.buf, uint64(len(s)))
+	t.buf = append(t.buf, s...)
+}
+
+// stop 结束当前结构体。
+func (t *thriftWriter) stop() { t.buf = append(t.buf, 0) }
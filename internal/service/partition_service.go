@@ -0,0 +1,583 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/db"
+)
+
+// -------- Partitioning --------
+
+// partitionConfig 保存在 lc_tables.config.partition 中。
+type partitionConfig struct {
+	ColumnID   string
+	ColumnName string
+	TypeID     string
+	PgColumn   string
+	Strategy   string // range | list
+	Interval   string // day | week | month（仅 range）
+	Premake    int
+	Values     []string // 仅 list
+}
+
+// execer 同时被 *pgxpool.Pool 和 pgx.Tx 实现。
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func (pc *partitionConfig) toMap() map[string]any {
+	values := make([]any, len(pc.Values))
+	for i, v := range pc.Values {
+		values[i] = v
+	}
+	return map[string]any{
+		"column_id":   pc.ColumnID,
+		"column_name": pc.ColumnName,
+		"type_id":     pc.TypeID,
+		"pg_column":   pc.PgColumn,
+		"strategy":    pc.Strategy,
+		"interval":    pc.Interval,
+		"premake":     pc.Premake,
+		"values":      values,
+	}
+}
+
+func (pc *partitionConfig) spec() *lowcodev1.PartitionSpec {
+	return &lowcodev1.PartitionSpec{
+		ColumnId:   pc.ColumnID,
+		ColumnName: pc.ColumnName,
+		TypeId:     pc.TypeID,
+		Strategy:   pc.Strategy,
+		Interval:   pc.Interval,
+		Premake:    int32(pc.Premake),
+		Values:     pc.Values,
+	}
+}
+
+// partitionFromConfig 从 lc_tables.config 中解析分区配置，未分区返回 nil。
+func partitionFromConfig(cfg map[string]any) *partitionConfig {
+	m, ok := cfg["partition"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	pc := &partitionConfig{}
+	pc.ColumnID, _ = m["column_id"].(string)
+	pc.ColumnName, _ = m["column_name"].(string)
+	pc.TypeID, _ = m["type_id"].(string)
+	pc.PgColumn, _ = m["pg_column"].(string)
+	pc.Strategy, _ = m["strategy"].(string)
+	pc.Interval, _ = m["interval"].(string)
+	if n, ok := m["premake"].(float64); ok {
+		pc.Premake = int(n)
+	}
+	if list, ok := m["values"].([]any); ok {
+		for _, v := range list {
+			if s, ok := v.(string); ok {
+				pc.Values = append(pc.Values, s)
+			}
+		}
+	}
+	if pc.PgColumn == "" {
+		return nil
+	}
+	return pc
+}
+
+// createPartitionedTable 创建分区父表、default 分区以及初始分区。
+func createPartitionedTable(ctx context.Context, tx pgx.Tx, schemaName, physTable string, spec *lowcodev1.PartitionSpec) (*partitionConfig, error) {
+	if spec.GetColumnName() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "partition.column_name is required")
+	}
+	pc := &partitionConfig{
+		ColumnID:   uuid.New().String(),
+		ColumnName: spec.GetColumnName(),
+		TypeID:     spec.GetTypeId(),
+		PgColumn:   "c_" + strings.ReplaceAll(uuid.New().String()[:8], "-", ""),
+		Interval:   spec.GetInterval(),
+		Premake:    int(spec.GetPremake()),
+		Values:     spec.GetValues(),
+	}
+	if pc.TypeID == "" {
+		pc.TypeID = "timestamp"
+	}
+	typ, err := resolveType(ctx, tx, pc.TypeID)
+	if err != nil {
+		return nil, err
+	}
+	pc.TypeID = typ.GetId()
+	pgType := typ.GetPgType()
+	if err := pc.setStrategy(pgType, typeKind(typ)); err != nil {
+		return nil, err
+	}
+
+	parent := pgx.Identifier{schemaName, physTable}.Sanitize()
+	col := pgx.Identifier{pc.PgColumn}.Sanitize()
+	createSQL := fmt.Sprintf(`CREATE TABLE %s (id UUID NOT NULL DEFAULT gen_random_uuid(), %s %s NOT NULL, %s, PRIMARY KEY (id, %s)) PARTITION BY %s (%s)`,
+		parent, col, pgType, systemColumnsSQL, col, strings.ToUpper(pc.Strategy), col)
+	if _, err := tx.Exec(ctx, createSQL); err != nil {
+		return nil, err
+	}
+	defSQL := fmt.Sprintf(`CREATE TABLE %s PARTITION OF %s DEFAULT`,
+		pgx.Identifier{schemaName, physTable + "_default"}.Sanitize(), parent)
+	if _, err := tx.Exec(ctx, defSQL); err != nil {
+		return nil, err
+	}
+
+	if pc.Strategy == "range" {
+		now := time.Now()
+		if err := ensureRangePartitions(ctx, tx, schemaName, physTable, pc, now, now); err != nil {
+			return nil, err
+		}
+		return pc, nil
+	}
+	if err := createListPartitions(ctx, tx, schemaName, physTable, pc.Values); err != nil {
+		return nil, err
+	}
+	return pc, nil
+}
+
+// setStrategy 按分区列的 PG 类型选择 range / list 并补齐默认值：时间类型按 range，其余按 list。
+func (pc *partitionConfig) setStrategy(pgType, kind string) error {
+	if virtualKinds[kind] {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "virtual type %s cannot be used as partition key", pc.TypeID)
+	}
+	switch pgType {
+	case "timestamptz", "timestamp", "date":
+		pc.Strategy = "range"
+		if pc.Interval == "" {
+			pc.Interval = "month"
+		}
+		if pc.Interval != "day" && pc.Interval != "week" && pc.Interval != "month" {
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "partition.interval must be day, week or month")
+		}
+		if pc.Premake <= 0 {
+			pc.Premake = 3
+		}
+	case "jsonb", "json", "bytea":
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "type %s cannot be used as partition key", pc.TypeID)
+	default:
+		pc.Strategy = "list"
+		pc.Interval = ""
+		pc.Premake = 0
+	}
+	return nil
+}
+
+func createListPartitions(ctx context.Context, tx pgx.Tx, schemaName, physTable string, values []string) error {
+	parent := pgx.Identifier{schemaName, physTable}.Sanitize()
+	for _, v := range values {
+		stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES IN (%s)`,
+			pgx.Identifier{schemaName, listPartitionName(physTable, v)}.Sanitize(), parent, quoteLiteral(v))
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertPartitionColumn 把分区列登记到 lc_columns（id 在建表时已生成）。
+func insertPartitionColumn(ctx context.Context, tx pgx.Tx, tableName string, pc *partitionConfig) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO lc_columns (id, table_id, name, type_id, pg_column, is_nullable, position, config)
+		VALUES ($1, $2, $3, $4, $5, FALSE, 1, '{}'::jsonb)
+	`, pc.ColumnID, tableName, pc.ColumnName, pc.TypeID, pc.PgColumn)
+	return err
+}
+
+// maxMigratedPartitions 限制 partitionExistingTable 为已有数据创建的分区数。
+const maxMigratedPartitions = 1000
+
+// partitionExistingTable 把未分区的表改为以已有列为分区键的分区表（UpdateTable 的 partition）：
+// 原表改名后按 LIKE 建同名的分区父表，建好 default 分区和覆盖已有数据的分区，搬入数据后删掉原表，
+// 再按原来的定义重建主键（加上分区键）、索引和触发器。整个过程在调用方的事务中进行，期间持有 ACCESS EXCLUSIVE 锁。
+func (s *LowcodeService) partitionExistingTable(ctx context.Context, tx pgx.Tx, ref tableRef, spec *lowcodev1.PartitionSpec, now time.Time) (*partitionConfig, error) {
+	if sqlViewFromConfig(ref.Config) != nil {
+		return nil, sqlViewReadOnly(ref.Name)
+	}
+	if partitionFromConfig(ref.Config) != nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is already partitioned", ref.Name)
+	}
+	if spec.GetColumnId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "partition.column_id is required")
+	}
+	cols, schemaName, physTable, err := s.loadColumns(ctx, tx, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	var key *columnMeta
+	for i := range cols {
+		if cols[i].Id == spec.GetColumnId() {
+			key = &cols[i]
+		}
+	}
+	if key == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found in table %s", spec.GetColumnId(), ref.Name)
+	}
+	pc := &partitionConfig{
+		ColumnID:   key.Id,
+		ColumnName: key.Name,
+		TypeID:     key.TypeId,
+		PgColumn:   key.PgColumn,
+		Interval:   spec.GetInterval(),
+		Premake:    int(spec.GetPremake()),
+		Values:     spec.GetValues(),
+	}
+	if err := pc.setStrategy(basePgType(key.PgType), key.Kind); err != nil {
+		return nil, err
+	}
+
+	parent := pgx.Identifier{schemaName, physTable}.Sanitize()
+	col := pgx.Identifier{pc.PgColumn}.Sanitize()
+	if _, err := tx.Exec(ctx, `LOCK TABLE `+parent+` IN ACCESS EXCLUSIVE MODE`); err != nil {
+		return nil, err
+	}
+	if err := checkRepartitionable(ctx, tx, ref.Name, schemaName, physTable); err != nil {
+		return nil, err
+	}
+	var hasNull bool
+	if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE %s IS NULL)`, parent, col)).Scan(&hasNull); err != nil {
+		return nil, err
+	}
+	if hasNull {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"column %s has NULL values; the partition key must be set on every row", key.Name)
+	}
+
+	// 索引和触发器的定义在改名前取出，其中引用的表名就是新的父表
+	var pkCols []string
+	var indexDefs []string
+	rows, err := tx.Query(ctx, `
+		SELECT i.indisprimary, i.indisunique, pg_get_indexdef(i.indexrelid), c.relname,
+		       ARRAY(SELECT a.attname::text FROM unnest(i.indkey::int2[]) WITH ORDINALITY k(attnum, n)
+		             JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = k.attnum ORDER BY k.n)
+		FROM pg_index i
+		JOIN pg_class c ON c.oid = i.indexrelid
+		WHERE i.indrelid = to_regclass(format('%I.%I', $1::text, $2::text))
+		ORDER BY c.relname`, schemaName, physTable)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var primary, unique bool
+		var def, name string
+		var keys []string
+		if err := rows.Scan(&primary, &unique, &def, &name, &keys); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		switch {
+		case primary:
+			pkCols = keys
+		case unique && !slices.Contains(keys, pc.PgColumn):
+			rows.Close()
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+				"unique index %s does not include column %s; drop it before partitioning", name, key.Name)
+		default:
+			indexDefs = append(indexDefs, def)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	triggers, err := queryStrings(ctx, tx, `
+		SELECT pg_get_triggerdef(oid) FROM pg_trigger
+		WHERE tgrelid = to_regclass(format('%I.%I', $1::text, $2::text)) AND NOT tgisinternal
+		ORDER BY tgname`, schemaName, physTable)
+	if err != nil {
+		return nil, err
+	}
+
+	// 需要创建的分区：range 覆盖最早的数据到当前周期之后 premake 个周期，list 覆盖已有取值和 partition.values
+	var from time.Time
+	if pc.Strategy == "range" {
+		var earliest *time.Time
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT min(%s) FROM %s`, col, parent)).Scan(&earliest); err != nil {
+			return nil, err
+		}
+		from = now
+		if earliest != nil && earliest.Before(now) {
+			from = *earliest
+		}
+		n := pc.Premake
+		for t := truncatePeriod(from.UTC(), pc.Interval); !t.After(now.UTC()); t = nextPeriod(t, pc.Interval) {
+			if n++; n > maxMigratedPartitions {
+				return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+					"data in column %s spans more than %d partitions; use a larger interval", key.Name, maxMigratedPartitions)
+			}
+		}
+	} else {
+		existing, err := queryStrings(ctx, tx, fmt.Sprintf(`SELECT DISTINCT %s::text FROM %s LIMIT %d`, col, parent, maxMigratedPartitions+1))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range existing {
+			if !slices.Contains(pc.Values, v) {
+				pc.Values = append(pc.Values, v)
+			}
+		}
+		if len(pc.Values) > maxMigratedPartitions {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+				"column %s has more than %d distinct values and cannot be used as a list partition key", key.Name, maxMigratedPartitions)
+		}
+	}
+
+	old := "lc_tmp_" + strings.ReplaceAll(uuid.New().String()[:8], "-", "")
+	oldTable := pgx.Identifier{schemaName, old}.Sanitize()
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, parent, pgx.Identifier{old}.Sanitize())); err != nil {
+		return nil, err
+	}
+	// serial 列的序列属于原表的列，删原表前改挂到新表上
+	sequences, err := tx.Query(ctx, `
+		SELECT d.objid::regclass::text, a.attname::text
+		FROM pg_depend d
+		JOIN pg_class s ON s.oid = d.objid AND s.relkind = 'S'
+		JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+		WHERE d.classid = 'pg_class'::regclass AND d.refobjid = to_regclass(format('%I.%I', $1::text, $2::text)) AND d.deptype = 'a'`,
+		schemaName, old)
+	if err != nil {
+		return nil, err
+	}
+	type ownedSequence struct{ seq, column string }
+	owned, err := pgx.CollectRows(sequences, func(r pgx.CollectableRow) (ownedSequence, error) {
+		var o ownedSequence
+		err := r.Scan(&o.seq, &o.column)
+		return o, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	insertCols, err := queryStrings(ctx, tx, `
+		SELECT quote_ident(attname) FROM pg_attribute
+		WHERE attrelid = to_regclass(format('%I.%I', $1::text, $2::text)) AND attnum > 0 AND NOT attisdropped AND attgenerated = ''
+		ORDER BY attnum`, schemaName, old)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := []string{
+		fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS INCLUDING GENERATED INCLUDING STORAGE INCLUDING COMMENTS) PARTITION BY %s (%s)`,
+			parent, oldTable, strings.ToUpper(pc.Strategy), col),
+		fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s SET NOT NULL`, parent, col),
+		fmt.Sprintf(`CREATE TABLE %s PARTITION OF %s DEFAULT`, pgx.Identifier{schemaName, physTable + "_default"}.Sanitize(), parent),
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+	if pc.Strategy == "range" {
+		err = ensureRangePartitions(ctx, tx, schemaName, physTable, pc, from, now)
+	} else {
+		err = createListPartitions(ctx, tx, schemaName, physTable, pc.Values)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	colList := strings.Join(insertCols, ", ")
+	stmts = []string{fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM %s`, parent, colList, colList, oldTable)}
+	for _, o := range owned {
+		stmts = append(stmts, fmt.Sprintf(`ALTER SEQUENCE %s OWNED BY %s.%s`, o.seq, parent, pgx.Identifier{o.column}.Sanitize()))
+	}
+	stmts = append(stmts, `DROP TABLE `+oldTable)
+	if len(pkCols) > 0 {
+		if !slices.Contains(pkCols, pc.PgColumn) {
+			pkCols = append(pkCols, pc.PgColumn)
+		}
+		ids := make([]string, len(pkCols))
+		for i, c := range pkCols {
+			ids[i] = pgx.Identifier{c}.Sanitize()
+		}
+		stmts = append(stmts, fmt.Sprintf(`ALTER TABLE %s ADD PRIMARY KEY (%s)`, parent, strings.Join(ids, ", ")))
+	}
+	stmts = append(stmts, indexDefs...)
+	stmts = append(stmts, triggers...)
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+	if !key.System {
+		if _, err := tx.Exec(ctx, `UPDATE lc_columns SET is_nullable = FALSE, updated_at = now() WHERE id = $1`, key.Id); err != nil {
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+// checkRepartitionable 拒绝无法安全重建的物理表：已经是分区表、按 tenant 隔离的共享表
+// （搬数据时只能看到当前 tenant 的行）、有外键或被视图引用、或者带 identity 列的表。
+func checkRepartitionable(ctx context.Context, tx pgx.Tx, name, schemaName, physTable string) error {
+	var relkind string
+	var scoped, referenced, identity bool
+	var views *string
+	err := tx.QueryRow(ctx, `
+		WITH t AS (SELECT to_regclass(format('%I.%I', $1::text, $2::text)) AS oid)
+		SELECT (SELECT relkind::text FROM pg_class WHERE oid = t.oid),
+		       EXISTS (SELECT 1 FROM pg_policy WHERE polrelid = t.oid AND polname = $3),
+		       EXISTS (SELECT 1 FROM pg_constraint WHERE contype = 'f' AND (confrelid = t.oid OR conrelid = t.oid)),
+		       EXISTS (SELECT 1 FROM pg_attribute WHERE attrelid = t.oid AND attnum > 0 AND NOT attisdropped AND attidentity <> ''),
+		       (SELECT string_agg(DISTINCT v.oid::regclass::text, ', ')
+		        FROM pg_depend d
+		        JOIN pg_rewrite r ON r.oid = d.objid
+		        JOIN pg_class v ON v.oid = r.ev_class
+		        WHERE d.classid = 'pg_rewrite'::regclass AND d.refobjid = t.oid AND v.oid <> t.oid)
+		FROM t`, schemaName, physTable, db.TenantPolicy).Scan(&relkind, &scoped, &referenced, &identity, &views)
+	if err != nil {
+		return err
+	}
+	switch {
+	case relkind != "r":
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is not a plain table and cannot be partitioned", name)
+	case scoped:
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is shared between tenants and cannot be partitioned in place", name)
+	case referenced:
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s has foreign keys and cannot be partitioned in place", name)
+	case identity:
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s has identity columns and cannot be partitioned in place", name)
+	case views != nil:
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is used by views %s; drop them before partitioning", name, *views)
+	}
+	return nil
+}
+
+// queryStrings 返回查询结果第一列的全部值。
+func queryStrings(ctx context.Context, q querier, sql string, args ...any) ([]string, error) {
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// ensureRangePartitions 保证从 from 所在周期到当前周期之后 premake 个周期的分区都存在；from 晚于 now 时按 now 处理。
+// 如果 default 分区里已有落在新分区范围内的行，PG 会拒绝创建，此时跳过该分区并记录日志。
+func ensureRangePartitions(ctx context.Context, q execer, schemaName, physTable string, pc *partitionConfig, from, now time.Time) error {
+	parent := pgx.Identifier{schemaName, physTable}.Sanitize()
+	if from.After(now) {
+		from = now
+	}
+	start := truncatePeriod(from.UTC(), pc.Interval)
+	last := truncatePeriod(now.UTC(), pc.Interval)
+	for i := 0; i < pc.Premake; i++ {
+		last = nextPeriod(last, pc.Interval)
+	}
+	for !start.After(last) {
+		end := nextPeriod(start, pc.Interval)
+		name := physTable + "_p" + start.Format("20060102")
+		stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)`,
+			pgx.Identifier{schemaName, name}.Sanitize(), parent,
+			quoteLiteral(start.Format(time.RFC3339)), quoteLiteral(end.Format(time.RFC3339)))
+		if _, err := q.Exec(ctx, stmt); err != nil {
+			if _, inTx := q.(pgx.Tx); inTx {
+				return err
+			}
+			log.Printf("partition: create %s.%s: %v", schemaName, name, err)
+		}
+		start = end
+	}
+	return nil
+}
+
+func truncatePeriod(t time.Time, interval string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case "day":
+		return day
+	case "week":
+		// 以周一为一周开始。
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	default:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func nextPeriod(t time.Time, interval string) time.Time {
+	switch interval {
+	case "day":
+		return t.AddDate(0, 0, 1)
+	case "week":
+		return t.AddDate(0, 0, 7)
+	default:
+		return t.AddDate(0, 1, 0)
+	}
+}
+
+// listPartitionName 用取值的 hash 生成分区表名，避免取值中的特殊字符。
+func listPartitionName(physTable, value string) string {
+	h := fnv.New32a()
+	h.Write([]byte(value))
+	return fmt.Sprintf("%s_l%08x", physTable, h.Sum32())
+}
+
+// quoteLiteral 把字符串转成 SQL 字面量；分区边界不能使用参数占位符。
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// RunPartitionMaintenance 定期为所有已打开的 tenant 库补齐 RANGE 分区，直到 ctx 结束。
+func (s *LowcodeService) RunPartitionMaintenance(ctx context.Context, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		s.tenants.ForEachPool(func(tenantID string, pool *pgxpool.Pool) {
+			if err := maintainPartitions(ctx, pool, time.Now()); err != nil {
+				log.Printf("partition maintenance (tenant %q): %v", tenantID, err)
+			}
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func maintainPartitions(ctx context.Context, pool *pgxpool.Pool, now time.Time) error {
+	rows, err := pool.Query(ctx, `SELECT schema_name, table_name, config FROM lc_tables WHERE config ? 'partition' AND archived_at IS NULL`)
+	if err != nil {
+		return err
+	}
+	type target struct {
+		schema, table string
+		pc            *partitionConfig
+	}
+	var targets []target
+	for rows.Next() {
+		var t target
+		var cfg map[string]any
+		if err := rows.Scan(&t.schema, &t.table, &cfg); err != nil {
+			rows.Close()
+			return err
+		}
+		if t.pc = partitionFromConfig(cfg); t.pc != nil && t.pc.Strategy == "range" {
+			targets = append(targets, t)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := ensureRangePartitions(ctx, pool, t.schema, t.table, t.pc, now, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
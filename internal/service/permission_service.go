@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+)
+
+// -------- Permission --------
+
+// lc_permissions 中 access / effect / principal_type 的取值。
+const (
+	accessRead   = "read"
+	accessWrite  = "write"
+	accessUnmask = "unmask"
+
+	principalRole = "role"
+	principalUser = "user"
+)
+
+// permissionFieldsSQL 是 scanPermission 需要的 lc_permissions 字段，SELECT / RETURNING 共用。
+const permissionFieldsSQL = `id::text, table_id, COALESCE(column_id::text, ''), principal_type, principal, access, effect, created_at`
+
+func scanPermission(row pgx.Row) (*lowcodev1.Permission, error) {
+	var p lowcodev1.Permission
+	var principalType, principal, access, effect string
+	var createdAt time.Time
+	if err := row.Scan(&p.Id, &p.TableId, &p.ColumnId, &principalType, &principal, &access, &effect, &createdAt); err != nil {
+		return nil, err
+	}
+	if principalType == principalRole {
+		p.Role = parseRole(principal)
+	} else {
+		p.UserId = principal
+	}
+	switch access {
+	case accessWrite:
+		p.Access = lowcodev1.PermissionAccess_PERMISSION_ACCESS_WRITE
+	case accessUnmask:
+		p.Access = lowcodev1.PermissionAccess_PERMISSION_ACCESS_UNMASK
+	default:
+		p.Access = lowcodev1.PermissionAccess_PERMISSION_ACCESS_READ
+	}
+	p.Effect = lowcodev1.PermissionEffect_PERMISSION_EFFECT_ALLOW
+	if effect == "deny" {
+		p.Effect = lowcodev1.PermissionEffect_PERMISSION_EFFECT_DENY
+	}
+	p.CreatedAt = timestamppb.New(createdAt)
+	return &p, nil
+}
+
+func (s *LowcodeService) ListPermissions(ctx context.Context, req *lowcodev1.ListPermissionsRequest) (*lowcodev1.ListPermissionsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableID, err := s.resolveTableName(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, `
+		SELECT `+permissionFieldsSQL+` FROM lc_permissions
+		WHERE table_id = $1
+		ORDER BY column_id NULLS FIRST, principal_type, principal, access`, tableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListPermissionsResponse
+	for rows.Next() {
+		p, err := scanPermission(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Permissions = append(res.Permissions, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// SetPermission 添加一条规则；同一表 / 列、同一对象、同一 access 已有规则时只修改 effect。
+func (s *LowcodeService) SetPermission(ctx context.Context, req *lowcodev1.SetPermissionRequest) (*lowcodev1.SetPermissionResponse, error) {
+	var principalType, principal string
+	switch {
+	case req.GetUserId() != "" && req.GetRole() != lowcodev1.Role_ROLE_UNSPECIFIED:
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "only one of role and user_id may be set")
+	case req.GetUserId() != "":
+		principalType, principal = principalUser, req.GetUserId()
+	case req.GetRole() != lowcodev1.Role_ROLE_UNSPECIFIED:
+		if _, ok := lowcodev1.Role_name[int32(req.GetRole())]; !ok {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "unknown role %d", req.GetRole())
+		}
+		principalType, principal = principalRole, roleName(req.GetRole())
+	default:
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "role or user_id is required")
+	}
+	var access, effect string
+	switch req.GetAccess() {
+	case lowcodev1.PermissionAccess_PERMISSION_ACCESS_READ:
+		access = accessRead
+	case lowcodev1.PermissionAccess_PERMISSION_ACCESS_WRITE:
+		access = accessWrite
+	case lowcodev1.PermissionAccess_PERMISSION_ACCESS_UNMASK:
+		access = accessUnmask
+	default:
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "access is required")
+	}
+	switch req.GetEffect() {
+	case lowcodev1.PermissionEffect_PERMISSION_EFFECT_ALLOW:
+		effect = "allow"
+	case lowcodev1.PermissionEffect_PERMISSION_EFFECT_DENY:
+		effect = "deny"
+	default:
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "effect is required")
+	}
+
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	t, err := lookupTable(ctx, tx, req.GetTableId(), false, true)
+	if err != nil {
+		return nil, err
+	}
+	// column_id 为空时用 NULL，比较时用 IS NOT DISTINCT FROM
+	var columnID *string
+	if req.GetColumnId() != "" {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_columns WHERE table_id = $1 AND id::text = $2)`,
+			t.Name, req.GetColumnId()).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", req.GetColumnId())
+		}
+		id := req.GetColumnId()
+		columnID = &id
+	}
+
+	p, err := scanPermission(tx.QueryRow(ctx, `
+		UPDATE lc_permissions SET effect = $6
+		WHERE table_id = $1 AND column_id IS NOT DISTINCT FROM $2::uuid AND principal_type = $3 AND principal = $4 AND access = $5
+		RETURNING `+permissionFieldsSQL, t.Name, columnID, principalType, principal, access, effect))
+	if errors.Is(err, pgx.ErrNoRows) {
+		p, err = scanPermission(tx.QueryRow(ctx, `
+			INSERT INTO lc_permissions (table_id, column_id, principal_type, principal, access, effect)
+			VALUES ($1, $2::uuid, $3, $4, $5, $6)
+			RETURNING `+permissionFieldsSQL, t.Name, columnID, principalType, principal, access, effect))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.SetPermissionResponse{Permission: p}, nil
+}
+
+func (s *LowcodeService) DeletePermission(ctx context.Context, req *lowcodev1.DeletePermissionRequest) (*lowcodev1.DeletePermissionResponse, error) {
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := pool.Exec(ctx, `DELETE FROM lc_permissions WHERE id::text = $1`, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "permission %s not found", req.GetId())
+	}
+	return &lowcodev1.DeletePermissionResponse{}, nil
+}
+
+// columnAccess 是调用方对一张表的读写权限。nil 表示不受限制（未开启认证、平台管理员或 owner），
+// 所有方法都可以在 nil 上调用。
+type columnAccess struct {
+	tableID string
+	// table 是按 access 的表级结果，columns 是列上规则的结果，key 为 access + "/" + column_id
+	table   map[string]bool
+	columns map[string]bool
+	// masks 是配置了 config.mask 的列，没有 unmask 权限时读取到的是脱敏后的值
+	masks map[string]maskRule
+}
+
+// permissionLevel 是规则的优先级，数值大的优先：列上的规则优先于表上的，用户规则优先于角色规则。
+func permissionLevel(columnID, principalType string) int {
+	level := 0
+	if principalType == principalUser {
+		level++
+	}
+	if columnID != "" {
+		level += 2
+	}
+	return level
+}
+
+// tableAccess 按 lc_permissions 计算调用方对 tableID 的读写权限，没有规则时按角色的默认权限：
+// 所有成员可读，editor 以上可写，只有 owner 能读取脱敏列的原值。
+func (s *LowcodeService) tableAccess(ctx context.Context, q querier, tableID string) (*columnAccess, error) {
+	id := auth.IdentityFromContext(ctx)
+	if id == nil || id.IsAdmin() {
+		return nil, nil
+	}
+	role, err := s.callerRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role >= owner {
+		return nil, nil
+	}
+	rows, err := q.Query(ctx, `
+		SELECT COALESCE(column_id::text, ''), principal_type, access, effect FROM lc_permissions
+		WHERE table_id = $1 AND ((principal_type = 'user' AND principal = $2) OR (principal_type = 'role' AND principal = $3))`,
+		tableID, id.UserID, roleName(role))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	a := &columnAccess{
+		tableID: tableID,
+		table:   map[string]bool{accessRead: true, accessWrite: role >= editor, accessUnmask: false},
+		columns: make(map[string]bool),
+		masks:   make(map[string]maskRule),
+	}
+	// 每个 key 只保留最高优先级的规则，同级时 deny 优先
+	type decision struct {
+		level int
+		allow bool
+	}
+	best := make(map[string]decision)
+	for rows.Next() {
+		var columnID, principalType, access, effect string
+		if err := rows.Scan(&columnID, &principalType, &access, &effect); err != nil {
+			return nil, err
+		}
+		key := access + "/" + columnID
+		d := decision{level: permissionLevel(columnID, principalType), allow: effect == "allow"}
+		if cur, ok := best[key]; ok && (cur.level > d.level || (cur.level == d.level && !cur.allow)) {
+			continue
+		}
+		best[key] = d
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for key, d := range best {
+		// column_id 为空的是表级规则
+		if access, ok := strings.CutSuffix(key, "/"); ok {
+			a.table[access] = d.allow
+			continue
+		}
+		a.columns[key] = d.allow
+	}
+	rows.Close()
+
+	rows, err = q.Query(ctx, `SELECT id::text, config FROM lc_columns WHERE table_id = $1 AND config ? 'mask'`, tableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var columnID string
+		var cfg map[string]any
+		if err := rows.Scan(&columnID, &cfg); err != nil {
+			return nil, err
+		}
+		// 配置在写入时已校验；无法解析的按 full 处理，宁可多遮
+		rule, ok, err := parseMaskRule(cfg)
+		if err != nil {
+			rule, ok = maskRule{mode: maskFull}, true
+		}
+		if ok {
+			a.masks[columnID] = rule
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *columnAccess) allowed(access, columnID string) bool {
+	if a == nil {
+		return true
+	}
+	if v, ok := a.columns[access+"/"+columnID]; ok {
+		return v
+	}
+	return a.table[access]
+}
+
+func (a *columnAccess) canRead(columnID string) bool  { return a.allowed(accessRead, columnID) }
+func (a *columnAccess) canWrite(columnID string) bool { return a.allowed(accessWrite, columnID) }
+
+// masked 报告 columnID 配置了脱敏且调用方没有 unmask 权限。
+func (a *columnAccess) masked(columnID string) (maskRule, bool) {
+	if a == nil {
+		return maskRule{}, false
+	}
+	rule, ok := a.masks[columnID]
+	if !ok || a.allowed(accessUnmask, columnID) {
+		return maskRule{}, false
+	}
+	return rule, true
+}
+
+// readable 报告调用方能否读取表中至少一列。
+func (a *columnAccess) readable() bool {
+	if a == nil || a.table[accessRead] {
+		return true
+	}
+	for key, allow := range a.columns {
+		if allow && strings.HasPrefix(key, accessRead+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *columnAccess) denied(access, what string) error {
+	return apierr.New(lowcodev1.ErrorCode_PERMISSION_DENIED, codes.PermissionDenied, "no %s permission on %s", access, what)
+}
+
+// checkTableRead 在调用方不能读取表中任何一列时返回 PermissionDenied。
+func (a *columnAccess) checkTableRead() error {
+	if a.readable() {
+		return nil
+	}
+	return a.denied(accessRead, "table "+a.tableID)
+}
+
+// checkTableWrite 检查表级写权限，用于删除、恢复等作用于整行的操作。
+func (a *columnAccess) checkTableWrite() error {
+	if a == nil || a.table[accessWrite] {
+		return nil
+	}
+	return a.denied(accessWrite, "table "+a.tableID)
+}
+
+// checkRead 检查 columnIDs 是否都可读且未被脱敏，用于过滤、排序、聚合等会泄露列值的参数。
+func (a *columnAccess) checkRead(columnIDs ...string) error {
+	for _, id := range columnIDs {
+		if !a.canRead(id) {
+			return a.denied(accessRead, "column "+id)
+		}
+		if _, ok := a.masked(id); ok {
+			return maskedColumnError(id)
+		}
+	}
+	return nil
+}
+
+// checkWrite 检查写入的 cells 和清空的列是否都可写。
+func (a *columnAccess) checkWrite(cells map[string]*lowcodev1.Value, cleared []string) error {
+	if a == nil {
+		return nil
+	}
+	for id := range cells {
+		if !a.canWrite(id) {
+			return a.denied(accessWrite, "column "+id)
+		}
+	}
+	for _, id := range cleared {
+		if !a.canWrite(id) {
+			return a.denied(accessWrite, "column "+id)
+		}
+	}
+	return nil
+}
+
+// redactRows 从 rows 中去掉不可读的列，并对脱敏列的值做脱敏。
+func (a *columnAccess) redactRows(rows ...*lowcodev1.Row) {
+	if a == nil {
+		return
+	}
+	for _, row := range rows {
+		if row == nil {
+			continue
+		}
+		for id, v := range row.Cells {
+			if !a.canRead(id) {
+				delete(row.Cells, id)
+				continue
+			}
+			if rule, ok := a.masked(id); ok && v != nil {
+				row.Cells[id] = rule.apply(v)
+			}
+		}
+	}
+}
+
+// checkTableWrite 是只需要表级写权限时的简写。
+func (s *LowcodeService) checkTableWrite(ctx context.Context, q querier, tableID string) error {
+	acc, err := s.tableAccess(ctx, q, tableID)
+	if err != nil {
+		return err
+	}
+	return acc.checkTableWrite()
+}
+
+// checkColumnRead 是只需要读取单列时的简写。
+func (s *LowcodeService) checkColumnRead(ctx context.Context, q querier, tableID, columnID string) error {
+	acc, err := s.tableAccess(ctx, q, tableID)
+	if err != nil {
+		return err
+	}
+	return acc.checkRead(columnID)
+}
+
+// checkColumnWrite 是只需要写入单列时的简写。
+func (s *LowcodeService) checkColumnWrite(ctx context.Context, q querier, tableID, columnID string) error {
+	acc, err := s.tableAccess(ctx, q, tableID)
+	if err != nil {
+		return err
+	}
+	return acc.checkWrite(map[string]*lowcodev1.Value{columnID: nil}, nil)
+}
+
+// filterColumnIDs 返回 filter 中引用的全部列 id（含嵌套的条件组）。
+func filterColumnIDs(f *lowcodev1.RowFilter) []string {
+	if f == nil {
+		return nil
+	}
+	if c := f.GetCondition(); c != nil {
+		return []string{c.GetColumnId()}
+	}
+	var ids []string
+	for _, sub := range f.GetGroup().GetFilters() {
+		ids = append(ids, filterColumnIDs(sub)...)
+	}
+	return ids
+}
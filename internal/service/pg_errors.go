@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- PG error translation --------
+
+// pgNamesTimeout 限制出错后解析表 id / 列 id 的查询时间，解析失败时只返回物理名字。
+const pgNamesTimeout = time.Second
+
+// translateError 把 handler 返回的 PG 错误转换为带错误码的错误（见 apierr.AnnotateWith），
+// 错误中的物理表名、列名解析为表 id、列 id 和列名。其它错误原样返回，由 apierr 拦截器处理。
+func (s *LowcodeService) translateError(ctx context.Context, err error) error {
+	var pgErr *pgconn.PgError
+	if err == nil || !errors.As(err, &pgErr) || apierr.CodeOf(err) != lowcodev1.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		return err
+	}
+	return apierr.AnnotateWith(err, s.pgErrorNames(ctx, pgErr))
+}
+
+// pgErrorNames 按 lc_tables / lc_columns 查出 pgErr 中物理表和列对应的表 id、列 id 和列名。
+func (s *LowcodeService) pgErrorNames(ctx context.Context, pgErr *pgconn.PgError) map[string]string {
+	if pgErr.SchemaName == "" || pgErr.TableName == "" {
+		return nil
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil
+	}
+	// 请求可能正是因为超时而失败，查询不受请求的 deadline 限制
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), pgNamesTimeout)
+	defer cancel()
+
+	var tableID string
+	if err := pool.QueryRow(ctx, `SELECT name FROM lc_tables WHERE schema_name = $1 AND table_name = $2`,
+		pgErr.SchemaName, pgErr.TableName).Scan(&tableID); err != nil {
+		return nil
+	}
+	names := map[string]string{apierr.MetaTableID: tableID}
+
+	pgCols := apierr.PgColumns(pgErr)
+	if len(pgCols) == 0 {
+		return names
+	}
+	rows, err := pool.Query(ctx, `SELECT pg_column, id::text, name FROM lc_columns WHERE table_id = $1 AND pg_column = ANY($2)`,
+		tableID, pgCols)
+	if err != nil {
+		return names
+	}
+	defer rows.Close()
+	type column struct{ id, name string }
+	byPg := make(map[string]column, len(pgCols))
+	for rows.Next() {
+		var pgColumn string
+		var c column
+		if err := rows.Scan(&pgColumn, &c.id, &c.name); err != nil {
+			return names
+		}
+		byPg[pgColumn] = c
+	}
+	if rows.Err() != nil {
+		return names
+	}
+	// 按错误中的顺序，跳过 tenant_id 等不对应列的物理列
+	var ids, colNames []string
+	for _, pg := range pgCols {
+		if c, ok := byPg[pg]; ok {
+			ids = append(ids, c.id)
+			colNames = append(colNames, c.name)
+		}
+	}
+	if len(ids) > 0 {
+		names[apierr.MetaColumnIDs] = strings.Join(ids, ",")
+		names[apierr.MetaColumnNames] = strings.Join(colNames, ",")
+	}
+	return names
+}
+
+// UnaryErrorTranslator 在 unary RPC 返回后调用 translateError，放在解析 tenant 的拦截器之后。
+func (s *LowcodeService) UnaryErrorTranslator(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, s.translateError(ctx, err)
+}
+
+// StreamErrorTranslator 在 streaming RPC 返回后调用 translateError。
+func (s *LowcodeService) StreamErrorTranslator(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return s.translateError(ss.Context(), handler(srv, ss))
+}
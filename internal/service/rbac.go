@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// -------- RBAC --------
+
+// memberRoleTTL 是成员角色在本进程中的缓存时间；其它实例上修改的角色最多这么久后生效。
+const memberRoleTTL = 5 * time.Second
+
+const (
+	viewer  = lowcodev1.Role_ROLE_VIEWER
+	editor  = lowcodev1.Role_ROLE_EDITOR
+	builder = lowcodev1.Role_ROLE_BUILDER
+	owner   = lowcodev1.Role_ROLE_OWNER
+)
+
+// methodRoles 是调用 LowcodeService 各方法需要的最低角色。不在表中的方法只允许平台管理员调用。
+var methodRoles = map[string]lowcodev1.Role{
+	// Tenant：只有 GetTenant / UpdateTenant 允许 owner 对自己的 tenant 调用，见 ownTenantMethods
+	"GetTenant":    owner,
+	"UpdateTenant": owner,
+
+	"ListTypes":   viewer,
+	"GetType":     viewer,
+	"ExportTypes": viewer,
+	"CreateType":  builder,
+	"UpdateType":  builder,
+	"DeleteType":  builder,
+	"ImportTypes": builder,
+
+	"ListTables":              viewer,
+	"GetTable":                viewer,
+	"GetTableSchema":          viewer,
+	"GetWorkspaceSchema":      viewer,
+	"ExportSchema":            viewer,
+	"ListTemplates":           viewer,
+	"CreateTable":             builder,
+	"CreateTableWithSchema":   builder,
+	"ApplyTableSchema":        builder,
+	"ImportSchema":            builder,
+	"CreateTableFromTemplate": builder,
+	"UpdateTable":             builder,
+	"DuplicateTable":          builder,
+	"DeleteTable":             builder,
+	"RestoreTable":            builder,
+	"PurgeTable":              builder,
+	"RepairTableSchema":       builder,
+
+	"ListWorkspaces":  viewer,
+	"GetWorkspace":    viewer,
+	"CreateWorkspace": builder,
+	"UpdateWorkspace": builder,
+	"DeleteWorkspace": builder,
+
+	"ListColumns":        viewer,
+	"AddColumn":          builder,
+	"UpdateColumn":       builder,
+	"DeleteColumn":       builder,
+	"ChangeColumnType":   builder,
+	"ReorderColumns":     builder,
+	"AddSelectOption":    builder,
+	"UpdateSelectOption": builder,
+	"RemoveSelectOption": builder,
+
+	"GetRow":                 viewer,
+	"FindRowByColumn":        viewer,
+	"ListRows":               viewer,
+	"StreamRows":             viewer,
+	"ExportRows":             viewer,
+	"SearchRows":             viewer,
+	"AggregateRows":          viewer,
+	"ListDistinctValues":     viewer,
+	"DownloadCellContent":    viewer,
+	"GetAttachmentUrl":       viewer,
+	"GetRowHistory":          viewer,
+	"CreateRow":              editor,
+	"UpdateRow":              editor,
+	"DeleteRow":              editor,
+	"RestoreRow":             editor,
+	"RestoreRowVersion":      editor,
+	"LinkRows":               editor,
+	"UnlinkRows":             editor,
+	"PurgeRows":              editor,
+	"BulkUpsertRows":         editor,
+	"BulkDeleteRows":         editor,
+	"UploadCellContent":      editor,
+	"CreateAttachmentUpload": editor,
+
+	"ListIndexes": viewer,
+	"CreateIndex": builder,
+	"UpdateIndex": builder,
+	"DeleteIndex": builder,
+	"SyncIndexes": builder,
+
+	"ListViews":  viewer,
+	"GetView":    viewer,
+	"CreateView": editor,
+	"UpdateView": editor,
+	"DeleteView": editor,
+
+	"ImportExternalTables": builder,
+	"ImportDatabaseSchema": builder,
+	"ImportExistingTable":  builder,
+
+	"CreateSQLView":  owner,
+	"RefreshSQLView": editor,
+
+	"GetOperation":    viewer,
+	"ListOperations":  viewer,
+	"CancelOperation": builder,
+
+	"ListMembers":  owner,
+	"SetMember":    owner,
+	"DeleteMember": owner,
+
+	"ListPermissions":  owner,
+	"SetPermission":    owner,
+	"DeletePermission": owner,
+
+	"ListAuditEvents": owner,
+
+	"CreateWebhook":         owner,
+	"ListWebhooks":          owner,
+	"DeleteWebhook":         owner,
+	"ListWebhookDeliveries": owner,
+
+	"RotateTenantKeys": owner,
+}
+
+// ownTenantMethods 是 tenant 注册表中允许 owner 调用的方法，请求的 id 必须是调用方所在的 tenant。
+var ownTenantMethods = map[string]bool{
+	"GetTenant":    true,
+	"UpdateTenant": true,
+}
+
+// memberRole 是缓存的成员角色。
+type memberRole struct {
+	role    lowcodev1.Role
+	expires time.Time
+}
+
+// Authorize 检查调用方是否可以调用 fullMethod（gRPC 完整方法名）。未开启认证时不检查（由前置的网关负责），
+// 平台管理员（token 允许访问任意 tenant）不受限制，其它调用方按 lc_members 中的角色检查。
+// req 用于检查 ownTenantMethods 的目标 tenant，streaming 方法传 nil。
+func (s *LowcodeService) Authorize(ctx context.Context, fullMethod string, req any) error {
+	id := auth.IdentityFromContext(ctx)
+	if id == nil || id.IsAdmin() {
+		return nil
+	}
+	method, ok := strings.CutPrefix(fullMethod, "/"+lowcodev1.LowcodeService_ServiceDesc.ServiceName+"/")
+	if !ok {
+		return nil
+	}
+	need, ok := methodRoles[method]
+	if !ok {
+		return apierr.New(lowcodev1.ErrorCode_PERMISSION_DENIED, codes.PermissionDenied, "%s requires a platform administrator", method)
+	}
+	if ownTenantMethods[method] {
+		target, _ := req.(interface{ GetId() string })
+		if target == nil || target.GetId() != tenant.FromContext(ctx) {
+			return apierr.New(lowcodev1.ErrorCode_PERMISSION_DENIED, codes.PermissionDenied, "%s is only allowed on the caller's own tenant", method)
+		}
+	}
+	role, err := s.callerRole(ctx)
+	if err != nil {
+		return err
+	}
+	if role < need {
+		return apierr.New(lowcodev1.ErrorCode_PERMISSION_DENIED, codes.PermissionDenied, "%s requires role %s", method, roleName(need))
+	}
+	return nil
+}
+
+// UnaryAuthorizer 在 unary RPC 执行前调用 Authorize，放在解析 tenant 和认证的拦截器之后。
+func (s *LowcodeService) UnaryAuthorizer(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.Authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuthorizer 在 streaming RPC 执行前调用 Authorize。
+func (s *LowcodeService) StreamAuthorizer(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.Authorize(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// callerRole 返回调用方在当前 tenant 中的角色，不是成员时为 ROLE_UNSPECIFIED。结果缓存 memberRoleTTL。
+func (s *LowcodeService) callerRole(ctx context.Context) (lowcodev1.Role, error) {
+	id := auth.IdentityFromContext(ctx)
+	if id == nil || id.IsAdmin() {
+		return owner, nil
+	}
+	if id.UserID == "" {
+		return lowcodev1.Role_ROLE_UNSPECIFIED, nil
+	}
+	key := tenant.FromContext(ctx) + "/" + id.UserID
+	if v, ok := s.memberRoles.Load(key); ok && time.Now().Before(v.(memberRole).expires) {
+		return v.(memberRole).role, nil
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var name string
+	err = pool.QueryRow(ctx, `SELECT role FROM lc_members WHERE user_id = $1`, id.UserID).Scan(&name)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return 0, err
+	}
+	role := parseRole(name)
+	s.memberRoles.Store(key, memberRole{role: role, expires: time.Now().Add(memberRoleTTL)})
+	return role, nil
+}
+
+// forgetMemberRole 在本进程中修改成员后丢弃缓存的角色。
+func (s *LowcodeService) forgetMemberRole(ctx context.Context, userID string) {
+	s.memberRoles.Delete(tenant.FromContext(ctx) + "/" + userID)
+}
+
+// roleName 返回 lc_members.role 中保存的角色名，如 ROLE_BUILDER -> builder。
+func roleName(r lowcodev1.Role) string {
+	return strings.ToLower(strings.TrimPrefix(r.String(), "ROLE_"))
+}
+
+// parseRole 是 roleName 的逆运算，未知的名字为 ROLE_UNSPECIFIED。
+func parseRole(name string) lowcodev1.Role {
+	if name == "" {
+		return lowcodev1.Role_ROLE_UNSPECIFIED
+	}
+	return lowcodev1.Role(lowcodev1.Role_value["ROLE_"+strings.ToUpper(name)])
+}
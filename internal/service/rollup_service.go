@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Rollup columns --------
+
+// rollup 列的 config：
+//
+//	relationship_column_id  本表的 relationship 列
+//	column_id               关联表中被聚合的列，count 时可省略（统计关联行数）
+//	function                count / sum / avg / min / max
+//
+// 值在读取时计算：每个 rollup 列对一页行只发一条 GROUP BY 查询。调用方不能读取（或脱敏的）被聚合列的 rollup 为空。
+var rollupFunctions = map[string]bool{"count": true, "sum": true, "avg": true, "min": true, "max": true}
+
+type rollupColumn struct {
+	Id       string
+	Rel      relationshipColumn
+	ColumnId string
+	Function string
+}
+
+// validateRollupConfig 在 AddColumn 时校验 rollup 配置：relationship 列属于本表，被聚合列属于关联表，sum / avg 要求数值列。
+func validateRollupConfig(ctx context.Context, tx pgx.Tx, tableKey string, cfg map[string]any) error {
+	relID, _ := cfg["relationship_column_id"].(string)
+	fn, _ := cfg["function"].(string)
+	colID, _ := cfg["column_id"].(string)
+	if relID == "" || !rollupFunctions[fn] {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"rollup requires config.relationship_column_id and config.function (count/sum/avg/min/max)")
+	}
+	if colID == "" && fn != "count" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "rollup %s requires config.column_id", fn)
+	}
+	pgType, kind, err := relatedColumnType(ctx, tx, tableKey, relID, colID)
+	if err != nil {
+		return err
+	}
+	if kind == encryptedKind {
+		return encryptedColumnError(colID)
+	}
+	if (fn == "sum" || fn == "avg") && !numericPgTypes[basePgType(pgType)] {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "rollup %s requires a numeric column, %s is %s", fn, colID, pgType)
+	}
+	return nil
+}
+
+// relatedColumnType 校验 relID 是本表的 relationship 列、colID 是其关联表中的物理列，返回该列的 pg_type 和 kind。
+// colID 为空时只校验 relationship 列。rollup / lookup 列共用。
+func relatedColumnType(ctx context.Context, tx pgx.Tx, tableKey, relID, colID string) (string, string, error) {
+	var target string
+	if err := tx.QueryRow(ctx, `
+		SELECT COALESCE(c.config->>'target_table_id', '')
+		FROM lc_columns c
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.id::text = $1 AND c.table_id = $2 AND COALESCE(ty.config->>'kind', '') = 'relationship'`,
+		relID, tableKey).Scan(&target); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", "", apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "relationship column %s not found in table %s", relID, tableKey)
+		}
+		return "", "", err
+	}
+	if colID == "" {
+		return "", "", nil
+	}
+	var pgType, kind string
+	if err := tx.QueryRow(ctx, `
+		SELECT ty.pg_type, COALESCE(ty.config->>'kind', '')
+		FROM lc_columns c
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.id::text = $1 AND c.table_id = $2 AND COALESCE(ty.config->>'kind', '') NOT IN (`+virtualKindsSQL+`)`,
+		colID, target).Scan(&pgType, &kind); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", "", apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found in table %s", colID, target)
+		}
+		return "", "", err
+	}
+	return pgType, kind, nil
+}
+
+// loadRollupColumns 加载表上的 rollup 列及其引用的 relationship 列。
+func (s *LowcodeService) loadRollupColumns(ctx context.Context, pool *pgxpool.Pool, tableID string) ([]rollupColumn, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT c.id, COALESCE(c.config->>'relationship_column_id', ''), COALESCE(c.config->>'column_id', ''), COALESCE(c.config->>'function', '')
+		FROM lc_columns c
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.table_id = $1 AND COALESCE(ty.config->>'kind', '') = 'rollup'
+		ORDER BY c.position`, tableID)
+	if err != nil {
+		return nil, err
+	}
+	rollups, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (rollupColumn, error) {
+		var rc rollupColumn
+		err := r.Scan(&rc.Id, &rc.Rel.Id, &rc.ColumnId, &rc.Function)
+		return rc, err
+	})
+	if err != nil || len(rollups) == 0 {
+		return nil, err
+	}
+
+	relIDs := make([]string, 0, len(rollups))
+	for _, rc := range rollups {
+		relIDs = append(relIDs, rc.Rel.Id)
+	}
+	rels, err := s.loadRelationshipColumns(ctx, pool, tableID, relIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]relationshipColumn, len(rels))
+	for _, r := range rels {
+		byID[r.Id] = r
+	}
+	out := rollups[:0]
+	for _, rc := range rollups {
+		rel, ok := byID[rc.Rel.Id]
+		if !ok || !rollupFunctions[rc.Function] {
+			continue
+		}
+		rc.Rel = rel
+		out = append(out, rc)
+	}
+	return out, nil
+}
+
+// computeVirtualColumns 为读出的 rows 填充读取时计算的虚拟列（rollup / lookup）。
+func (s *LowcodeService) computeVirtualColumns(ctx context.Context, pool *pgxpool.Pool, tableID string, rows []*lowcodev1.Row) error {
+	if err := s.computeRollups(ctx, pool, tableID, rows); err != nil {
+		return err
+	}
+	return s.computeLookups(ctx, pool, tableID, rows)
+}
+
+// computeRollups 为 rows 计算 rollup 列的值。没有关联行时 count 为 0，其它函数为空。
+func (s *LowcodeService) computeRollups(ctx context.Context, pool *pgxpool.Pool, tableID string, rows []*lowcodev1.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	rollups, err := s.loadRollupColumns(ctx, pool, tableID)
+	if err != nil {
+		return err
+	}
+	for _, rc := range rollups {
+		// 调用方不能读取被聚合的列（或列被脱敏、count(*) 时整张关联表不可读）时 rollup 为空，
+		// 否则 min / max 等会泄露原值
+		acc, err := s.tableAccess(ctx, pool, rc.Rel.TargetTableId)
+		if err != nil {
+			return err
+		}
+		if !rollupReadable(acc, rc.ColumnId) {
+			continue
+		}
+		values, err := s.rollupValues(ctx, pool, rc, rows)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			v, ok := values[row.Id]
+			if !ok && rc.Function == "count" {
+				v = &lowcodev1.Value{Kind: &lowcodev1.Value_NumberValue{NumberValue: 0}}
+			}
+			if v == nil {
+				continue
+			}
+			if row.Cells == nil {
+				row.Cells = make(map[string]*lowcodev1.Value)
+			}
+			row.Cells[rc.Id] = v
+		}
+	}
+	return nil
+}
+
+// rollupReadable 报告调用方能否看到聚合 columnID 的结果：列可读且未被脱敏；columnID 为空（count(*)）时关联表至少有一列可读。
+func rollupReadable(acc *columnAccess, columnID string) bool {
+	if columnID == "" {
+		return acc.readable()
+	}
+	return acc.checkRead(columnID) == nil
+}
+
+// rollupValues 用一条 GROUP BY 查询算出每个父行的聚合值，key 为父行 id。
+func (s *LowcodeService) rollupValues(ctx context.Context, pool *pgxpool.Pool, rc rollupColumn, rows []*lowcodev1.Row) (map[string]*lowcodev1.Value, error) {
+	targetCols, targetSchema, targetTable, err := s.loadColumns(ctx, pool, rc.Rel.TargetTableId)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]columnMeta, len(targetCols))
+	for _, c := range targetCols {
+		byID[c.Id] = c
+	}
+	agg := "count(*)"
+	if rc.ColumnId != "" {
+		colSQL, _, err := queryColumn(rc.ColumnId, byID)
+		if err != nil {
+			return nil, err
+		}
+		agg = fmt.Sprintf("%s(t.%s)", rc.Function, colSQL)
+	}
+	target := pgx.Identifier{targetSchema, targetTable}.Sanitize()
+
+	// groupSQL 按 key 分组；parentOf 把 key 映射回父行 id（多对一时多个父行可能共享一个 key）
+	var groupSQL string
+	var keys []string
+	parentOf := make(map[string][]string)
+	switch {
+	case rc.Rel.JoinTable != "":
+		groupSQL = fmt.Sprintf(`SELECT j.source_id::text, %s FROM %s j JOIN %s t ON t.id = j.target_id WHERE j.source_id = ANY($1)%s GROUP BY j.source_id`,
+			agg, pgx.Identifier{rc.Rel.JoinSchema, rc.Rel.JoinTable}.Sanitize(), target, andNotDeleted(targetCols))
+		for _, r := range rows {
+			keys = append(keys, r.Id)
+			parentOf[r.Id] = []string{r.Id}
+		}
+	case rc.Rel.LinkColumnId != "":
+		link, ok := byID[rc.Rel.LinkColumnId]
+		if !ok {
+			return nil, nil
+		}
+		linkSQL := "t." + pgx.Identifier{link.PgColumn}.Sanitize()
+		groupSQL = fmt.Sprintf(`SELECT %s::text, %s FROM %s t WHERE %s = ANY($1)%s GROUP BY %s`,
+			linkSQL, agg, target, linkSQL, andNotDeleted(targetCols), linkSQL)
+		for _, r := range rows {
+			keys = append(keys, r.Id)
+			parentOf[r.Id] = []string{r.Id}
+		}
+	default:
+		groupSQL = fmt.Sprintf(`SELECT t.id::text, %s FROM %s t WHERE t.id = ANY($1)%s GROUP BY t.id`,
+			agg, target, andNotDeleted(targetCols))
+		for _, r := range rows {
+			fk := cellString(r.Cells, rc.Rel.TargetColumnId)
+			if _, err := uuid.Parse(fk); err != nil {
+				continue
+			}
+			if _, seen := parentOf[fk]; !seen {
+				keys = append(keys, fk)
+			}
+			parentOf[fk] = append(parentOf[fk], r.Id)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	res, err := pool.Query(ctx, groupSQL, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	out := make(map[string]*lowcodev1.Value)
+	for res.Next() {
+		var key string
+		var v any
+		if err := res.Scan(&key, &v); err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		for _, parent := range parentOf[key] {
+			out[parent] = anyToValue(v)
+		}
+	}
+	return out, res.Err()
+}
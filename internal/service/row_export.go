@@ -0,0 +1,347 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Row export (CSV / XLSX / JSONL / Parquet) --------
+
+// exportBatch 是导出时每次查询的行数。
+const exportBatch = 1000
+
+// ExportContentType 返回导出格式的 MIME 类型和文件扩展名。
+func ExportContentType(format lowcodev1.ExportFormat) (contentType, ext string) {
+	switch format {
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", ".xlsx"
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return "application/x-ndjson", ".jsonl"
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return "application/vnd.apache.parquet", ".parquet"
+	}
+	return "text/csv; charset=utf-8", ".csv"
+}
+
+// rowEncoder 把导出的行写成某种文件格式。各格式都以 id 和列名标识列：CSV / XLSX 的表头、
+// JSONL 的 key、Parquet 的 schema。
+type rowEncoder interface {
+	header(cols []columnMeta) error
+	row(row *lowcodev1.Row, cols []columnMeta) error
+	close() error
+}
+
+// acc 用于 Parquet 的 schema：脱敏列的值总是文本。
+func newRowEncoder(format lowcodev1.ExportFormat, w io.Writer, tableID string, acc *columnAccess) (rowEncoder, error) {
+	switch format {
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, lowcodev1.ExportFormat_EXPORT_FORMAT_CSV:
+		return &csvEncoder{w: csv.NewWriter(w)}, nil
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		x, err := newXLSXWriter(w, tableID)
+		if err != nil {
+			return nil, err
+		}
+		return &xlsxEncoder{x: x}, nil
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return &jsonlEncoder{w: w}, nil
+	case lowcodev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return &parquetEncoder{w: w, acc: acc}, nil
+	}
+	return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "unsupported export format %s", format)
+}
+
+// ExportRowsTo 按 req 导出一张表的行，文件内容分块交给 onChunk。ExportRows 和 HTTP 导出路由共用。
+// 和 StreamRows 一样用 keyset 游标逐批读取，权限、脱敏和隐藏列的处理与 ListRows 相同。
+func (s *LowcodeService) ExportRowsTo(ctx context.Context, req *lowcodev1.ExportRowsRequest, onChunk func([]byte) error) error {
+	if req.GetTableId() == "" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	pool, err := s.tenants.ReadPoolFor(ctx)
+	if err != nil {
+		return err
+	}
+	page := &lowcodev1.ListRowsRequest{
+		TableId:       req.GetTableId(),
+		Filter:        req.GetFilter(),
+		Sorts:         req.GetSorts(),
+		IncludeHidden: req.GetIncludeHidden(),
+		ViewId:        req.GetViewId(),
+	}
+	var visible []string
+	if req.GetViewId() != "" {
+		if page, visible, err = s.applyView(ctx, pool, page); err != nil {
+			return err
+		}
+	}
+
+	cols, _, _, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return err
+	}
+	acc, err := s.tableAccess(ctx, pool, req.GetTableId())
+	if err != nil {
+		return err
+	}
+	if err := acc.checkTableRead(); err != nil {
+		return err
+	}
+	exported, err := exportColumns(cols, acc, req.GetColumnIds(), visible, req.GetIncludeHidden())
+	if err != nil {
+		return err
+	}
+
+	// 表头留在缓冲中，第一页查询失败时调用方还没有收到任何数据
+	bw := bufio.NewWriterSize(&chunkWriter{send: onChunk}, cellChunkSize)
+	enc, err := newRowEncoder(req.GetFormat(), bw, req.GetTableId(), acc)
+	if err != nil {
+		return err
+	}
+	if err := enc.header(exported); err != nil {
+		return err
+	}
+	for {
+		res, err := s.listRows(ctx, pool, page, exportBatch)
+		if err != nil {
+			return err
+		}
+		for _, row := range res.GetRows() {
+			if err := enc.row(row, exported); err != nil {
+				return err
+			}
+		}
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		page.PageToken = res.GetNextPageToken()
+	}
+	if err := enc.close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// exportColumns 返回导出的列：指定了 columnIDs 时按其顺序，否则为视图的可见列，都没有时为全部可读的列
+// （隐藏列只在 includeHidden 时导出，不包括 deleted_at）。指定的列必须存在且可读。
+func exportColumns(cols []columnMeta, acc *columnAccess, columnIDs, visible []string, includeHidden bool) ([]columnMeta, error) {
+	byID := make(map[string]columnMeta, len(cols))
+	for _, c := range cols {
+		byID[c.Id] = c
+	}
+	var out []columnMeta
+	if len(columnIDs) > 0 {
+		for _, id := range columnIDs {
+			c, ok := byID[id]
+			if !ok {
+				return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", id)
+			}
+			if !acc.canRead(id) {
+				return nil, acc.denied(accessRead, "column "+id)
+			}
+			if c.IsHidden && !includeHidden {
+				return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column %s is hidden, set include_hidden to export it", id)
+			}
+			out = append(out, c)
+		}
+		return out, nil
+	}
+	if len(visible) > 0 {
+		// 视图中可能还留着已删除的列
+		for _, id := range visible {
+			if c, ok := byID[id]; ok && acc.canRead(id) && (!c.IsHidden || includeHidden) {
+				out = append(out, c)
+			}
+		}
+		return out, nil
+	}
+	for _, c := range cols {
+		if (c.System && c.Id == "deleted_at") || (c.IsHidden && !includeHidden) || !acc.canRead(c.Id) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (s *LowcodeService) ExportRows(req *lowcodev1.ExportRowsRequest, stream lowcodev1.LowcodeService_ExportRowsServer) error {
+	return s.ExportRowsTo(stream.Context(), req, func(chunk []byte) error {
+		return stream.Send(&lowcodev1.ExportRowsResponse{Chunk: chunk})
+	})
+}
+
+// valueText 返回单元格值的文本形式：数字不用科学计数法，时间为 RFC 3339，json / list 为 JSON，bytes 为 base64，null 为空串。
+func valueText(v *lowcodev1.Value) string {
+	switch x := v.GetKind().(type) {
+	case nil, *lowcodev1.Value_NullValue:
+		return ""
+	case *lowcodev1.Value_StringValue:
+		return x.StringValue
+	case *lowcodev1.Value_NumberValue:
+		return strconv.FormatFloat(x.NumberValue, 'f', -1, 64)
+	case *lowcodev1.Value_BoolValue:
+		return strconv.FormatBool(x.BoolValue)
+	case *lowcodev1.Value_TimestampValue:
+		return x.TimestampValue.AsTime().UTC().Format(time.RFC3339Nano)
+	case *lowcodev1.Value_BytesValue:
+		return base64.StdEncoding.EncodeToString(x.BytesValue)
+	}
+	b, err := json.Marshal(valueToJSON(v))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// csvEncoder 写出 RFC 4180 的 CSV。以 = + - @ 和制表符、回车开头的文本前加 '，
+// 防止表格软件把单元格当作公式执行（CSV 注入）。
+type csvEncoder struct {
+	w      *csv.Writer
+	record []string
+}
+
+func (e *csvEncoder) header(cols []columnMeta) error {
+	e.record = make([]string, 0, len(cols)+1)
+	e.record = append(e.record, "id")
+	for _, c := range cols {
+		e.record = append(e.record, c.Name)
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) row(row *lowcodev1.Row, cols []columnMeta) error {
+	e.record = append(e.record[:0], row.GetId())
+	for _, c := range cols {
+		v := row.GetCells()[c.Id]
+		text := valueText(v)
+		if _, ok := v.GetKind().(*lowcodev1.Value_StringValue); ok && strings.IndexAny(text, "=+-@\t\r") == 0 {
+			text = "'" + text
+		}
+		e.record = append(e.record, text)
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// xlsxEncoder 写出只有一个工作表的 .xlsx，数字、布尔和时间保留为对应的单元格类型。
+type xlsxEncoder struct {
+	x      *xlsxWriter
+	values []*lowcodev1.Value
+}
+
+func (e *xlsxEncoder) header(cols []columnMeta) error {
+	e.values = make([]*lowcodev1.Value, 0, len(cols)+1)
+	e.values = append(e.values, stringValue("id"))
+	for _, c := range cols {
+		e.values = append(e.values, stringValue(c.Name))
+	}
+	return e.x.writeRow(e.values)
+}
+
+func (e *xlsxEncoder) row(row *lowcodev1.Row, cols []columnMeta) error {
+	e.values = append(e.values[:0], stringValue(row.GetId()))
+	for _, c := range cols {
+		e.values = append(e.values, row.GetCells()[c.Id])
+	}
+	return e.x.writeRow(e.values)
+}
+
+func (e *xlsxEncoder) close() error {
+	return e.x.close()
+}
+
+func stringValue(s string) *lowcodev1.Value {
+	return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: s}}
+}
+
+// jsonlEncoder 每行写出一个 JSON 对象，key 按列的顺序为 id 和列名；值同 valueToJSON：
+// 数字、布尔、json 和数组保留 JSON 类型，时间为 RFC 3339 字符串，bytes 为 base64，null 为 null。
+type jsonlEncoder struct {
+	w    io.Writer
+	keys [][]byte
+	line []byte
+}
+
+func (e *jsonlEncoder) header(cols []columnMeta) error {
+	e.keys = make([][]byte, len(cols))
+	for i, c := range cols {
+		k, err := json.Marshal(c.Name)
+		if err != nil {
+			return err
+		}
+		e.keys[i] = k
+	}
+	return nil
+}
+
+func (e *jsonlEncoder) row(row *lowcodev1.Row, cols []columnMeta) error {
+	id, err := json.Marshal(row.GetId())
+	if err != nil {
+		return err
+	}
+	e.line = append(append(e.line[:0], `{"id":`...), id...)
+	for i, c := range cols {
+		v, err := json.Marshal(valueToJSON(row.GetCells()[c.Id]))
+		if err != nil {
+			return err
+		}
+		e.line = append(append(append(append(e.line, ','), e.keys[i]...), ':'), v...)
+	}
+	e.line = append(e.line, '}', '\n')
+	_, err = e.w.Write(e.line)
+	return err
+}
+
+func (e *jsonlEncoder) close() error { return nil }
+
+// parquetEncoder 写出 Parquet 文件：id 为必填的字符串列，其它列都可为 null，类型见 parquetKindFor。
+type parquetEncoder struct {
+	w      io.Writer
+	acc    *columnAccess
+	p      *parquetWriter
+	values []*lowcodev1.Value
+}
+
+func (e *parquetEncoder) header(cols []columnMeta) error {
+	pcols := make([]*parquetColumn, 0, len(cols)+1)
+	pcols = append(pcols, &parquetColumn{name: "id", kind: parquetString, required: true})
+	for _, c := range cols {
+		kind := parquetKindFor(c)
+		if _, masked := e.acc.masked(c.Id); masked {
+			kind = parquetString
+		}
+		pcols = append(pcols, &parquetColumn{name: c.Name, kind: kind})
+	}
+	p, err := newParquetWriter(e.w, pcols)
+	if err != nil {
+		return err
+	}
+	e.p = p
+	return nil
+}
+
+func (e *parquetEncoder) row(row *lowcodev1.Row, cols []columnMeta) error {
+	e.values = append(e.values[:0], stringValue(row.GetId()))
+	for _, c := range cols {
+		e.values = append(e.values, row.GetCells()[c.Id])
+	}
+	return e.p.writeRow(e.values)
+}
+
+func (e *parquetEncoder) close() error {
+	return e.p.close()
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+)
+
+// -------- Row history --------
+
+// lc_row_history.action 的取值。
+const (
+	rowVersionUpdate  = "update"
+	rowVersionDelete  = "delete"
+	rowVersionRestore = "restore"
+)
+
+const maxRowHistoryPageSize = 1000
+
+// lockRows 在事务中锁住并返回满足 cond 的行（修改前的完整行），用于写入 lc_row_history。
+func lockRows(ctx context.Context, tx pgx.Tx, cols []columnMeta, schemaName, tableName, cond string, args ...any) ([]*lowcodev1.Row, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT %s FROM %s.%s WHERE %s FOR UPDATE`,
+		rowColumnsSQL(cols),
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		cond,
+	), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*lowcodev1.Row
+	for rows.Next() {
+		row, err := scanRow(rows, cols)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// recordRowVersions 把 rows（修改前的完整行）作为 action 版本写入 lc_row_history，与修改在同一个事务中。
+func recordRowVersions(ctx context.Context, tx pgx.Tx, tableID string, cols []columnMeta, action string, rows []*lowcodev1.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var columnIDs []string
+	for _, c := range cols {
+		if !c.System {
+			columnIDs = append(columnIDs, c.Id)
+		}
+	}
+	batch := &pgx.Batch{}
+	for _, row := range rows {
+		b, err := protojson.Marshal(row)
+		if err != nil {
+			return err
+		}
+		batch.Queue(`
+			INSERT INTO lc_row_history (table_id, row_id, action, row, column_ids, user_id)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			tableID, row.Id, action, string(b), columnIDs, auth.UserIDFromContext(ctx))
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// rowVersionFieldsSQL 是 scanRowVersion 需要的 lc_row_history 字段。
+const rowVersionFieldsSQL = `id::text, action, row, column_ids, user_id, created_at`
+
+// scanRowVersion 扫描一个版本，同时返回当时表中的非系统列。
+func scanRowVersion(row pgx.Row, extra ...any) (*lowcodev1.RowVersion, []string, error) {
+	var v lowcodev1.RowVersion
+	var action string
+	var raw []byte
+	var columnIDs []string
+	var createdAt time.Time
+	dest := append([]any{&v.Id, &action, &raw, &columnIDs, &v.UserId, &createdAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, nil, err
+	}
+	v.Action = lowcodev1.RowVersionAction(lowcodev1.RowVersionAction_value["ROW_VERSION_ACTION_"+strings.ToUpper(action)])
+	v.Row = &lowcodev1.Row{}
+	if err := protojson.Unmarshal(raw, v.Row); err != nil {
+		return nil, nil, fmt.Errorf("row version %s: decode: %w", v.Id, err)
+	}
+	v.CreatedAt = timestamppb.New(createdAt)
+	return &v, columnIDs, nil
+}
+
+// GetRowHistory 按时间倒序返回行的历史版本；设置 as_of 时另外返回该时刻的行：
+// as_of 之后最早的版本就是当时的值，之后没有修改时为当前的行。
+func (s *LowcodeService) GetRowHistory(ctx context.Context, req *lowcodev1.GetRowHistoryRequest) (*lowcodev1.GetRowHistoryResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetRowId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and row_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	acc, err := s.tableAccess(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkTableRead(); err != nil {
+		return nil, err
+	}
+
+	var a sqlArgs
+	conds := []string{"table_id = " + a.add(req.GetTableId()), "row_id::text = " + a.add(req.GetRowId())}
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if len(token.Keys) != 1 || token.Keys[0] == nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid page_token")
+		}
+		conds = append(conds, fmt.Sprintf("(created_at, id) < (%s::timestamptz, %s::uuid)", a.add(*token.Keys[0]), a.add(token.ID)))
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > maxRowHistoryPageSize {
+		pageSize = maxRowHistoryPageSize
+	}
+	// 多取一行用来判断是否还有下一页
+	rows, err := pool.Query(ctx, fmt.Sprintf(`SELECT %s, created_at::text FROM lc_row_history WHERE %s ORDER BY created_at DESC, id DESC LIMIT %s`,
+		rowVersionFieldsSQL, strings.Join(conds, " AND "), a.add(pageSize+1)), a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.GetRowHistoryResponse
+	var lastKey string
+	for rows.Next() {
+		if len(res.Versions) == int(pageSize) {
+			res.NextPageToken = encodePageToken(pageToken{ID: res.Versions[len(res.Versions)-1].GetId(), Keys: []*string{&lastKey}})
+			break
+		}
+		var key string
+		v, _, err := scanRowVersion(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+		lastKey = key
+		if err := s.openRows(ctx, cols, v.Row); err != nil {
+			return nil, err
+		}
+		acc.redactRows(v.Row)
+		res.Versions = append(res.Versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if req.GetAsOf() != nil {
+		row, err := s.rowAsOf(ctx, pool, cols, schemaName, tableName, req.GetTableId(), req.GetRowId(), req.GetAsOf().AsTime())
+		if err != nil {
+			return nil, err
+		}
+		if err := s.openRows(ctx, cols, row); err != nil {
+			return nil, err
+		}
+		acc.redactRows(row)
+		res.Row = row
+	}
+	return &res, nil
+}
+
+// rowAsOf 返回 asOf 时刻的行。表有 created_at 系统列时，晚于 asOf 创建的行视为不存在。
+func (s *LowcodeService) rowAsOf(ctx context.Context, q querier, cols []columnMeta, schemaName, tableName, tableID, rowID string, asOf time.Time) (*lowcodev1.Row, error) {
+	notFound := apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s did not exist at %s", rowID, asOf.Format(time.RFC3339))
+	v, _, err := scanRowVersion(q.QueryRow(ctx, `
+		SELECT `+rowVersionFieldsSQL+` FROM lc_row_history
+		WHERE table_id = $1 AND row_id::text = $2 AND created_at > $3
+		ORDER BY created_at, id LIMIT 1`, tableID, rowID, asOf))
+	var row *lowcodev1.Row
+	switch {
+	case err == nil:
+		row = v.Row
+	case errors.Is(err, pgx.ErrNoRows):
+		// 之后没有修改：当前的行（含回收站中的行）
+		row, err = scanRow(q.QueryRow(ctx, fmt.Sprintf(`SELECT %s FROM %s.%s WHERE id::text = $1`,
+			rowColumnsSQL(cols), pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{tableName}.Sanitize()), rowID), cols)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, notFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+	if created := row.GetCells()["created_at"].GetTimestampValue(); created != nil && created.AsTime().After(asOf) {
+		return nil, notFound
+	}
+	return row, nil
+}
+
+// RestoreRowVersion 把行恢复为 version_id 版本的值：当时存在的非系统列写回版本中的值（含 NULL），
+// 之后新增的列保持不变；回收站中的行同时恢复，已物理删除的行按原 id 重新插入。覆盖前的行作为 restore 版本记录，
+// 恢复本身也可以撤销。
+func (s *LowcodeService) RestoreRowVersion(ctx context.Context, req *lowcodev1.RestoreRowVersionRequest) (*lowcodev1.RestoreRowVersionResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetRowId() == "" || req.GetVersionId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id, row_id and version_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	v, columnIDs, err := scanRowVersion(pool.QueryRow(ctx, `
+		SELECT `+rowVersionFieldsSQL+` FROM lc_row_history
+		WHERE id::text = $1 AND table_id = $2 AND row_id::text = $3`,
+		req.GetVersionId(), req.GetTableId(), req.GetRowId()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "version %s of row %s not found", req.GetVersionId(), req.GetRowId())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 要写回的列和值：版本中为 NULL 的列写 NULL
+	existed := make(map[string]bool, len(columnIDs))
+	for _, id := range columnIDs {
+		existed[id] = true
+	}
+	var restoreCols []columnMeta
+	var restoreIDs []string
+	var args []any
+	for _, c := range cols {
+		if c.System || !existed[c.Id] {
+			continue
+		}
+		var arg any
+		if val, ok := v.Row.GetCells()[c.Id]; ok {
+			if arg, err = cellArg(c, val); err != nil {
+				return nil, err
+			}
+		}
+		restoreCols = append(restoreCols, c)
+		restoreIDs = append(restoreIDs, c.Id)
+		args = append(args, arg)
+	}
+	if len(restoreCols) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "none of the columns in version %s exist any more", req.GetVersionId())
+	}
+	acc, err := s.tableAccess(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkTableWrite(); err != nil {
+		return nil, err
+	}
+	if err := acc.checkWrite(nil, restoreIDs); err != nil {
+		return nil, err
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	current, err := lockRows(ctx, tx, cols, schemaName, tableName, "id = $1", req.GetRowId())
+	if err != nil {
+		return nil, err
+	}
+
+	rel := pgx.Identifier{schemaName}.Sanitize() + "." + pgx.Identifier{tableName}.Sanitize()
+	var stmt string
+	if len(current) > 0 {
+		sets := make([]string, len(restoreCols))
+		for i, c := range restoreCols {
+			sets[i] = fmt.Sprintf("%s = $%d", pgx.Identifier{c.PgColumn}.Sanitize(), i+1)
+		}
+		auditCols, auditArgs := auditWrites(ctx, cols, false)
+		for i, col := range auditCols {
+			sets = append(sets, fmt.Sprintf("%s = $%d", col, len(args)+1))
+			args = append(args, auditArgs[i])
+		}
+		if softDeletes(cols) {
+			sets = append(sets, "deleted_at = NULL")
+		}
+		args = append(args, req.GetRowId())
+		stmt = fmt.Sprintf(`UPDATE %s SET %s WHERE id = $%d RETURNING %s`, rel, strings.Join(sets, ", "), len(args), rowColumnsSQL(cols))
+		if err := recordRowVersions(ctx, tx, req.GetTableId(), cols, rowVersionRestore, current); err != nil {
+			return nil, err
+		}
+	} else {
+		pgCols := []string{"id"}
+		for _, c := range restoreCols {
+			pgCols = append(pgCols, pgx.Identifier{c.PgColumn}.Sanitize())
+		}
+		auditCols, auditArgs := auditWrites(ctx, cols, true)
+		pgCols = append(pgCols, auditCols...)
+		args = append(append([]any{req.GetRowId()}, args...), auditArgs...)
+		params := make([]string, len(args))
+		for i := range params {
+			params[i] = fmt.Sprintf("$%d", i+1)
+		}
+		stmt = fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) RETURNING %s`, rel, strings.Join(pgCols, ", "), strings.Join(params, ", "), rowColumnsSQL(cols))
+	}
+	row, err := scanRow(tx.QueryRow(ctx, stmt, args...), cols)
+	if err != nil {
+		return nil, checkViolation(err, cols)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	var before *lowcodev1.Row
+	if len(current) > 0 {
+		before = current[0]
+	}
+	auditRowChange(ctx, row.Id, before, row, restoreIDs)
+	if err := s.openRows(ctx, cols, row); err != nil {
+		return nil, err
+	}
+	acc.redactRows(row)
+	return &lowcodev1.RestoreRowVersionResponse{Row: row}, nil
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Row query (filter / sort / paging) --------
+
+// pageToken 是 ListRows 的 keyset 游标：记录上一页最后一行的 id 和各排序列的值（text 形式，NULL 为 nil）。
+// 对客户端是不透明的 base64 字符串。
+type pageToken struct {
+	ID   string    `json:"id"`
+	Keys []*string `json:"k,omitempty"`
+}
+
+func encodePageToken(t pageToken) string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var t pageToken
+	if s == "" {
+		return t, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(b, &t)
+	}
+	if err != nil || t.ID == "" {
+		return pageToken{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid page_token")
+	}
+	return t, nil
+}
+
+// clampPageSize 应用 MAX_ROW：既是默认值也是上限；未配置时默认 50、上限 100。
+func (s *LowcodeService) clampPageSize(pageSize int32) int32 {
+	maxRow := s.maxRow
+	if pageSize <= 0 {
+		if maxRow > 0 {
+			pageSize = maxRow
+		} else {
+			pageSize = 50
+		}
+	}
+	if maxRow > 0 && pageSize > maxRow {
+		pageSize = maxRow
+	} else if maxRow <= 0 && pageSize > 100 {
+		pageSize = 100
+	}
+	return pageSize
+}
+
+// sqlArgs 收集参数并返回对应的占位符，保证占位符编号和参数顺序一致。
+type sqlArgs struct {
+	args []any
+}
+
+func (a *sqlArgs) add(v any) string {
+	a.args = append(a.args, v)
+	return fmt.Sprintf("$%d", len(a.args))
+}
+
+// addValue 按列类型转换过滤值后再加入参数。
+func (a *sqlArgs) addValue(v *lowcodev1.Value, pgType, columnID string) (string, error) {
+	arg, err := coerceValue(v, pgType)
+	if err != nil {
+		return "", filterError("filter on column %s: %v", columnID, err)
+	}
+	return a.add(arg), nil
+}
+
+// maxFilterDepth 限制嵌套 group 的层数，避免生成过深的 SQL。
+const maxFilterDepth = 8
+
+// compileFilter 把 RowFilter 编译成参数化的 WHERE 片段；cols 以 column id 为 key，
+// 另外 column_id = "id" 表示行 id。返回空串表示没有条件。
+func compileFilter(f *lowcodev1.RowFilter, cols map[string]columnMeta, a *sqlArgs) (string, error) {
+	return compileFilterDepth(f, cols, a, 0)
+}
+
+func compileFilterDepth(f *lowcodev1.RowFilter, cols map[string]columnMeta, a *sqlArgs, depth int) (string, error) {
+	if f == nil {
+		return "", nil
+	}
+	if depth > maxFilterDepth {
+		return "", filterError("filter is nested too deeply (max %d)", maxFilterDepth)
+	}
+	switch k := f.Kind.(type) {
+	case *lowcodev1.RowFilter_Condition:
+		return compileCondition(k.Condition, cols, a)
+	case *lowcodev1.RowFilter_Group:
+		var parts []string
+		for _, sub := range k.Group.GetFilters() {
+			part, err := compileFilterDepth(sub, cols, a, depth+1)
+			if err != nil {
+				return "", err
+			}
+			if part != "" {
+				parts = append(parts, "("+part+")")
+			}
+		}
+		sep := " AND "
+		if k.Group.GetCombinator() == lowcodev1.FilterGroup_OR {
+			sep = " OR "
+		}
+		return strings.Join(parts, sep), nil
+	default:
+		return "", nil
+	}
+}
+
+func compileCondition(c *lowcodev1.FilterCondition, cols map[string]columnMeta, a *sqlArgs) (string, error) {
+	colSQL, pgType, err := queryColumn(c.GetColumnId(), cols)
+	if err != nil {
+		return "", err
+	}
+
+	op := c.GetOperator()
+	switch op {
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_IS_NULL:
+		return colSQL + " IS NULL", nil
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_IS_NOT_NULL:
+		return colSQL + " IS NOT NULL", nil
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_ARRAY_CONTAINS, lowcodev1.FilterOperator_FILTER_OPERATOR_ARRAY_OVERLAPS:
+		return arrayFilterSQL(colSQL, pgType, c, a)
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_IN, lowcodev1.FilterOperator_FILTER_OPERATOR_NOT_IN:
+		if len(c.GetValues()) == 0 {
+			// 空集合：IN 永远为假，NOT IN 永远为真
+			if op == lowcodev1.FilterOperator_FILTER_OPERATOR_IN {
+				return "FALSE", nil
+			}
+			return "TRUE", nil
+		}
+		ph := make([]string, len(c.GetValues()))
+		for i, v := range c.GetValues() {
+			if ph[i], err = a.addValue(v, pgType, c.GetColumnId()); err != nil {
+				return "", err
+			}
+		}
+		kw := " IN "
+		if op == lowcodev1.FilterOperator_FILTER_OPERATOR_NOT_IN {
+			kw = " NOT IN "
+		}
+		return colSQL + kw + "(" + strings.Join(ph, ", ") + ")", nil
+	}
+
+	if c.GetValue() == nil {
+		return "", filterError("filter on column %s requires a value", c.GetColumnId())
+	}
+	switch op {
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_CONTAINS, lowcodev1.FilterOperator_FILTER_OPERATOR_STARTS_WITH:
+		str, ok := c.GetValue().Kind.(*lowcodev1.Value_StringValue)
+		if !ok {
+			return "", filterError("%s requires a string value", op)
+		}
+		pattern := escapeLike(str.StringValue) + "%"
+		if op == lowcodev1.FilterOperator_FILTER_OPERATOR_CONTAINS {
+			pattern = "%" + pattern
+		}
+		return colSQL + "::text ILIKE " + a.add(pattern), nil
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_WITHIN_RADIUS:
+		return withinRadiusSQL(colSQL, pgType, c, a)
+	}
+
+	var cmp string
+	switch op {
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_EQ:
+		cmp = "="
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_NEQ:
+		// NEQ 同时匹配 NULL，与表格类产品的习惯一致
+		ph, err := a.addValue(c.GetValue(), pgType, c.GetColumnId())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s IS DISTINCT FROM %s", colSQL, ph), nil
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_LT:
+		cmp = "<"
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_LTE:
+		cmp = "<="
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_GT:
+		cmp = ">"
+	case lowcodev1.FilterOperator_FILTER_OPERATOR_GTE:
+		cmp = ">="
+	default:
+		return "", filterError("unsupported filter operator %s", op)
+	}
+	ph, err := a.addValue(c.GetValue(), pgType, c.GetColumnId())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", colSQL, cmp, ph), nil
+}
+
+// arrayFilterSQL 编译数组列的 ARRAY_CONTAINS / ARRAY_OVERLAPS：元素取自 values，
+// 也可以用 list_value 作为 value。两者都能使用 GIN 索引。
+func arrayFilterSQL(colSQL, pgType string, c *lowcodev1.FilterCondition, a *sqlArgs) (string, error) {
+	if !strings.HasSuffix(basePgType(pgType), "[]") {
+		return "", filterError("%s requires an array column, %s is %s", c.GetOperator(), c.GetColumnId(), pgType)
+	}
+	elems := c.GetValues()
+	if len(elems) == 0 {
+		elems = c.GetValue().GetListValue().GetValues()
+	}
+	if len(elems) == 0 {
+		return "", filterError("%s on column %s requires values", c.GetOperator(), c.GetColumnId())
+	}
+	list := &lowcodev1.Value{Kind: &lowcodev1.Value_ListValue{ListValue: &lowcodev1.ValueList{Values: elems}}}
+	ph, err := a.addValue(list, pgType, c.GetColumnId())
+	if err != nil {
+		return "", err
+	}
+	op := "@>"
+	if c.GetOperator() == lowcodev1.FilterOperator_FILTER_OPERATOR_ARRAY_OVERLAPS {
+		op = "&&"
+	}
+	return fmt.Sprintf("%s %s %s::%s", colSQL, op, ph, pgType), nil
+}
+
+// queryColumn 把对外的 column id 解析成 SQL 中的列引用和 PG 类型。加密列在库中只有密文，不能用于查询。
+func queryColumn(columnID string, cols map[string]columnMeta) (string, string, error) {
+	if columnID == "id" {
+		return "id", "uuid", nil
+	}
+	meta, ok := cols[columnID]
+	if !ok {
+		return "", "", apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.InvalidArgument, "column %s not found", columnID)
+	}
+	if meta.encrypted() {
+		return "", "", encryptedColumnError(columnID)
+	}
+	return pgx.Identifier{meta.PgColumn}.Sanitize(), meta.PgType, nil
+}
+
+// escapeLike 转义 LIKE 模式中的通配符（PG 默认转义符为反斜杠）。
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func filterError(format string, args ...any) error {
+	return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, format, args...)
+}
+
+// sortKey 是编译后的一个排序列。
+type sortKey struct {
+	colSQL     string
+	pgType     string
+	desc       bool
+	nullsFirst bool
+}
+
+func compileSorts(specs []*lowcodev1.SortSpec, cols map[string]columnMeta) ([]sortKey, error) {
+	keys := make([]sortKey, 0, len(specs))
+	for _, sp := range specs {
+		colSQL, pgType, err := queryColumn(sp.GetColumnId(), cols)
+		if err != nil {
+			return nil, err
+		}
+		k := sortKey{colSQL: colSQL, pgType: pgType, desc: sp.GetDirection() == lowcodev1.SortSpec_DESC}
+		switch sp.GetNulls() {
+		case lowcodev1.SortSpec_NULLS_FIRST:
+			k.nullsFirst = true
+		case lowcodev1.SortSpec_NULLS_LAST:
+			k.nullsFirst = false
+		default:
+			k.nullsFirst = k.desc
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// orderBySQL 生成 ORDER BY 列表，最后追加 id 保证顺序确定。
+func orderBySQL(keys []sortKey) string {
+	parts := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		dir := "ASC"
+		if k.desc {
+			dir = "DESC"
+		}
+		nulls := "NULLS LAST"
+		if k.nullsFirst {
+			nulls = "NULLS FIRST"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %s", k.colSQL, dir, nulls))
+	}
+	parts = append(parts, "id ASC")
+	return strings.Join(parts, ", ")
+}
+
+// keysetSQL 生成“排在游标之后”的条件：
+// (k0 在 v0 之后) OR (k0 = v0 AND k1 在 v1 之后) OR ... OR (全部相等 AND id > last_id)。
+// 游标值以 text 传入，再在 SQL 中转回列类型。
+func keysetSQL(keys []sortKey, t pageToken, a *sqlArgs) (string, error) {
+	if len(t.Keys) != len(keys) {
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "page_token does not match sorts")
+	}
+	var ors, eqs []string
+	for i, k := range keys {
+		v := t.Keys[i]
+		var val string
+		if v != nil {
+			val = fmt.Sprintf("%s::text::%s", a.add(*v), k.pgType)
+		}
+		if after := keyAfter(k, val, v == nil); after != "" {
+			ors = append(ors, strings.Join(append(append([]string{}, eqs...), after), " AND "))
+		}
+		if v == nil {
+			eqs = append(eqs, k.colSQL+" IS NULL")
+		} else {
+			eqs = append(eqs, fmt.Sprintf("%s = %s", k.colSQL, val))
+		}
+	}
+	ors = append(ors, strings.Join(append(eqs, "id > "+a.add(t.ID)), " AND "))
+	return "(" + strings.Join(ors, ") OR (") + ")", nil
+}
+
+// keyAfter 返回单列“排在 val 之后”的条件；没有任何值能排在后面时返回空串。
+func keyAfter(k sortKey, val string, isNull bool) string {
+	cmp := ">"
+	if k.desc {
+		cmp = "<"
+	}
+	switch {
+	case isNull && k.nullsFirst:
+		return k.colSQL + " IS NOT NULL"
+	case isNull:
+		return ""
+	case k.nullsFirst:
+		return fmt.Sprintf("%s %s %s", k.colSQL, cmp, val)
+	default:
+		return fmt.Sprintf("(%s %s %s OR %s IS NULL)", k.colSQL, cmp, val, k.colSQL)
+	}
+}
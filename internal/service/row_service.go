@@ -7,9 +7,10 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/grpc/codes"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
 )
 
 // -------- Row / Cell --------
@@ -21,34 +22,69 @@ func (s *LowcodeService) CreateRow(ctx context.Context, req *lowcodev1.CreateRow
 	}
 	tableID := req.GetTableId()
 	if tableID == "" {
-		return nil, fmt.Errorf("table_id is required")
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
 	}
 
 	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
 	if err != nil {
 		return nil, err
 	}
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkWrite(req.GetCells(), nil); err != nil {
+		return nil, err
+	}
 
-	if len(req.GetCells()) == 0 {
-		return nil, fmt.Errorf("cells is empty")
+	row, err := s.insertRow(ctx, pool, cols, schemaName, tableName, req.GetCells())
+	if err != nil {
+		return nil, err
+	}
+	auditRowChange(ctx, row.Id, nil, row, cellColumnIDs(req.GetCells(), nil))
+	if err := s.openRows(ctx, cols, row); err != nil {
+		return nil, err
+	}
+	acc.redactRows(row)
+	return &lowcodev1.CreateRowResponse{Row: row}, nil
+}
+
+// insertRow 校验 cells（以列 id 为 key）并插入一行，返回插入后的完整行（加密列为密文）。
+func (s *LowcodeService) insertRow(ctx context.Context, q querier, cols []columnMeta, schemaName, tableName string, cells map[string]*lowcodev1.Value) (*lowcodev1.Row, error) {
+	if len(cells) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "cells is empty")
+	}
+	if v := validateCells(cols, cells, nil, true, ""); len(v) > 0 {
+		return nil, apierr.NewValidation(v)
+	}
+	cells, err := s.sealCells(ctx, cols, cells)
+	if err != nil {
+		return nil, err
 	}
 
 	var pgCols []string
 	var args []any
 	argPos := 1
 	for _, c := range cols {
-		val, ok := req.Cells[c.Id]
-		if !ok {
+		val, ok := cells[c.Id]
+		if !ok || c.System {
 			continue
 		}
-		pgCols = append(pgCols, c.PgColumn)
-		args = append(args, valueToAnyForColumn(val, c.PgType))
+		arg, err := cellArg(c, val)
+		if err != nil {
+			return nil, err
+		}
+		pgCols = append(pgCols, pgx.Identifier{c.PgColumn}.Sanitize())
+		args = append(args, arg)
 		_ = argPos
 	}
 
 	if len(pgCols) == 0 {
 		return nil, fmt.Errorf("no valid cells for known columns")
 	}
+	auditCols, auditArgs := auditWrites(ctx, cols, true)
+	pgCols = append(pgCols, auditCols...)
+	args = append(args, auditArgs...)
 
 	colsSQL := strings.Join(pgCols, ", ")
 	params := make([]string, len(pgCols))
@@ -57,27 +93,23 @@ func (s *LowcodeService) CreateRow(ctx context.Context, req *lowcodev1.CreateRow
 	}
 	paramSQL := strings.Join(params, ", ")
 
-	insert := fmt.Sprintf(`INSERT INTO %s.%s (%s) VALUES (%s) RETURNING id`,
+	// RETURNING 全部物理列，让默认值等服务端生成的值也返回给调用方。
+	insert := fmt.Sprintf(`INSERT INTO %s.%s (%s) VALUES (%s) RETURNING %s`,
 		pgx.Identifier{schemaName}.Sanitize(),
 		pgx.Identifier{tableName}.Sanitize(),
 		colsSQL,
 		paramSQL,
+		rowColumnsSQL(cols),
 	)
 
-	var rowID string
-	if err := pool.QueryRow(ctx, insert, args...).Scan(&rowID); err != nil {
-		return nil, err
+	row, err := scanRow(q.QueryRow(ctx, insert, args...), cols)
+	if err != nil {
+		return nil, checkViolation(err, cols)
 	}
-
-	return &lowcodev1.CreateRowResponse{
-		Row: &lowcodev1.Row{
-			Id:    rowID,
-			Cells: req.GetCells(),
-		},
-	}, nil
+	return row, nil
 }
 
-// 这里为了简单，只实现按 id 精确匹配的 UpdateRow，BulkUpsertRows 里会复用。
+// UpdateRow 按 id 更新给定的 cells，返回更新后的完整行。
 func (s *LowcodeService) UpdateRow(ctx context.Context, req *lowcodev1.UpdateRowRequest) (*lowcodev1.UpdateRowResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
@@ -85,50 +117,110 @@ func (s *LowcodeService) UpdateRow(ctx context.Context, req *lowcodev1.UpdateRow
 	}
 	tableID := req.GetTableId()
 	if tableID == "" || req.GetRowId() == "" {
-		return nil, fmt.Errorf("table_id and row_id are required")
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and row_id are required")
 	}
 
 	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
 	if err != nil {
 		return nil, err
 	}
-	if len(req.GetCells()) == 0 {
-		return nil, fmt.Errorf("cells is empty")
+	if len(req.GetCells()) == 0 && len(req.GetClearColumnIds()) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "cells and clear_column_ids are empty")
+	}
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkWrite(req.GetCells(), req.GetClearColumnIds()); err != nil {
+		return nil, err
+	}
+	clear := make(map[string]bool, len(req.GetClearColumnIds()))
+	for _, id := range req.GetClearColumnIds() {
+		clear[id] = true
+	}
+	if v := validateCells(cols, req.GetCells(), clear, false, ""); len(v) > 0 {
+		return nil, apierr.NewValidation(v)
+	}
+	cells, err := s.sealCells(ctx, cols, req.GetCells())
+	if err != nil {
+		return nil, err
 	}
 
 	var setParts []string
 	var args []any
 	argIdx := 1
 	for _, c := range cols {
-		val, ok := req.Cells[c.Id]
+		if c.System {
+			continue
+		}
+		if clear[c.Id] {
+			setParts = append(setParts, pgx.Identifier{c.PgColumn}.Sanitize()+" = NULL")
+			continue
+		}
+		val, ok := cells[c.Id]
 		if !ok {
 			continue
 		}
-		setParts = append(setParts, fmt.Sprintf("%s = $%d", c.PgColumn, argIdx))
-		args = append(args, valueToAnyForColumn(val, c.PgType))
+		arg, err := cellArg(c, val)
+		if err != nil {
+			return nil, err
+		}
+		setParts = append(setParts, fmt.Sprintf("%s = $%d", pgx.Identifier{c.PgColumn}.Sanitize(), argIdx))
+		args = append(args, arg)
 		argIdx++
 	}
 	if len(setParts) == 0 {
 		return nil, fmt.Errorf("no valid cells for known columns")
 	}
+	auditCols, auditArgs := auditWrites(ctx, cols, false)
+	for i, col := range auditCols {
+		setParts = append(setParts, fmt.Sprintf("%s = $%d", col, argIdx))
+		args = append(args, auditArgs[i])
+		argIdx++
+	}
+
+	// 修改前的行写入 lc_row_history，和 UPDATE 在同一个事务中
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	before, err := lockRows(ctx, tx, cols, schemaName, tableName, "id = $1"+andNotDeleted(cols), req.GetRowId())
+	if err != nil {
+		return nil, err
+	}
+	if len(before) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", req.GetRowId())
+	}
+	if err := recordRowVersions(ctx, tx, tableID, cols, rowVersionUpdate, before); err != nil {
+		return nil, err
+	}
 
 	args = append(args, req.GetRowId())
-	update := fmt.Sprintf(`UPDATE %s.%s SET %s WHERE id = $%d`,
+	update := fmt.Sprintf(`UPDATE %s.%s SET %s WHERE id = $%d%s RETURNING %s`,
 		pgx.Identifier{schemaName}.Sanitize(),
 		pgx.Identifier{tableName}.Sanitize(),
 		strings.Join(setParts, ", "),
 		argIdx,
+		andNotDeleted(cols),
+		rowColumnsSQL(cols),
 	)
-	if _, err := pool.Exec(ctx, update, args...); err != nil {
+	row, err := scanRow(tx.QueryRow(ctx, update, args...), cols)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", req.GetRowId())
+		}
+		return nil, checkViolation(err, cols)
+	}
+	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
-
-	return &lowcodev1.UpdateRowResponse{
-		Row: &lowcodev1.Row{
-			Id:    req.GetRowId(),
-			Cells: req.GetCells(),
-		},
-	}, nil
+	auditRowChange(ctx, row.Id, before[0], row, cellColumnIDs(req.GetCells(), req.GetClearColumnIds()))
+	if err := s.openRows(ctx, cols, row); err != nil {
+		return nil, err
+	}
+	acc.redactRows(row)
+	return &lowcodev1.UpdateRowResponse{Row: row}, nil
 }
 
 func (s *LowcodeService) DeleteRow(ctx context.Context, req *lowcodev1.DeleteRowRequest) (*lowcodev1.DeleteRowResponse, error) {
@@ -138,33 +230,208 @@ func (s *LowcodeService) DeleteRow(ctx context.Context, req *lowcodev1.DeleteRow
 	}
 	tableID := req.GetTableId()
 	if tableID == "" || req.GetRowId() == "" {
-		return nil, fmt.Errorf("table_id and row_id are required")
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and row_id are required")
 	}
 
-	_, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkTableWrite(ctx, pool, tableID); err != nil {
+		return nil, err
+	}
 
-	del := fmt.Sprintf(`DELETE FROM %s.%s WHERE id = $1`,
-		pgx.Identifier{schemaName}.Sanitize(),
-		pgx.Identifier{tableName}.Sanitize())
-	if _, err := pool.Exec(ctx, del, req.GetRowId()); err != nil {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	if err := deleteRows(ctx, tx, tableID, cols, schemaName, tableName, "id = $1", req.GetRowId()); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 	return &lowcodev1.DeleteRowResponse{}, nil
 }
 
-// 简化实现：ListRows 只做无条件分页。
-func (s *LowcodeService) ListRows(ctx context.Context, req *lowcodev1.ListRowsRequest) (*lowcodev1.ListRowsResponse, error) {
+// deleteRows 删除满足 cond 的行，删除前的行写入 lc_row_history。
+func deleteRows(ctx context.Context, tx pgx.Tx, tableID string, cols []columnMeta, schemaName, tableName, cond string, args ...any) error {
+	before, err := lockRows(ctx, tx, cols, schemaName, tableName, cond+andNotDeleted(cols), args...)
+	if err != nil {
+		return err
+	}
+	if err := recordRowVersions(ctx, tx, tableID, cols, rowVersionDelete, before); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, deleteRowsSQL(cols, schemaName, tableName, cond), args...)
+	return err
+}
+
+// deleteRowsSQL 生成删除语句：支持软删除的表只标记 deleted_at（进入回收站），否则物理删除。
+func deleteRowsSQL(cols []columnMeta, schemaName, tableName, cond string) string {
+	rel := pgx.Identifier{schemaName}.Sanitize() + "." + pgx.Identifier{tableName}.Sanitize()
+	if softDeletes(cols) {
+		return fmt.Sprintf(`UPDATE %s SET deleted_at = now() WHERE %s AND deleted_at IS NULL`, rel, cond)
+	}
+	return fmt.Sprintf(`DELETE FROM %s WHERE %s`, rel, cond)
+}
+
+// RestoreRow 把回收站中的行恢复（清空 deleted_at），返回恢复后的行。
+func (s *LowcodeService) RestoreRow(ctx context.Context, req *lowcodev1.RestoreRowRequest) (*lowcodev1.RestoreRowResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	tableID := req.GetTableId()
-	if tableID == "" {
-		return nil, fmt.Errorf("table_id is required")
+	if req.GetTableId() == "" || req.GetRowId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and row_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
 	}
+	if !softDeletes(cols) {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s does not support soft delete", req.GetTableId())
+	}
+	acc, err := s.tableAccess(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkTableWrite(); err != nil {
+		return nil, err
+	}
+	restore := fmt.Sprintf(`UPDATE %s.%s SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL RETURNING %s`,
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		rowColumnsSQL(cols),
+	)
+	row, err := scanRow(pool.QueryRow(ctx, restore, req.GetRowId()), cols)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "deleted row %s not found", req.GetRowId())
+		}
+		return nil, err
+	}
+	if err := s.openRows(ctx, cols, row); err != nil {
+		return nil, err
+	}
+	acc.redactRows(row)
+	return &lowcodev1.RestoreRowResponse{Row: row}, nil
+}
+
+// PurgeRows 物理删除回收站中的行，未被软删除的行不受影响。
+func (s *LowcodeService) PurgeRows(ctx context.Context, req *lowcodev1.PurgeRowsRequest) (*lowcodev1.PurgeRowsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTableWrite(ctx, pool, req.GetTableId()); err != nil {
+		return nil, err
+	}
+	if !softDeletes(cols) {
+		return &lowcodev1.PurgeRowsResponse{}, nil
+	}
+
+	var a sqlArgs
+	conds := []string{"deleted_at IS NOT NULL"}
+	if len(req.GetRowIds()) > 0 {
+		conds = append(conds, "id = ANY("+a.add(req.GetRowIds())+")")
+	}
+	if req.GetDeletedBefore() != nil {
+		conds = append(conds, "deleted_at < "+a.add(req.GetDeletedBefore().AsTime()))
+	}
+	purge := fmt.Sprintf(`DELETE FROM %s.%s WHERE %s`,
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		strings.Join(conds, " AND "),
+	)
+	tag, err := pool.Exec(ctx, purge, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.PurgeRowsResponse{Purged: tag.RowsAffected()}, nil
+}
+
+// ListRows 支持 filter 过滤和 sorts 排序，并做 keyset 分页，page_token 由上一页的 next_page_token 给出。
+func (s *LowcodeService) ListRows(ctx context.Context, req *lowcodev1.ListRowsRequest) (*lowcodev1.ListRowsResponse, error) {
+	pool, err := s.tenants.ReadPoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	if req.GetViewId() == "" {
+		return s.listRows(ctx, pool, req, s.clampPageSize(req.GetPageSize()))
+	}
+	req, visible, err := s.applyView(ctx, pool, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.listRows(ctx, pool, req, s.clampPageSize(req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	keepCells(res.Rows, visible)
+	return res, nil
+}
+
+// maxStreamBatch 是 StreamRows 单条消息的行数上限。
+const maxStreamBatch = 5000
+
+// StreamRows 用和 ListRows 相同的 keyset 游标逐批扫描整表，每批作为一条消息发送，
+// 不会一次性把结果放进内存，也不受 MAX_ROW 限制。
+func (s *LowcodeService) StreamRows(req *lowcodev1.StreamRowsRequest, stream lowcodev1.LowcodeService_StreamRowsServer) error {
+	ctx := stream.Context()
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return err
+	}
+	if req.GetTableId() == "" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	batch := req.GetBatchSize()
+	if batch <= 0 {
+		batch = 500
+	}
+	if batch > maxStreamBatch {
+		batch = maxStreamBatch
+	}
+
+	page := &lowcodev1.ListRowsRequest{
+		TableId:         req.GetTableId(),
+		Filter:          req.GetFilter(),
+		Sorts:           req.GetSorts(),
+		ExpandColumnIds: req.GetExpandColumnIds(),
+		IncludeHidden:   req.GetIncludeHidden(),
+	}
+	for {
+		res, err := s.listRows(ctx, pool, page, batch)
+		if err != nil {
+			return err
+		}
+		if len(res.GetRows()) > 0 {
+			if err := stream.Send(&lowcodev1.StreamRowsResponse{Rows: res.GetRows()}); err != nil {
+				return err
+			}
+		}
+		if res.GetNextPageToken() == "" {
+			return nil
+		}
+		page.PageToken = res.GetNextPageToken()
+	}
+}
+
+// listRows 执行一页查询；pageSize 由调用方决定（ListRows 受 MAX_ROW 限制，StreamRows 不受）。
+func (s *LowcodeService) listRows(ctx context.Context, pool *pgxpool.Pool, req *lowcodev1.ListRowsRequest, pageSize int32) (*lowcodev1.ListRowsResponse, error) {
+	tableID := req.GetTableId()
 	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
 	if err != nil {
 		return nil, err
@@ -172,44 +439,97 @@ func (s *LowcodeService) ListRows(ctx context.Context, req *lowcodev1.ListRowsRe
 	if len(cols) == 0 {
 		return &lowcodev1.ListRowsResponse{}, nil
 	}
-
-	pageSize := req.GetPageSize()
-	// Apply MAX_ROW config as both default and upper bound when set.
-	maxRow := s.maxRow
-	if pageSize <= 0 {
-		if maxRow > 0 {
-			pageSize = maxRow
-		} else {
-			pageSize = 50
+	// 不可读的列不能用于过滤、排序和展开，否则可以通过结果推断出列值
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkTableRead(); err != nil {
+		return nil, err
+	}
+	if err := acc.checkRead(filterColumnIDs(req.GetFilter())...); err != nil {
+		return nil, err
+	}
+	for _, sort := range req.GetSorts() {
+		if err := acc.checkRead(sort.GetColumnId()); err != nil {
+			return nil, err
 		}
 	}
-	if maxRow > 0 && pageSize > maxRow {
-		pageSize = maxRow
-	} else if maxRow <= 0 && pageSize > 100 {
-		// Backward-compatible hard cap when MAX_ROW is not configured.
-		pageSize = 100
+
+	token, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := parseExpandPaths(req.GetExpandColumnIds())
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkRead(tree.columnIDs()...); err != nil {
+		return nil, err
 	}
 
-	// 目前忽略 page_token，简单 offset=0。
-	columnSQL := "id"
+	byID := make(map[string]columnMeta, len(cols))
 	for _, c := range cols {
-		columnSQL += ", " + c.PgColumn
+		byID[c.Id] = c
+	}
+	sorts, err := compileSorts(req.GetSorts(), byID)
+	if err != nil {
+		return nil, err
 	}
 
-	query := fmt.Sprintf(`SELECT %s FROM %s.%s ORDER BY id LIMIT $1`,
+	// 排序列额外以 text 形式选出，用来生成下一页游标。
+	columnSQL := rowColumnsSQL(cols)
+	for _, k := range sorts {
+		columnSQL += ", " + k.colSQL + "::text"
+	}
+
+	var a sqlArgs
+	var conds []string
+	filterSQL, err := compileFilter(req.GetFilter(), byID, &a)
+	if err != nil {
+		return nil, err
+	}
+	if filterSQL != "" {
+		conds = append(conds, "("+filterSQL+")")
+	}
+	if softDeletes(cols) && !req.GetIncludeDeleted() {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	// keyset 分页：从上一页最后一行之后继续；多取一行用来判断是否还有下一页。
+	if token.ID != "" {
+		keyset, err := keysetSQL(sorts, token, &a)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, keyset)
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	query := fmt.Sprintf(`SELECT %s FROM %s.%s %s ORDER BY %s LIMIT %s`,
 		columnSQL,
 		pgx.Identifier{schemaName}.Sanitize(),
 		pgx.Identifier{tableName}.Sanitize(),
+		where,
+		orderBySQL(sorts),
+		a.add(pageSize+1),
 	)
-	rows, err := pool.Query(ctx, query, pageSize)
+	rows, err := pool.Query(ctx, query, a.args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	var resp lowcodev1.ListRowsResponse
+	var lastKeys []*string
+	hasMore := false
 	for rows.Next() {
-		scanTargets := make([]any, 1+len(cols))
+		if len(resp.Rows) == int(pageSize) {
+			hasMore = true
+			break
+		}
+		scanTargets := make([]any, 1+len(cols)+len(sorts))
 		var id string
 		scanTargets[0] = &id
 		values := make([]any, len(cols))
@@ -217,6 +537,10 @@ func (s *LowcodeService) ListRows(ctx context.Context, req *lowcodev1.ListRowsRe
 			values[i] = new(any)
 			scanTargets[i+1] = values[i]
 		}
+		keys := make([]*string, len(sorts))
+		for i := range keys {
+			scanTargets[1+len(cols)+i] = &keys[i]
+		}
 		if err := rows.Scan(scanTargets...); err != nil {
 			return nil, err
 		}
@@ -233,123 +557,279 @@ func (s *LowcodeService) ListRows(ctx context.Context, req *lowcodev1.ListRowsRe
 			row.Cells[c.Id] = anyToValue(*vPtr)
 		}
 
-		// 展开 relationship 列：把子表/关联表数据放入 cells，值为 json_value { "rows": [ { "id", "cells" }, ... ] }
-		if len(req.GetExpandColumnIds()) > 0 {
-			relCols, err := s.loadRelationshipColumns(ctx, pool, tableID, req.GetExpandColumnIds())
-			if err != nil {
-				return nil, err
-			}
-			for _, rel := range relCols {
-				related, err := s.fetchRelatedRows(ctx, pool, rel, id, row.Cells)
-				if err != nil {
-					return nil, err
-				}
-				if related == nil {
-					related = []*structpb.Value{}
-				}
-				listVal := &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{Values: related}}}
-				expandStruct := &structpb.Struct{Fields: map[string]*structpb.Value{"rows": listVal}}
-				if row.Cells == nil {
-					row.Cells = make(map[string]*lowcodev1.Value)
-				}
-				row.Cells[rel.Id] = &lowcodev1.Value{Kind: &lowcodev1.Value_JsonValue{JsonValue: expandStruct}}
-			}
-		}
-
 		resp.Rows = append(resp.Rows, row)
+		lastKeys = keys
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	if err := s.openRows(ctx, cols, resp.Rows...); err != nil {
+		return nil, err
+	}
+	if err := s.expandRows(ctx, pool, tableID, tree, resp.Rows); err != nil {
+		return nil, err
+	}
+	if err := s.computeVirtualColumns(ctx, pool, cols[0].TableId, resp.Rows); err != nil {
+		return nil, err
+	}
+	if !req.GetIncludeHidden() {
+		if err := hideCells(ctx, pool, cols[0].TableId, resp.Rows); err != nil {
+			return nil, err
+		}
+	}
+	acc.redactRows(resp.Rows...)
+	if hasMore {
+		resp.NextPageToken = encodePageToken(pageToken{ID: resp.Rows[len(resp.Rows)-1].Id, Keys: lastKeys})
 	}
-	return &resp, rows.Err()
+	return &resp, nil
 }
 
-// fetchRelatedRows 根据 relationship 配置查询关联行，返回可序列化为 JSON 的 []*structpb.Value（每项为 { "id", "cells" }）。
-func (s *LowcodeService) fetchRelatedRows(ctx context.Context, pool *pgxpool.Pool, rel relationshipColumn, currentRowID string, currentRowCells map[string]*lowcodev1.Value) ([]*structpb.Value, error) {
-	targetCols, targetSchema, targetTable, err := s.loadColumns(ctx, pool, rel.TargetTableId)
+// textPgTypes 是 SearchRows 默认会搜索的列类型。
+var textPgTypes = map[string]bool{"text": true, "varchar": true, "character varying": true, "citext": true}
+
+// SearchRows 把关键字转换成对各文本列 CONTAINS 条件的 OR 组合，再复用 ListRows 的过滤和分页。
+func (s *LowcodeService) SearchRows(ctx context.Context, req *lowcodev1.SearchRowsRequest) (*lowcodev1.SearchRowsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(targetCols) == 0 {
-		return nil, nil
+	tableID := req.GetTableId()
+	if tableID == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "query is required")
+	}
+	cols, _, _, err := s.loadColumns(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
 	}
 
-	var query string
-	var args []any
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
 
-	if rel.LinkColumnId != "" {
-		// 一对多：子表中外键列 = 当前行 id
-		var linkPgCol string
-		if err := pool.QueryRow(ctx, `SELECT pg_column FROM lc_columns WHERE id = $1`, rel.LinkColumnId).Scan(&linkPgCol); err != nil {
-			if err == pgx.ErrNoRows {
-				return nil, nil
-			}
-			return nil, err
-		}
-		columnSQL := "id"
-		for _, c := range targetCols {
-			columnSQL += ", " + c.PgColumn
-		}
-		query = fmt.Sprintf(`SELECT %s FROM %s.%s WHERE %s = $1 ORDER BY id`,
-			columnSQL,
-			pgx.Identifier{targetSchema}.Sanitize(),
-			pgx.Identifier{targetTable}.Sanitize(),
-			pgx.Identifier{linkPgCol}.Sanitize(),
-		)
-		args = []any{currentRowID}
+	var targets []string
+	if len(req.GetColumnIds()) > 0 {
+		// 显式指定的列按 ::text 搜索，不限制类型；不可读的列由 listRows 拒绝
+		targets = req.GetColumnIds()
 	} else {
-		// 多对一/一对一：当前行某列存目标行 id，查目标表 by id
-		var relatedID string
-		if v, ok := currentRowCells[rel.TargetColumnId]; ok && v != nil {
-			if sv, ok := v.Kind.(*lowcodev1.Value_StringValue); ok && sv != nil {
-				relatedID = sv.StringValue
+		// 默认只搜索可读且未脱敏的文本列
+		for _, c := range cols {
+			if _, masked := acc.masked(c.Id); textPgTypes[c.PgType] && acc.canRead(c.Id) && !masked {
+				targets = append(targets, c.Id)
 			}
 		}
-		if relatedID == "" {
-			return nil, nil
-		}
-		columnSQL := "id"
-		for _, c := range targetCols {
-			columnSQL += ", " + c.PgColumn
-		}
-		query = fmt.Sprintf(`SELECT %s FROM %s.%s WHERE id = $1`,
-			columnSQL,
-			pgx.Identifier{targetSchema}.Sanitize(),
-			pgx.Identifier{targetTable}.Sanitize(),
-		)
-		args = []any{relatedID}
 	}
+	if len(targets) == 0 {
+		return &lowcodev1.SearchRowsResponse{}, nil
+	}
+
+	group := &lowcodev1.FilterGroup{Combinator: lowcodev1.FilterGroup_OR}
+	for _, id := range targets {
+		group.Filters = append(group.Filters, &lowcodev1.RowFilter{Kind: &lowcodev1.RowFilter_Condition{Condition: &lowcodev1.FilterCondition{
+			ColumnId: id,
+			Operator: lowcodev1.FilterOperator_FILTER_OPERATOR_CONTAINS,
+			Value:    &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: query}},
+		}}})
+	}
+	res, err := s.ListRows(ctx, &lowcodev1.ListRowsRequest{
+		TableId:   tableID,
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		Filter:    &lowcodev1.RowFilter{Kind: &lowcodev1.RowFilter_Group{Group: group}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.SearchRowsResponse{Rows: res.GetRows(), NextPageToken: res.GetNextPageToken()}, nil
+}
 
-	rows, err := pool.Query(ctx, query, args...)
+// GetRow 按 id 返回单行，可选展开 relationship 列。
+func (s *LowcodeService) GetRow(ctx context.Context, req *lowcodev1.GetRowRequest) (*lowcodev1.GetRowResponse, error) {
+	pool, err := s.tenants.ReadPoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	tableID := req.GetTableId()
+	if tableID == "" || req.GetRowId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id and row_id are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cols) == 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", req.GetRowId())
+	}
 
-	var list []*structpb.Value
-	for rows.Next() {
-		scanTargets := make([]any, 1+len(targetCols))
-		var rowID string
-		scanTargets[0] = &rowID
-		values := make([]any, len(targetCols))
-		for i := range values {
-			values[i] = new(any)
-			scanTargets[i+1] = values[i]
+	tree, err := parseExpandPaths(req.GetExpandColumnIds())
+	if err != nil {
+		return nil, err
+	}
+	acc, err := s.tableAccess(ctx, pool, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkTableRead(); err != nil {
+		return nil, err
+	}
+	if err := acc.checkRead(tree.columnIDs()...); err != nil {
+		return nil, err
+	}
+
+	live := andNotDeleted(cols)
+	if req.GetIncludeDeleted() {
+		live = ""
+	}
+	query := fmt.Sprintf(`SELECT %s FROM %s.%s WHERE id = $1%s`,
+		rowColumnsSQL(cols),
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		live,
+	)
+	row, err := scanRow(pool.QueryRow(ctx, query, req.GetRowId()), cols)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "row %s not found", req.GetRowId())
 		}
-		if err := rows.Scan(scanTargets...); err != nil {
-			return nil, err
+		return nil, err
+	}
+
+	if err := s.finishRow(ctx, pool, tableID, cols, tree, acc, req.GetIncludeHidden(), row); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.GetRowResponse{Row: row}, nil
+}
+
+// finishRow 为读取出的单行展开关联、计算虚拟列、去掉隐藏列并按权限处理，GetRow / FindRowByColumn 共用。
+func (s *LowcodeService) finishRow(ctx context.Context, pool *pgxpool.Pool, tableID string, cols []columnMeta, tree expandTree, acc *columnAccess, includeHidden bool, row *lowcodev1.Row) error {
+	if err := s.openRows(ctx, cols, row); err != nil {
+		return err
+	}
+	if err := s.expandRows(ctx, pool, tableID, tree, []*lowcodev1.Row{row}); err != nil {
+		return err
+	}
+	if err := s.computeVirtualColumns(ctx, pool, cols[0].TableId, []*lowcodev1.Row{row}); err != nil {
+		return err
+	}
+	if !includeHidden {
+		if err := hideCells(ctx, pool, cols[0].TableId, []*lowcodev1.Row{row}); err != nil {
+			return err
 		}
-		cellsMap := make(map[string]interface{}, len(targetCols))
-		for i, c := range targetCols {
-			vPtr := values[i].(*any)
-			if *vPtr != nil {
-				cellsMap[c.Id] = *vPtr
-			}
+	}
+	acc.redactRows(row)
+	return nil
+}
+
+// hideCells 从 rows 中去掉隐藏列（含虚拟列）的单元格。在展开和计算虚拟列之后调用，
+// 隐藏列仍可用于过滤、排序和公式。
+func hideCells(ctx context.Context, q querier, tableID string, rows []*lowcodev1.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	idRows, err := q.Query(ctx, `SELECT id::text FROM lc_columns WHERE table_id = $1 AND is_hidden`, tableID)
+	if err != nil {
+		return err
+	}
+	hidden, err := pgx.CollectRows(idRows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, id := range hidden {
+			delete(row.Cells, id)
 		}
-		rowMap := map[string]interface{}{"id": rowID, "cells": cellsMap}
-		s, err := structpb.NewStruct(rowMap)
-		if err != nil {
+	}
+	return nil
+}
+
+// FindRowByColumn 按列值查找单行。列上有单列唯一索引时最多一行；
+// 没有唯一索引时如果匹配到多行，返回 FailedPrecondition，避免业务键 upsert 命中错误的行。
+func (s *LowcodeService) FindRowByColumn(ctx context.Context, req *lowcodev1.FindRowByColumnRequest) (*lowcodev1.FindRowByColumnResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() == "" || req.GetColumnId() == "" || req.GetValue() == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id, column_id and value are required")
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	var col *columnMeta
+	for i := range cols {
+		if cols[i].Id == req.GetColumnId() {
+			col = &cols[i]
+			break
+		}
+	}
+	if col == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", req.GetColumnId())
+	}
+	if col.encrypted() {
+		// 密文每次加密都不同，不能按值查找
+		return nil, encryptedColumnError(col.Id)
+	}
+	tree, err := parseExpandPaths(req.GetExpandColumnIds())
+	if err != nil {
+		return nil, err
+	}
+	acc, err := s.tableAccess(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.checkRead(append([]string{col.Id}, tree.columnIDs()...)...); err != nil {
+		return nil, err
+	}
+
+	var unique bool
+	if !col.System {
+		if err := pool.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM lc_indexes
+				WHERE table_id = $1 AND is_unique AND column_ids = ARRAY[$2::uuid]
+			)`, col.TableId, col.Id).Scan(&unique); err != nil {
 			return nil, err
 		}
-		list = append(list, structpb.NewStructValue(s))
 	}
-	return list, rows.Err()
+
+	// 查找和读取行在同一条查询、同一个（主库）连接池上完成：改用 GetRow 会读副本，刚写入的行可能还没有同步过去
+	query := fmt.Sprintf(`SELECT %s FROM %s.%s WHERE %s = $1%s LIMIT 2`,
+		rowColumnsSQL(cols),
+		pgx.Identifier{schemaName}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		pgx.Identifier{col.PgColumn}.Sanitize(),
+		andNotDeleted(cols),
+	)
+	arg, err := cellArg(*col, req.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	found, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (*lowcodev1.Row, error) { return scanRow(r, cols) })
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(found) == 0:
+		return nil, apierr.New(lowcodev1.ErrorCode_ROW_NOT_FOUND, codes.NotFound, "no row with column %s = given value", col.Id)
+	case len(found) > 1 && !unique:
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "multiple rows match column %s; add a unique index to use it as a key", col.Id)
+	}
+	if err := acc.checkTableRead(); err != nil {
+		return nil, err
+	}
+	if err := s.finishRow(ctx, pool, req.GetTableId(), cols, tree, acc, false, found[0]); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.FindRowByColumnResponse{Row: found[0]}, nil
 }
 
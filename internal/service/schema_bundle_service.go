@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Schema bundle (export / import) --------
+
+// schemaBundleVersion 是 SchemaBundle 的格式版本，格式不兼容地变化时递增。
+const schemaBundleVersion = 1
+
+// bundleLocalConfigKeys 是列 config 中只对当前 tenant 有意义的字段，导出时去掉，导入时重新生成。
+var bundleLocalConfigKeys = []string{"join_schema", "join_table", sharedKeyColumnsKey}
+
+// ExportSchema 导出自定义类型以及全部表的列和索引。列带上源 id，供导入时改写 config 中的列引用。
+func (s *LowcodeService) ExportSchema(ctx context.Context, req *lowcodev1.ExportSchemaRequest) (*lowcodev1.ExportSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, err := exportSchemaBundle(ctx, pool, req.GetIncludeBuiltinTypes())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.ExportSchemaResponse{Bundle: b}, nil
+}
+
+// exportSchemaBundle 生成 ExportSchema 的文档，ExportTenant 在只读事务中复用它。
+func exportSchemaBundle(ctx context.Context, q querier, includeBuiltinTypes bool) (*lowcodev1.SchemaBundle, error) {
+	b := &lowcodev1.SchemaBundle{Version: schemaBundleVersion, ExportedAt: timestamppb.Now()}
+	var err error
+	if b.Types, err = exportTypes(ctx, q, includeBuiltinTypes); err != nil {
+		return nil, err
+	}
+
+	// SQL 视图的定义直接引用物理表，不随 schema 导出
+	rows, err := q.Query(ctx, `SELECT name, schema_name, config, description, icon FROM lc_tables WHERE archived_at IS NULL AND NOT config ? 'sql_view' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	type tableRow struct {
+		Name        string
+		SchemaName  string
+		Config      map[string]any
+		Description string
+		Icon        string
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowToStructByPos[tableRow])
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		td, err := exportTable(ctx, q, t.Name, t.Config)
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", t.Name, err)
+		}
+		td.SchemaName, td.Description, td.Icon = t.SchemaName, t.Description, t.Icon
+		b.Tables = append(b.Tables, td)
+	}
+	return b, nil
+}
+
+// exportTable 生成表的定义：分区列随 partition 导出，is_unique 列的唯一索引（<列名>_unique）记在列定义上。
+func exportTable(ctx context.Context, q querier, name string, tableCfg map[string]any) (*lowcodev1.TableDefinition, error) {
+	td := &lowcodev1.TableDefinition{Name: name}
+	pc := partitionFromConfig(tableCfg)
+	if pc != nil {
+		td.Partition = pc.spec()
+	}
+
+	cols, err := sourceColumns(ctx, q, name)
+	if err != nil {
+		return nil, err
+	}
+	nameByID := make(map[string]string, len(cols))
+	defByID := make(map[string]*lowcodev1.ColumnDefinition, len(cols))
+	for _, c := range cols {
+		nameByID[c.Id] = c.Name
+		if pc != nil && c.Id == pc.ColumnID {
+			continue
+		}
+		d := &lowcodev1.ColumnDefinition{
+			Id:          c.Id,
+			Name:        c.Name,
+			TypeId:      c.TypeId,
+			IsNullable:  c.IsNullable,
+			Position:    c.Position,
+			IsHidden:    c.IsHidden,
+			IsReadonly:  c.IsReadonly,
+			Description: c.Description,
+			Label:       c.Label,
+		}
+		if len(c.UIHints) > 0 {
+			d.UiHints = toStruct(c.UIHints)
+		}
+		cfg := make(map[string]any, len(c.Config))
+		for k, v := range c.Config {
+			if !slices.Contains(bundleLocalConfigKeys, k) {
+				cfg[k] = v
+			}
+		}
+		if len(cfg) > 0 {
+			d.Config = toStruct(cfg)
+		}
+		defByID[c.Id] = d
+		td.Columns = append(td.Columns, d)
+	}
+
+	rows, err := q.Query(ctx, `SELECT id, name, column_ids::text[], is_unique, COALESCE(config->>'method', '') FROM lc_indexes WHERE table_id = $1 ORDER BY name`, name)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := pgx.CollectRows(rows, pgx.RowToStructByPos[currentIndex])
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range indexes {
+		if idx.IsUnique && len(idx.ColumnIDs) == 1 && defByID[idx.ColumnIDs[0]] != nil && idx.Name == nameByID[idx.ColumnIDs[0]]+"_unique" {
+			defByID[idx.ColumnIDs[0]].IsUnique = true
+			continue
+		}
+		names := make([]string, len(idx.ColumnIDs))
+		for i, id := range idx.ColumnIDs {
+			names[i] = nameByID[id]
+		}
+		td.Indexes = append(td.Indexes, &lowcodev1.IndexDefinition{
+			Name:        idx.Name,
+			ColumnNames: names,
+			IsUnique:    idx.IsUnique,
+			IndexMethod: indexMethodFromName(idx.Method),
+		})
+	}
+	return td, nil
+}
+
+// columnImportPhase 决定导入时列的创建顺序：被引用的列先建。relationship 可能引用外键物理列，
+// formula 引用同表的列，rollup / lookup 引用 relationship 和目标表的列。
+func columnImportPhase(kind string) int {
+	switch kind {
+	case "relationship":
+		return 1
+	case "formula":
+		return 2
+	case "rollup":
+		return 3
+	case "lookup":
+		return 4
+	}
+	return 0
+}
+
+// ImportSchema 在一个事务内导入 SchemaBundle：先同步类型，再创建全部表，然后跨表按 columnImportPhase 的顺序加列，
+// 最后建索引。文档中的表在当前 tenant 中已存在时整体失败。列使用新的 id，config 中的源列 id 改写为新 id。
+func (s *LowcodeService) ImportSchema(ctx context.Context, req *lowcodev1.ImportSchemaRequest) (*lowcodev1.ImportSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b := req.GetBundle()
+	if b == nil {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "bundle is required")
+	}
+	if err := checkSchemaBundle(b); err != nil {
+		return nil, err
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	res, _, err := s.importSchemaBundle(ctx, tx, b, req.GetOverwriteTypes())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// checkSchemaBundle 校验文档版本和表、列定义。
+func checkSchemaBundle(b *lowcodev1.SchemaBundle) error {
+	if b.GetVersion() != schemaBundleVersion {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"unsupported schema bundle version %d (expected %d)", b.GetVersion(), schemaBundleVersion)
+	}
+	var violations []apierr.FieldViolation
+	for i, t := range b.GetTables() {
+		if t.GetName() == "" {
+			violations = append(violations, apierr.FieldViolation{Field: fmt.Sprintf("tables[%d].name", i), Description: "name is required"})
+		}
+		for _, v := range columnDefinitionViolations(t.GetColumns()) {
+			v.Field = fmt.Sprintf("tables[%d].%s", i, v.Field)
+			violations = append(violations, v)
+		}
+	}
+	if len(violations) > 0 {
+		return apierr.NewValidation(violations)
+	}
+	return nil
+}
+
+// importSchemaBundle 在 tx 中导入已校验的文档，另外返回源列 id 到新列 id 的映射（ImportTenant 按它改写行数据）。
+func (s *LowcodeService) importSchemaBundle(ctx context.Context, tx pgx.Tx, b *lowcodev1.SchemaBundle, overwriteTypes bool) (*lowcodev1.ImportSchemaResponse, map[string]string, error) {
+	var res lowcodev1.ImportSchemaResponse
+	var err error
+	if res.Types, err = importTypes(ctx, tx, b.GetTypes(), overwriteTypes); err != nil {
+		return nil, nil, err
+	}
+
+	// 源列 id -> 新列 id
+	ids := make(map[string]string)
+	tableNames := make([]string, 0, len(b.GetTables()))
+	for _, t := range b.GetTables() {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_tables WHERE name = $1)`, t.GetName()).Scan(&exists); err != nil {
+			return nil, nil, err
+		}
+		if exists {
+			return nil, nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists", t.GetName())
+		}
+		tbl, err := createTable(ctx, tx, &lowcodev1.CreateTableRequest{Name: t.GetName(), SchemaName: t.GetSchemaName(), Partition: t.GetPartition()})
+		if err != nil {
+			return nil, nil, fmt.Errorf("table %s: %w", t.GetName(), err)
+		}
+		if t.GetDescription() != "" || t.GetIcon() != "" {
+			tbl, err = scanTable(tx.QueryRow(ctx, `
+				UPDATE lc_tables SET description = $2, icon = $3, updated_at = now()
+				WHERE name = $1
+				RETURNING `+tableFieldsSQL, t.GetName(), t.GetDescription(), t.GetIcon()))
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if src := t.GetPartition().GetColumnId(); src != "" {
+			ids[src] = tbl.GetPartition().GetColumnId()
+		}
+		res.Tables = append(res.Tables, tbl)
+		tableNames = append(tableNames, t.GetName())
+	}
+
+	type pendingColumn struct {
+		table string
+		def   *lowcodev1.ColumnDefinition
+		phase int
+	}
+	var pending []pendingColumn
+	for _, t := range b.GetTables() {
+		for _, d := range t.GetColumns() {
+			typ, err := resolveType(ctx, tx, d.GetTypeId())
+			if err != nil {
+				return nil, nil, fmt.Errorf("table %s column %s: %w", t.GetName(), d.GetName(), err)
+			}
+			pending = append(pending, pendingColumn{table: t.GetName(), def: d, phase: columnImportPhase(typeKind(typ))})
+		}
+	}
+	slices.SortStableFunc(pending, func(a, b pendingColumn) int { return a.phase - b.phase })
+	var created []string
+	for _, p := range pending {
+		cfg, err := remapColumnConfig(p.def.GetConfig().AsMap(), ids, p.table, p.table)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, k := range bundleLocalConfigKeys {
+			delete(cfg, k)
+		}
+		ar := addColumnRequest(p.table, p.def)
+		ar.Config = toStruct(cfg)
+		added, err := addColumn(ctx, tx, ar)
+		if err != nil {
+			return nil, nil, fmt.Errorf("table %s column %s: %w", p.table, p.def.GetName(), err)
+		}
+		if p.def.GetId() != "" {
+			ids[p.def.GetId()] = added.GetColumn().GetId()
+		}
+		created = append(created, added.GetColumn().GetId())
+	}
+
+	// 引用了后创建的列时（如 formula 引用 lookup 列），建列时还没有新 id，这里补一遍改写
+	for _, id := range created {
+		var tableID string
+		var cfg map[string]any
+		if err := tx.QueryRow(ctx, `SELECT table_id, config FROM lc_columns WHERE id = $1`, id).Scan(&tableID, &cfg); err != nil {
+			return nil, nil, err
+		}
+		remapped, err := remapColumnConfig(cfg, ids, tableID, tableID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cfg == nil || reflect.DeepEqual(cfg, remapped) {
+			continue
+		}
+		if _, err := tx.Exec(ctx, `UPDATE lc_columns SET config = $2, updated_at = now() WHERE id = $1`, id, remapped); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, t := range b.GetTables() {
+		if len(t.GetIndexes()) == 0 {
+			continue
+		}
+		cols, err := queryColumns(ctx, tx, columnSelectSQL+`WHERE c.table_id = $1`, t.GetName())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, d := range t.GetIndexes() {
+			colIDs, err := columnIDsByName(cols, d.GetColumnNames())
+			if err != nil {
+				return nil, nil, fmt.Errorf("table %s index %s: %w", t.GetName(), d.GetName(), err)
+			}
+			if _, err := s.createIndex(ctx, tx, &lowcodev1.CreateIndexRequest{
+				TableId:     t.GetName(),
+				Name:        d.GetName(),
+				ColumnIds:   colIDs,
+				IsUnique:    d.GetIsUnique(),
+				IndexMethod: d.GetIndexMethod(),
+			}); err != nil {
+				return nil, nil, fmt.Errorf("table %s index %s: %w", t.GetName(), d.GetName(), err)
+			}
+		}
+	}
+
+	if res.Columns, err = queryColumns(ctx, tx, columnSelectSQL+`WHERE c.table_id = ANY($1) ORDER BY c.table_id, c.position`, tableNames); err != nil {
+		return nil, nil, err
+	}
+	if res.Indexes, err = queryIndexes(ctx, tx, `
+		SELECT `+indexFieldsSQL+`
+		FROM lc_indexes
+		WHERE table_id = ANY($1)
+		ORDER BY table_id, name`, tableNames); err != nil {
+		return nil, nil, err
+	}
+	return &res, ids, nil
+}
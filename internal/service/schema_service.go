@@ -0,0 +1,518 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Table schema in one call --------
+
+// CreateTableWithSchema 在一个事务内依次执行 CreateTable、AddColumn、CreateIndex 的逻辑，
+// 避免客户端串行调用中途失败留下半成品表。索引按列名引用列（含分区列）。
+func (s *LowcodeService) CreateTableWithSchema(ctx context.Context, req *lowcodev1.CreateTableWithSchemaRequest) (*lowcodev1.CreateTableWithSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "name is required")
+	}
+	if v := columnDefinitionViolations(req.GetColumns()); len(v) > 0 {
+		return nil, apierr.NewValidation(v)
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := s.createTableWithSchema(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *LowcodeService) createTableWithSchema(ctx context.Context, tx pgx.Tx, req *lowcodev1.CreateTableWithSchemaRequest) (*lowcodev1.CreateTableWithSchemaResponse, error) {
+	var res lowcodev1.CreateTableWithSchemaResponse
+	var err error
+	res.Table, err = createTable(ctx, tx, &lowcodev1.CreateTableRequest{
+		Name:        req.GetName(),
+		SchemaName:  req.GetSchemaName(),
+		Partition:   req.GetPartition(),
+		AutoSuffix:  req.GetAutoSuffix(),
+		WorkspaceId: req.GetWorkspaceId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 分区列由 createTable 创建
+	res.Columns, err = queryColumns(ctx, tx, columnSelectSQL+`WHERE c.table_id = $1 ORDER BY c.position`, res.Table.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range req.GetColumns() {
+		added, err := addColumn(ctx, tx, addColumnRequest(res.Table.GetId(), d))
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", d.GetName(), err)
+		}
+		res.Columns = append(res.Columns, added.GetColumn())
+		if added.GetUniqueIndex() != nil {
+			res.Indexes = append(res.Indexes, added.GetUniqueIndex())
+		}
+	}
+
+	for _, d := range req.GetIndexes() {
+		ids, err := columnIDsByName(res.Columns, d.GetColumnNames())
+		if err != nil {
+			return nil, fmt.Errorf("index %s: %w", d.GetName(), err)
+		}
+		idx, err := s.createIndex(ctx, tx, &lowcodev1.CreateIndexRequest{
+			TableId:     res.Table.GetId(),
+			Name:        d.GetName(),
+			ColumnIds:   ids,
+			IsUnique:    d.GetIsUnique(),
+			IndexMethod: d.GetIndexMethod(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("index %s: %w", d.GetName(), err)
+		}
+		res.Indexes = append(res.Indexes, idx)
+	}
+	return &res, nil
+}
+
+func addColumnRequest(tableID string, d *lowcodev1.ColumnDefinition) *lowcodev1.AddColumnRequest {
+	return &lowcodev1.AddColumnRequest{
+		TableId:     tableID,
+		Name:        d.GetName(),
+		TypeId:      d.GetTypeId(),
+		IsNullable:  d.GetIsNullable(),
+		Position:    d.GetPosition(),
+		Config:      d.GetConfig(),
+		IsUnique:    d.GetIsUnique(),
+		IsHidden:    d.GetIsHidden(),
+		IsReadonly:  d.GetIsReadonly(),
+		Description: d.GetDescription(),
+		Label:       d.GetLabel(),
+		UiHints:     d.GetUiHints(),
+	}
+}
+
+// columnDefinitionViolations 检查列定义的 name / type_id 是否为空以及列名是否重复。
+func columnDefinitionViolations(defs []*lowcodev1.ColumnDefinition) []apierr.FieldViolation {
+	var out []apierr.FieldViolation
+	seen := make(map[string]bool, len(defs))
+	for i, d := range defs {
+		field := fmt.Sprintf("columns[%d]", i)
+		switch {
+		case d.GetName() == "":
+			out = append(out, apierr.FieldViolation{Field: field + ".name", Description: "name is required"})
+		case seen[d.GetName()]:
+			out = append(out, apierr.FieldViolation{Field: field + ".name", Description: fmt.Sprintf("duplicate column name %s", d.GetName())})
+		}
+		if d.GetTypeId() == "" {
+			out = append(out, apierr.FieldViolation{Field: field + ".type_id", Description: "type_id is required"})
+		}
+		seen[d.GetName()] = true
+	}
+	return out
+}
+
+// columnIDsByName 把索引定义中的列名解析为列 id。
+func columnIDsByName(cols []*lowcodev1.Column, names []string) ([]string, error) {
+	byName := make(map[string]string, len(cols))
+	for _, c := range cols {
+		byName[c.GetName()] = c.GetId()
+	}
+	ids := make([]string, len(names))
+	for i, n := range names {
+		id, ok := byName[n]
+		if !ok {
+			return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", n)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// -------- Declarative schema apply --------
+
+// schemaChange 是 ApplyTableSchema 计划中的一步，apply 在同一个事务内按顺序执行。
+type schemaChange struct {
+	change *lowcodev1.SchemaChange
+	apply  func(ctx context.Context, tx pgx.Tx) error
+	// impact 只在破坏性的步骤上设置，dry_run 时计算该步的影响
+	impact func(ctx context.Context, tx pgx.Tx) (*lowcodev1.SchemaImpact, error)
+}
+
+// currentIndex 是 lc_indexes 中已登记的索引。
+type currentIndex struct {
+	ID        string
+	Name      string
+	ColumnIDs []string
+	IsUnique  bool
+	Method    string // pg_am 名，早期创建的索引为空
+}
+
+// ApplyTableSchema 对比期望的表定义与当前的 lc_tables / lc_columns / lc_indexes，生成并在一个事务内执行
+// 最少的变更：建表、加列、改类型（CAST）、改可空 / 位置 / config、重建变化的索引；prune 时删除多余的列和索引。
+// dry_run 时只返回计划，改类型、删列和删索引的步骤附带影响分析（同 ChangeColumnType / DeleteColumn 的 dry_run）。
+func (s *LowcodeService) ApplyTableSchema(ctx context.Context, req *lowcodev1.ApplyTableSchemaRequest) (*lowcodev1.ApplyTableSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "name is required")
+	}
+	if v := columnDefinitionViolations(req.GetColumns()); len(v) > 0 {
+		return nil, apierr.NewValidation(v)
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	plan, err := s.planTableSchema(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	var res lowcodev1.ApplyTableSchemaResponse
+	for _, c := range plan {
+		res.Changes = append(res.Changes, c.change)
+	}
+	if req.GetDryRun() {
+		for i, c := range plan {
+			if c.impact == nil {
+				continue
+			}
+			impact, err := c.impact(ctx, tx)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", c.change.GetAction(), c.change.GetTarget(), err)
+			}
+			res.Changes[i].Impact = impact
+			res.Impact = mergeImpact(res.Impact, impact)
+		}
+		return &res, nil
+	}
+	for _, c := range plan {
+		if err := c.apply(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", c.change.GetAction(), c.change.GetTarget(), err)
+		}
+	}
+
+	if res.Table, err = scanTable(tx.QueryRow(ctx, `SELECT `+tableFieldsSQL+` FROM lc_tables WHERE name = $1`, req.GetName())); err != nil {
+		return nil, err
+	}
+	if res.Columns, err = queryColumns(ctx, tx, columnSelectSQL+`WHERE c.table_id = $1 ORDER BY c.position`, req.GetName()); err != nil {
+		return nil, err
+	}
+	if res.Indexes, err = queryIndexes(ctx, tx, `
+		SELECT `+indexFieldsSQL+`
+		FROM lc_indexes
+		WHERE table_id = $1
+		ORDER BY name`, req.GetName()); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) planTableSchema(ctx context.Context, tx pgx.Tx, req *lowcodev1.ApplyTableSchemaRequest) ([]schemaChange, error) {
+	name := req.GetName()
+	var plan []schemaChange
+	add := func(action, target, detail string, apply func(ctx context.Context, tx pgx.Tx) error) {
+		plan = append(plan, schemaChange{
+			change: &lowcodev1.SchemaChange{Action: action, Target: target, Detail: detail},
+			apply:  apply,
+		})
+	}
+	// destructive 为最后加入的步骤设置 dry_run 时的影响分析
+	destructive := func(impact func(ctx context.Context, tx pgx.Tx) (*lowcodev1.SchemaImpact, error)) {
+		plan[len(plan)-1].impact = impact
+	}
+	dropIndexImpact := func(id string) {
+		destructive(func(ctx context.Context, tx pgx.Tx) (*lowcodev1.SchemaImpact, error) { return indexImpact(ctx, tx, id) })
+	}
+
+	var tableCfg map[string]any
+	err := tx.QueryRow(ctx, `SELECT config FROM lc_tables WHERE name = $1`, name).Scan(&tableCfg)
+	exists := err == nil
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	var cols []columnMeta
+	var indexes []currentIndex
+	partitionColumn := ""
+	if exists {
+		if cols, err = sourceColumns(ctx, tx, name); err != nil {
+			return nil, err
+		}
+		rows, err := tx.Query(ctx, `SELECT id, name, column_ids::text[], is_unique, COALESCE(config->>'method', '') FROM lc_indexes WHERE table_id = $1 ORDER BY name`, name)
+		if err != nil {
+			return nil, err
+		}
+		if indexes, err = pgx.CollectRows(rows, pgx.RowToStructByPos[currentIndex]); err != nil {
+			return nil, err
+		}
+		if pc := partitionFromConfig(tableCfg); pc != nil {
+			partitionColumn = pc.ColumnName
+		}
+	} else {
+		add("create_table", name, "", func(ctx context.Context, tx pgx.Tx) error {
+			_, err := createTable(ctx, tx, &lowcodev1.CreateTableRequest{Name: name, SchemaName: req.GetSchemaName(), Partition: req.GetPartition()})
+			return err
+		})
+		// 分区列随建表创建
+		partitionColumn = req.GetPartition().GetColumnName()
+	}
+
+	byName := make(map[string]columnMeta, len(cols))
+	nameByID := make(map[string]string, len(cols))
+	for _, c := range cols {
+		byName[c.Name] = c
+		nameByID[c.Id] = c.Name
+	}
+	desired := make(map[string]bool, len(req.GetColumns()))
+	managed := make(map[string]bool) // 由列 is_unique 管理的索引名
+
+	for _, d := range req.GetColumns() {
+		d := d
+		desired[d.GetName()] = true
+		if d.GetName() == partitionColumn && partitionColumn != "" {
+			continue
+		}
+		c, ok := byName[d.GetName()]
+		if !ok {
+			add("add_column", d.GetName(), d.GetTypeId(), func(ctx context.Context, tx pgx.Tx) error {
+				_, err := addColumn(ctx, tx, addColumnRequest(name, d))
+				return err
+			})
+			continue
+		}
+
+		typ, err := resolveType(ctx, tx, d.GetTypeId())
+		if err != nil {
+			return nil, err
+		}
+		if typ.GetId() != c.TypeId {
+			add("change_column_type", c.Name, c.TypeId+" -> "+typ.GetId(), func(ctx context.Context, tx pgx.Tx) error {
+				_, err := changeColumnType(ctx, tx, &lowcodev1.ChangeColumnTypeRequest{ColumnId: c.Id, NewTypeId: typ.GetId()})
+				return err
+			})
+			destructive(func(ctx context.Context, tx pgx.Tx) (*lowcodev1.SchemaImpact, error) {
+				res, err := changeColumnType(ctx, tx, &lowcodev1.ChangeColumnTypeRequest{ColumnId: c.Id, NewTypeId: typ.GetId(), DryRun: true})
+				return res.GetImpact(), err
+			})
+		}
+
+		upd := &lowcodev1.UpdateColumnRequest{Id: c.Id}
+		var details []string
+		if d.GetIsNullable() != c.IsNullable {
+			nullable := d.GetIsNullable()
+			upd.IsNullable = &nullable
+			details = append(details, fmt.Sprintf("is_nullable=%t", nullable))
+		}
+		if d.GetIsHidden() != c.IsHidden {
+			hidden := d.GetIsHidden()
+			upd.IsHidden = &hidden
+			details = append(details, fmt.Sprintf("is_hidden=%t", hidden))
+		}
+		if d.GetIsReadonly() != c.IsReadonly {
+			readonly := d.GetIsReadonly()
+			upd.IsReadonly = &readonly
+			details = append(details, fmt.Sprintf("is_readonly=%t", readonly))
+		}
+		if d.GetDescription() != c.Description {
+			description := d.GetDescription()
+			upd.Description = &description
+			details = append(details, "description")
+		}
+		if d.GetLabel() != c.Label {
+			label := d.GetLabel()
+			upd.Label = &label
+			details = append(details, "label")
+		}
+		if d.GetUiHints() != nil && !reflect.DeepEqual(d.GetUiHints().AsMap(), c.UIHints) {
+			upd.UiHints = d.GetUiHints()
+			details = append(details, "ui_hints")
+		}
+		if d.GetPosition() != 0 && d.GetPosition() != c.Position {
+			upd.Position = d.GetPosition()
+			details = append(details, fmt.Sprintf("position=%d", d.GetPosition()))
+		}
+		if want := d.GetConfig().AsMap(); d.GetConfig() != nil && !configContains(c.Config, want) {
+			merged := make(map[string]any, len(c.Config)+len(want))
+			for k, v := range c.Config {
+				merged[k] = v
+			}
+			for k, v := range want {
+				merged[k] = v
+			}
+			if typ.GetId() == c.TypeId && (c.Kind == selectKindSingle || c.Kind == selectKindMulti) {
+				if err := normalizeSelectOptions(merged); err != nil {
+					return nil, err
+				}
+			}
+			upd.Config = toStruct(merged)
+			details = append(details, "config")
+		}
+		if len(details) > 0 {
+			add("update_column", c.Name, strings.Join(details, ", "), func(ctx context.Context, tx pgx.Tx) error {
+				_, err := updateColumn(ctx, tx, upd)
+				return err
+			})
+		}
+
+		uniqueName := c.Name + "_unique"
+		var unique *currentIndex
+		for i, idx := range indexes {
+			if idx.IsUnique && len(idx.ColumnIDs) == 1 && idx.ColumnIDs[0] == c.Id {
+				unique = &indexes[i]
+				if idx.Name == uniqueName {
+					break
+				}
+			}
+		}
+		switch {
+		case d.GetIsUnique() && unique == nil:
+			managed[uniqueName] = true
+			add("create_index", uniqueName, "unique ("+c.Name+")", func(ctx context.Context, tx pgx.Tx) error {
+				var schemaName, physTable string
+				if err := tx.QueryRow(ctx, `SELECT schema_name, table_name FROM lc_tables WHERE name = $1`, name).Scan(&schemaName, &physTable); err != nil {
+					return err
+				}
+				_, err := createUniqueColumnIndex(ctx, tx, name, schemaName, physTable, &lowcodev1.Column{Id: c.Id, Name: c.Name, PgColumn: c.PgColumn})
+				return err
+			})
+		case d.GetIsUnique():
+			managed[unique.Name] = true
+		case unique != nil && unique.Name == uniqueName:
+			managed[uniqueName] = true
+			id := unique.ID
+			add("drop_index", uniqueName, "", func(ctx context.Context, tx pgx.Tx) error { return deleteIndex(ctx, tx, id) })
+			dropIndexImpact(id)
+		}
+	}
+	if req.GetPrune() {
+		for _, c := range cols {
+			if desired[c.Name] || c.Name == partitionColumn {
+				continue
+			}
+			id := c.Id
+			add("drop_column", c.Name, "", func(ctx context.Context, tx pgx.Tx) error {
+				_, err := deleteColumn(ctx, tx, &lowcodev1.DeleteColumnRequest{Id: id})
+				return err
+			})
+			destructive(func(ctx context.Context, tx pgx.Tx) (*lowcodev1.SchemaImpact, error) {
+				res, err := deleteColumn(ctx, tx, &lowcodev1.DeleteColumnRequest{Id: id, DryRun: true})
+				return res.GetImpact(), err
+			})
+		}
+	}
+
+	current := make(map[string]currentIndex, len(indexes))
+	for _, idx := range indexes {
+		current[idx.Name] = idx
+	}
+	wanted := make(map[string]bool, len(req.GetIndexes()))
+	for _, d := range req.GetIndexes() {
+		d := d
+		wanted[d.GetName()] = true
+		if idx, ok := current[d.GetName()]; ok {
+			names := make([]string, len(idx.ColumnIDs))
+			for i, id := range idx.ColumnIDs {
+				names[i] = nameByID[id]
+			}
+			sameMethod := d.GetIndexMethod() == lowcodev1.IndexMethod_INDEX_METHOD_UNSPECIFIED || indexMethodNames[d.GetIndexMethod()] == idx.Method
+			if idx.IsUnique == d.GetIsUnique() && slices.Equal(names, d.GetColumnNames()) && sameMethod {
+				continue
+			}
+			id := idx.ID
+			add("drop_index", idx.Name, "definition changed", func(ctx context.Context, tx pgx.Tx) error { return deleteIndex(ctx, tx, id) })
+			dropIndexImpact(id)
+		}
+		detail := "(" + strings.Join(d.GetColumnNames(), ", ") + ")"
+		if d.GetIsUnique() {
+			detail = "unique " + detail
+		}
+		if m, ok := indexMethodNames[d.GetIndexMethod()]; ok {
+			detail = "using " + m + " " + detail
+		}
+		add("create_index", d.GetName(), detail, func(ctx context.Context, tx pgx.Tx) error {
+			// 列可能在本次计划中刚刚添加，执行时再按名字解析
+			cols, err := queryColumns(ctx, tx, columnSelectSQL+`WHERE c.table_id = $1`, name)
+			if err != nil {
+				return err
+			}
+			ids, err := columnIDsByName(cols, d.GetColumnNames())
+			if err != nil {
+				return err
+			}
+			_, err = s.createIndex(ctx, tx, &lowcodev1.CreateIndexRequest{TableId: name, Name: d.GetName(), ColumnIds: ids, IsUnique: d.GetIsUnique(), IndexMethod: d.GetIndexMethod()})
+			return err
+		})
+	}
+	if req.GetPrune() {
+		for _, idx := range indexes {
+			if wanted[idx.Name] || managed[idx.Name] {
+				continue
+			}
+			id := idx.ID
+			add("drop_index", idx.Name, "", func(ctx context.Context, tx pgx.Tx) error { return deleteIndex(ctx, tx, id) })
+			dropIndexImpact(id)
+		}
+	}
+	return plan, nil
+}
+
+// configContains 判断 want 中给出的每个字段都与 cur 一致。服务端补充的字段（选项 id、join_table、
+// 合并进来的类型默认值等）不算差异；选择列的选项可以只写 label。
+func configContains(cur, want any) bool {
+	switch w := want.(type) {
+	case map[string]any:
+		c, ok := cur.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range w {
+			if !configContains(c[k], v) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		c, ok := cur.([]any)
+		if !ok || len(c) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !configContains(c[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		if m, ok := cur.(map[string]any); ok {
+			return m["label"] == w
+		}
+	}
+	return reflect.DeepEqual(cur, want)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Select options --------
+
+// 选择列类型（lc_types.config.kind）。single_select 存 text，multi_select 存 text[]，单元格保存选项的 label。
+const (
+	selectKindSingle = "single_select"
+	selectKindMulti  = "multi_select"
+)
+
+// normalizeSelectOptions 规范化 AddColumn 传入的 config.options：字符串转成 {id, label}，
+// 缺少 id 的补上，label 不能为空或重复。
+func normalizeSelectOptions(cfg map[string]any) error {
+	raw, _ := cfg["options"].([]any)
+	options := make([]any, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, o := range raw {
+		opt := map[string]any{}
+		switch x := o.(type) {
+		case string:
+			opt["label"] = x
+		case map[string]any:
+			for k, v := range x {
+				opt[k] = v
+			}
+		default:
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "select option must be a string or an object with label")
+		}
+		label, _ := opt["label"].(string)
+		if label == "" {
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "select option label is required")
+		}
+		if seen[label] {
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "duplicate select option %q", label)
+		}
+		seen[label] = true
+		if id, _ := opt["id"].(string); id == "" {
+			opt["id"] = newOptionID()
+		}
+		options = append(options, opt)
+	}
+	cfg["options"] = options
+	return nil
+}
+
+func newOptionID() string {
+	return "opt_" + strings.ReplaceAll(uuid.New().String()[:8], "-", "")
+}
+
+// unknownSelectValues 返回写入选择列的值中不在 config.options 里的 label。
+func unknownSelectValues(c columnMeta, v *lowcodev1.Value) []string {
+	options, _ := c.Config["options"].([]any)
+	var labels []string
+	switch x := v.GetKind().(type) {
+	case *lowcodev1.Value_StringValue:
+		labels = []string{x.StringValue}
+	case *lowcodev1.Value_ListValue:
+		for _, e := range x.ListValue.GetValues() {
+			labels = append(labels, e.GetStringValue())
+		}
+	}
+	var bad []string
+	for _, l := range labels {
+		if !containsOption(options, l) {
+			bad = append(bad, fmt.Sprintf("%q", l))
+		}
+	}
+	return bad
+}
+
+// selectColumn 是选项管理 RPC 在事务中锁定的列。
+type selectColumn struct {
+	kind                         string
+	schemaName, tableName, pgCol string
+	cfg                          map[string]any
+	options                      []any
+}
+
+// lockSelectColumn 锁定 lc_columns 中的选择列并读出选项，避免并发修改选项时互相覆盖。
+func lockSelectColumn(ctx context.Context, tx pgx.Tx, columnID string) (*selectColumn, error) {
+	sc := &selectColumn{}
+	if err := tx.QueryRow(ctx, `
+		SELECT COALESCE(ty.config->>'kind', ''), t.schema_name, t.table_name, c.pg_column, c.config
+		FROM lc_columns c
+		JOIN lc_tables t ON c.table_id = t.name
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.id = $1
+		FOR UPDATE OF c`, columnID).Scan(&sc.kind, &sc.schemaName, &sc.tableName, &sc.pgCol, &sc.cfg); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.NotFound, "column %s not found", columnID)
+		}
+		return nil, err
+	}
+	if sc.kind != selectKindSingle && sc.kind != selectKindMulti {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "column %s is not a select column", columnID)
+	}
+	if sc.cfg == nil {
+		sc.cfg = map[string]any{}
+	}
+	sc.options, _ = sc.cfg["options"].([]any)
+	return sc, nil
+}
+
+// find 返回 option_id 对应的选项下标。
+func (sc *selectColumn) find(optionID string) (int, map[string]any, error) {
+	for i, o := range sc.options {
+		if opt, ok := o.(map[string]any); ok && opt["id"] == optionID {
+			return i, opt, nil
+		}
+	}
+	return -1, nil, apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "option %s not found", optionID)
+}
+
+// migrate 改写已有单元格：to 为空时删除该选项（single_select 置空，multi_select 从数组中移除）。
+func (sc *selectColumn) migrate(ctx context.Context, tx pgx.Tx, from, to string) (int64, error) {
+	col := pgx.Identifier{sc.pgCol}.Sanitize()
+	rel := pgx.Identifier{sc.schemaName, sc.tableName}.Sanitize()
+	var q string
+	args := []any{from}
+	switch {
+	case sc.kind == selectKindSingle && to == "":
+		q = fmt.Sprintf(`UPDATE %s SET %s = NULL WHERE %s = $1`, rel, col, col)
+	case sc.kind == selectKindSingle:
+		q = fmt.Sprintf(`UPDATE %s SET %s = $2 WHERE %s = $1`, rel, col, col)
+		args = append(args, to)
+	case to == "":
+		q = fmt.Sprintf(`UPDATE %s SET %s = array_remove(%s, $1) WHERE $1 = ANY(%s)`, rel, col, col, col)
+	default:
+		q = fmt.Sprintf(`UPDATE %s SET %s = array_replace(%s, $1, $2) WHERE $1 = ANY(%s)`, rel, col, col, col)
+		args = append(args, to)
+	}
+	tag, err := tx.Exec(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// save 写回 config.options 并返回更新后的列。
+func (sc *selectColumn) save(ctx context.Context, tx pgx.Tx, columnID string) (*lowcodev1.Column, error) {
+	sc.cfg["options"] = sc.options
+	return scanColumn(tx.QueryRow(ctx, `
+		UPDATE lc_columns SET config = $2, updated_at = now()
+		WHERE id = $1
+		RETURNING `+columnFieldsSQL,
+		columnID, sc.cfg))
+}
+
+func (s *LowcodeService) AddSelectOption(ctx context.Context, req *lowcodev1.AddSelectOptionRequest) (*lowcodev1.AddSelectOptionResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetColumnId() == "" || req.GetLabel() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column_id and label are required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	sc, err := lockSelectColumn(ctx, tx, req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if containsOption(sc.options, req.GetLabel()) {
+		return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "option %q already exists", req.GetLabel())
+	}
+	opt := &lowcodev1.SelectOption{Id: newOptionID(), Label: req.GetLabel(), Color: req.GetColor()}
+	sc.options = append(sc.options, map[string]any{"id": opt.Id, "label": opt.Label, "color": opt.Color})
+	col, err := sc.save(ctx, tx, req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.AddSelectOptionResponse{Column: col, Option: opt}, nil
+}
+
+// UpdateSelectOption 修改选项的 label / 颜色；改 label 时同一事务内改写已有单元格。
+func (s *LowcodeService) UpdateSelectOption(ctx context.Context, req *lowcodev1.UpdateSelectOptionRequest) (*lowcodev1.UpdateSelectOptionResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetColumnId() == "" || req.GetOptionId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column_id and option_id are required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	sc, err := lockSelectColumn(ctx, tx, req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	_, opt, err := sc.find(req.GetOptionId())
+	if err != nil {
+		return nil, err
+	}
+	var migrated int64
+	oldLabel, _ := opt["label"].(string)
+	if label := req.GetLabel(); label != "" && label != oldLabel {
+		if containsOption(sc.options, label) {
+			return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "option %q already exists", label)
+		}
+		if migrated, err = sc.migrate(ctx, tx, oldLabel, label); err != nil {
+			return nil, err
+		}
+		opt["label"] = label
+	}
+	if req.GetColor() != "" {
+		opt["color"] = req.GetColor()
+	}
+	col, err := sc.save(ctx, tx, req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UpdateSelectOptionResponse{Column: col, MigratedRows: migrated}, nil
+}
+
+// RemoveSelectOption 删除选项，并从已有单元格中清除它。
+func (s *LowcodeService) RemoveSelectOption(ctx context.Context, req *lowcodev1.RemoveSelectOptionRequest) (*lowcodev1.RemoveSelectOptionResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetColumnId() == "" || req.GetOptionId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "column_id and option_id are required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	sc, err := lockSelectColumn(ctx, tx, req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	i, opt, err := sc.find(req.GetOptionId())
+	if err != nil {
+		return nil, err
+	}
+	label, _ := opt["label"].(string)
+	migrated, err := sc.migrate(ctx, tx, label, "")
+	if err != nil {
+		return nil, err
+	}
+	sc.options = append(sc.options[:i], sc.options[i+1:]...)
+	col, err := sc.save(ctx, tx, req.GetColumnId())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.RemoveSelectOptionResponse{Column: col, MigratedRows: migrated}, nil
+}
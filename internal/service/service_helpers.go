@@ -2,16 +2,22 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
 )
 
 // -------- shared helpers --------
@@ -22,42 +28,194 @@ type columnMeta struct {
 	Name       string
 	TypeId     string
 	PgType     string // 实际 PG 类型，用于写入时空字符串转 NULL
+	Kind       string // lc_types.config.kind，如 single_select
 	PgColumn   string
 	IsNullable bool
 	Position   int32
+	IsHidden   bool           // 行接口默认不返回
+	IsReadonly bool           // 行接口拒绝写入
+	System     bool           // 系统列（created_at 等），只读
+	Config     map[string]any // 列配置，写入校验规则见 validateCells
+
+	// 以下只由 sourceColumns 填充（复制 / 导出列定义时使用）
+	Description string
+	Label       string
+	UIHints     map[string]any
+}
+
+// systemColumnsSQL 是 CreateTable 为每张物理表加上的系统列，lc_tables.config.system_columns 标记该表拥有它们。
+// updated_at 由 lc_touch_updated_at 触发器维护，created_by / updated_by 来自请求的 x-user-id。
+// deleted_at 非空表示行在回收站中（软删除）。
+const systemColumnsSQL = `created_at TIMESTAMPTZ NOT NULL DEFAULT now(), updated_at TIMESTAMPTZ NOT NULL DEFAULT now(), created_by TEXT, updated_by TEXT, deleted_at TIMESTAMPTZ`
+
+// systemColumns 以只读 cell 的形式出现在 Row 中，column id 即物理列名。
+var systemColumns = []columnMeta{
+	{Id: "created_at", Name: "created_at", TypeId: "timestamp", PgType: "timestamptz", PgColumn: "created_at", System: true},
+	{Id: "updated_at", Name: "updated_at", TypeId: "timestamp", PgType: "timestamptz", PgColumn: "updated_at", System: true},
+	{Id: "created_by", Name: "created_by", TypeId: "text", PgType: "text", PgColumn: "created_by", IsNullable: true, System: true},
+	{Id: "updated_by", Name: "updated_by", TypeId: "text", PgType: "text", PgColumn: "updated_by", IsNullable: true, System: true},
+	{Id: "deleted_at", Name: "deleted_at", TypeId: "timestamp", PgType: "timestamptz", PgColumn: "deleted_at", IsNullable: true, System: true},
 }
 
-// resolveTableName 接受对外使用的 table 标识（可以是内部 UUID，也可以是逻辑 name），
-// 并解析成 lc_tables.name，供以 name 作为外键的表（如 lc_columns）使用。
-func (s *LowcodeService) resolveTableName(ctx context.Context, pool *pgxpool.Pool, tableIdentifier string) (string, error) {
-	if tableIdentifier == "" {
-		return "", fmt.Errorf("table_id is required")
+// softDeletes 判断表是否支持软删除（有 deleted_at 系统列）。
+func softDeletes(cols []columnMeta) bool {
+	for _, c := range cols {
+		if c.System && c.Id == "deleted_at" {
+			return true
+		}
 	}
-	const q = `
-		SELECT name
-		FROM lc_tables
-		WHERE name = $1
-	`
-	var name string
-	if err := pool.QueryRow(ctx, q, tableIdentifier).Scan(&name); err != nil {
-		return "", err
+	return false
+}
+
+// andNotDeleted 返回追加在 WHERE 后面、排除回收站行的条件；不支持软删除的表返回空串。
+func andNotDeleted(cols []columnMeta) string {
+	if softDeletes(cols) {
+		return " AND deleted_at IS NULL"
 	}
-	return name, nil
+	return ""
 }
 
-func (s *LowcodeService) loadColumns(ctx context.Context, pool *pgxpool.Pool, tableID string) ([]columnMeta, string, string, error) {
-	resolvedName, err := s.resolveTableName(ctx, pool, tableID)
+// auditWrites 返回写入时需要额外设置的系统列（created_by / updated_by）及其值。
+// insert=false 时只设置 updated_by。
+func auditWrites(ctx context.Context, cols []columnMeta, insert bool) ([]string, []any) {
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, nil
+	}
+	var pgCols []string
+	var args []any
+	for _, c := range cols {
+		if c.Id == "updated_by" || (insert && c.Id == "created_by") {
+			pgCols = append(pgCols, pgx.Identifier{c.PgColumn}.Sanitize())
+			args = append(args, userID)
+		}
+	}
+	return pgCols, args
+}
+
+// tableFieldsSQL 是 scanTable 需要的 lc_tables 字段，SELECT / RETURNING 共用。
+const tableFieldsSQL = `name, schema_name, table_name, config, description, icon, created_at, updated_at, archived_at, original_name, workspace_id`
+
+// tablesWithStatsSQL 是带 pg_class 估算统计的 lc_tables，字段为 lc_tables 的全部字段加 estimated_rows / total_bytes。
+// 分区表按 pg_partition_tree 汇总各分区；物理表不存在时统计为 0。
+const tablesWithStatsSQL = `(
+	SELECT t.*, COALESCE(s.estimated_rows, 0) AS estimated_rows, COALESCE(s.total_bytes, 0) AS total_bytes
+	FROM lc_tables t
+	LEFT JOIN LATERAL (
+		SELECT sum(GREATEST(c.reltuples, 0))::bigint AS estimated_rows, sum(pg_total_relation_size(c.oid))::bigint AS total_bytes
+		FROM pg_partition_tree(to_regclass(format('%I.%I', t.schema_name, t.table_name))) p
+		JOIN pg_class c ON c.oid = p.relid
+	) s ON true
+) t`
+
+// scanTable 扫描 tableFieldsSQL 对应的一行，并按对外约定填充 Table。extra 接收 tableFieldsSQL 之后的字段。
+func scanTable(row pgx.Row, extra ...any) (*lowcodev1.Table, error) {
+	var t lowcodev1.Table
+	var cfg map[string]any
+	var createdAt, updatedAt time.Time
+	var archivedAt *time.Time
+	var workspaceID *string
+	dest := append([]any{&t.Name, &t.SchemaName, &t.TableName, &cfg, &t.Description, &t.Icon, &createdAt, &updatedAt, &archivedAt, &t.OriginalName, &workspaceID}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	// 对外约定：Table.Id 使用逻辑 name。
+	t.Id = t.Name
+	t.CreatedAt = timestamppb.New(createdAt)
+	t.UpdatedAt = timestamppb.New(updatedAt)
+	if archivedAt != nil {
+		t.ArchivedAt = timestamppb.New(*archivedAt)
+	}
+	if workspaceID != nil {
+		t.WorkspaceId = *workspaceID
+	}
+	if pc := partitionFromConfig(cfg); pc != nil {
+		t.Partition = pc.spec()
+	}
+	if v := sqlViewFromConfig(cfg); v != nil {
+		t.SqlView = v.spec()
+	}
+	return &t, nil
+}
+
+// columnFieldsSQL 是 scanColumn 需要的 lc_columns 字段，SELECT / RETURNING 共用。
+const columnFieldsSQL = `id, table_id, name, type_id, pg_column, is_nullable, position, config, created_at, updated_at, is_hidden, is_readonly, description, label, ui_hints`
+
+// scanColumn 扫描 columnFieldsSQL（或同顺序的 columnSelectSQL）对应的一行。
+func scanColumn(row pgx.Row) (*lowcodev1.Column, error) {
+	var c lowcodev1.Column
+	var cfg, uiHints map[string]any
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgColumn, &c.IsNullable, &c.Position, &cfg, &createdAt, &updatedAt,
+		&c.IsHidden, &c.IsReadonly, &c.Description, &c.Label, &uiHints); err != nil {
+		return nil, err
+	}
+	if len(uiHints) > 0 {
+		c.UiHints = toStruct(uiHints)
+	}
+	c.CreatedAt = timestamppb.New(createdAt)
+	c.UpdatedAt = timestamppb.New(updatedAt)
+	if cfg != nil {
+		c.Config = toStruct(cfg)
+	}
+	return &c, nil
+}
+
+// tableRef 是 lookupTable 解析出的表。
+type tableRef struct {
+	Name       string // lc_tables.name，即对外的 table id
+	SchemaName string
+	PhysTable  string
+	Config     map[string]any
+	ArchivedAt *time.Time
+	// OriginalName 是归档前的表名，未归档的表为空
+	OriginalName string
+}
+
+// lookupTable 是所有接受 table id 的接口共用的解析入口。table id 就是逻辑名（lc_tables.name），
+// lc_tables 没有单独的 UUID。id 为空返回 "table_id is required"，表不存在返回 TABLE_NOT_FOUND；
+// 归档的表只有 includeArchived 时可见。lock 时对 lc_tables 行加 FOR UPDATE（q 须为事务）。
+func lookupTable(ctx context.Context, q querier, id string, includeArchived, lock bool) (tableRef, error) {
+	if id == "" {
+		return tableRef{}, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "table_id is required")
+	}
+	query := `SELECT name, schema_name, table_name, config, archived_at, original_name FROM lc_tables WHERE name = $1`
+	if !includeArchived {
+		query += ` AND archived_at IS NULL`
+	}
+	if lock {
+		query += ` FOR UPDATE`
+	}
+	var t tableRef
+	if err := q.QueryRow(ctx, query, id).Scan(&t.Name, &t.SchemaName, &t.PhysTable, &t.Config, &t.ArchivedAt, &t.OriginalName); err != nil {
+		if err == pgx.ErrNoRows {
+			return tableRef{}, apierr.New(lowcodev1.ErrorCode_TABLE_NOT_FOUND, codes.NotFound, "table %s not found", id)
+		}
+		return tableRef{}, err
+	}
+	return t, nil
+}
+
+// resolveTableName 把 table id 解析成 lc_tables.name，供以 name 作为外键的表（如 lc_columns）使用；规则见 lookupTable。
+func (s *LowcodeService) resolveTableName(ctx context.Context, pool querier, tableIdentifier string) (string, error) {
+	t, err := lookupTable(ctx, pool, tableIdentifier, false, false)
+	return t.Name, err
+}
+
+func (s *LowcodeService) loadColumns(ctx context.Context, pool querier, tableID string) ([]columnMeta, string, string, error) {
+	// 表信息单独查询：没有用户列的表也要返回物理表名和系统列。
+	t, err := lookupTable(ctx, pool, tableID, false, false)
 	if err != nil {
 		return nil, "", "", err
 	}
+	resolvedName, schemaName, tableName := t.Name, t.SchemaName, t.PhysTable
+	hasSystem, _ := t.Config["system_columns"].(bool)
 	const q = `
-		SELECT c.id, c.table_id, c.name, c.type_id, ty.pg_type, c.pg_column, c.is_nullable, c.position,
-		       t.schema_name, t.table_name
+		SELECT c.id, c.table_id, c.name, c.type_id, ty.pg_type, COALESCE(ty.config->>'kind', ''), c.pg_column, c.is_nullable, c.position, c.config, c.is_hidden, c.is_readonly
 		FROM lc_columns c
-		JOIN lc_tables t ON c.table_id = t.name
 		JOIN lc_types ty ON c.type_id = ty.id
 		WHERE c.table_id = $1
-		  AND COALESCE(ty.config->>'kind', '') NOT IN ('formula', 'relationship')
+		  AND COALESCE(ty.config->>'kind', '') NOT IN (` + virtualKindsSQL + `)
 		ORDER BY c.position
 	`
 	rows, err := pool.Query(ctx, q, resolvedName)
@@ -67,10 +225,9 @@ func (s *LowcodeService) loadColumns(ctx context.Context, pool *pgxpool.Pool, ta
 	defer rows.Close()
 
 	var cols []columnMeta
-	var schemaName, tableName string
 	for rows.Next() {
 		var c columnMeta
-		if err := rows.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgType, &c.PgColumn, &c.IsNullable, &c.Position, &schemaName, &tableName); err != nil {
+		if err := rows.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgType, &c.Kind, &c.PgColumn, &c.IsNullable, &c.Position, &c.Config, &c.IsHidden, &c.IsReadonly); err != nil {
 			return nil, "", "", err
 		}
 		cols = append(cols, c)
@@ -78,16 +235,64 @@ func (s *LowcodeService) loadColumns(ctx context.Context, pool *pgxpool.Pool, ta
 	if err := rows.Err(); err != nil {
 		return nil, "", "", err
 	}
+	if hasSystem {
+		for _, c := range systemColumns {
+			c.TableId = resolvedName
+			cols = append(cols, c)
+		}
+	}
 	return cols, schemaName, tableName, nil
 }
 
+// virtualKinds 是没有物理列的列类型（lc_types.config.kind），值在读取时计算。
+var virtualKinds = map[string]bool{"formula": true, "relationship": true, "rollup": true, "lookup": true}
+
+// virtualKindsSQL 是 virtualKinds 的 SQL 列表形式。
+const virtualKindsSQL = `'formula', 'relationship', 'rollup', 'lookup'`
+
+// rowColumnsSQL 返回 "id, <物理列...>"，与 scanRow 的扫描顺序一致，SELECT / RETURNING 共用。
+func rowColumnsSQL(cols []columnMeta) string {
+	columnSQL := "id"
+	for _, c := range cols {
+		columnSQL += ", " + geoColumnSQL(c)
+	}
+	return columnSQL
+}
+
+// scanRow 扫描 rowColumnsSQL 对应的一行，NULL 单元格不放入 cells。
+func scanRow(row pgx.Row, cols []columnMeta) (*lowcodev1.Row, error) {
+	scanTargets := make([]any, 1+len(cols))
+	var id string
+	scanTargets[0] = &id
+	values := make([]any, len(cols))
+	for i := range values {
+		scanTargets[i+1] = &values[i]
+	}
+	if err := row.Scan(scanTargets...); err != nil {
+		return nil, err
+	}
+	out := &lowcodev1.Row{
+		Id:    id,
+		Cells: make(map[string]*lowcodev1.Value, len(cols)),
+	}
+	for i, c := range cols {
+		if values[i] != nil {
+			out.Cells[c.Id] = anyToValue(values[i])
+		}
+	}
+	return out, nil
+}
+
 // relationshipColumn 表示一个 relationship 类型列的元数据，用于 expand 查询。
-// Config 约定：target_table_id=关联表 id；link_column_id=子表中外键列 id（一对多）；target_column_id=本表中外键列 id（多对一/一对一）。
+// Config 约定：target_table_id=关联表 id；link_column_id=子表中外键列 id（一对多）；target_column_id=本表中外键列 id（多对一/一对一）；
+// kind=many_to_many 时由服务创建关联表，join_schema / join_table 记录其位置（多对多）。
 type relationshipColumn struct {
-	Id               string
-	TargetTableId    string
-	LinkColumnId     string   // 子表指向当前表行 id 的列，有则为一对多
-	TargetColumnId   string   // 本表存目标行 id 的列，有则为多对一/一对一
+	Id             string
+	TargetTableId  string
+	LinkColumnId   string // 子表指向当前表行 id 的列，有则为一对多
+	TargetColumnId string // 本表存目标行 id 的列，有则为多对一/一对一
+	JoinSchema     string // 多对多关联表 (source_id, target_id)
+	JoinTable      string
 }
 
 // loadRelationshipColumns 加载表中指定 id 的 relationship 列及其 config。
@@ -137,11 +342,15 @@ func (s *LowcodeService) loadRelationshipColumns(ctx context.Context, pool *pgxp
 			if v, _ := cfg["target_column_id"].(string); v != "" {
 				rc.TargetColumnId = v
 			}
+			if kind, _ := cfg["kind"].(string); kind == relationshipManyToMany {
+				rc.JoinSchema, _ = cfg["join_schema"].(string)
+				rc.JoinTable, _ = cfg["join_table"].(string)
+			}
 		}
 		if rc.TargetTableId == "" {
 			continue
 		}
-		if rc.LinkColumnId == "" && rc.TargetColumnId == "" {
+		if rc.LinkColumnId == "" && rc.TargetColumnId == "" && rc.JoinTable == "" {
 			continue
 		}
 		out = append(out, rc)
@@ -150,20 +359,7 @@ func (s *LowcodeService) loadRelationshipColumns(ctx context.Context, pool *pgxp
 }
 
 // valueToAny 把 protobuf Value 转成可以写入 PG 的 Go 值。
-func valueToAny(v *lowcodev1.Value) any {
-	return valueToAnyForColumn(v, "")
-}
-
-// valueToAnyForColumn 根据列 PG 类型转换：空字符串写入 numeric/timestamptz/boolean 等时转为 nil (NULL)。
-func valueToAnyForColumn(v *lowcodev1.Value, pgType string) any {
-	raw := valueToAnyRaw(v)
-	// 空字符串且列为非 text 类型时写 NULL，避免 "invalid input syntax for type numeric: \"\""
-	if s, ok := raw.(string); ok && s == "" && pgType != "" && pgType != "text" && pgType != "jsonb" && pgType != "json" {
-		return nil
-	}
-	return raw
-}
-
+// valueToAnyRaw 按 Value 的 kind 直接转换，不考虑列类型；写入列时使用 coerceValue。
 func valueToAnyRaw(v *lowcodev1.Value) any {
 	if v == nil {
 		return nil
@@ -181,6 +377,14 @@ func valueToAnyRaw(v *lowcodev1.Value) any {
 		return x.BytesValue
 	case *lowcodev1.Value_JsonValue:
 		return x.JsonValue.AsMap()
+	case *lowcodev1.Value_NullValue:
+		return nil
+	case *lowcodev1.Value_ListValue:
+		out := make([]any, len(x.ListValue.GetValues()))
+		for i, e := range x.ListValue.GetValues() {
+			out[i] = valueToAnyRaw(e)
+		}
+		return out
 	default:
 		return nil
 	}
@@ -198,8 +402,24 @@ func anyToValue(v any) *lowcodev1.Value {
 		return &lowcodev1.Value{Kind: &lowcodev1.Value_BoolValue{BoolValue: t}}
 	case time.Time:
 		return &lowcodev1.Value{Kind: &lowcodev1.Value_TimestampValue{TimestampValue: timestamppb.New(t)}}
-	case int32, int64, float32, float64:
+	case int16, int32, int64, float32, float64:
 		return &lowcodev1.Value{Kind: &lowcodev1.Value_NumberValue{NumberValue: toFloat64(t)}}
+	case [16]byte:
+		// uuid 列
+		return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: uuid.UUID(t).String()}}
+	case nil:
+		return &lowcodev1.Value{Kind: &lowcodev1.Value_NullValue{}}
+	case map[string]any:
+		if st, err := structpb.NewStruct(t); err == nil {
+			return &lowcodev1.Value{Kind: &lowcodev1.Value_JsonValue{JsonValue: st}}
+		}
+	case []any:
+		// PG 数组列和 jsonb 数组都会被 pgx 解码成 []any，元素递归转换
+		list := &lowcodev1.ValueList{Values: make([]*lowcodev1.Value, len(t))}
+		for i, e := range t {
+			list.Values[i] = anyToValue(e)
+		}
+		return &lowcodev1.Value{Kind: &lowcodev1.Value_ListValue{ListValue: list}}
 	case pgtype.Numeric:
 		if f, err := numericToFloat64(t); err == nil {
 			return &lowcodev1.Value{Kind: &lowcodev1.Value_NumberValue{NumberValue: f}}
@@ -216,6 +436,32 @@ func anyToValue(v any) *lowcodev1.Value {
 	return &lowcodev1.Value{Kind: &lowcodev1.Value_StringValue{StringValue: fmt.Sprint(v)}}
 }
 
+// valueToJSON 把 Value 转成 structpb 可接受的值（时间为 RFC3339 字符串，bytes 为 base64），用于嵌套在 json_value 中。
+func valueToJSON(v *lowcodev1.Value) any {
+	switch x := v.GetKind().(type) {
+	case *lowcodev1.Value_StringValue:
+		return x.StringValue
+	case *lowcodev1.Value_NumberValue:
+		return x.NumberValue
+	case *lowcodev1.Value_BoolValue:
+		return x.BoolValue
+	case *lowcodev1.Value_TimestampValue:
+		return x.TimestampValue.AsTime().Format(time.RFC3339Nano)
+	case *lowcodev1.Value_BytesValue:
+		return base64.StdEncoding.EncodeToString(x.BytesValue)
+	case *lowcodev1.Value_JsonValue:
+		return x.JsonValue.AsMap()
+	case *lowcodev1.Value_ListValue:
+		out := make([]any, len(x.ListValue.GetValues()))
+		for i, e := range x.ListValue.GetValues() {
+			out[i] = valueToJSON(e)
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
 func numericToFloat64(n pgtype.Numeric) (float64, error) {
 	f8, err := n.Float64Value()
 	if err != nil || !f8.Valid {
@@ -226,6 +472,8 @@ func numericToFloat64(n pgtype.Numeric) (float64, error) {
 
 func toFloat64(v any) float64 {
 	switch t := v.(type) {
+	case int16:
+		return float64(t)
 	case int32:
 		return float64(t)
 	case int64:
@@ -250,4 +498,3 @@ func toStruct(m map[string]any) *structpb.Struct {
 	}
 	return s
 }
-
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// fakeRow 按顺序把 values 赋给 Scan 的目标，err 非空时直接返回 err。
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[i]))
+	}
+	return nil
+}
+
+// fakeQuerier 记录 QueryRow 收到的 SQL 和参数，返回固定的 row。
+type fakeQuerier struct {
+	row   fakeRow
+	sql   string
+	args  []any
+	calls int
+}
+
+func (q *fakeQuerier) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, errors.New("unexpected Query")
+}
+
+func (q *fakeQuerier) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	q.calls++
+	q.sql, q.args = sql, args
+	return q.row
+}
+
+func TestLookupTable(t *testing.T) {
+	archivedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	dbErr := errors.New("connection reset")
+	tests := []struct {
+		name            string
+		id              string
+		includeArchived bool
+		lock            bool
+		row             fakeRow
+		wantCode        lowcodev1.ErrorCode
+		wantStatus      codes.Code
+		wantErr         error
+		wantSQL         []string
+		notSQL          []string
+		want            tableRef
+	}{
+		{
+			name:       "empty id",
+			wantCode:   lowcodev1.ErrorCode_VALIDATION_FAILED,
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name:       "unknown table",
+			id:         "missing",
+			row:        fakeRow{err: pgx.ErrNoRows},
+			wantCode:   lowcodev1.ErrorCode_TABLE_NOT_FOUND,
+			wantStatus: codes.NotFound,
+		},
+		{
+			name:    "database error",
+			id:      "orders",
+			row:     fakeRow{err: dbErr},
+			wantErr: dbErr,
+		},
+		{
+			name:    "active table",
+			id:      "orders",
+			row:     fakeRow{values: []any{"orders", "public", "lc_t_orders", map[string]any{"system_columns": true}, (*time.Time)(nil), ""}},
+			wantSQL: []string{"WHERE name = $1", "archived_at IS NULL"},
+			notSQL:  []string{"FOR UPDATE"},
+			want:    tableRef{Name: "orders", SchemaName: "public", PhysTable: "lc_t_orders", Config: map[string]any{"system_columns": true}},
+		},
+		{
+			name:            "archived table with lock",
+			id:              "orders__archived_ab12",
+			includeArchived: true,
+			lock:            true,
+			row:             fakeRow{values: []any{"orders__archived_ab12", "public", "lc_a_ab12", map[string]any{}, &archivedAt, "orders"}},
+			wantSQL:         []string{"WHERE name = $1", "FOR UPDATE"},
+			notSQL:          []string{"archived_at IS NULL"},
+			want:            tableRef{Name: "orders__archived_ab12", SchemaName: "public", PhysTable: "lc_a_ab12", Config: map[string]any{}, ArchivedAt: &archivedAt, OriginalName: "orders"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &fakeQuerier{row: tt.row}
+			got, err := lookupTable(context.Background(), q, tt.id, tt.includeArchived, tt.lock)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantCode != lowcodev1.ErrorCode_ERROR_CODE_UNSPECIFIED:
+				if apierr.CodeOf(err) != tt.wantCode || status.Code(err) != tt.wantStatus {
+					t.Fatalf("err = %v, want %s / %s", err, tt.wantCode, tt.wantStatus)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			default:
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ref = %+v, want %+v", got, tt.want)
+				}
+			}
+			if tt.id == "" {
+				if q.calls != 0 {
+					t.Errorf("empty id queried the database")
+				}
+				return
+			}
+			if len(q.args) != 1 || q.args[0] != tt.id {
+				t.Errorf("args = %v, want [%s]", q.args, tt.id)
+			}
+			for _, s := range tt.wantSQL {
+				if !strings.Contains(q.sql, s) {
+					t.Errorf("query %q does not contain %q", q.sql, s)
+				}
+			}
+			for _, s := range tt.notSQL {
+				if strings.Contains(q.sql, s) {
+					t.Errorf("query %q contains %q", q.sql, s)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveTableName(t *testing.T) {
+	s := &LowcodeService{}
+	q := &fakeQuerier{row: fakeRow{values: []any{"orders", "public", "lc_t_orders", map[string]any{}, (*time.Time)(nil), ""}}}
+	name, err := s.resolveTableName(context.Background(), q, "orders")
+	if err != nil || name != "orders" {
+		t.Fatalf("resolveTableName = %q, %v; want orders", name, err)
+	}
+	if !strings.Contains(q.sql, "archived_at IS NULL") {
+		t.Errorf("resolveTableName must not resolve archived tables: %q", q.sql)
+	}
+
+	q = &fakeQuerier{row: fakeRow{err: pgx.ErrNoRows}}
+	if _, err := s.resolveTableName(context.Background(), q, "missing"); apierr.CodeOf(err) != lowcodev1.ErrorCode_TABLE_NOT_FOUND {
+		t.Fatalf("resolveTableName(missing) err = %v, want TABLE_NOT_FOUND", err)
+	}
+}
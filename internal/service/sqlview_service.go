@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- SQL View --------
+
+// sqlViewPhysPrefix 是 SQL 视图的物理名前缀，与 CreateTable 的 lc_t_ 区分。
+const sqlViewPhysPrefix = "lc_v_"
+
+// sqlViewConfig 保存在 lc_tables.config.sql_view 中。
+type sqlViewConfig struct {
+	SQL          string
+	Materialized bool
+	RefreshedAt  string // RFC3339，仅物化视图
+}
+
+func (v *sqlViewConfig) toMap() map[string]any {
+	m := map[string]any{"sql": v.SQL, "materialized": v.Materialized}
+	if v.RefreshedAt != "" {
+		m["refreshed_at"] = v.RefreshedAt
+	}
+	return m
+}
+
+func sqlViewFromConfig(cfg map[string]any) *sqlViewConfig {
+	m, ok := cfg["sql_view"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	v := &sqlViewConfig{}
+	v.SQL, _ = m["sql"].(string)
+	v.Materialized, _ = m["materialized"].(bool)
+	v.RefreshedAt, _ = m["refreshed_at"].(string)
+	return v
+}
+
+func (v *sqlViewConfig) spec() *lowcodev1.SQLViewSpec {
+	s := &lowcodev1.SQLViewSpec{Sql: v.SQL, Materialized: v.Materialized}
+	if t, err := time.Parse(time.RFC3339Nano, v.RefreshedAt); err == nil {
+		s.RefreshedAt = timestamppb.New(t)
+	}
+	return s
+}
+
+// dropSQL 返回删除物理视图的语句。
+func (v *sqlViewConfig) dropSQL(schemaName, physTable string) string {
+	kind := "VIEW"
+	if v.Materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+	return fmt.Sprintf(`DROP %s IF EXISTS %s`, kind, pgx.Identifier{schemaName, physTable}.Sanitize())
+}
+
+// sqlViewReadOnly 返回对 SQL 视图做写入或结构变更时的错误。
+func sqlViewReadOnly(name string) error {
+	return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is a SQL view and is read-only", name)
+}
+
+// pooledMaterializedView 返回 pooled 模式下创建或刷新物化视图时的错误：物化视图是所有 tenant 共用的物理表，
+// REFRESH 按调用者的 app.tenant_id 经 RLS 读取来源表，结果只有这个 tenant 的行，却会被其它 tenant 读到。
+func pooledMaterializedView() error {
+	return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+		"materialized SQL views are not supported with TENANT_MODE=pooled; create a plain view instead")
+}
+
+// requireBaseTable 拒绝对 SQL 视图做结构变更：视图的列由 SELECT 决定，不能单独增删改。
+func requireBaseTable(ctx context.Context, q querier, name string) error {
+	var isView bool
+	if err := q.QueryRow(ctx, `SELECT config ? 'sql_view' FROM lc_tables WHERE name = $1`, name).Scan(&isView); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	if isView {
+		return sqlViewReadOnly(name)
+	}
+	return nil
+}
+
+// normalizeViewSQL 去掉首尾空白和末尾的分号，只接受以 SELECT / WITH 开头的语句。
+// 多条语句由扩展协议拒绝，数据修改和行锁由 viewPlanRelations 检查执行计划拒绝，volatile 函数由 checkViewFunctions 拒绝。
+func normalizeViewSQL(sql string) (string, error) {
+	sql = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(sql), ";"))
+	if sql == "" {
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "sql is required")
+	}
+	words := strings.FieldsFunc(strings.ToLower(sql), func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '('
+	})
+	if len(words) == 0 || (words[0] != "select" && words[0] != "with") {
+		return "", apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "sql must be a single SELECT statement")
+	}
+	return sql, nil
+}
+
+// explainNode 是 EXPLAIN (VERBOSE, FORMAT JSON) 输出中的一个计划节点。
+type explainNode struct {
+	NodeType     string        `json:"Node Type"`
+	RelationName string        `json:"Relation Name"`
+	Schema       string        `json:"Schema"`
+	Plans        []explainNode `json:"Plans"`
+}
+
+// viewPlanRelations 只做 EXPLAIN（不执行）拿到查询会读取的物理表，计划中出现数据修改或行锁时拒绝。
+// 固定使用扩展协议（不依赖连接的 default_query_exec_mode），一次只能包含一条语句。
+func viewPlanRelations(ctx context.Context, q querier, sql string) (schemas, names []string, err error) {
+	var raw []byte
+	if err := q.QueryRow(ctx, `EXPLAIN (VERBOSE, FORMAT JSON) `+sql, pgx.QueryExecModeExec).Scan(&raw); err != nil {
+		return nil, nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid sql: %v", err)
+	}
+	var plans []struct {
+		Plan explainNode `json:"Plan"`
+	}
+	if err := json.Unmarshal(raw, &plans); err != nil {
+		return nil, nil, err
+	}
+	seen := map[string]bool{}
+	var walk func(n explainNode) error
+	walk = func(n explainNode) error {
+		switch n.NodeType {
+		case "ModifyTable", "LockRows":
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "sql must be read-only (found %s)", n.NodeType)
+		}
+		if n.RelationName != "" && !seen[n.Schema+"."+n.RelationName] {
+			seen[n.Schema+"."+n.RelationName] = true
+			schemas = append(schemas, n.Schema)
+			names = append(names, n.RelationName)
+		}
+		for _, c := range n.Plans {
+			if err := walk(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, p := range plans {
+		if err := walk(p.Plan); err != nil {
+			return nil, nil, err
+		}
+	}
+	return schemas, names, nil
+}
+
+// checkManagedRelations 确认查询读取的每张物理表都是已注册（未归档）的表、其分区、多对多关联表或其它物化 SQL 视图，
+// 不允许直接读取 lc_* 元数据表或未注册的表。
+func checkManagedRelations(ctx context.Context, q querier, schemas, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	rows, err := q.Query(ctx, `
+		SELECT r.schema_name, r.rel_name
+		FROM unnest($1::text[], $2::text[]) AS r(schema_name, rel_name)
+		CROSS JOIN LATERAL (SELECT to_regclass(format('%I.%I', r.schema_name, r.rel_name)) AS oid) rel
+		WHERE NOT EXISTS (
+			SELECT 1 FROM lc_tables t
+			WHERE t.archived_at IS NULL
+			  AND to_regclass(format('%I.%I', t.schema_name, t.table_name)) = COALESCE(pg_partition_root(rel.oid), rel.oid)
+		) AND NOT EXISTS (
+			SELECT 1 FROM lc_columns c
+			WHERE c.config->>'join_schema' = r.schema_name AND c.config->>'join_table' = r.rel_name
+		)`, schemas, names)
+	if err != nil {
+		return err
+	}
+	bad, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (string, error) {
+		var schema, name string
+		err := r.Scan(&schema, &name)
+		return schema + "." + name, err
+	})
+	if err != nil {
+		return err
+	}
+	if len(bad) > 0 {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"sql may only read registered tables; not allowed: %s", strings.Join(bad, ", "))
+	}
+	return nil
+}
+
+// validateViewSQL 在只读事务中 EXPLAIN 视图体，并确认只读取已注册的表。
+func validateViewSQL(ctx context.Context, pool *pgxpool.Pool, body string) error {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	// 走扩展协议，多条语句会被拒绝，之后拼进 CREATE 的也只能是这一条语句
+	schemas, names, err := viewPlanRelations(ctx, tx, body)
+	if err != nil {
+		return err
+	}
+	return checkManagedRelations(ctx, tx, schemas, names)
+}
+
+// checkViewFunctions 拒绝调用 volatile 函数（含运算符底层的函数）的视图，如 set_config、nextval、
+// pg_advisory_lock、dblink：它们可能改变会话状态或写入数据，而 SELECT 只读的检查看不到。
+// 在视图创建后、物化视图填充数据前检查 pg_depend，此时视图体还没有执行过。
+func checkViewFunctions(ctx context.Context, q querier, rel string) error {
+	rows, err := q.Query(ctx, `
+		SELECT DISTINCT p.oid::regprocedure::text
+		FROM pg_rewrite r
+		JOIN pg_depend d ON d.classid = 'pg_rewrite'::regclass AND d.objid = r.oid
+		LEFT JOIN pg_operator o ON d.refclassid = 'pg_operator'::regclass AND o.oid = d.refobjid
+		JOIN pg_proc p ON (d.refclassid = 'pg_proc'::regclass AND p.oid = d.refobjid) OR p.oid = o.oprcode::oid
+		WHERE r.ev_class = $1::regclass AND p.provolatile = 'v'
+		ORDER BY 1`, rel)
+	if err != nil {
+		return err
+	}
+	funcs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	if len(funcs) > 0 {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+			"sql must not call volatile functions: %s", strings.Join(funcs, ", "))
+	}
+	return nil
+}
+
+// CreateSQLView 校验 SELECT 后创建 PG 视图（或物化视图）lc_v_<name>，并把它注册为只读表：
+// 结果列登记为 is_readonly 的列，ListRows / GetRow / AggregateRows 等读取接口照常使用。
+// 普通视图定义为 SELECT * FROM (<sql>) q，PG 不会把它当作可自动更新的视图，写入在数据库层同样被拒绝。
+// 视图不继承来源表的列权限和脱敏规则，所以只有 owner 可以创建（见 methodRoles），由 owner 为视图设置规则。
+func (s *LowcodeService) CreateSQLView(ctx context.Context, req *lowcodev1.CreateSQLViewRequest) (*lowcodev1.CreateSQLViewResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTableName("name", req.GetName()); err != nil {
+		return nil, err
+	}
+	sql, err := normalizeViewSQL(req.GetSql())
+	if err != nil {
+		return nil, err
+	}
+	schemaName := req.GetSchemaName()
+	if schemaName == "" {
+		schemaName = "public"
+	}
+	cfg := &sqlViewConfig{SQL: sql, Materialized: req.GetMaterialized()}
+	if cfg.Materialized && s.tenants.Pooled() {
+		return nil, pooledMaterializedView()
+	}
+	body, kind := `SELECT * FROM (`+sql+`) q`, "VIEW"
+	if cfg.Materialized {
+		body, kind = sql, "MATERIALIZED VIEW"
+		cfg.RefreshedAt = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	if err := validateViewSQL(ctx, pool, body); err != nil {
+		return nil, err
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if req.GetWorkspaceId() != "" {
+		if err := checkWorkspace(ctx, tx, req.GetWorkspaceId()); err != nil {
+			return nil, err
+		}
+	}
+	name, err := claimTableName(ctx, tx, schemaName, req.GetName(), false)
+	if err != nil {
+		return nil, err
+	}
+	physTable := sqlViewPhysPrefix + name
+	rel := pgx.Identifier{schemaName, physTable}.Sanitize()
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, pgx.Identifier{schemaName}.Sanitize())); err != nil {
+		return nil, err
+	}
+	// 物化视图先不填充数据，检查过调用的函数后再 REFRESH
+	create := fmt.Sprintf(`CREATE %s %s AS %s`, kind, rel, body)
+	if cfg.Materialized {
+		create += ` WITH NO DATA`
+	}
+	if _, err := tx.Exec(ctx, create); err != nil {
+		return nil, err
+	}
+	if err := checkViewFunctions(ctx, tx, rel); err != nil {
+		return nil, err
+	}
+	if cfg.Materialized {
+		if _, err := tx.Exec(ctx, `REFRESH MATERIALIZED VIEW `+rel); err != nil {
+			return nil, err
+		}
+	}
+
+	var outCols []existingColumn
+	var idType string
+	attRows, err := tx.Query(ctx, `
+		SELECT attname, format_type(atttypid, atttypmod)
+		FROM pg_attribute
+		WHERE attrelid = $1::regclass AND attnum > 0 AND NOT attisdropped
+		ORDER BY attnum`, rel)
+	if err != nil {
+		return nil, err
+	}
+	for attRows.Next() {
+		var c existingColumn
+		if err := attRows.Scan(&c.Name, &c.PgType); err != nil {
+			attRows.Close()
+			return nil, err
+		}
+		if c.Name == "id" {
+			idType = c.PgType
+			continue
+		}
+		outCols = append(outCols, c)
+	}
+	attRows.Close()
+	if err := attRows.Err(); err != nil {
+		return nil, err
+	}
+	if idType != "uuid" && idType != "text" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "sql must return an id column of type uuid or text")
+	}
+	if cfg.Materialized {
+		// 唯一索引保证 id 唯一（分页依赖它），也是 REFRESH ... CONCURRENTLY 的前提
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE UNIQUE INDEX %s ON %s (id)`,
+			pgx.Identifier{physTable + "_id_key"}.Sanitize(), rel)); err != nil {
+			return nil, err
+		}
+	}
+
+	var res lowcodev1.CreateSQLViewResponse
+	res.Table, err = scanTable(tx.QueryRow(ctx, `
+		INSERT INTO lc_tables (name, schema_name, table_name, config, workspace_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid)
+		RETURNING `+tableFieldsSQL, name, schemaName, physTable, map[string]any{"sql_view": cfg.toMap()}, req.GetWorkspaceId()))
+	if err != nil {
+		return nil, err
+	}
+	for i, oc := range outCols {
+		c, err := scanColumn(tx.QueryRow(ctx, `
+			INSERT INTO lc_columns (table_id, name, type_id, pg_column, is_nullable, position, config, is_readonly)
+			VALUES ($1, $2, $3, $2, TRUE, $4, '{}'::jsonb, TRUE)
+			RETURNING `+columnFieldsSQL, name, oc.Name, lcTypeForPgType(oc.PgType), i+1))
+		if err != nil {
+			return nil, err
+		}
+		res.Columns = append(res.Columns, c)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// RefreshSQLView 重新计算物化视图并记录 refreshed_at。
+func (s *LowcodeService) RefreshSQLView(ctx context.Context, req *lowcodev1.RefreshSQLViewRequest) (*lowcodev1.RefreshSQLViewResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := lookupTable(ctx, pool, req.GetTableId(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	view := sqlViewFromConfig(ref.Config)
+	if view == nil || !view.Materialized {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is not a materialized SQL view", ref.Name)
+	}
+	if s.tenants.Pooled() {
+		return nil, pooledMaterializedView()
+	}
+	refresh := `REFRESH MATERIALIZED VIEW `
+	if req.GetConcurrently() {
+		refresh += `CONCURRENTLY `
+	}
+	if _, err := pool.Exec(ctx, refresh+pgx.Identifier{ref.SchemaName, ref.PhysTable}.Sanitize()); err != nil {
+		return nil, err
+	}
+	t, err := scanTable(pool.QueryRow(ctx, `
+		UPDATE lc_tables
+		SET config = jsonb_set(config, '{sql_view,refreshed_at}', to_jsonb($2::text)), updated_at = now()
+		WHERE name = $1
+		RETURNING `+tableFieldsSQL, ref.Name, time.Now().UTC().Format(time.RFC3339Nano)))
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.RefreshSQLViewResponse{Table: t}, nil
+}
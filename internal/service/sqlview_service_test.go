@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/config"
+	"github.com/solat/lowcode-database/internal/db"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// newPooledTestService 用 LC_TEST_POOLED_DATABASE_URL（应用角色，不能是超级用户或 BYPASSRLS）和
+// LC_TEST_ADMIN_DATABASE_URL（超级用户，用于安装事件触发器）创建 pooled 模式的 LowcodeService，未设置时跳过测试。
+func newPooledTestService(t *testing.T) *LowcodeService {
+	t.Helper()
+	dsn, admin := os.Getenv("LC_TEST_POOLED_DATABASE_URL"), os.Getenv("LC_TEST_ADMIN_DATABASE_URL")
+	if dsn == "" || admin == "" {
+		t.Skip("LC_TEST_POOLED_DATABASE_URL or LC_TEST_ADMIN_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	m, err := db.NewTenantManager(ctx, &config.Config{TenantMode: string(db.TenantModePooled), SingleDatabaseURL: dsn, DatabaseURL: admin})
+	if err != nil {
+		t.Fatalf("NewTenantManager: %v", err)
+	}
+	t.Cleanup(func() { m.Close(ctx) })
+	return NewLowcodeService(m, 0, 0, nil)
+}
+
+// TestPooledSQLViewIsolation 确认 pooled 模式下 SQL 视图不会把一个 tenant 的行暴露给另一个 tenant：
+// 物化视图（REFRESH 时按调用者的 tenant 填充，所有 tenant 共用）被拒绝，普通视图按读取者的 tenant 经 RLS 过滤。
+func TestPooledSQLViewIsolation(t *testing.T) {
+	s := newPooledTestService(t)
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	ctxA := tenant.WithTenantID(context.Background(), "lc_test_a_"+suffix)
+	ctxB := tenant.WithTenantID(context.Background(), "lc_test_b_"+suffix)
+	name, viewName := "iso_"+suffix, "iso_v_"+suffix
+
+	if _, err := s.CreateTable(ctxA, &lowcodev1.CreateTableRequest{Name: name}); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+	t.Cleanup(func() {
+		s.DeleteTable(ctxA, &lowcodev1.DeleteTableRequest{Id: viewName})
+		s.DeleteTable(ctxA, &lowcodev1.DeleteTableRequest{Id: name, Purge: true, Cascade: true})
+	})
+	col, err := s.AddColumn(ctxA, &lowcodev1.AddColumnRequest{TableId: name, Name: "title", TypeId: "text", IsNullable: true})
+	if err != nil {
+		t.Fatalf("AddColumn: %v", err)
+	}
+	cells := map[string]*lowcodev1.Value{col.GetColumn().GetId(): {Kind: &lowcodev1.Value_StringValue{StringValue: "tenant a only"}}}
+	if _, err := s.CreateRow(ctxA, &lowcodev1.CreateRowRequest{TableId: name, Cells: cells}); err != nil {
+		t.Fatalf("CreateRow: %v", err)
+	}
+
+	sql := "SELECT id, " + col.GetColumn().GetPgColumn() + " FROM lc_t_" + name
+	_, err = s.CreateSQLView(ctxA, &lowcodev1.CreateSQLViewRequest{Name: viewName, Sql: sql, Materialized: true})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("CreateSQLView(materialized) err = %v, want FailedPrecondition", err)
+	}
+	if _, err := s.CreateSQLView(ctxA, &lowcodev1.CreateSQLViewRequest{Name: viewName, Sql: sql}); err != nil {
+		t.Fatalf("CreateSQLView: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want int
+	}{
+		{"tenant a", ctxA, 1},
+		{"tenant b", ctxB, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.ListRows(tt.ctx, &lowcodev1.ListRowsRequest{TableId: viewName})
+			if err != nil {
+				t.Fatalf("ListRows: %v", err)
+			}
+			if len(res.GetRows()) != tt.want {
+				t.Errorf("%s sees %d rows through the view, want %d", tt.name, len(res.GetRows()), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/config"
+	"github.com/solat/lowcode-database/internal/db"
+)
+
+// newTestService 用 LC_TEST_DATABASE_URL 创建 single 模式的 LowcodeService，未设置时跳过测试。
+func newTestService(t *testing.T) *LowcodeService {
+	t.Helper()
+	dsn := os.Getenv("LC_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("LC_TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	m, err := db.NewTenantManager(ctx, &config.Config{TenantMode: string(db.TenantModeSingle), SingleDatabaseURL: dsn})
+	if err != nil {
+		t.Fatalf("NewTenantManager: %v", err)
+	}
+	t.Cleanup(func() { m.Close(ctx) })
+	return NewLowcodeService(m, 0, 0, nil)
+}
+
+// TestTableIdentifierResolution 确认各个服务接受 table id 的 RPC 都经过 lookupTable：
+// 逻辑名能解析到表，不存在的名字和 UUID（lc_tables 没有 UUID id）都返回 TABLE_NOT_FOUND。
+func TestTableIdentifierResolution(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	name := "ident_" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	if _, err := s.CreateTable(ctx, &lowcodev1.CreateTableRequest{Name: name}); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+	t.Cleanup(func() {
+		s.DeleteTable(context.Background(), &lowcodev1.DeleteTableRequest{Id: name, Purge: true, Cascade: true})
+	})
+	col, err := s.AddColumn(ctx, &lowcodev1.AddColumnRequest{TableId: name, Name: "title", TypeId: "text", IsNullable: true})
+	if err != nil {
+		t.Fatalf("AddColumn: %v", err)
+	}
+	colID := col.GetColumn().GetId()
+
+	rpcs := []struct {
+		name string
+		call func(ctx context.Context, id string) error
+	}{
+		{"GetTable", func(ctx context.Context, id string) error {
+			_, err := s.GetTable(ctx, &lowcodev1.GetTableRequest{Id: id})
+			return err
+		}},
+		{"UpdateTable", func(ctx context.Context, id string) error {
+			desc := "updated"
+			_, err := s.UpdateTable(ctx, &lowcodev1.UpdateTableRequest{Id: id, Description: &desc})
+			return err
+		}},
+		{"GetTableSchema", func(ctx context.Context, id string) error {
+			_, err := s.GetTableSchema(ctx, &lowcodev1.GetTableSchemaRequest{TableId: id})
+			return err
+		}},
+		{"RepairTableSchema", func(ctx context.Context, id string) error {
+			_, err := s.RepairTableSchema(ctx, &lowcodev1.RepairTableSchemaRequest{TableId: id, DryRun: true})
+			return err
+		}},
+		{"ListColumns", func(ctx context.Context, id string) error {
+			_, err := s.ListColumns(ctx, &lowcodev1.ListColumnsRequest{TableId: id})
+			return err
+		}},
+		{"ListIndexes", func(ctx context.Context, id string) error {
+			_, err := s.ListIndexes(ctx, &lowcodev1.ListIndexesRequest{TableId: id})
+			return err
+		}},
+		{"ListViews", func(ctx context.Context, id string) error {
+			_, err := s.ListViews(ctx, &lowcodev1.ListViewsRequest{TableId: id})
+			return err
+		}},
+		{"ListPermissions", func(ctx context.Context, id string) error {
+			_, err := s.ListPermissions(ctx, &lowcodev1.ListPermissionsRequest{TableId: id})
+			return err
+		}},
+		{"CreateRow", func(ctx context.Context, id string) error {
+			cells := map[string]*lowcodev1.Value{colID: {Kind: &lowcodev1.Value_StringValue{StringValue: "a"}}}
+			_, err := s.CreateRow(ctx, &lowcodev1.CreateRowRequest{TableId: id, Cells: cells})
+			return err
+		}},
+		{"ListRows", func(ctx context.Context, id string) error {
+			_, err := s.ListRows(ctx, &lowcodev1.ListRowsRequest{TableId: id})
+			return err
+		}},
+		{"SearchRows", func(ctx context.Context, id string) error {
+			_, err := s.SearchRows(ctx, &lowcodev1.SearchRowsRequest{TableId: id, Query: "a"})
+			return err
+		}},
+		{"ListDistinctValues", func(ctx context.Context, id string) error {
+			_, err := s.ListDistinctValues(ctx, &lowcodev1.ListDistinctValuesRequest{TableId: id, ColumnId: colID})
+			return err
+		}},
+	}
+	ids := []struct {
+		name  string
+		id    string
+		found bool
+	}{
+		{"logical name", name, true},
+		{"unknown name", name + "_missing", false},
+		{"uuid", uuid.New().String(), false},
+	}
+	for _, rpc := range rpcs {
+		for _, id := range ids {
+			t.Run(rpc.name+"/"+id.name, func(t *testing.T) {
+				err := rpc.call(ctx, id.id)
+				notFound := apierr.CodeOf(err) == lowcodev1.ErrorCode_TABLE_NOT_FOUND
+				if id.found && err != nil {
+					t.Fatalf("%s(%q): %v", rpc.name, id.id, err)
+				}
+				if !id.found && !notFound {
+					t.Fatalf("%s(%q) err = %v, want TABLE_NOT_FOUND", rpc.name, id.id, err)
+				}
+			})
+		}
+	}
+}
+
+// tableResolution 是一个 RPC 解析 table id 时调用的函数及其参数，由 findTableResolution 从源码中找出。
+type tableResolution struct {
+	fn              string // lookupTable / lockArchivedTable / resolveTableName / loadColumns
+	includeArchived bool
+	lock            bool
+}
+
+// findTableResolution 在方法 method 中按源码顺序找第一个解析 table id 的调用，方法内没有时继续查找它调用的 s.xxx 方法。
+func findTableResolution(methods map[string]*ast.FuncDecl, method string, seen map[string]bool) (tableResolution, bool) {
+	decl := methods[method]
+	if decl == nil || seen[method] {
+		return tableResolution{}, false
+	}
+	seen[method] = true
+	var res tableResolution
+	var found bool
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if found || !ok {
+			return !found
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			switch fun.Name {
+			case "lookupTable":
+				res, found = tableResolution{fn: fun.Name}, true
+				res.includeArchived = fmt.Sprint(call.Args[3]) == "true"
+				res.lock = fmt.Sprint(call.Args[4]) == "true"
+			case "lockArchivedTable":
+				res, found = tableResolution{fn: fun.Name, includeArchived: true, lock: true}, true
+			}
+		case *ast.SelectorExpr:
+			if recv, ok := fun.X.(*ast.Ident); !ok || recv.Name != "s" {
+				break
+			}
+			switch fun.Sel.Name {
+			case "resolveTableName", "loadColumns":
+				res, found = tableResolution{fn: fun.Sel.Name}, true
+			default:
+				res, found = findTableResolution(methods, fun.Sel.Name, seen)
+			}
+		}
+		return !found
+	})
+	return res, found
+}
+
+// TestTableResolutionPaths 不需要数据库：从源码找出各 RPC 解析 table id 时调用的函数，用 fakeQuerier 执行同样的调用，
+// 确认都经过 lookupTable，归档的表是否可见、是否加锁符合各 RPC 的语义，不存在的名字和 UUID 返回 TABLE_NOT_FOUND。
+func TestTableResolutionPaths(t *testing.T) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("parse package: %v", err)
+	}
+	methods := map[string]*ast.FuncDecl{}
+	for _, f := range pkgs["service"].Files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Recv != nil && fd.Body != nil {
+				methods[fd.Name.Name] = fd
+			}
+		}
+	}
+
+	tests := []struct {
+		rpc             string
+		includeArchived bool
+		lock            bool
+	}{
+		{"GetTable", true, false},
+		{"UpdateTable", false, false},
+		{"DeleteTable", true, true},
+		{"RestoreTable", true, true},
+		{"PurgeTable", true, true},
+		{"GetTableSchema", false, false},
+		{"RepairTableSchema", false, true},
+		{"ListColumns", false, false},
+		{"ListIndexes", false, false},
+		{"ListViews", false, false},
+		{"ListPermissions", false, false},
+		{"CreateRow", false, false},
+		{"ListRows", false, false},
+		{"SearchRows", false, false},
+		{"ListDistinctValues", false, false},
+	}
+	s := &LowcodeService{}
+	for _, tt := range tests {
+		t.Run(tt.rpc, func(t *testing.T) {
+			res, ok := findTableResolution(methods, tt.rpc, map[string]bool{})
+			if !ok {
+				t.Fatalf("%s does not resolve its table through lookupTable", tt.rpc)
+			}
+			if res.includeArchived != tt.includeArchived || res.lock != tt.lock {
+				t.Fatalf("%s resolves with %s(includeArchived=%v, lock=%v), want includeArchived=%v, lock=%v",
+					tt.rpc, res.fn, res.includeArchived, res.lock, tt.includeArchived, tt.lock)
+			}
+			resolve := func(q querier, id string) error {
+				var err error
+				switch res.fn {
+				case "lookupTable":
+					_, err = lookupTable(context.Background(), q, id, res.includeArchived, res.lock)
+				case "lockArchivedTable":
+					_, err = lockArchivedTable(context.Background(), q, id)
+				case "resolveTableName":
+					_, err = s.resolveTableName(context.Background(), q, id)
+				case "loadColumns":
+					_, _, _, err = s.loadColumns(context.Background(), q, id)
+				}
+				return err
+			}
+
+			for _, id := range []string{"orders_missing", uuid.New().String()} {
+				q := &fakeQuerier{row: fakeRow{err: pgx.ErrNoRows}}
+				if err := resolve(q, id); apierr.CodeOf(err) != lowcodev1.ErrorCode_TABLE_NOT_FOUND {
+					t.Fatalf("%s(%q) err = %v, want TABLE_NOT_FOUND", tt.rpc, id, err)
+				}
+				if q.calls != 1 || !strings.Contains(q.sql, "FROM lc_tables WHERE name = $1") || len(q.args) != 1 || q.args[0] != id {
+					t.Fatalf("%s(%q) queried %q %v, want a lookup of lc_tables by name", tt.rpc, id, q.sql, q.args)
+				}
+				if got := !strings.Contains(q.sql, "archived_at IS NULL"); got != tt.includeArchived {
+					t.Errorf("%s: archived tables visible = %v, want %v (%q)", tt.rpc, got, tt.includeArchived, q.sql)
+				}
+				if got := strings.Contains(q.sql, "FOR UPDATE"); got != tt.lock {
+					t.Errorf("%s: locks the table = %v, want %v (%q)", tt.rpc, got, tt.lock, q.sql)
+				}
+			}
+
+			// RestoreTable / PurgeTable 只接受归档的表
+			if res.fn == "lockArchivedTable" {
+				q := &fakeQuerier{row: fakeRow{values: []any{"orders", "public", "lc_t_orders", map[string]any{}, (*time.Time)(nil), ""}}}
+				if err := resolve(q, "orders"); status.Code(err) != codes.FailedPrecondition {
+					t.Errorf("%s(active table) err = %v, want FailedPrecondition", tt.rpc, err)
+				}
+				archivedAt := time.Now()
+				q = &fakeQuerier{row: fakeRow{values: []any{"orders__archived_ab12", "public", "lc_a_ab12", map[string]any{}, &archivedAt, "orders"}}}
+				if err := resolve(q, "orders__archived_ab12"); err != nil {
+					t.Errorf("%s(archived table): %v", tt.rpc, err)
+				}
+			}
+		})
+	}
+}
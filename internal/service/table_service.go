@@ -3,12 +3,19 @@ package service
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"maps"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
 )
 
 // -------- Table --------
@@ -18,55 +25,325 @@ func (s *LowcodeService) CreateTable(ctx context.Context, req *lowcodev1.CreateT
 	if err != nil {
 		return nil, err
 	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	t, err := createTable(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.CreateTableResponse{Table: t}, nil
+}
+
+// maxTableNameLen 是逻辑表名的长度上限：物理表名 lc_t_<name> 再加上分区子表后缀（_pYYYYMMDD / _l<8位hex>）
+// 仍要落在 PG 标识符 63 字节以内，超出会被静默截断，可能和其他表撞名。
+const maxTableNameLen = 63 - len("lc_t_") - len("_p20060102")
+
+// tableNameRe 限制逻辑表名：小写字母、数字、下划线，以字母开头。
+var tableNameRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// reservedTableNamePrefixes 留给元数据表和系统目录，逻辑表名不能使用。
+var reservedTableNamePrefixes = []string{"lc_", "pg_"}
+
+// validateTableName 校验会用于生成物理表名的逻辑表名，不合法时返回 InvalidArgument 并附带建议的安全表名。
+func validateTableName(field, name string) error {
+	if name == "" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s is required", field)
+	}
+	var problem string
+	switch {
+	case len(name) > maxTableNameLen:
+		problem = fmt.Sprintf("must be at most %d bytes", maxTableNameLen)
+	case !tableNameRe.MatchString(name):
+		problem = "must start with a lowercase letter and contain only lowercase letters, digits and underscores"
+	case strings.Contains(name, "__archived_"):
+		problem = `must not contain "__archived_"`
+	default:
+		for _, p := range reservedTableNamePrefixes {
+			if strings.HasPrefix(name, p) {
+				problem = fmt.Sprintf("must not start with reserved prefix %q", p)
+				break
+			}
+		}
+	}
+	if problem == "" {
+		return nil
+	}
+	return apierr.NewValidation([]apierr.FieldViolation{{
+		Field:       field,
+		Description: fmt.Sprintf("table name %q %s; suggested name: %q", name, problem, safeTableName(name)),
+		Reason:      "INVALID_TABLE_NAME",
+	}})
+}
+
+// safeTableName 把任意字符串规整成能通过 validateTableName 的表名：转小写、非法字符换成下划线、
+// 去掉保留前缀和归档标记，过长时截断并追加原名的哈希，避免截断后互相冲突。
+func safeTableName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	s := strings.ReplaceAll(b.String(), "__archived_", "_archived_")
+	for strings.Contains(s, "__") {
+		s = strings.ReplaceAll(s, "__", "_")
+	}
+	s = strings.Trim(s, "_")
+	for trimmed := true; trimmed; {
+		trimmed = false
+		for _, p := range reservedTableNamePrefixes {
+			if strings.HasPrefix(s, p) {
+				s, trimmed = strings.TrimLeft(s[len(p):], "_"), true
+			}
+		}
+	}
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		s = "t_" + s
+	}
+	if len(s) > maxTableNameLen {
+		h := fnv.New32a()
+		h.Write([]byte(name))
+		suffix := fmt.Sprintf("_%08x", h.Sum32())
+		s = strings.TrimRight(s[:maxTableNameLen-len(suffix)], "_") + suffix
+	}
+	return strings.TrimRight(s, "_")
+}
+
+// maxTableNameSuffix 是 auto_suffix 模式下最多尝试的后缀序号。
+const maxTableNameSuffix = 1000
+
+// claimTableName 在建表前检查逻辑表名和对应的物理表 lc_t_<name> 是否已被占用，
+// 避免把 Postgres 的唯一约束冲突原样抛给调用方。autoSuffix 时依次尝试 <name>_2、<name>_3…
+// 并返回第一个可用的名字。每个候选名字先取事务级 advisory lock，并发建同名表时后到者会看到前者的结果。
+func claimTableName(ctx context.Context, tx pgx.Tx, schemaName, name string, autoSuffix bool) (string, error) {
+	for n := 1; n <= maxTableNameSuffix; n++ {
+		candidate := name
+		if n > 1 {
+			suffix := fmt.Sprintf("_%d", n)
+			if len(name)+len(suffix) > maxTableNameLen {
+				candidate = strings.TrimRight(name[:maxTableNameLen-len(suffix)], "_") + suffix
+			} else {
+				candidate = name + suffix
+			}
+		}
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('lc_tables:' || $1))`, candidate); err != nil {
+			return "", err
+		}
+		var registered, physical bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM lc_tables WHERE name = $1),
+			       to_regclass(format('%I.%I', $2::text, 'lc_t_' || $1)) IS NOT NULL`,
+			candidate, schemaName).Scan(&registered, &physical); err != nil {
+			return "", err
+		}
+		if !registered && !physical {
+			return candidate, nil
+		}
+		if autoSuffix {
+			continue
+		}
+		if registered {
+			return "", apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists", candidate)
+		}
+		// 物理表存在但没有登记：多半是手工建的表或残留，可以用 ImportExistingTable 接管
+		return "", apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists,
+			"physical table %s.%s already exists but is not registered; import it with ImportExistingTable or choose a different name", schemaName, "lc_t_"+candidate)
+	}
+	return "", apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists and no free suffix was found", name)
+}
+
+// createTable 在 tx 中创建物理表并登记到 lc_tables，CreateTable 和 CreateTableWithSchema 共用。
+func createTable(ctx context.Context, tx pgx.Tx, req *lowcodev1.CreateTableRequest) (*lowcodev1.Table, error) {
+	if err := validateTableName("name", req.GetName()); err != nil {
+		return nil, err
+	}
 	schemaName := req.GetSchemaName()
 	if schemaName == "" {
 		schemaName = "public"
 	}
+	if req.GetWorkspaceId() != "" {
+		if err := checkWorkspace(ctx, tx, req.GetWorkspaceId()); err != nil {
+			return nil, err
+		}
+	}
+	name, err := claimTableName(ctx, tx, schemaName, req.GetName(), req.GetAutoSuffix())
+	if err != nil {
+		return nil, err
+	}
 	// 物理表名直接基于逻辑表名生成，形如 lc_t_<table_name>。
 	// pgx.Identifier 会负责正确转义，避免 SQL 注入。
-	physTable := "lc_t_" + req.GetName()
+	physTable := "lc_t_" + name
 
+	// Ensure schema exists
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, pgx.Identifier{schemaName}.Sanitize())); err != nil {
+		return nil, err
+	}
+
+	// 分区表：分区列必须在建表时存在并包含在主键里，所以和物理表一起创建。
+	var pc *partitionConfig
+	if req.GetPartition() != nil {
+		pc, err = createPartitionedTable(ctx, tx, schemaName, physTable, req.GetPartition())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Create physical table with id column
+		createSQL := fmt.Sprintf(`CREATE TABLE %s.%s (id UUID PRIMARY KEY DEFAULT gen_random_uuid(), %s)`,
+			pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{physTable}.Sanitize(), systemColumnsSQL)
+		if _, err := tx.Exec(ctx, createSQL); err != nil {
+			return nil, err
+		}
+	}
+	touchSQL := fmt.Sprintf(`CREATE TRIGGER lc_touch_updated_at BEFORE UPDATE ON %s.%s FOR EACH ROW EXECUTE FUNCTION lc_touch_updated_at()`,
+		pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{physTable}.Sanitize())
+	if _, err := tx.Exec(ctx, touchSQL); err != nil {
+		return nil, err
+	}
+
+	cfg := map[string]any{"system_columns": true}
+	if pc != nil {
+		cfg["partition"] = pc.toMap()
+	}
+	t, err := scanTable(tx.QueryRow(ctx, `
+		INSERT INTO lc_tables (name, schema_name, table_name, config, workspace_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid)
+		RETURNING `+tableFieldsSQL, name, schemaName, physTable, cfg, req.GetWorkspaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if pc != nil {
+		if err := insertPartitionColumn(ctx, tx, t.Name, pc); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// UpdateTable 修改表名、描述和图标。lc_columns / lc_indexes 的外键随改名级联更新，
+// 其它表 relationship 列的 config.target_table_id 在同一事务内改写。
+func (s *LowcodeService) UpdateTable(ctx context.Context, req *lowcodev1.UpdateTableRequest) (*lowcodev1.UpdateTableResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if req.GetAsync() {
+		if req.GetPartition() == nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "async requires partition")
+		}
+		// 表不存在时直接报错，不登记操作
+		ref, err := lookupTable(ctx, pool, req.GetId(), false, false)
+		if err != nil {
+			return nil, err
+		}
+		op, err := s.startOperation(ctx, pool, "UpdateTable", ref.Name, func(ctx context.Context) (proto.Message, error) {
+			return s.runUpdateTable(ctx, pool, req)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.UpdateTableResponse{Operation: op}, nil
+	}
+	return s.runUpdateTable(ctx, pool, req)
+}
+
+func (s *LowcodeService) runUpdateTable(ctx context.Context, pool *pgxpool.Pool, req *lowcodev1.UpdateTableRequest) (*lowcodev1.UpdateTableResponse, error) {
 	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback(ctx)
 
-	// Ensure schema exists
-	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, pgx.Identifier{schemaName}.Sanitize())); err != nil {
+	ref, err := lookupTable(ctx, tx, req.GetId(), false, true)
+	if err != nil {
 		return nil, err
 	}
+	schemaName, physTable, cfg := ref.SchemaName, ref.PhysTable, ref.Config
+	if req.GetWorkspaceId() != "" {
+		if err := checkWorkspace(ctx, tx, req.GetWorkspaceId()); err != nil {
+			return nil, err
+		}
+	}
 
-	// Create physical table with id column
-	createSQL := fmt.Sprintf(`CREATE TABLE %s.%s (id UUID PRIMARY KEY DEFAULT gen_random_uuid())`,
-		pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{physTable}.Sanitize())
-	if _, err := tx.Exec(ctx, createSQL); err != nil {
-		return nil, err
+	name, newPhysTable := req.GetId(), physTable
+	if req.GetName() != "" && req.GetName() != req.GetId() {
+		if err := validateTableName("name", req.GetName()); err != nil {
+			return nil, err
+		}
+		name = req.GetName()
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_tables WHERE name = $1)`, name).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists", name)
+		}
+		// 只重命名由 CreateTable 按逻辑名生成的物理表；导入 / 接管的表和分区表（子分区按父表名命名）保持原名
+		if physTable == "lc_t_"+req.GetId() && partitionFromConfig(cfg) == nil {
+			newPhysTable = "lc_t_" + name
+			if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`,
+				pgx.Identifier{schemaName, physTable}.Sanitize(), pgx.Identifier{newPhysTable}.Sanitize())); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	const ins = `
-		INSERT INTO lc_tables (name, schema_name, table_name)
-		VALUES ($1, $2, $3)
-		RETURNING name, schema_name, table_name, created_at, updated_at
-	`
-	row := tx.QueryRow(ctx, ins, req.GetName(), schemaName, physTable)
+	// 分区在改名之后进行，分区按新的物理表名命名；config 为 NULL 时保持不变
+	var newCfg any
+	if req.GetPartition() != nil {
+		ref.PhysTable = newPhysTable
+		pc, err := s.partitionExistingTable(ctx, tx, ref, req.GetPartition(), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		partitioned := maps.Clone(cfg)
+		if partitioned == nil {
+			partitioned = map[string]any{}
+		}
+		partitioned["partition"] = pc.toMap()
+		newCfg = partitioned
+	}
 
-	var t lowcodev1.Table
-	var createdAt, updatedAt time.Time
-	if err := row.Scan(&t.Name, &t.SchemaName, &t.TableName, &createdAt, &updatedAt); err != nil {
+	t, err := scanTable(tx.QueryRow(ctx, `
+		UPDATE lc_tables
+		SET name = $2, table_name = $3,
+		    description = COALESCE($4, description),
+		    icon = COALESCE($5, icon),
+		    workspace_id = CASE WHEN $6::text IS NULL THEN workspace_id ELSE NULLIF($6, '')::uuid END,
+		    config = COALESCE($7, config),
+		    updated_at = now()
+		WHERE name = $1
+		RETURNING `+tableFieldsSQL, req.GetId(), name, newPhysTable, req.Description, req.Icon, req.WorkspaceId, newCfg))
+	if err != nil {
 		return nil, err
 	}
-	// 对外约定：Table.Id 使用逻辑 name。
-	t.Id = t.Name
-	t.CreatedAt = timestamppb.New(createdAt)
-	t.UpdatedAt = timestamppb.New(updatedAt)
+	if name != req.GetId() {
+		if _, err := tx.Exec(ctx, `
+			UPDATE lc_columns
+			SET config = jsonb_set(config, '{target_table_id}', to_jsonb($2::text)), updated_at = now()
+			WHERE config->>'target_table_id' = $1`, req.GetId(), name); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
-	return &lowcodev1.CreateTableResponse{Table: &t}, nil
+	return &lowcodev1.UpdateTableResponse{Table: t}, nil
 }
 
+// DeleteTable 默认把表归档（archiveTable），purge 时立即删除物理表和元数据。
 func (s *LowcodeService) DeleteTable(ctx context.Context, req *lowcodev1.DeleteTableRequest) (*lowcodev1.DeleteTableResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
@@ -78,126 +355,264 @@ func (s *LowcodeService) DeleteTable(ctx context.Context, req *lowcodev1.DeleteT
 	}
 	defer tx.Rollback(ctx)
 
-	var schemaName, tableName string
-	if err := tx.QueryRow(ctx, `SELECT schema_name, table_name FROM lc_tables WHERE name = $1`, req.GetId()).
-		Scan(&schemaName, &tableName); err != nil {
-		if err == pgx.ErrNoRows {
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	ref, err := lookupTable(ctx, tx, req.GetId(), true, true)
+	if err != nil {
+		// 删除不存在的表视为成功
+		if apierr.CodeOf(err) == lowcodev1.ErrorCode_TABLE_NOT_FOUND {
 			return &lowcodev1.DeleteTableResponse{}, nil
 		}
 		return nil, err
 	}
+	schemaName, tableName, cfg, archivedAt := ref.SchemaName, ref.PhysTable, ref.Config, ref.ArchivedAt
+	if archivedAt != nil && !req.GetPurge() {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "table %s is already archived; use PurgeTable to delete it", req.GetId())
+	}
 
-	dropSQL := fmt.Sprintf(`DROP TABLE IF EXISTS %s.%s`,
-		pgx.Identifier{schemaName}.Sanitize(), pgx.Identifier{tableName}.Sanitize())
-	if _, err := tx.Exec(ctx, dropSQL); err != nil {
+	// 其它表中指向该表的虚拟列：默认阻止删除，cascade 时一起删除
+	dependents, err := dependentVirtualColumns(ctx, tx, req.GetId())
+	if err != nil {
 		return nil, err
 	}
+	if len(dependents) > 0 && !req.GetCascade() && !req.GetDryRun() {
+		names := make([]string, len(dependents))
+		for i, d := range dependents {
+			names[i] = fmt.Sprintf("%s.%s (%s)", d.tableID, d.name, d.id)
+		}
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"table %s is referenced by columns %s; delete them first or pass cascade", req.GetId(), strings.Join(names, ", "))
+	}
+	var dependentDrops []string
+	if req.GetCascade() {
+		for _, d := range dependents {
+			if d.joinTable != "" {
+				dependentDrops = append(dependentDrops, dropJoinTableSQL(d.joinSchema, d.joinTable))
+			}
+		}
+	}
+	// SQL 视图没有数据，不归档，直接删除视图
+	view := sqlViewFromConfig(cfg)
+	purge := req.GetPurge() || view != nil
+	// 归档时本表的物理表和关联表保留到清除
+	var statements []string
+	if view != nil {
+		statements = []string{view.dropSQL(schemaName, tableName)}
+	} else if purge || req.GetDryRun() {
+		if statements, err = tableDropStatements(ctx, tx, req.GetId(), schemaName, tableName); err != nil {
+			return nil, err
+		}
+	}
+	statements = append(statements, dependentDrops...)
+
+	if req.GetDryRun() {
+		impact, err := tableImpact(ctx, tx, req.GetId(), schemaName, tableName, statements)
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.DeleteTableResponse{Impact: impact}, nil
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
 
-	if _, err := tx.Exec(ctx, `DELETE FROM lc_tables WHERE name = $1`, req.GetId()); err != nil {
+	if req.GetCascade() && len(dependents) > 0 {
+		ids := make([]string, len(dependents))
+		for i, d := range dependents {
+			ids[i] = d.id
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM lc_columns WHERE id = ANY($1::uuid[])`, ids); err != nil {
+			return nil, err
+		}
+	}
+	var res lowcodev1.DeleteTableResponse
+	if purge {
+		if _, err := tx.Exec(ctx, `DELETE FROM lc_tables WHERE name = $1`, req.GetId()); err != nil {
+			return nil, err
+		}
+	} else if res.Archived, err = archiveTable(ctx, tx, req.GetId(), schemaName, tableName, cfg); err != nil {
 		return nil, err
 	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
-	return &lowcodev1.DeleteTableResponse{}, nil
+	return &res, nil
+}
+
+// dependentColumn 是其它表中依赖被删除表的虚拟列。
+type dependentColumn struct {
+	id, tableID, name     string
+	joinSchema, joinTable string
 }
 
-func (s *LowcodeService) ListTables(ctx context.Context, _ *lowcodev1.ListTablesRequest) (*lowcodev1.ListTablesResponse, error) {
+// dependentVirtualColumns 查出其它表中 config 指向该表（target_table_id 或引用其列 id）的虚拟列。
+func dependentVirtualColumns(ctx context.Context, q querier, tableName string) ([]dependentColumn, error) {
+	rows, err := q.Query(ctx, `
+		SELECT c.id, c.table_id, c.name, COALESCE(c.config->>'join_schema', ''), COALESCE(c.config->>'join_table', '')
+		FROM lc_columns c
+		JOIN lc_types ty ON c.type_id = ty.id
+		WHERE c.table_id <> $1
+		  AND COALESCE(ty.config->>'kind', '') IN (`+virtualKindsSQL+`)
+		  AND (c.config->>'target_table_id' = $1
+		       OR EXISTS (SELECT 1 FROM lc_columns x WHERE x.table_id = $1 AND strpos(c.config::text, x.id::text) > 0))
+		ORDER BY c.table_id, c.position`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(r pgx.CollectableRow) (dependentColumn, error) {
+		var d dependentColumn
+		err := r.Scan(&d.id, &d.tableID, &d.name, &d.joinSchema, &d.joinTable)
+		return d, err
+	})
+}
+
+// ListTables 按 sort 排序并做 keyset 分页（page_size 为 0 时返回全部），每张表附带 pg_class 的估算统计。
+func (s *LowcodeService) ListTables(ctx context.Context, req *lowcodev1.ListTablesRequest) (*lowcodev1.ListTablesResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	const q = `SELECT name, schema_name, table_name, created_at, updated_at FROM lc_tables ORDER BY created_at`
-	rows, err := pool.Query(ctx, q)
+	sortSQL, sortType := tableSortSQL(req.GetSort())
+	cmp, dir := ">", "ASC"
+	if req.GetDescending() {
+		cmp, dir = "<", "DESC"
+	}
+
+	var a sqlArgs
+	conds := []string{"(archived_at IS NULL OR " + a.add(req.GetIncludeArchived()) + ")"}
+	if p := req.GetNamePrefix(); p != "" {
+		conds = append(conds, "name LIKE "+a.add(escapeLike(p)+"%"))
+	}
+	switch {
+	case req.GetWorkspaceId() != "" && req.GetUnassigned():
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "workspace_id and unassigned are mutually exclusive")
+	case req.GetWorkspaceId() != "":
+		if err := checkWorkspace(ctx, pool, req.GetWorkspaceId()); err != nil {
+			return nil, err
+		}
+		conds = append(conds, "workspace_id = "+a.add(req.GetWorkspaceId())+"::uuid")
+	case req.GetUnassigned():
+		conds = append(conds, "workspace_id IS NULL")
+	}
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if len(token.Keys) != 1 || token.Keys[0] == nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid page_token")
+		}
+		conds = append(conds, fmt.Sprintf("(%s, name) %s (%s::text::%s, %s)", sortSQL, cmp, a.add(*token.Keys[0]), sortType, a.add(token.ID)))
+	}
+	pageSize := req.GetPageSize()
+	if pageSize > maxTablePageSize {
+		pageSize = maxTablePageSize
+	}
+	limit := ""
+	if pageSize > 0 {
+		// 多取一行用来判断是否还有下一页
+		limit = " LIMIT " + a.add(pageSize+1)
+	}
+	q := fmt.Sprintf(`SELECT %s, estimated_rows, total_bytes, %s::text FROM %s WHERE %s ORDER BY %s %s, name %s%s`,
+		tableFieldsSQL, sortSQL, tablesWithStatsSQL, strings.Join(conds, " AND "), sortSQL, dir, dir, limit)
+	rows, err := pool.Query(ctx, q, a.args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	var res lowcodev1.ListTablesResponse
+	var lastKey string
 	for rows.Next() {
-		var t lowcodev1.Table
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&t.Name, &t.SchemaName, &t.TableName, &createdAt, &updatedAt); err != nil {
+		if pageSize > 0 && len(res.Tables) == int(pageSize) {
+			res.NextPageToken = encodePageToken(pageToken{ID: res.Tables[len(res.Tables)-1].GetName(), Keys: []*string{&lastKey}})
+			break
+		}
+		var stats lowcodev1.TableStats
+		var key string
+		t, err := scanTable(rows, &stats.EstimatedRows, &stats.TotalBytes, &key)
+		if err != nil {
 			return nil, err
 		}
-		// 对外：Table.Id 使用逻辑 name。
-		t.Id = t.Name
-		t.CreatedAt = timestamppb.New(createdAt)
-		t.UpdatedAt = timestamppb.New(updatedAt)
-		res.Tables = append(res.Tables, &t)
+		t.Stats = &stats
+		lastKey = key
+		res.Tables = append(res.Tables, t)
 	}
 	return &res, rows.Err()
 }
 
-// GetTableSchema 返回指定 table 以及其所有 columns 和 indexes。
-func (s *LowcodeService) GetTableSchema(ctx context.Context, req *lowcodev1.GetTableSchemaRequest) (*lowcodev1.GetTableSchemaResponse, error) {
+// maxTablePageSize 是 ListTables 的 page_size 上限。
+const maxTablePageSize = 1000
+
+// tableSortSQL 返回排序字段对应的列及其类型（用于从 page_token 还原游标值）。
+func tableSortSQL(sort lowcodev1.TableSort) (string, string) {
+	switch sort {
+	case lowcodev1.TableSort_TABLE_SORT_UPDATED_AT:
+		return "updated_at", "timestamptz"
+	case lowcodev1.TableSort_TABLE_SORT_NAME:
+		return "name", "text"
+	case lowcodev1.TableSort_TABLE_SORT_ESTIMATED_ROWS:
+		return "estimated_rows", "bigint"
+	case lowcodev1.TableSort_TABLE_SORT_TOTAL_BYTES:
+		return "total_bytes", "bigint"
+	}
+	return "created_at", "timestamptz"
+}
+
+// GetTable 返回表记录和估算统计，不查询列和索引。
+func (s *LowcodeService) GetTable(ctx context.Context, req *lowcodev1.GetTableRequest) (*lowcodev1.GetTableResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if req.GetTableId() == "" {
-		return nil, fmt.Errorf("table_id is required")
+	ref, err := lookupTable(ctx, pool, req.GetId(), true, false)
+	if err != nil {
+		return nil, err
 	}
-
-	// table
-	var tbl lowcodev1.Table
-	row := pool.QueryRow(ctx, `
-		SELECT name, schema_name, table_name, created_at, updated_at
-		FROM lc_tables
-		WHERE name = $1
-	`, req.GetTableId())
-	var tblCreatedAt, tblUpdatedAt time.Time
-	if err := row.Scan(&tbl.Name, &tbl.SchemaName, &tbl.TableName, &tblCreatedAt, &tblUpdatedAt); err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("table not found")
-		}
+	var stats lowcodev1.TableStats
+	t, err := scanTable(pool.QueryRow(ctx, `SELECT `+tableFieldsSQL+`, estimated_rows, total_bytes FROM `+tablesWithStatsSQL+` WHERE name = $1`, ref.Name),
+		&stats.EstimatedRows, &stats.TotalBytes)
+	if err != nil {
 		return nil, err
 	}
-	// 对外：Table.Id 使用逻辑 name。
-	tbl.Id = tbl.Name
-	tbl.CreatedAt = timestamppb.New(tblCreatedAt)
-	tbl.UpdatedAt = timestamppb.New(tblUpdatedAt)
+	t.Stats = &stats
+	return &lowcodev1.GetTableResponse{Table: t}, nil
+}
 
-	// columns
-	colRows, err := pool.Query(ctx, `
-		SELECT id, table_id, name, type_id, pg_column, is_nullable, position, config, created_at, updated_at
-		FROM lc_columns
-		WHERE table_id = $1
-		ORDER BY position
-	`, req.GetTableId())
+// GetTableSchema 返回指定 table 以及其所有 columns 和 indexes；verify 时附带与物理表的差异（见 tableDrift）。
+func (s *LowcodeService) GetTableSchema(ctx context.Context, req *lowcodev1.GetTableSchemaRequest) (*lowcodev1.GetTableSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name, err := s.resolveTableName(ctx, pool, req.GetTableId())
 	if err != nil {
 		return nil, err
 	}
-	defer colRows.Close()
 
-	var columns []*lowcodev1.Column
-	for colRows.Next() {
-		var c lowcodev1.Column
-		var cfg map[string]any
-		var createdAt, updatedAt time.Time
-		if err := colRows.Scan(&c.Id, &c.TableId, &c.Name, &c.TypeId, &c.PgColumn, &c.IsNullable, &c.Position, &cfg, &createdAt, &updatedAt); err != nil {
-			return nil, err
-		}
-		c.CreatedAt = timestamppb.New(createdAt)
-		c.UpdatedAt = timestamppb.New(updatedAt)
-		if cfg != nil {
-			c.Config = toStruct(cfg)
-		}
-		columns = append(columns, &c)
+	// table
+	tbl, err := scanTable(pool.QueryRow(ctx, `SELECT `+tableFieldsSQL+` FROM lc_tables WHERE name = $1`, name))
+	if err != nil {
+		return nil, err
 	}
-	if err := colRows.Err(); err != nil {
+
+	// columns
+	columns, err := queryColumns(ctx, pool, columnSelectSQL+`WHERE c.table_id = $1 ORDER BY c.position`, name)
+	if err != nil {
 		return nil, err
 	}
 
 	// indexes
 	idxRows, err := pool.Query(ctx, `
-		SELECT id, table_id, name, pg_index, column_ids, is_unique, created_at, updated_at
+		SELECT `+indexFieldsSQL+`
 		FROM lc_indexes
 		WHERE table_id = $1
 		ORDER BY name
-	`, req.GetTableId())
+	`, name)
 	if err != nil {
 		return nil, err
 	}
@@ -205,23 +620,112 @@ func (s *LowcodeService) GetTableSchema(ctx context.Context, req *lowcodev1.GetT
 
 	var indexes []*lowcodev1.Index
 	for idxRows.Next() {
-		var idx lowcodev1.Index
-		var createdAt, updatedAt time.Time
-		if err := idxRows.Scan(&idx.Id, &idx.TableId, &idx.Name, &idx.PgIndex, &idx.ColumnIds, &idx.IsUnique, &createdAt, &updatedAt); err != nil {
+		idx, err := scanIndex(idxRows)
+		if err != nil {
 			return nil, err
 		}
-		idx.CreatedAt = timestamppb.New(createdAt)
-		idx.UpdatedAt = timestamppb.New(updatedAt)
-		indexes = append(indexes, &idx)
+		indexes = append(indexes, idx)
 	}
 	if err := idxRows.Err(); err != nil {
 		return nil, err
 	}
 
-	return &lowcodev1.GetTableSchemaResponse{
-		Table:   &tbl,
+	res := &lowcodev1.GetTableSchemaResponse{
+		Table:   tbl,
 		Columns: columns,
 		Indexes: indexes,
-	}, nil
+	}
+	if req.GetVerify() {
+		if res.Drift, err = tableDrift(ctx, pool, tbl.GetId(), tbl.GetSchemaName(), tbl.GetTableName()); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
+// GetWorkspaceSchema 用三条批量查询（tables / columns / indexes）拼出整个库的元数据，
+// 避免客户端 ListTables 后逐表调用 GetTableSchema。workspace_id / unassigned 时只返回对应 workspace 中的表。
+func (s *LowcodeService) GetWorkspaceSchema(ctx context.Context, req *lowcodev1.GetWorkspaceSchemaRequest) (*lowcodev1.GetWorkspaceSchemaResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同 ListTables 的 workspace 过滤；列、索引和关联关系只返回选中的表的
+	var a sqlArgs
+	where := "archived_at IS NULL"
+	switch {
+	case req.GetWorkspaceId() != "" && req.GetUnassigned():
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "workspace_id and unassigned are mutually exclusive")
+	case req.GetWorkspaceId() != "":
+		if err := checkWorkspace(ctx, pool, req.GetWorkspaceId()); err != nil {
+			return nil, err
+		}
+		where += " AND workspace_id = " + a.add(req.GetWorkspaceId()) + "::uuid"
+	case req.GetUnassigned():
+		where += " AND workspace_id IS NULL"
+	}
+
+	var res lowcodev1.GetWorkspaceSchemaResponse
+	byTable := make(map[string]*lowcodev1.TableSchema)
+
+	tblRows, err := pool.Query(ctx, `SELECT `+tableFieldsSQL+` FROM lc_tables WHERE `+where+` ORDER BY created_at`, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer tblRows.Close()
+	for tblRows.Next() {
+		t, err := scanTable(tblRows)
+		if err != nil {
+			return nil, err
+		}
+		ts := &lowcodev1.TableSchema{Table: t}
+		byTable[t.Id] = ts
+		res.Tables = append(res.Tables, ts)
+	}
+	if err := tblRows.Err(); err != nil {
+		return nil, err
+	}
+
+	cols, err := queryColumns(ctx, pool, columnSelectSQL+`ORDER BY c.table_id, c.position`)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cols {
+		ts, ok := byTable[c.TableId]
+		if !ok {
+			continue
+		}
+		ts.Columns = append(ts.Columns, c)
+		if c.TypeId != "relationship" || c.Config == nil {
+			continue
+		}
+		cfg := c.Config.AsMap()
+		rel := &lowcodev1.Relationship{ColumnId: c.Id, TableId: c.TableId}
+		rel.TargetTableId, _ = cfg["target_table_id"].(string)
+		rel.LinkColumnId, _ = cfg["link_column_id"].(string)
+		rel.TargetColumnId, _ = cfg["target_column_id"].(string)
+		if js, _ := cfg["join_schema"].(string); js != "" {
+			jt, _ := cfg["join_table"].(string)
+			rel.JoinTable = js + "." + jt
+		}
+		if rel.TargetTableId != "" {
+			res.Relationships = append(res.Relationships, rel)
+		}
+	}
+
+	idxs, err := queryIndexes(ctx, pool, `
+		SELECT `+indexFieldsSQL+`
+		FROM lc_indexes
+		ORDER BY table_id, name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range idxs {
+		if ts, ok := byTable[idx.TableId]; ok {
+			ts.Indexes = append(ts.Indexes, idx)
+		}
+	}
+	return &res, nil
+}
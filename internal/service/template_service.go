@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Table templates --------
+
+// tableTemplate 是代码中预置的表模板，Rows 中的示例行以列名为 key。
+type tableTemplate struct {
+	ID          string
+	Name        string
+	Description string
+	Columns     []*lowcodev1.ColumnDefinition
+	Indexes     []*lowcodev1.IndexDefinition
+	Rows        []map[string]any
+}
+
+// templateColumn 定义模板中的可空列；position 由 templateColumns 按顺序填写。
+func templateColumn(name, typeID string, cfg map[string]any) *lowcodev1.ColumnDefinition {
+	d := &lowcodev1.ColumnDefinition{Name: name, TypeId: typeID, IsNullable: true}
+	if cfg != nil {
+		d.Config = toStruct(cfg)
+	}
+	return d
+}
+
+func templateColumns(defs ...*lowcodev1.ColumnDefinition) []*lowcodev1.ColumnDefinition {
+	for i, d := range defs {
+		d.Position = int32(i + 1)
+	}
+	return defs
+}
+
+func required(d *lowcodev1.ColumnDefinition) *lowcodev1.ColumnDefinition {
+	d.IsNullable = false
+	return d
+}
+
+func unique(d *lowcodev1.ColumnDefinition) *lowcodev1.ColumnDefinition {
+	d.IsUnique = true
+	return d
+}
+
+func selectOptions(labels ...string) map[string]any {
+	options := make([]any, len(labels))
+	for i, l := range labels {
+		options[i] = l
+	}
+	return map[string]any{"options": options}
+}
+
+// tableTemplates 按 id 排列。
+var tableTemplates = []tableTemplate{
+	{
+		ID:          "crm",
+		Name:        "CRM",
+		Description: "联系人与销售线索：状态、预计金额和最近联系时间",
+		Columns: templateColumns(
+			required(templateColumn("name", "text", nil)),
+			unique(templateColumn("email", "email", nil)),
+			templateColumn("phone", "phone", nil),
+			templateColumn("company", "text", nil),
+			templateColumn("status", "single_select", selectOptions("Lead", "Qualified", "Customer", "Churned")),
+			templateColumn("deal_value", "currency", nil),
+			templateColumn("last_contacted_at", "timestamp", nil),
+			templateColumn("notes", "text", nil),
+		),
+		Indexes: []*lowcodev1.IndexDefinition{
+			{Name: "status_idx", ColumnNames: []string{"status"}},
+		},
+		Rows: []map[string]any{
+			{"name": "Ada Lovelace", "email": "ada@example.com", "company": "Analytical Engines", "status": "Customer", "deal_value": 12000.0, "last_contacted_at": "2024-05-02T10:00:00Z"},
+			{"name": "Alan Turing", "email": "alan@example.com", "company": "Bletchley Labs", "status": "Qualified", "deal_value": 8500.0, "last_contacted_at": "2024-05-10T15:30:00Z"},
+			{"name": "Grace Hopper", "email": "grace@example.com", "phone": "+1 555 0100", "company": "Compiler Co", "status": "Lead"},
+		},
+	},
+	{
+		ID:          "inventory",
+		Name:        "Inventory",
+		Description: "商品库存：SKU、分类、数量、单价和补货阈值",
+		Columns: templateColumns(
+			required(unique(templateColumn("sku", "text", nil))),
+			required(templateColumn("name", "text", nil)),
+			templateColumn("category", "single_select", selectOptions("Hardware", "Software", "Accessories")),
+			templateColumn("quantity", "number", map[string]any{"min": 0.0}),
+			templateColumn("unit_price", "currency", nil),
+			templateColumn("reorder_level", "number", map[string]any{"min": 0.0}),
+			templateColumn("supplier", "text", nil),
+			templateColumn("discontinued", "bool", nil),
+		),
+		Indexes: []*lowcodev1.IndexDefinition{
+			{Name: "category_idx", ColumnNames: []string{"category"}},
+		},
+		Rows: []map[string]any{
+			{"sku": "KB-001", "name": "Mechanical keyboard", "category": "Hardware", "quantity": 42.0, "unit_price": 89.0, "reorder_level": 10.0, "supplier": "Keyworks", "discontinued": false},
+			{"sku": "MS-002", "name": "Wireless mouse", "category": "Hardware", "quantity": 7.0, "unit_price": 29.5, "reorder_level": 15.0, "supplier": "Keyworks", "discontinued": false},
+			{"sku": "CB-010", "name": "USB-C cable", "category": "Accessories", "quantity": 120.0, "unit_price": 9.9, "reorder_level": 30.0, "discontinued": false},
+		},
+	},
+	{
+		ID:          "project_tracker",
+		Name:        "Project tracker",
+		Description: "任务跟踪：状态、优先级、负责人、截止时间和标签",
+		Columns: templateColumns(
+			required(templateColumn("title", "text", nil)),
+			templateColumn("status", "single_select", selectOptions("Todo", "In progress", "Done")),
+			templateColumn("priority", "single_select", selectOptions("Low", "Medium", "High")),
+			templateColumn("assignee", "text", nil),
+			templateColumn("due_at", "timestamp", nil),
+			templateColumn("estimate_hours", "number", map[string]any{"min": 0.0}),
+			templateColumn("tags", "multi_select", selectOptions("Frontend", "Backend", "Design", "Bug")),
+		),
+		Indexes: []*lowcodev1.IndexDefinition{
+			{Name: "status_idx", ColumnNames: []string{"status"}},
+			{Name: "due_at_idx", ColumnNames: []string{"due_at"}},
+		},
+		Rows: []map[string]any{
+			{"title": "Design the onboarding flow", "status": "In progress", "priority": "High", "assignee": "Mia", "due_at": "2024-06-01T00:00:00Z", "estimate_hours": 16.0, "tags": []any{"Design", "Frontend"}},
+			{"title": "Set up CI pipeline", "status": "Done", "priority": "Medium", "assignee": "Leo", "estimate_hours": 4.0, "tags": []any{"Backend"}},
+			{"title": "Fix login redirect", "status": "Todo", "priority": "High", "due_at": "2024-05-20T00:00:00Z", "tags": []any{"Bug", "Frontend"}},
+		},
+	},
+}
+
+func lookupTemplate(id string) (tableTemplate, bool) {
+	for _, t := range tableTemplates {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return tableTemplate{}, false
+}
+
+// ListTemplates 返回全部内置模板。模板定义在代码中，不依赖 tenant 数据库。
+func (s *LowcodeService) ListTemplates(ctx context.Context, req *lowcodev1.ListTemplatesRequest) (*lowcodev1.ListTemplatesResponse, error) {
+	var res lowcodev1.ListTemplatesResponse
+	for _, t := range tableTemplates {
+		res.Templates = append(res.Templates, &lowcodev1.Template{
+			Id:             t.ID,
+			Name:           t.Name,
+			Description:    t.Description,
+			Columns:        t.Columns,
+			Indexes:        t.Indexes,
+			SampleRowCount: int32(len(t.Rows)),
+		})
+	}
+	return &res, nil
+}
+
+// CreateTableFromTemplate 按模板创建表（同 CreateTableWithSchema），再写入示例行，全部在一个事务内完成。
+func (s *LowcodeService) CreateTableFromTemplate(ctx context.Context, req *lowcodev1.CreateTableFromTemplateRequest) (*lowcodev1.CreateTableFromTemplateResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := lookupTemplate(req.GetTemplateId())
+	if !ok {
+		return nil, apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "template %s not found", req.GetTemplateId())
+	}
+	name := req.GetName()
+	if name == "" {
+		name = t.ID
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_tables WHERE name = $1)`, name).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "table %s already exists", name)
+	}
+	created, err := s.createTableWithSchema(ctx, tx, &lowcodev1.CreateTableWithSchemaRequest{
+		Name:       name,
+		SchemaName: req.GetSchemaName(),
+		Columns:    t.Columns,
+		Indexes:    t.Indexes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &lowcodev1.CreateTableFromTemplateResponse{Table: created.GetTable(), Columns: created.GetColumns(), Indexes: created.GetIndexes()}
+
+	if !req.GetSkipSampleRows() {
+		if res.Rows, err = s.insertSeedRows(ctx, tx, name, t.Rows); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,622 @@
+package service
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/storage"
+)
+
+// -------- Tenant archive (export / import) --------
+
+// tenantArchiveVersion 是 ExportTenant 归档的格式版本，格式不兼容地变化时递增。
+const tenantArchiveVersion = 1
+
+// 归档是 tar.gz，包含以下文件（按写入顺序）。jsonl 文件每行一条记录。
+const (
+	archiveSchema = "schema.json" // SchemaBundle（protojson），必须在数据之前
+	// tables/<表名>.jsonl：一行一条记录，key 为 id、系统列名和源列 id
+	archiveTablesDir = "tables/"
+	// links/<relationship 列的源 id>.jsonl：多对多关联表的行
+	archiveLinksDir   = "links/"
+	archiveBlobs      = "blobs.jsonl"       // lc_blobs，jsonb 单元格引用的内容
+	archiveBlobChunks = "blob_chunks.jsonl" // lc_blob_chunks
+	archiveManifest   = "manifest.json"     // 最后写入，记录每个文件的记录数
+)
+
+// archiveBatchRows / archiveBatchBytes 限制导入时一条 INSERT 的行数和 JSON 大小。
+const (
+	archiveBatchRows  = 500
+	archiveBatchBytes = 8 << 20
+)
+
+// tenantArchiveManifest 是归档的 manifest.json。导入时按 Records 核对每个文件，发现被截断的归档。
+type tenantArchiveManifest struct {
+	Version    int32            `json:"version"`
+	TenantID   string           `json:"tenant_id"`
+	ExportedAt time.Time        `json:"exported_at"`
+	Records    map[string]int64 `json:"records"`
+}
+
+// ExportTenantArchive 在一个可重复读的只读事务中导出 tenant：schema 同 ExportSchema，之后是每张表的全部行
+// （包括回收站中的行）、多对多关联和单元格内容。to_storage 时写入对象存储，否则分块交给 onChunk。
+// suspended 的 tenant 也可以导出。附件列只导出元数据，文件本身留在对象存储中。
+func (s *LowcodeService) ExportTenantArchive(ctx context.Context, req *lowcodev1.ExportTenantRequest, onChunk func([]byte) error) (*lowcodev1.TenantArchiveInfo, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetTenantId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "tenant_id is required")
+	}
+	var store storage.Store
+	if req.GetToStorage() {
+		var err error
+		if store, err = s.requireStorage(); err != nil {
+			return nil, err
+		}
+	}
+	pool, err := s.tenants.OpenTenant(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	defer pool.Close()
+
+	if store == nil {
+		w := &countingWriter{w: &chunkWriter{send: onChunk}}
+		info, err := writeTenantArchive(ctx, pool, req.GetTenantId(), w)
+		if err != nil {
+			return nil, err
+		}
+		info.Size = w.n
+		return info, nil
+	}
+
+	return uploadTenantArchive(ctx, store, pool, req.GetTenantId(), "tenant-archives/")
+}
+
+// uploadTenantArchive 把 tenant 归档写入对象存储的 <prefix><tenant>/<时间>.tar.gz，ExportTenant 和备份共用。
+func uploadTenantArchive(ctx context.Context, store storage.Store, pool *pgxpool.Pool, tenantID, prefix string) (*lowcodev1.TenantArchiveInfo, error) {
+	// S3 的预签名 PUT 需要 Content-Length，先写到临时文件
+	f, err := os.CreateTemp("", "lc-tenant-*.tar.gz")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	w := &countingWriter{w: f}
+	info, err := writeTenantArchive(ctx, pool, tenantID, w)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	info.Size = w.n
+	info.StorageKey = fmt.Sprintf("%s%s/%s.tar.gz", prefix, tenantID, info.GetExportedAt().AsTime().Format("20060102T150405Z"))
+	if err := storage.Put(ctx, store, info.StorageKey, "application/gzip", f, w.n); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// writeTenantArchive 把 pool 对应的 tenant 写成归档。
+func writeTenantArchive(ctx context.Context, pool *pgxpool.Pool, tenantID string, w io.Writer) (*lowcodev1.TenantArchiveInfo, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	bundle, err := exportSchemaBundle(ctx, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	info := &lowcodev1.TenantArchiveInfo{
+		TenantId:   tenantID,
+		Version:    tenantArchiveVersion,
+		ExportedAt: bundle.GetExportedAt(),
+		TableCount: int32(len(bundle.GetTables())),
+	}
+	gz := gzip.NewWriter(w)
+	a := &archiveWriter{tw: tar.NewWriter(gz), modTime: bundle.GetExportedAt().AsTime(), records: map[string]int64{}}
+
+	schema, err := protojson.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.writeFile(archiveSchema, schema); err != nil {
+		return nil, err
+	}
+	for _, t := range bundle.GetTables() {
+		ref, err := lookupTable(ctx, tx, t.GetName(), false, false)
+		if err != nil {
+			return nil, err
+		}
+		cols, err := sourceColumns(ctx, tx, t.GetName())
+		if err != nil {
+			return nil, err
+		}
+		n, err := a.writeQuery(ctx, tx, archiveTablesDir+t.GetName()+".jsonl", archiveRowsSQL(ref, cols))
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", t.GetName(), err)
+		}
+		info.RowCount += n
+		for _, c := range cols {
+			if c.Kind != "relationship" || c.Config["join_table"] == nil {
+				continue
+			}
+			join := pgx.Identifier{fmt.Sprint(c.Config["join_schema"]), fmt.Sprint(c.Config["join_table"])}.Sanitize()
+			if _, err := a.writeQuery(ctx, tx, archiveLinksDir+c.Id+".jsonl", `SELECT to_jsonb(j) FROM `+join+` j`); err != nil {
+				return nil, fmt.Errorf("table %s column %s: %w", t.GetName(), c.Name, err)
+			}
+		}
+	}
+	if _, err := a.writeQuery(ctx, tx, archiveBlobs, `SELECT to_jsonb(b) FROM lc_blobs b`); err != nil {
+		return nil, err
+	}
+	if _, err := a.writeQuery(ctx, tx, archiveBlobChunks, `SELECT to_jsonb(c) FROM lc_blob_chunks c ORDER BY blob_id, seq`); err != nil {
+		return nil, err
+	}
+
+	manifest, err := json.Marshal(tenantArchiveManifest{
+		Version:    tenantArchiveVersion,
+		TenantID:   tenantID,
+		ExportedAt: a.modTime,
+		Records:    a.records,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := a.writeFile(archiveManifest, manifest); err != nil {
+		return nil, err
+	}
+	if err := a.tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// archiveRowsSQL 查询表的全部行，每行一个 JSON 对象：id、系统列保持原名，其它物理列以列 id 为 key。
+// geometry 列转成 EWKB 十六进制文本，导入时由 PG 原样解析。
+func archiveRowsSQL(ref tableRef, cols []columnMeta) string {
+	fields := []string{"id"}
+	if system, _ := ref.Config["system_columns"].(bool); system {
+		for _, c := range systemColumns {
+			fields = append(fields, pgx.Identifier{c.PgColumn}.Sanitize())
+		}
+	}
+	for _, c := range cols {
+		if virtualKinds[c.Kind] {
+			continue
+		}
+		expr := pgx.Identifier{c.PgColumn}.Sanitize()
+		if isGeometryPgType(c.PgType) {
+			expr += "::text"
+		}
+		fields = append(fields, expr+" AS "+pgx.Identifier{c.Id}.Sanitize())
+	}
+	return fmt.Sprintf(`SELECT to_jsonb(r) FROM (SELECT %s FROM %s) r`,
+		strings.Join(fields, ", "), pgx.Identifier{ref.SchemaName, ref.PhysTable}.Sanitize())
+}
+
+// archiveWriter 往 tar 中写文件并记录每个文件的记录数。
+type archiveWriter struct {
+	tw      *tar.Writer
+	modTime time.Time
+	records map[string]int64
+}
+
+func (a *archiveWriter) writeFile(name string, data []byte) error {
+	if err := a.tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: a.modTime}); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(data)
+	return err
+}
+
+// writeQuery 把查询结果（每行一个 jsonb）写成 jsonl 文件。tar 头需要文件大小，所以先写到临时文件。
+func (a *archiveWriter) writeQuery(ctx context.Context, q querier, name, sql string) (int64, error) {
+	f, err := os.CreateTemp("", "lc-archive-*.jsonl")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	bw := bufio.NewWriter(f)
+	rows, err := q.Query(ctx, sql)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for rows.Next() {
+		var line []byte
+		if err := rows.Scan(&line); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		bw.Write(line)
+		bw.WriteByte('\n')
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	if err := a.tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: size, ModTime: a.modTime}); err != nil {
+		return 0, err
+	}
+	if _, err := io.Copy(a.tw, f); err != nil {
+		return 0, err
+	}
+	a.records[name] = n
+	return n, nil
+}
+
+// ImportTenantArchive 创建 info.tenant_id 并把归档恢复进去：先按 schema.json 重建表（列使用新的 id），
+// 再写入行、关联和单元格内容，行 id 保持不变。归档来自 info.storage_key 或 next 依次返回的分块（io.EOF 结束）。
+// 任何一步失败都会删除新 tenant。
+func (s *LowcodeService) ImportTenantArchive(ctx context.Context, info *lowcodev1.ImportTenantInfo, next func() ([]byte, error)) (*lowcodev1.ImportTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if info.GetTenantId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "tenant_id is required")
+	}
+	var r io.Reader = &chunkReader{next: next}
+	if key := info.GetStorageKey(); key != "" {
+		store, err := s.requireStorage()
+		if err != nil {
+			return nil, err
+		}
+		body, err := storage.Get(ctx, store, key)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		r = body
+	}
+
+	var res lowcodev1.ImportTenantResponse
+	t, err := s.tenants.ProvisionTenant(ctx, info.GetTenantId(), func(ctx context.Context, pool *pgxpool.Pool) error {
+		return s.restoreTenantArchive(ctx, pool, info.GetTenantId(), r, &res)
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Tenant = tenantToProto(t)
+	return &res, nil
+}
+
+// restoreTenantArchive 在一个事务中按顺序读取归档的文件并写入 pool（tenantID 的库）。
+func (s *LowcodeService) restoreTenantArchive(ctx context.Context, pool *pgxpool.Pool, tenantID string, r io.Reader, res *lowcodev1.ImportTenantResponse) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return invalidArchive("%v", err)
+	}
+	tr := tar.NewReader(gz)
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var ids map[string]string // 源列 id -> 新列 id，schema.json 导入后才有
+	var manifest *tenantArchiveManifest
+	records := make(map[string]int64)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return invalidArchive("%v", err)
+		}
+		name := hdr.Name
+		if name != archiveSchema && name != archiveManifest && ids == nil {
+			return invalidArchive("%s must precede %s", archiveSchema, name)
+		}
+		switch {
+		case name == archiveSchema:
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return invalidArchive("%v", err)
+			}
+			var b lowcodev1.SchemaBundle
+			if err := protojson.Unmarshal(data, &b); err != nil {
+				return invalidArchive("%s: %v", name, err)
+			}
+			if err := checkSchemaBundle(&b); err != nil {
+				return err
+			}
+			if _, ids, err = s.importSchemaBundle(ctx, tx, &b, false); err != nil {
+				return err
+			}
+			res.TableCount = int32(len(b.GetTables()))
+
+		case name == archiveManifest:
+			manifest = &tenantArchiveManifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return invalidArchive("%s: %v", name, err)
+			}
+			if manifest.Version != tenantArchiveVersion {
+				return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument,
+					"unsupported tenant archive version %d (expected %d)", manifest.Version, tenantArchiveVersion)
+			}
+			res.SourceTenantId = manifest.TenantID
+
+		case strings.HasPrefix(name, archiveTablesDir) && strings.HasSuffix(name, ".jsonl"):
+			table := strings.TrimSuffix(strings.TrimPrefix(name, archiveTablesDir), ".jsonl")
+			ref, err := lookupTable(ctx, tx, table, false, false)
+			if err != nil {
+				return err
+			}
+			keys, err := archiveRowKeys(ctx, tx, ref, ids)
+			if err != nil {
+				return err
+			}
+			n, err := insertArchiveRecords(ctx, tx, pgx.Identifier{ref.SchemaName, ref.PhysTable}.Sanitize(), keys, tr)
+			if err != nil {
+				return fmt.Errorf("table %s: %w", table, err)
+			}
+			records[name] = n
+			res.RowCount += n
+
+		case strings.HasPrefix(name, archiveLinksDir) && strings.HasSuffix(name, ".jsonl"):
+			src := strings.TrimSuffix(strings.TrimPrefix(name, archiveLinksDir), ".jsonl")
+			var joinSchema, joinTable string
+			if err := tx.QueryRow(ctx, `SELECT config->>'join_schema', config->>'join_table' FROM lc_columns WHERE id = $1 AND config ? 'join_table'`, ids[src]).Scan(&joinSchema, &joinTable); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return invalidArchive("%s: column %s is not a many_to_many relationship in %s", name, src, archiveSchema)
+				}
+				return err
+			}
+			if records[name], err = insertArchiveRecords(ctx, tx, pgx.Identifier{joinSchema, joinTable}.Sanitize(), nil, tr); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+		case name == archiveBlobs:
+			if records[name], err = insertArchiveRecords(ctx, tx, "lc_blobs", nil, tr); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+		case name == archiveBlobChunks:
+			if records[name], err = insertArchiveRecords(ctx, tx, "lc_blob_chunks", nil, tr); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+		default:
+			return invalidArchive("unexpected file %s", name)
+		}
+	}
+
+	if manifest == nil {
+		return invalidArchive("%s is missing", archiveManifest)
+	}
+	for name, want := range manifest.Records {
+		if got := records[name]; got != want {
+			return invalidArchive("%s has %d records, manifest says %d", name, got, want)
+		}
+	}
+	// 归档中加密列的密文绑定源 tenant，换成新 tenant 的密文
+	if _, _, err := reencryptColumns(ctx, tx, s.tenants.Keyring(), manifest.TenantID, tenantID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// archiveRowKeys 把归档行中的 key 映射到新表的物理列：id、系统列不变，源列 id 经 ids 换成新列的物理列。
+func archiveRowKeys(ctx context.Context, q querier, ref tableRef, ids map[string]string) (map[string]string, error) {
+	keys := map[string]string{"id": "id"}
+	if system, _ := ref.Config["system_columns"].(bool); system {
+		for _, c := range systemColumns {
+			keys[c.PgColumn] = c.PgColumn
+		}
+	}
+	cols, err := sourceColumns(ctx, q, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	pgColumns := make(map[string]string, len(cols))
+	for _, c := range cols {
+		if !virtualKinds[c.Kind] {
+			pgColumns[c.Id] = c.PgColumn
+		}
+	}
+	for src, dst := range ids {
+		if pg, ok := pgColumns[dst]; ok {
+			keys[src] = pg
+		}
+	}
+	return keys, nil
+}
+
+// insertArchiveRecords 读取 jsonl 并分批插入 table，keys 非空时按它改写每条记录的 key。
+// 值由 jsonb_populate_recordset 按目标列的类型解析，和导出时 to_jsonb 的输出对应。
+func insertArchiveRecords(ctx context.Context, tx pgx.Tx, table string, keys map[string]string, r io.Reader) (int64, error) {
+	br := bufio.NewReader(r)
+	var batch bytes.Buffer
+	var batchRows int
+	columns := make(map[string]bool)
+	var n int64
+
+	flush := func() error {
+		if batchRows == 0 {
+			return nil
+		}
+		batch.WriteByte(']')
+		list := make([]string, 0, len(columns))
+		for c := range columns {
+			list = append(list, pgx.Identifier{c}.Sanitize())
+		}
+		colsSQL := strings.Join(list, ", ")
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM jsonb_populate_recordset(NULL::%s, $1::jsonb)`,
+			table, colsSQL, colsSQL, table), batch.String()); err != nil {
+			return err
+		}
+		batch.Reset()
+		batchRows = 0
+		return nil
+	}
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var rec map[string]json.RawMessage
+			if jerr := json.Unmarshal(line, &rec); jerr != nil {
+				return n, invalidArchive("record %d: %v", n+1, jerr)
+			}
+			if keys != nil {
+				mapped := make(map[string]json.RawMessage, len(rec))
+				for k, v := range rec {
+					pg, ok := keys[k]
+					if !ok {
+						return n, invalidArchive("record %d: unknown column %s", n+1, k)
+					}
+					mapped[pg] = v
+				}
+				rec = mapped
+			}
+			for k := range rec {
+				columns[k] = true
+			}
+			b, jerr := json.Marshal(rec)
+			if jerr != nil {
+				return n, jerr
+			}
+			if batchRows == 0 {
+				batch.WriteByte('[')
+			} else {
+				batch.WriteByte(',')
+			}
+			batch.Write(b)
+			batchRows++
+			n++
+			if batchRows >= archiveBatchRows || batch.Len() >= archiveBatchBytes {
+				if err := flush(); err != nil {
+					return n, err
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return n, invalidArchive("%v", err)
+		}
+	}
+	return n, flush()
+}
+
+func invalidArchive(format string, args ...any) error {
+	return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid tenant archive: "+format, args...)
+}
+
+func (s *LowcodeService) ExportTenant(req *lowcodev1.ExportTenantRequest, stream lowcodev1.LowcodeService_ExportTenantServer) error {
+	info, err := s.ExportTenantArchive(stream.Context(), req, func(chunk []byte) error {
+		return stream.Send(&lowcodev1.ExportTenantResponse{Payload: &lowcodev1.ExportTenantResponse_Chunk{Chunk: chunk}})
+	})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&lowcodev1.ExportTenantResponse{Payload: &lowcodev1.ExportTenantResponse_Info{Info: info}})
+}
+
+func (s *LowcodeService) ImportTenant(stream lowcodev1.LowcodeService_ImportTenantServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "first message must carry info")
+	}
+	res, err := s.ImportTenantArchive(stream.Context(), info, func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if msg.GetInfo() != nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "info must only be sent once")
+		}
+		return msg.GetChunk(), nil
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+// chunkWriter 把写入的数据切成不超过 cellChunkSize 的分块交给 send。
+type chunkWriter struct {
+	send func([]byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	for off := 0; off < len(p); off += cellChunkSize {
+		if err := w.send(p[off:min(off+cellChunkSize, len(p))]); err != nil {
+			return off, err
+		}
+	}
+	return len(p), nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// chunkReader 把 next 依次返回的分块当作一个 io.Reader。
+type chunkReader struct {
+	next func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
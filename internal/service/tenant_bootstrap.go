@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Tenant bootstrap --------
+
+// LoadTenantBootstrap 从 path（TenantBootstrap 的 JSON）读取 CreateTenant 默认写入新库的内容。
+func (s *LowcodeService) LoadTenantBootstrap(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var b lowcodev1.TenantBootstrap
+	if err := protojson.Unmarshal(data, &b); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := checkTenantBootstrap(&b); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	s.bootstrap = &b
+	return nil
+}
+
+// tenantBootstrapFor 返回 CreateTenant 要写入的内容，没有时返回 nil。
+func (s *LowcodeService) tenantBootstrapFor(req *lowcodev1.CreateTenantRequest) (*lowcodev1.TenantBootstrap, error) {
+	if req.GetSkipBootstrap() {
+		return nil, nil
+	}
+	if b := req.GetBootstrap(); b != nil {
+		if err := checkTenantBootstrap(b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+	return s.bootstrap, nil
+}
+
+// checkTenantBootstrap 在建库之前校验 schema 文档和模板 id；行数据要等表建好后才能校验。
+func checkTenantBootstrap(b *lowcodev1.TenantBootstrap) error {
+	if b.GetSchema() != nil {
+		if err := checkSchemaBundle(b.GetSchema()); err != nil {
+			return err
+		}
+	}
+	for _, id := range b.GetTemplateIds() {
+		if _, ok := lookupTemplate(id); !ok {
+			return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "template %s not found", id)
+		}
+	}
+	for i, r := range b.GetRows() {
+		if r.GetTable() == "" {
+			return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "rows[%d].table is required", i)
+		}
+	}
+	return nil
+}
+
+// bootstrapTenant 在一个事务中把 b 写入新 tenant 的库：先导入 schema，再按模板建表，最后写入 rows。
+func (s *LowcodeService) bootstrapTenant(ctx context.Context, pool *pgxpool.Pool, b *lowcodev1.TenantBootstrap) error {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if b.GetSchema() != nil {
+		if _, _, err := s.importSchemaBundle(ctx, tx, b.GetSchema(), false); err != nil {
+			return err
+		}
+	}
+	for _, id := range b.GetTemplateIds() {
+		t, _ := lookupTemplate(id)
+		if _, err := s.createTableWithSchema(ctx, tx, &lowcodev1.CreateTableWithSchemaRequest{
+			Name:    t.ID,
+			Columns: t.Columns,
+			Indexes: t.Indexes,
+		}); err != nil {
+			return fmt.Errorf("template %s: %w", id, err)
+		}
+		if !b.GetSkipSampleRows() {
+			if _, err := s.insertSeedRows(ctx, tx, t.ID, t.Rows); err != nil {
+				return err
+			}
+		}
+	}
+	for _, r := range b.GetRows() {
+		rows := make([]map[string]any, len(r.GetRows()))
+		for i, row := range r.GetRows() {
+			rows[i] = row.AsMap()
+		}
+		if _, err := s.insertSeedRows(ctx, tx, r.GetTable(), rows); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// insertSeedRows 向表写入以列名为 key 的行（模板示例行和 bootstrap 的初始行）。
+func (s *LowcodeService) insertSeedRows(ctx context.Context, tx pgx.Tx, table string, rows []map[string]any) ([]*lowcodev1.Row, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	cols, schemaName, tableName, err := s.loadColumns(ctx, tx, table)
+	if err != nil {
+		return nil, err
+	}
+	idByName := make(map[string]string, len(cols))
+	for _, c := range cols {
+		idByName[c.Name] = c.Id
+	}
+	out := make([]*lowcodev1.Row, 0, len(rows))
+	for i, r := range rows {
+		cells := make(map[string]*lowcodev1.Value, len(r))
+		for k, v := range r {
+			id, ok := idByName[k]
+			if !ok {
+				return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "%s row %d: column %s not found", table, i, k)
+			}
+			cells[id] = anyToValue(v)
+		}
+		row, err := s.insertRow(ctx, tx, cols, schemaName, tableName, cells)
+		if err != nil {
+			return nil, fmt.Errorf("%s row %d: %w", table, i, err)
+		}
+		out = append(out, row)
+	}
+	return out, s.openRows(ctx, cols, out...)
+}
@@ -2,12 +2,20 @@ package service
 
 import (
 	"context"
+	"errors"
 	"strings"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/db"
+	"github.com/solat/lowcode-database/internal/migrate"
+	"github.com/solat/lowcode-database/internal/tenant"
 )
 
 // -------- Tenant --------
@@ -17,9 +25,269 @@ func (s *LowcodeService) CreateTenant(ctx context.Context, req *lowcodev1.Create
 	if id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	if err := s.tenants.CreateTenant(ctx, id); err != nil {
+	b, err := s.tenantBootstrapFor(req)
+	if err != nil {
+		return nil, err
+	}
+	var bootstrap func(ctx context.Context, pool *pgxpool.Pool) error
+	if b != nil {
+		bootstrap = func(ctx context.Context, pool *pgxpool.Pool) error {
+			// 初始行中的加密列按新 tenant 加密，而不是调用方（平台管理员）所在的 tenant
+			return s.bootstrapTenant(tenant.WithTenantID(ctx, id), pool, b)
+		}
+	}
+	if err := s.tenants.CreateTenant(ctx, id, req.GetDsn(), bootstrap); err != nil {
+		if errors.Is(err, db.ErrTenantUnavailable) || errors.Is(err, tenant.ErrInvalidID) ||
+			errors.Is(err, db.ErrInvalidDSN) || errors.Is(err, db.ErrDSNEncryptionUnavailable) {
+			return nil, err
+		}
+		// bootstrap 中的校验错误（如初始行不合法）原样返回
+		if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "create tenant %s: %v", id, err)
 	}
 	return &lowcodev1.CreateTenantResponse{Id: id}, nil
 }
 
+// requireMultiTenant 拒绝 single 模式下的 tenant 管理请求。
+func (s *LowcodeService) requireMultiTenant() error {
+	if !s.tenants.MultiTenant() {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "tenant management requires TENANT_MODE=multi")
+	}
+	return nil
+}
+
+func tenantToProto(t *db.TenantInfo) *lowcodev1.Tenant {
+	return &lowcodev1.Tenant{
+		Id:               t.ID,
+		SizeBytes:        t.SizeBytes,
+		Connections:      t.Connections,
+		AllowConnections: t.AllowConnections,
+		PoolOpen:         t.PoolOpen,
+		MaxConns:         t.MaxConns,
+		DsnHost:          t.DSNHost,
+		ReplicaHost:      t.ReplicaHost,
+		State:            lowcodev1.TenantState(lowcodev1.TenantState_value["TENANT_STATE_"+strings.ToUpper(string(t.State))]),
+		StateReason:      t.StateReason,
+		CreatedAt:        timestamppb.New(t.CreatedAt),
+		UpdatedAt:        timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func (s *LowcodeService) ListTenants(ctx context.Context, req *lowcodev1.ListTenantsRequest) (*lowcodev1.ListTenantsResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	var state db.TenantState
+	if st := req.GetState(); st != lowcodev1.TenantState_TENANT_STATE_UNSPECIFIED {
+		state = db.TenantState(strings.ToLower(strings.TrimPrefix(st.String(), "TENANT_STATE_")))
+	}
+	infos, err := s.tenants.ListTenants(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	var res lowcodev1.ListTenantsResponse
+	for i := range infos {
+		res.Tenants = append(res.Tenants, tenantToProto(&infos[i]))
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) GetTenant(ctx context.Context, req *lowcodev1.GetTenantRequest) (*lowcodev1.GetTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	info, err := s.tenants.GetTenant(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.GetTenantResponse{Tenant: tenantToProto(info)}, nil
+}
+
+// DeleteTenant 删除 tenant 的数据库，不可恢复。confirm 必须与 id 相同。
+func (s *LowcodeService) DeleteTenant(ctx context.Context, req *lowcodev1.DeleteTenantRequest) (*lowcodev1.DeleteTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.GetConfirm() != req.GetId() {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "confirm must equal the tenant id %q to delete it", req.GetId())
+	}
+	if err := s.tenants.DeleteTenant(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.DeleteTenantResponse{}, nil
+}
+
+// CloneTenant 以源 tenant 的库为模板创建新 tenant，可选只保留结构。
+func (s *LowcodeService) CloneTenant(ctx context.Context, req *lowcodev1.CloneTenantRequest) (*lowcodev1.CloneTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetSourceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "source_id is required")
+	}
+	if req.GetTargetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_id is required")
+	}
+	info, err := s.tenants.CloneTenant(ctx, req.GetSourceId(), req.GetTargetId(), req.GetIncludeData(), req.GetDisconnectSource())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetIncludeData() {
+		if err := s.resealClonedTenant(ctx, req.GetSourceId(), req.GetTargetId()); err != nil {
+			// 加密列无法在新 tenant 中解密，同克隆的其它失败一样删除新 tenant
+			if derr := s.tenants.DeleteTenant(context.WithoutCancel(ctx), req.GetTargetId()); derr != nil {
+				return nil, errors.Join(err, derr)
+			}
+			return nil, err
+		}
+	}
+	return &lowcodev1.CloneTenantResponse{Tenant: tenantToProto(info)}, nil
+}
+
+// resealClonedTenant 把复制出的加密列从源 tenant 的密文重新加密为新 tenant 的密文（密文绑定 tenant id）。
+func (s *LowcodeService) resealClonedTenant(ctx context.Context, sourceID, targetID string) error {
+	ctx = tenant.WithTenantID(ctx, targetID)
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if _, _, err := reencryptColumns(ctx, tx, s.tenants.Keyring(), sourceID, targetID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// MigrateAllTenants 把 tenant 库迁移到最新版本，单个 tenant 的失败记录在结果中而不是让整个请求失败。
+func (s *LowcodeService) MigrateAllTenants(ctx context.Context, req *lowcodev1.MigrateAllTenantsRequest) (*lowcodev1.MigrateAllTenantsResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetConcurrency() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "concurrency must not be negative")
+	}
+	results, err := s.tenants.MigrateTenants(ctx, req.GetTenantIds(), int(req.GetConcurrency()))
+	if err != nil {
+		return nil, err
+	}
+	res := &lowcodev1.MigrateAllTenantsResponse{LatestVersion: int32(migrate.Latest())}
+	for _, r := range results {
+		out := &lowcodev1.TenantMigrationResult{
+			TenantId:    r.TenantID,
+			FromVersion: int32(r.FromVersion),
+			ToVersion:   int32(r.ToVersion),
+		}
+		if r.Err != nil {
+			out.Error = r.Err.Error()
+		}
+		res.Results = append(res.Results, out)
+	}
+	return res, nil
+}
+
+// ListTenantHealth 返回本实例 tenant 连接池的健康状态。
+func (s *LowcodeService) ListTenantHealth(ctx context.Context, req *lowcodev1.ListTenantHealthRequest) (*lowcodev1.ListTenantHealthResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	health, err := s.tenants.PoolHealth(ctx, req.GetRefresh())
+	if err != nil {
+		return nil, err
+	}
+	res := &lowcodev1.ListTenantHealthResponse{}
+	for _, h := range health {
+		out := &lowcodev1.TenantHealth{
+			TenantId:            h.TenantID,
+			Healthy:             h.Healthy,
+			PoolOpen:            h.PoolOpen,
+			LatencyMs:           h.Latency.Milliseconds(),
+			LastError:           h.LastError,
+			ConsecutiveFailures: int32(h.ConsecutiveFailures),
+			Reconnects:          int32(h.Reconnects),
+		}
+		if !h.CheckedAt.IsZero() {
+			out.CheckedAt = timestamppb.New(h.CheckedAt)
+		}
+		res.Tenants = append(res.Tenants, out)
+	}
+	return res, nil
+}
+
+// UpdateTenant 修改 tenant 的连接池上限和专属连接串，各实例在状态缓存过期后按新配置重建连接池。
+func (s *LowcodeService) UpdateTenant(ctx context.Context, req *lowcodev1.UpdateTenantRequest) (*lowcodev1.UpdateTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.MaxConns != nil && req.GetMaxConns() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_conns must not be negative")
+	}
+	info, err := s.tenants.UpdateTenant(ctx, req.GetId(), req.MaxConns, req.Dsn, req.ReplicaDsn)
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UpdateTenantResponse{Tenant: tenantToProto(info)}, nil
+}
+
+// SuspendTenant 暂停 tenant：数据保留，请求返回 TENANT_SUSPENDED，直到 ResumeTenant。
+func (s *LowcodeService) SuspendTenant(ctx context.Context, req *lowcodev1.SuspendTenantRequest) (*lowcodev1.SuspendTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	info, err := s.tenants.SuspendTenant(ctx, req.GetId(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.SuspendTenantResponse{Tenant: tenantToProto(info)}, nil
+}
+
+func (s *LowcodeService) ResumeTenant(ctx context.Context, req *lowcodev1.ResumeTenantRequest) (*lowcodev1.ResumeTenantResponse, error) {
+	if err := s.requireMultiTenant(); err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	info, err := s.tenants.ResumeTenant(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.ResumeTenantResponse{Tenant: tenantToProto(info)}, nil
+}
+
+// tenantScoped 判断物理表是否按 tenant 隔离（pooled 模式下带 db.TenantPolicy 策略）。
+func tenantScoped(ctx context.Context, q querier, schemaName, tableName string) (bool, error) {
+	var scoped bool
+	err := q.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM pg_policy
+			WHERE polrelid = to_regclass(format('%I.%I', $1::text, $2::text)) AND polname = $3
+		)`, schemaName, tableName, db.TenantPolicy).Scan(&scoped)
+	return scoped, err
+}
+
+// tenantUniqueKeys 在按 tenant 隔离的表上把 tenant 列加到唯一索引的最前面，让唯一性只在 tenant 内生效。
+func tenantUniqueKeys(ctx context.Context, q querier, schemaName, tableName string, keys []string) ([]string, error) {
+	scoped, err := tenantScoped(ctx, q, schemaName, tableName)
+	if err != nil || !scoped {
+		return keys, err
+	}
+	return append([]string{pgx.Identifier{db.TenantColumn}.Sanitize()}, keys...), nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// -------- Validated text types --------
+
+// email / url / phone 是带格式校验的文本类型（lc_types.config.kind），列为 text。
+// 写入不合法的值时返回 VALIDATION_FAILED，字段上带 textFormatReasons 中的机器可读原因。
+var textFormatReasons = map[string]string{
+	"email": "INVALID_EMAIL",
+	"url":   "INVALID_URL",
+	"phone": "INVALID_PHONE",
+}
+
+// phoneRe 接受 E.164 风格的号码：可选的 "+"，7-15 位数字；空格、"-"、"."、括号作为分隔符忽略。
+var (
+	phoneRe        = regexp.MustCompile(`^\+?[0-9]{7,15}$`)
+	phoneSeparator = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+)
+
+// validTextFormat 判断 s 是否符合 kind 的格式；不是格式类型时总是返回 true。
+func validTextFormat(kind, s string) bool {
+	switch kind {
+	case "email":
+		// 只接受裸地址，不接受 "Name <a@b.c>"
+		addr, err := mail.ParseAddress(s)
+		return err == nil && addr.Address == s && strings.Contains(s[strings.LastIndexByte(s, '@'):], ".")
+	case "url":
+		u, err := url.Parse(s)
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case "phone":
+		return phoneRe.MatchString(phoneSeparator.Replace(s))
+	}
+	return true
+}
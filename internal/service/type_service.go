@@ -2,11 +2,19 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sort"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/auth"
+	"github.com/solat/lowcode-database/internal/migrate"
 )
 
 // -------- Type --------
@@ -16,71 +24,387 @@ func (s *LowcodeService) CreateType(ctx context.Context, req *lowcodev1.CreateTy
 	if err != nil {
 		return nil, err
 	}
-	const q = `
-		INSERT INTO lc_types (id, name, pg_type, config)
-		VALUES ($1, $1, $2, $3)
-		RETURNING id, name, pg_type, config, created_at, updated_at
-	`
-	row := pool.QueryRow(ctx, q, req.GetName(), req.GetPgType(), req.GetConfig().AsMap())
-
-	var t lowcodev1.Type
-	var cfg map[string]any
-	var createdAt, updatedAt time.Time
-	if err := row.Scan(&t.Id, &t.Name, &t.PgType, &cfg, &createdAt, &updatedAt); err != nil {
+	if _, ok := migrate.LookupBuiltinType(req.GetName()); ok {
+		return nil, apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "type %s is a builtin type", req.GetName())
+	}
+	if err := checkEncryptedType(req.GetName(), req.GetPgType(), req.GetConfig().AsMap()); err != nil {
 		return nil, err
 	}
-	// 对外约定：Type.Id == Type.Name，避免前端关心内部 UUID。
-	t.Id = t.Name
-	t.CreatedAt = timestamppb.New(createdAt)
-	t.UpdatedAt = timestamppb.New(updatedAt)
-	if cfg != nil {
-		t.Config = toStruct(cfg)
+	t, err := scanType(pool.QueryRow(ctx, insertTypeSQL, req.GetName(), req.GetPgType(), req.GetConfig().AsMap(), auth.UserIDFromContext(ctx)))
+	if err != nil {
+		return nil, err
 	}
-	return &lowcodev1.CreateTypeResponse{Type: &t}, nil
+	return &lowcodev1.CreateTypeResponse{Type: t}, nil
 }
 
+// ListTypes 返回内置类型和 tenant 自定义类型，按 name 排序。内置类型以代码中的定义为准。
 func (s *LowcodeService) ListTypes(ctx context.Context, _ *lowcodev1.ListTypesRequest) (*lowcodev1.ListTypesResponse, error) {
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	const q = `SELECT id, name, pg_type, config, created_at, updated_at FROM lc_types ORDER BY name`
-	rows, err := pool.Query(ctx, q)
+	rows, err := pool.Query(ctx, `SELECT `+typeColumnsSQL+` FROM lc_types ORDER BY name`)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	var res lowcodev1.ListTypesResponse
+	seen := map[string]bool{}
 	for rows.Next() {
-		var t lowcodev1.Type
-		var cfg map[string]any
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&t.Id, &t.Name, &t.PgType, &cfg, &createdAt, &updatedAt); err != nil {
+		t, err := scanType(rows)
+		if err != nil {
 			return nil, err
 		}
-		// 对外约定：Type.Id == Type.Name。
-		t.Id = t.Name
-		t.CreatedAt = timestamppb.New(createdAt)
-		t.UpdatedAt = timestamppb.New(updatedAt)
-		if cfg != nil {
-			t.Config = toStruct(cfg)
+		seen[t.Name] = true
+		res.Types = append(res.Types, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// 正常情况下 Migrate 已把内置类型同步到 lc_types，这里补上尚未同步的
+	added := false
+	for _, b := range migrate.BuiltinTypes {
+		if !seen[b.ID] {
+			res.Types = append(res.Types, &lowcodev1.Type{Id: b.ID, Name: b.ID, PgType: b.PgType, Config: toStruct(b.Config), Builtin: true})
+			added = true
 		}
-		res.Types = append(res.Types, &t)
 	}
-	return &res, rows.Err()
+	if added {
+		sort.Slice(res.Types, func(i, j int) bool { return res.Types[i].Name < res.Types[j].Name })
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) GetType(ctx context.Context, req *lowcodev1.GetTypeRequest) (*lowcodev1.GetTypeResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := resolveType(ctx, pool, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.GetTypeResponse{Type: t}, nil
+}
+
+// resolveType 按 id 或 name 查找类型（id 精确匹配优先）。GetType、AddColumn、分区键等校验共用这一解析路径。
+func resolveType(ctx context.Context, q querier, idOrName string) (*lowcodev1.Type, error) {
+	if idOrName == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "type id is required")
+	}
+	t, err := scanType(q.QueryRow(ctx, `
+		SELECT `+typeColumnsSQL+`
+		FROM lc_types
+		WHERE id = $1 OR name = $1
+		ORDER BY (id = $1) DESC
+		LIMIT 1`, idOrName))
+	if err == pgx.ErrNoRows {
+		return nil, apierr.New(lowcodev1.ErrorCode_TYPE_NOT_FOUND, codes.NotFound, "type %s not found", idOrName)
+	}
+	return t, err
+}
+
+// isBuiltinType 判断 lc_types 中的一行是否为内置类型。同名但 pg_type 不同的行是内置类型登记之前
+// tenant 自建的类型，按普通类型处理。
+func isBuiltinType(name, pgType string) bool {
+	b, ok := migrate.LookupBuiltinType(name)
+	return ok && b.PgType == pgType
+}
+
+// readOnlyBuiltin 返回修改内置类型时的错误。
+func readOnlyBuiltin(name string) error {
+	return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition, "type %s is a builtin type and cannot be modified or deleted", name)
+}
+
+// typeKind 返回类型的 config.kind，普通类型为空。
+func typeKind(t *lowcodev1.Type) string {
+	kind, _ := t.GetConfig().AsMap()["kind"].(string)
+	return kind
+}
+
+// UpdateType 修改类型定义并递增 version。pg_type 变化时：没有列使用该类型直接修改；
+// 有列使用时需要 cast_columns，在同一事务内把这些物理列转换为新类型，转换失败则整体回滚。
+// 已被列使用的类型不允许修改 config.kind（会改变列的行为，如从普通列变成虚拟列）。
+func (s *LowcodeService) UpdateType(ctx context.Context, req *lowcodev1.UpdateTypeRequest) (*lowcodev1.UpdateTypeResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var name, curPgType string
+	var curCfg map[string]any
+	if err := tx.QueryRow(ctx, `SELECT name, pg_type, config FROM lc_types WHERE id = $1 OR name = $1 FOR UPDATE`, req.GetId()).
+		Scan(&name, &curPgType, &curCfg); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apierr.New(lowcodev1.ErrorCode_TYPE_NOT_FOUND, codes.NotFound, "type %s not found", req.GetId())
+		}
+		return nil, err
+	}
+	if isBuiltinType(name, curPgType) {
+		return nil, readOnlyBuiltin(name)
+	}
+	pgType, cfg := curPgType, curCfg
+	if req.GetPgType() != "" {
+		pgType = req.GetPgType()
+	}
+	if req.GetConfig() != nil {
+		cfg = req.GetConfig().AsMap()
+	}
+	if pgType == curPgType && reflect.DeepEqual(cfg, curCfg) {
+		t, err := scanType(tx.QueryRow(ctx, `SELECT `+typeColumnsSQL+` FROM lc_types WHERE name = $1`, name))
+		if err != nil {
+			return nil, err
+		}
+		return &lowcodev1.UpdateTypeResponse{Type: t}, nil
+	}
+
+	var used []typedColumn
+	if err := func() error {
+		rows, err := tx.Query(ctx, `
+			SELECT t.schema_name, t.table_name, c.pg_column
+			FROM lc_columns c
+			JOIN lc_tables t ON c.table_id = t.name
+			WHERE c.type_id = $1
+			ORDER BY t.name, c.position`, name)
+		if err != nil {
+			return err
+		}
+		used, err = pgx.CollectRows(rows, pgx.RowToStructByPos[typedColumn])
+		return err
+	}(); err != nil {
+		return nil, err
+	}
+
+	if err := checkEncryptedType(name, pgType, cfg); err != nil {
+		return nil, err
+	}
+	curKind, _ := curCfg["kind"].(string)
+	kind, _ := cfg["kind"].(string)
+	if kind != curKind && len(used) > 0 {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"type %s is used by %d columns, config.kind cannot change from %q to %q", name, len(used), curKind, kind)
+	}
+	altered := 0
+	// 虚拟列没有物理列，pg_type 只是占位
+	if pgType != curPgType && len(used) > 0 && !virtualKinds[kind] {
+		if !req.GetCastColumns() {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+				"type %s is used by %d columns, set cast_columns to convert them from %s to %s", name, len(used), curPgType, pgType)
+		}
+		for _, c := range used {
+			col := pgx.Identifier{c.PgColumn}.Sanitize()
+			if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s::%s`,
+				pgx.Identifier{c.Schema, c.Table}.Sanitize(), col, pgType, col, pgType)); err != nil {
+				return nil, err
+			}
+			altered++
+		}
+	}
+
+	t, err := scanType(tx.QueryRow(ctx, updateTypeSQL, name, pgType, cfg, auth.UserIDFromContext(ctx)))
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UpdateTypeResponse{Type: t, AlteredColumns: int32(altered)}, nil
+}
+
+// typedColumn 是使用某个类型的物理列。
+type typedColumn struct {
+	Schema   string
+	Table    string
+	PgColumn string
 }
 
 func (s *LowcodeService) DeleteType(ctx context.Context, req *lowcodev1.DeleteTypeRequest) (*lowcodev1.DeleteTypeResponse, error) {
-	// 兼容：既支持按内部 UUID 删除，也支持按 name（对外暴露的 id）删除。
-	const q = `DELETE FROM lc_types WHERE id = $1 OR name = $1`
+	// 兼容：既支持按内部 UUID 删除，也支持按 name（对外暴露的 id）删除。内置类型不删除。
 	pool, err := s.tenants.PoolFor(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := pool.Exec(ctx, q, req.GetId()); err != nil {
+	var name, pgType string
+	err = pool.QueryRow(ctx, `SELECT name, pg_type FROM lc_types WHERE id = $1 OR name = $1`, req.GetId()).Scan(&name, &pgType)
+	if err == nil && isBuiltinType(name, pgType) {
+		return nil, readOnlyBuiltin(name)
+	}
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM lc_types WHERE id = $1 OR name = $1`, req.GetId()); err != nil {
 		return nil, err
 	}
 	return &lowcodev1.DeleteTypeResponse{}, nil
 }
 
+func (s *LowcodeService) ExportTypes(ctx context.Context, req *lowcodev1.ExportTypesRequest) (*lowcodev1.ExportTypesResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	types, err := exportTypes(ctx, pool, req.GetIncludeBuiltin())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.ExportTypesResponse{Types: types}, nil
+}
+
+func exportTypes(ctx context.Context, q querier, includeBuiltin bool) ([]*lowcodev1.TypeDefinition, error) {
+	rows, err := q.Query(ctx, `SELECT name, pg_type, config FROM lc_types ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*lowcodev1.TypeDefinition
+	for rows.Next() {
+		var d lowcodev1.TypeDefinition
+		var cfg map[string]any
+		if err := rows.Scan(&d.Name, &d.PgType, &cfg); err != nil {
+			return nil, err
+		}
+		if isBuiltinType(d.Name, d.PgType) && !includeBuiltin {
+			continue
+		}
+		if cfg != nil {
+			d.Config = toStruct(cfg)
+		}
+		out = append(out, &d)
+	}
+	return out, rows.Err()
+}
+
+// ImportTypes 在一个事务中同步类型目录。已被列使用的类型不允许修改 pg_type，避免元数据和物理列类型不一致。
+func (s *LowcodeService) ImportTypes(ctx context.Context, req *lowcodev1.ImportTypesRequest) (*lowcodev1.ImportTypesResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := importTypes(ctx, tx, req.GetTypes(), req.GetOverwrite())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func importTypes(ctx context.Context, tx pgx.Tx, types []*lowcodev1.TypeDefinition, overwrite bool) (*lowcodev1.ImportTypesResponse, error) {
+	var res lowcodev1.ImportTypesResponse
+	for _, d := range types {
+		if d.GetName() == "" || d.GetPgType() == "" {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "type name and pg_type are required")
+		}
+		cfg := d.GetConfig().AsMap()
+		if err := checkEncryptedType(d.GetName(), d.GetPgType(), cfg); err != nil {
+			return nil, err
+		}
+
+		var curPgType string
+		var curCfg map[string]any
+		err := tx.QueryRow(ctx, `SELECT pg_type, config FROM lc_types WHERE name = $1`, d.GetName()).Scan(&curPgType, &curCfg)
+		switch {
+		case err == pgx.ErrNoRows:
+			if _, ok := migrate.LookupBuiltinType(d.GetName()); ok {
+				return nil, fmt.Errorf("type %s is a builtin type and cannot be overwritten", d.GetName())
+			}
+			t, err := scanType(tx.QueryRow(ctx, insertTypeSQL, d.GetName(), d.GetPgType(), cfg, auth.UserIDFromContext(ctx)))
+			if err != nil {
+				return nil, err
+			}
+			res.Created = append(res.Created, t)
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if curPgType == d.GetPgType() && reflect.DeepEqual(curCfg, cfg) {
+			res.Unchanged = append(res.Unchanged, d.GetName())
+			continue
+		}
+		if isBuiltinType(d.GetName(), curPgType) {
+			return nil, fmt.Errorf("type %s is a builtin type and cannot be overwritten", d.GetName())
+		}
+		if !overwrite {
+			return nil, fmt.Errorf("type %s already exists with a different definition", d.GetName())
+		}
+		if curPgType != d.GetPgType() {
+			var used bool
+			if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_columns WHERE type_id = $1)`, d.GetName()).Scan(&used); err != nil {
+				return nil, err
+			}
+			if used {
+				return nil, fmt.Errorf("type %s is used by columns, pg_type cannot change from %s to %s", d.GetName(), curPgType, d.GetPgType())
+			}
+		}
+		t, err := scanType(tx.QueryRow(ctx, updateTypeSQL, d.GetName(), d.GetPgType(), cfg, auth.UserIDFromContext(ctx)))
+		if err != nil {
+			return nil, err
+		}
+		res.Updated = append(res.Updated, t)
+	}
+	return &res, nil
+}
+
+const typeColumnsSQL = `id, name, pg_type, config, version, created_at, updated_at`
+
+// insertTypeSQL / updateTypeSQL 写入类型并在同一条语句中把新定义记入 lc_type_versions。
+// 参数：$1 name，$2 pg_type，$3 config，$4 操作人（x-user-id）。
+const (
+	insertTypeSQL = `
+		WITH t AS (
+			INSERT INTO lc_types (id, name, pg_type, config)
+			VALUES ($1, $1, $2, $3)
+			RETURNING ` + typeColumnsSQL + `
+		), v AS (
+			INSERT INTO lc_type_versions (type_id, version, pg_type, config, created_by)
+			SELECT id, version, pg_type, config, NULLIF($4, '') FROM t
+		)
+		SELECT ` + typeColumnsSQL + ` FROM t`
+	updateTypeSQL = `
+		WITH t AS (
+			UPDATE lc_types
+			SET pg_type = $2, config = $3, version = version + 1, updated_at = now()
+			WHERE name = $1
+			RETURNING ` + typeColumnsSQL + `
+		), v AS (
+			INSERT INTO lc_type_versions (type_id, version, pg_type, config, created_by)
+			SELECT id, version, pg_type, config, NULLIF($4, '') FROM t
+		)
+		SELECT ` + typeColumnsSQL + ` FROM t`
+)
+
+func scanType(row pgx.Row) (*lowcodev1.Type, error) {
+	var t lowcodev1.Type
+	var cfg map[string]any
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&t.Id, &t.Name, &t.PgType, &cfg, &t.Version, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	// 对外约定：Type.Id == Type.Name。
+	t.Id = t.Name
+	t.Builtin = isBuiltinType(t.Name, t.PgType)
+	t.CreatedAt = timestamppb.New(createdAt)
+	t.UpdatedAt = timestamppb.New(updatedAt)
+	if cfg != nil {
+		t.Config = toStruct(cfg)
+	}
+	return &t, nil
+}
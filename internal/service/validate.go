@@ -0,0 +1,156 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Row validation --------
+
+// 列 config 中支持的校验规则：
+//
+//	required   bool      insert 时必须提供非空值；update 时不能清空
+//	min / max  number    数值范围（含边界）
+//	min_length / max_length  number  字符串长度（按字符计）
+//	regex      string    字符串必须匹配的正则
+//	options    [string]  取值必须是其中之一（single_select / multi_select 列的 options 见 select_service.go）
+//
+// is_readonly 列不能写入也不能清空（Reason=READONLY）。
+//
+// 校验在写入 PG 之前进行，值与列类型不匹配（见 coerceValue）也在这里报告，
+// 所有问题一次性以 google.rpc.BadRequest 返回。
+
+// validateCells 校验一行的 cells。insert=true 时检查 required 列是否缺失；
+// cleared 是本次要清空的列 id。prefix 用于批量接口区分 item（如 "items[3]."）。
+func validateCells(cols []columnMeta, cells map[string]*lowcodev1.Value, cleared map[string]bool, insert bool, prefix string) []apierr.FieldViolation {
+	var out []apierr.FieldViolation
+	add := func(c columnMeta, format string, args ...any) {
+		out = append(out, apierr.FieldViolation{Field: prefix + c.Id, Description: fmt.Sprintf(format, args...)})
+	}
+	for _, c := range cols {
+		if c.System {
+			continue
+		}
+		v, present := cells[c.Id]
+		if c.IsReadonly && (present || cleared[c.Id]) {
+			out = append(out, apierr.FieldViolation{
+				Field:       prefix + c.Id,
+				Description: fmt.Sprintf("%s is read-only", c.Name),
+				Reason:      "READONLY",
+			})
+			continue
+		}
+		if present {
+			if _, err := coerceValue(v, c.PgType); err != nil {
+				add(c, "%v", err)
+				continue
+			}
+		}
+		empty := !present || isEmptyValue(v)
+		if !empty && (c.Kind == selectKindSingle || c.Kind == selectKindMulti) {
+			// 选择列的取值必须是 config.options 中的 label
+			if bad := unknownSelectValues(c, v); len(bad) > 0 {
+				add(c, "%s has unknown options: %s", c.Name, strings.Join(bad, ", "))
+				continue
+			}
+		}
+		if _, ok := v.GetKind().(*lowcodev1.Value_StringValue); !empty && c.encrypted() && !ok {
+			// 加密列只接受明文字符串，不能直接写入密文
+			add(c, "%s must be a string", c.Name)
+			continue
+		}
+		if !empty && c.Kind == attachmentKind {
+			if msg := invalidAttachments(v); msg != "" {
+				add(c, "%s: %s", c.Name, msg)
+				continue
+			}
+		}
+		if c.Config == nil {
+			continue
+		}
+		if required, _ := c.Config["required"].(bool); required {
+			if (insert && empty) || cleared[c.Id] || (present && empty) {
+				add(c, "%s is required", c.Name)
+				continue
+			}
+		}
+		if empty {
+			continue
+		}
+
+		switch x := v.GetKind().(type) {
+		case *lowcodev1.Value_NumberValue:
+			if min, ok := c.Config["min"].(float64); ok && x.NumberValue < min {
+				add(c, "%s must be >= %v", c.Name, min)
+			}
+			if max, ok := c.Config["max"].(float64); ok && x.NumberValue > max && c.Kind != "rating" {
+				add(c, "%s must be <= %v", c.Name, max)
+			}
+			if numberFormatKinds[c.Kind] {
+				if msg := numberFormatViolation(c, x.NumberValue); msg != "" {
+					add(c, "%s", msg)
+				}
+			}
+		case *lowcodev1.Value_StringValue:
+			if reason, ok := textFormatReasons[c.Kind]; ok && !validTextFormat(c.Kind, x.StringValue) {
+				out = append(out, apierr.FieldViolation{
+					Field:       prefix + c.Id,
+					Description: fmt.Sprintf("%s is not a valid %s", c.Name, c.Kind),
+					Reason:      reason,
+				})
+				continue
+			}
+			n := utf8.RuneCountInString(x.StringValue)
+			if min, ok := c.Config["min_length"].(float64); ok && float64(n) < min {
+				add(c, "%s must be at least %v characters", c.Name, min)
+			}
+			if max, ok := c.Config["max_length"].(float64); ok && float64(n) > max {
+				add(c, "%s must be at most %v characters", c.Name, max)
+			}
+			if pattern, ok := c.Config["regex"].(string); ok && pattern != "" {
+				re, err := regexp.Compile(pattern)
+				if err != nil {
+					add(c, "column config has invalid regex: %v", err)
+				} else if !re.MatchString(x.StringValue) {
+					add(c, "%s does not match %s", c.Name, pattern)
+				}
+			}
+			if options, ok := c.Config["options"].([]any); ok && len(options) > 0 && c.Kind != selectKindSingle && !containsOption(options, x.StringValue) {
+				add(c, "%s must be one of the configured options", c.Name)
+			}
+		}
+	}
+	return out
+}
+
+func isEmptyValue(v *lowcodev1.Value) bool {
+	switch x := v.GetKind().(type) {
+	case nil, *lowcodev1.Value_NullValue:
+		return true
+	case *lowcodev1.Value_StringValue:
+		return x.StringValue == ""
+	}
+	return false
+}
+
+// containsOption 判断 s 是否在 options 中；选项可以是字符串，也可以是带 label 的对象（选择列）。
+func containsOption(options []any, s string) bool {
+	for _, o := range options {
+		switch x := o.(type) {
+		case string:
+			if x == s {
+				return true
+			}
+		case map[string]any:
+			if label, _ := x["label"].(string); label == s {
+				return true
+			}
+		}
+	}
+	return false
+}
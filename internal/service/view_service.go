@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- View --------
+
+// viewFieldsSQL 是 scanView 需要的 lc_views 字段，SELECT / RETURNING 共用。
+const viewFieldsSQL = `id, table_id, name, filter, sorts, visible_column_ids::text[], page_size, created_at, updated_at`
+
+// scanView 扫描 viewFieldsSQL 对应的一行；filter / sorts 以 protojson 保存。
+func scanView(row pgx.Row) (*lowcodev1.View, error) {
+	var v lowcodev1.View
+	var filter, sorts []byte
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&v.Id, &v.TableId, &v.Name, &filter, &sorts, &v.VisibleColumnIds, &v.PageSize, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	if len(filter) > 0 {
+		v.Filter = &lowcodev1.RowFilter{}
+		if err := protojson.Unmarshal(filter, v.Filter); err != nil {
+			return nil, fmt.Errorf("view %s: decode filter: %w", v.Id, err)
+		}
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(sorts, &raw); err != nil {
+		return nil, fmt.Errorf("view %s: decode sorts: %w", v.Id, err)
+	}
+	for _, r := range raw {
+		sp := &lowcodev1.SortSpec{}
+		if err := protojson.Unmarshal(r, sp); err != nil {
+			return nil, fmt.Errorf("view %s: decode sorts: %w", v.Id, err)
+		}
+		v.Sorts = append(v.Sorts, sp)
+	}
+	v.CreatedAt = timestamppb.New(createdAt)
+	v.UpdatedAt = timestamppb.New(updatedAt)
+	return &v, nil
+}
+
+// encodeViewQuery 把 filter / sorts 编码为 lc_views 中保存的 JSON；filter 为空时返回 nil（NULL）。
+func encodeViewQuery(filter *lowcodev1.RowFilter, sorts []*lowcodev1.SortSpec) ([]byte, []byte, error) {
+	var filterJSON []byte
+	if filter != nil {
+		b, err := protojson.Marshal(filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		filterJSON = b
+	}
+	raw := make([]json.RawMessage, 0, len(sorts))
+	for _, sp := range sorts {
+		b, err := protojson.Marshal(sp)
+		if err != nil {
+			return nil, nil, err
+		}
+		raw = append(raw, b)
+	}
+	sortsJSON, err := json.Marshal(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filterJSON, sortsJSON, nil
+}
+
+func viewNotFound(id string) error {
+	return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "view %s not found", id)
+}
+
+// lookupView 按 id 读取视图，id 不是 UUID 时同样视为不存在。
+func lookupView(ctx context.Context, q querier, id string) (*lowcodev1.View, error) {
+	if id == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "view id is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, viewNotFound(id)
+	}
+	v, err := scanView(q.QueryRow(ctx, `SELECT `+viewFieldsSQL+` FROM lc_views WHERE id = $1`, id))
+	if err == pgx.ErrNoRows {
+		return nil, viewNotFound(id)
+	}
+	return v, err
+}
+
+// validateView 按表当前的列编译一遍 filter 和 sorts，并检查可见列都属于该表，避免保存一个无法执行的视图。
+func (s *LowcodeService) validateView(ctx context.Context, q querier, tableID, name string, filter *lowcodev1.RowFilter, sorts []*lowcodev1.SortSpec, visible []string, pageSize int32) error {
+	if name == "" {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "name is required")
+	}
+	if pageSize < 0 {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "page_size must not be negative")
+	}
+	cols, _, _, err := s.loadColumns(ctx, q, tableID)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]columnMeta, len(cols))
+	for _, c := range cols {
+		byID[c.Id] = c
+	}
+	if _, err := compileFilter(filter, byID, &sqlArgs{}); err != nil {
+		return err
+	}
+	if _, err := compileSorts(sorts, byID); err != nil {
+		return err
+	}
+	for _, id := range visible {
+		if _, ok := byID[id]; !ok {
+			return apierr.New(lowcodev1.ErrorCode_COLUMN_NOT_FOUND, codes.InvalidArgument, "visible column %s not found in table %s", id, tableID)
+		}
+	}
+	return nil
+}
+
+// checkViewName 检查视图名在表内是否已被其它视图使用。
+func checkViewName(ctx context.Context, q querier, tableID, name, exceptID string) error {
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_views WHERE table_id = $1 AND name = $2 AND id::text <> $3)`, tableID, name, exceptID).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "view %s already exists in table %s", name, tableID)
+	}
+	return nil
+}
+
+func (s *LowcodeService) CreateView(ctx context.Context, req *lowcodev1.CreateViewRequest) (*lowcodev1.CreateViewResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableID, err := s.resolveTableName(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateView(ctx, pool, tableID, req.GetName(), req.GetFilter(), req.GetSorts(), req.GetVisibleColumnIds(), req.GetPageSize()); err != nil {
+		return nil, err
+	}
+	if err := checkViewName(ctx, pool, tableID, req.GetName(), ""); err != nil {
+		return nil, err
+	}
+	filter, sorts, err := encodeViewQuery(req.GetFilter(), req.GetSorts())
+	if err != nil {
+		return nil, err
+	}
+	v, err := scanView(pool.QueryRow(ctx, `
+		INSERT INTO lc_views (table_id, name, filter, sorts, visible_column_ids, page_size)
+		VALUES ($1, $2, $3, $4, COALESCE($5::uuid[], '{}'), $6)
+		RETURNING `+viewFieldsSQL, tableID, req.GetName(), filter, sorts, req.GetVisibleColumnIds(), req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.CreateViewResponse{View: v}, nil
+}
+
+func (s *LowcodeService) ListViews(ctx context.Context, req *lowcodev1.ListViewsRequest) (*lowcodev1.ListViewsResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableID, err := s.resolveTableName(ctx, pool, req.GetTableId())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, `SELECT `+viewFieldsSQL+` FROM lc_views WHERE table_id = $1 ORDER BY name`, tableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListViewsResponse
+	for rows.Next() {
+		v, err := scanView(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Views = append(res.Views, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) GetView(ctx context.Context, req *lowcodev1.GetViewRequest) (*lowcodev1.GetViewResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, err := lookupView(ctx, pool, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.GetViewResponse{View: v}, nil
+}
+
+// UpdateView 整体替换视图的 filter / sorts / visible_column_ids / page_size，name 为空时保持不变。
+func (s *LowcodeService) UpdateView(ctx context.Context, req *lowcodev1.UpdateViewRequest) (*lowcodev1.UpdateViewResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := lookupView(ctx, pool, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	name := req.GetName()
+	if name == "" {
+		name = cur.GetName()
+	}
+	if err := s.validateView(ctx, pool, cur.GetTableId(), name, req.GetFilter(), req.GetSorts(), req.GetVisibleColumnIds(), req.GetPageSize()); err != nil {
+		return nil, err
+	}
+	if name != cur.GetName() {
+		if err := checkViewName(ctx, pool, cur.GetTableId(), name, cur.GetId()); err != nil {
+			return nil, err
+		}
+	}
+	filter, sorts, err := encodeViewQuery(req.GetFilter(), req.GetSorts())
+	if err != nil {
+		return nil, err
+	}
+	v, err := scanView(pool.QueryRow(ctx, `
+		UPDATE lc_views
+		SET name = $2, filter = $3, sorts = $4, visible_column_ids = COALESCE($5::uuid[], '{}'), page_size = $6, updated_at = now()
+		WHERE id = $1
+		RETURNING `+viewFieldsSQL, cur.GetId(), name, filter, sorts, req.GetVisibleColumnIds(), req.GetPageSize()))
+	if err == pgx.ErrNoRows {
+		return nil, viewNotFound(req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UpdateViewResponse{View: v}, nil
+}
+
+func (s *LowcodeService) DeleteView(ctx context.Context, req *lowcodev1.DeleteViewRequest) (*lowcodev1.DeleteViewResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, viewNotFound(req.GetId())
+	}
+	tag, err := pool.Exec(ctx, `DELETE FROM lc_views WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, viewNotFound(req.GetId())
+	}
+	return &lowcodev1.DeleteViewResponse{}, nil
+}
+
+// applyView 把视图合并进 ListRows 请求：filter 取 AND，请求没有 sorts / page_size 时用视图的。
+// 返回合并后的请求和视图的可见列（为空表示全部列）。
+func (s *LowcodeService) applyView(ctx context.Context, q querier, req *lowcodev1.ListRowsRequest) (*lowcodev1.ListRowsRequest, []string, error) {
+	tableID, err := s.resolveTableName(ctx, q, req.GetTableId())
+	if err != nil {
+		return nil, nil, err
+	}
+	v, err := lookupView(ctx, q, req.GetViewId())
+	if err != nil {
+		return nil, nil, err
+	}
+	if v.GetTableId() != tableID {
+		return nil, nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "view %s does not belong to table %s", v.GetId(), req.GetTableId())
+	}
+	out := proto.Clone(req).(*lowcodev1.ListRowsRequest)
+	switch {
+	case v.GetFilter() == nil:
+	case req.GetFilter() == nil:
+		out.Filter = v.GetFilter()
+	default:
+		out.Filter = &lowcodev1.RowFilter{Kind: &lowcodev1.RowFilter_Group{Group: &lowcodev1.FilterGroup{
+			Combinator: lowcodev1.FilterGroup_AND,
+			Filters:    []*lowcodev1.RowFilter{v.GetFilter(), req.GetFilter()},
+		}}}
+	}
+	if len(req.GetSorts()) == 0 {
+		out.Sorts = v.GetSorts()
+	}
+	if req.GetPageSize() <= 0 {
+		out.PageSize = v.GetPageSize()
+	}
+	return out, v.GetVisibleColumnIds(), nil
+}
+
+// keepCells 只保留 visible 中的单元格，visible 为空时不做处理。
+func keepCells(rows []*lowcodev1.Row, visible []string) {
+	if len(visible) == 0 {
+		return
+	}
+	keep := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		keep[id] = true
+	}
+	for _, row := range rows {
+		for id := range row.Cells {
+			if !keep[id] {
+				delete(row.Cells, id)
+			}
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+	"github.com/solat/lowcode-database/internal/tenant"
+)
+
+// -------- Webhook --------
+
+const (
+	// webhookTimeout 是一次投递的超时，包括读取响应。
+	webhookTimeout = 10 * time.Second
+	// maxWebhookErrorBytes 是投递失败时记录的响应 body 上限。
+	maxWebhookErrorBytes = 1 << 10
+	maxWebhookPageSize   = 1000
+)
+
+// webhookFieldsSQL 是 scanWebhook 需要的 lc_webhooks 字段，SELECT / RETURNING 共用。
+const webhookFieldsSQL = `id::text, url, table_id, methods, secret <> '', created_at`
+
+func scanWebhook(row pgx.Row) (*lowcodev1.Webhook, error) {
+	var w lowcodev1.Webhook
+	var createdAt time.Time
+	if err := row.Scan(&w.Id, &w.Url, &w.TableId, &w.Methods, &w.HasSecret, &createdAt); err != nil {
+		return nil, err
+	}
+	w.CreatedAt = timestamppb.New(createdAt)
+	return &w, nil
+}
+
+func (s *LowcodeService) CreateWebhook(ctx context.Context, req *lowcodev1.CreateWebhookRequest) (*lowcodev1.CreateWebhookResponse, error) {
+	if u, err := url.Parse(req.GetUrl()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "url must be an absolute http or https URL")
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTableId() != "" {
+		if _, err := lookupTable(ctx, pool, req.GetTableId(), false, false); err != nil {
+			return nil, err
+		}
+	}
+	methods := req.GetMethods()
+	if methods == nil {
+		methods = []string{}
+	}
+	w, err := scanWebhook(pool.QueryRow(ctx, `
+		INSERT INTO lc_webhooks (url, table_id, methods, secret) VALUES ($1, $2, $3, $4)
+		RETURNING `+webhookFieldsSQL, req.GetUrl(), req.GetTableId(), methods, req.GetSecret()))
+	if err != nil {
+		return nil, err
+	}
+	auditResources(ctx, w.Id)
+	return &lowcodev1.CreateWebhookResponse{Webhook: w}, nil
+}
+
+func (s *LowcodeService) ListWebhooks(ctx context.Context, _ *lowcodev1.ListWebhooksRequest) (*lowcodev1.ListWebhooksResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, `SELECT `+webhookFieldsSQL+` FROM lc_webhooks ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListWebhooksResponse
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Webhooks = append(res.Webhooks, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) DeleteWebhook(ctx context.Context, req *lowcodev1.DeleteWebhookRequest) (*lowcodev1.DeleteWebhookResponse, error) {
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, webhookNotFound(req.GetId())
+	}
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := pool.Exec(ctx, `DELETE FROM lc_webhooks WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, webhookNotFound(req.GetId())
+	}
+	return &lowcodev1.DeleteWebhookResponse{}, nil
+}
+
+func webhookNotFound(id string) error {
+	return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "webhook %s not found", id)
+}
+
+// webhookDeliveryFieldsSQL 是 scanWebhookDelivery 需要的 lc_webhook_deliveries 字段。
+const webhookDeliveryFieldsSQL = `id::text, created_at, webhook_id::text, url, audit_event_id::text, method, status_code, error, duration_ms`
+
+func scanWebhookDelivery(row pgx.Row, extra ...any) (*lowcodev1.WebhookDelivery, error) {
+	var d lowcodev1.WebhookDelivery
+	var createdAt time.Time
+	dest := append([]any{&d.Id, &createdAt, &d.WebhookId, &d.Url, &d.AuditEventId, &d.Method, &d.StatusCode, &d.Error, &d.DurationMs}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	d.CreatedAt = timestamppb.New(createdAt)
+	return &d, nil
+}
+
+func (s *LowcodeService) ListWebhookDeliveries(ctx context.Context, req *lowcodev1.ListWebhookDeliveriesRequest) (*lowcodev1.ListWebhookDeliveriesResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var a sqlArgs
+	conds := []string{"TRUE"}
+	if req.GetWebhookId() != "" {
+		if _, err := uuid.Parse(req.GetWebhookId()); err != nil {
+			return nil, webhookNotFound(req.GetWebhookId())
+		}
+		conds = append(conds, "webhook_id = "+a.add(req.GetWebhookId())+"::uuid")
+	}
+	if req.GetFailedOnly() {
+		conds = append(conds, "status_code NOT BETWEEN 200 AND 299")
+	}
+	if req.GetStartTime() != nil {
+		conds = append(conds, "created_at >= "+a.add(req.GetStartTime().AsTime()))
+	}
+	if req.GetEndTime() != nil {
+		conds = append(conds, "created_at < "+a.add(req.GetEndTime().AsTime()))
+	}
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if len(token.Keys) != 1 || token.Keys[0] == nil {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "invalid page_token")
+		}
+		conds = append(conds, fmt.Sprintf("(created_at, id) < (%s::timestamptz, %s::uuid)", a.add(*token.Keys[0]), a.add(token.ID)))
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > maxWebhookPageSize {
+		pageSize = maxWebhookPageSize
+	}
+	// 多取一行用来判断是否还有下一页
+	q := fmt.Sprintf(`SELECT %s, created_at::text FROM lc_webhook_deliveries WHERE %s ORDER BY created_at DESC, id DESC LIMIT %s`,
+		webhookDeliveryFieldsSQL, strings.Join(conds, " AND "), a.add(pageSize+1))
+	rows, err := pool.Query(ctx, q, a.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListWebhookDeliveriesResponse
+	var lastKey string
+	for rows.Next() {
+		if len(res.Deliveries) == int(pageSize) {
+			res.NextPageToken = encodePageToken(pageToken{ID: res.Deliveries[len(res.Deliveries)-1].GetId(), Keys: []*string{&lastKey}})
+			break
+		}
+		var key string
+		d, err := scanWebhookDelivery(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+		lastKey = key
+		res.Deliveries = append(res.Deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// webhookTarget 是一个需要投递的 webhook。
+type webhookTarget struct {
+	id, url, secret string
+}
+
+// deliverWebhooks 把成功调用的审计事件 POST 到当前 tenant 中匹配的 webhook，并把结果写入 lc_webhook_deliveries。
+// 在 writeAudit 之后于后台执行，ctx 不会被取消；投递失败不重试，也不影响调用结果。
+func (s *LowcodeService) deliverWebhooks(ctx context.Context, pool *pgxpool.Pool, ev *lowcodev1.AuditEvent) {
+	rows, err := pool.Query(ctx, `
+		SELECT id::text, url, secret FROM lc_webhooks
+		WHERE (table_id = '' OR table_id = $1) AND (cardinality(methods) = 0 OR $2 = ANY(methods))
+		ORDER BY created_at, id`, ev.GetTableId(), ev.GetMethod())
+	if err == nil {
+		var targets []webhookTarget
+		targets, err = pgx.CollectRows(rows, func(r pgx.CollectableRow) (webhookTarget, error) {
+			var w webhookTarget
+			err := r.Scan(&w.id, &w.url, &w.secret)
+			return w, err
+		})
+		if err == nil && len(targets) > 0 {
+			var body []byte
+			if body, err = protojson.Marshal(ev); err == nil {
+				for _, w := range targets {
+					s.deliverWebhook(ctx, pool, w, ev, body)
+				}
+			}
+		}
+	}
+	if err != nil {
+		log.Printf("webhook (tenant %q): audit event %s: %v", tenant.FromContext(ctx), ev.GetId(), err)
+	}
+}
+
+// deliverWebhook 执行一次投递。body 为审计事件的 JSON，设置了 secret 时附带 HMAC-SHA256 签名。
+func (s *LowcodeService) deliverWebhook(ctx context.Context, pool *pgxpool.Pool, w webhookTarget, ev *lowcodev1.AuditEvent, body []byte) {
+	start := time.Now()
+	status, errMsg := 0, ""
+	reqCtx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err == nil {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Lowcode-Event", ev.GetMethod())
+		req.Header.Set("X-Lowcode-Tenant", tenant.FromContext(ctx))
+		if w.secret != "" {
+			mac := hmac.New(sha256.New, []byte(w.secret))
+			mac.Write(body)
+			req.Header.Set("X-Lowcode-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		}
+		var resp *http.Response
+		if resp, err = s.webhookClient.Do(req); err == nil {
+			status = resp.StatusCode
+			msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxWebhookErrorBytes))
+			resp.Body.Close()
+			if status < 200 || status > 299 {
+				errMsg = strings.ToValidUTF8(string(msg), "")
+			}
+		}
+	}
+	if err != nil {
+		errMsg = err.Error()
+	}
+	if _, err := pool.Exec(ctx, `
+		INSERT INTO lc_webhook_deliveries (webhook_id, url, audit_event_id, method, status_code, error, duration_ms)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		w.id, w.url, ev.GetId(), ev.GetMethod(), status, errMsg, time.Since(start).Milliseconds()); err != nil {
+		log.Printf("webhook (tenant %q): %s: record delivery of audit event %s: %v", tenant.FromContext(ctx), w.id, ev.GetId(), err)
+	}
+}
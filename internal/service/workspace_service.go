@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- Workspace --------
+
+// workspaceFieldsSQL 是 scanWorkspace 需要的 lc_workspaces 字段，SELECT / RETURNING 共用。
+const workspaceFieldsSQL = `id, name, description, icon, created_at, updated_at`
+
+func scanWorkspace(row pgx.Row) (*lowcodev1.Workspace, error) {
+	var w lowcodev1.Workspace
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&w.Id, &w.Name, &w.Description, &w.Icon, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	w.CreatedAt = timestamppb.New(createdAt)
+	w.UpdatedAt = timestamppb.New(updatedAt)
+	return &w, nil
+}
+
+// workspaceNotFound 返回 workspace 不存在的错误。
+func workspaceNotFound(id string) error {
+	return apierr.New(lowcodev1.ErrorCode_NOT_FOUND, codes.NotFound, "workspace %s not found", id)
+}
+
+// checkWorkspace 校验建表 / 移动表时指定的 workspace 存在。id 不是 UUID 时同样视为不存在，避免把 PG 的类型错误抛给调用方。
+func checkWorkspace(ctx context.Context, q querier, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return workspaceNotFound(id)
+	}
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_workspaces WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return workspaceNotFound(id)
+	}
+	return nil
+}
+
+// checkWorkspaceName 检查 workspace 名字是否已被其它 workspace 使用。
+func checkWorkspaceName(ctx context.Context, q querier, name, exceptID string) error {
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM lc_workspaces WHERE name = $1 AND id::text <> $2)`, name, exceptID).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return apierr.New(lowcodev1.ErrorCode_ALREADY_EXISTS, codes.AlreadyExists, "workspace %s already exists", name)
+	}
+	return nil
+}
+
+func (s *LowcodeService) CreateWorkspace(ctx context.Context, req *lowcodev1.CreateWorkspaceRequest) (*lowcodev1.CreateWorkspaceResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "name is required")
+	}
+	if err := checkWorkspaceName(ctx, pool, req.GetName(), ""); err != nil {
+		return nil, err
+	}
+	w, err := scanWorkspace(pool.QueryRow(ctx, `
+		INSERT INTO lc_workspaces (name, description, icon)
+		VALUES ($1, $2, $3)
+		RETURNING `+workspaceFieldsSQL, req.GetName(), req.GetDescription(), req.GetIcon()))
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.CreateWorkspaceResponse{Workspace: w}, nil
+}
+
+func (s *LowcodeService) ListWorkspaces(ctx context.Context, _ *lowcodev1.ListWorkspacesRequest) (*lowcodev1.ListWorkspacesResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := pool.Query(ctx, `SELECT `+workspaceFieldsSQL+` FROM lc_workspaces ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res lowcodev1.ListWorkspacesResponse
+	for rows.Next() {
+		w, err := scanWorkspace(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Workspaces = append(res.Workspaces, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *LowcodeService) GetWorkspace(ctx context.Context, req *lowcodev1.GetWorkspaceRequest) (*lowcodev1.GetWorkspaceResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, workspaceNotFound(req.GetId())
+	}
+	w, err := scanWorkspace(pool.QueryRow(ctx, `SELECT `+workspaceFieldsSQL+` FROM lc_workspaces WHERE id = $1`, req.GetId()))
+	if err == pgx.ErrNoRows {
+		return nil, workspaceNotFound(req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.GetWorkspaceResponse{Workspace: w}, nil
+}
+
+func (s *LowcodeService) UpdateWorkspace(ctx context.Context, req *lowcodev1.UpdateWorkspaceRequest) (*lowcodev1.UpdateWorkspaceResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, workspaceNotFound(req.GetId())
+	}
+	if req.Name != nil {
+		if req.GetName() == "" {
+			return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "name must not be empty")
+		}
+		if err := checkWorkspaceName(ctx, pool, req.GetName(), req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+	w, err := scanWorkspace(pool.QueryRow(ctx, `
+		UPDATE lc_workspaces
+		SET name = COALESCE($2, name),
+		    description = COALESCE($3, description),
+		    icon = COALESCE($4, icon),
+		    updated_at = now()
+		WHERE id = $1
+		RETURNING `+workspaceFieldsSQL, req.GetId(), req.Name, req.Description, req.Icon))
+	if err == pgx.ErrNoRows {
+		return nil, workspaceNotFound(req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lowcodev1.UpdateWorkspaceResponse{Workspace: w}, nil
+}
+
+// DeleteWorkspace 只删除分组本身，其中的表由外键 ON DELETE SET NULL 变为未分组。
+func (s *LowcodeService) DeleteWorkspace(ctx context.Context, req *lowcodev1.DeleteWorkspaceRequest) (*lowcodev1.DeleteWorkspaceResponse, error) {
+	pool, err := s.tenants.PoolFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, workspaceNotFound(req.GetId())
+	}
+	tag, err := pool.Exec(ctx, `DELETE FROM lc_workspaces WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, workspaceNotFound(req.GetId())
+	}
+	return &lowcodev1.DeleteWorkspaceResponse{}, nil
+}
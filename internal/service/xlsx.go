@@ -0,0 +1,161 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+
+	lowcodev1 "github.com/solat/lowcode-database/gen/lowcode/v1"
+	"github.com/solat/lowcode-database/internal/apierr"
+)
+
+// -------- XLSX --------
+
+// Excel 的限制：工作表最多 1048576 行，单元格文本最多 32767 个字符，工作表名最多 31 个字符。
+const (
+	xlsxMaxRows      = 1 << 20
+	xlsxMaxCellChars = 32767
+	xlsxMaxSheetName = 31
+)
+
+// xlsxEpoch 是 Excel 日期序列号的起点（1900 日期系统，已包含 1900-02-29 的历史错误）。
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// xlsxSheetNameReplacer 替换工作表名中不允许的字符。
+var xlsxSheetNameReplacer = strings.NewReplacer(`[`, "_", `]`, "_", `:`, "_", `*`, "_", `?`, "_", `/`, "_", `\`, "_")
+
+// xlsxWriter 以流的方式写出只有一个工作表的 .xlsx：行直接写入 zip 中的 sheet1.xml，不在内存中保留。
+// 文本使用 inline string，不需要共享字符串表；时间使用样式 1（yyyy-mm-dd hh:mm:ss）。
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet io.Writer
+	row   bytes.Buffer
+	rows  int
+}
+
+func newXLSXWriter(w io.Writer, sheetName string) (*xlsxWriter, error) {
+	sheetName = xlsxSheetNameReplacer.Replace(sheetName)
+	if sheetName == "" {
+		sheetName = "Sheet1"
+	}
+	if utf8.RuneCountInString(sheetName) > xlsxMaxSheetName {
+		sheetName = string([]rune(sheetName)[:xlsxMaxSheetName])
+	}
+	var name bytes.Buffer
+	xml.EscapeText(&name, []byte(sheetName))
+
+	x := &xlsxWriter{zw: zip.NewWriter(w)}
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="` + name.String() + `" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+			`</Relationships>`},
+		{"xl/styles.xml", xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+			`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+			`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+			`</styleSheet>`},
+	}
+	for _, p := range parts {
+		f, err := x.zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return nil, err
+		}
+	}
+	sheet, err := x.zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, xml.Header+`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return nil, err
+	}
+	x.sheet = sheet
+	return x, nil
+}
+
+// writeRow 写出一行，values 中的 nil 为空单元格。
+func (x *xlsxWriter) writeRow(values []*lowcodev1.Value) error {
+	if x.rows >= xlsxMaxRows {
+		return apierr.New(lowcodev1.ErrorCode_VALIDATION_FAILED, codes.FailedPrecondition,
+			"xlsx supports at most %d rows, narrow the filter or export as csv", xlsxMaxRows-1)
+	}
+	x.rows++
+	x.row.Reset()
+	x.row.WriteString("<row>")
+	for _, v := range values {
+		switch k := v.GetKind().(type) {
+		case nil, *lowcodev1.Value_NullValue:
+			x.row.WriteString("<c/>")
+		case *lowcodev1.Value_NumberValue:
+			if math.IsNaN(k.NumberValue) || math.IsInf(k.NumberValue, 0) {
+				x.stringCell(valueText(v))
+				continue
+			}
+			x.row.WriteString(`<c><v>` + strconv.FormatFloat(k.NumberValue, 'g', -1, 64) + `</v></c>`)
+		case *lowcodev1.Value_BoolValue:
+			b := "0"
+			if k.BoolValue {
+				b = "1"
+			}
+			x.row.WriteString(`<c t="b"><v>` + b + `</v></c>`)
+		case *lowcodev1.Value_TimestampValue:
+			days := k.TimestampValue.AsTime().Sub(xlsxEpoch).Hours() / 24
+			x.row.WriteString(`<c s="1"><v>` + strconv.FormatFloat(days, 'f', -1, 64) + `</v></c>`)
+		default:
+			x.stringCell(valueText(v))
+		}
+	}
+	x.row.WriteString("</row>")
+	_, err := x.sheet.Write(x.row.Bytes())
+	return err
+}
+
+// stringCell 写出文本单元格，超过 Excel 上限的部分被截掉。
+func (x *xlsxWriter) stringCell(s string) {
+	if utf8.RuneCountInString(s) > xlsxMaxCellChars {
+		s = string([]rune(s)[:xlsxMaxCellChars])
+	}
+	x.row.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+	// EscapeText 把 XML 中不允许的控制字符替换为 U+FFFD
+	xml.EscapeText(&x.row, []byte(s))
+	x.row.WriteString(`</t></is></c>`)
+}
+
+// close 结束工作表并写出 zip 的目录。
+func (x *xlsxWriter) close() error {
+	if _, err := io.WriteString(x.sheet, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	if err := x.zw.Close(); err != nil {
+		return fmt.Errorf("write xlsx: %w", err)
+	}
+	return nil
+}
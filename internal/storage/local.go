@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalPathPrefix 是本地存储的 HTTP 路由前缀，由 cmd/server 挂载 LocalStore.Handler。
+const LocalPathPrefix = "/v1/files/"
+
+// LocalStore 把文件保存在本地目录，预签名 URL 指向本服务的 LocalPathPrefix 路由，
+// 用 HMAC 签名 (method, key, expires, filename) 防止篡改。适合开发环境或单机部署。
+type LocalStore struct {
+	dir     string
+	baseURL string // e.g. "http://localhost:8080"
+	secret  []byte
+	ttl     time.Duration
+}
+
+// NewLocalStore creates a Store that keeps files under dir.
+func NewLocalStore(dir, baseURL string, secret []byte, ttl time.Duration) (*LocalStore, error) {
+	if dir == "" || baseURL == "" {
+		return nil, fmt.Errorf("local storage requires STORAGE_LOCAL_DIR and STORAGE_PUBLIC_URL")
+	}
+	if len(secret) < 16 {
+		return nil, fmt.Errorf("local storage requires STORAGE_SIGNING_KEY of at least 16 bytes")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create storage dir: %w", err)
+	}
+	return &LocalStore{dir: dir, baseURL: strings.TrimRight(baseURL, "/"), secret: secret, ttl: ttl}, nil
+}
+
+func (l *LocalStore) PresignUpload(ctx context.Context, key, contentType string) (*Presigned, error) {
+	p := l.presign(http.MethodPut, key, "")
+	if contentType != "" {
+		p.Headers = map[string]string{"Content-Type": contentType}
+	}
+	return p, nil
+}
+
+func (l *LocalStore) PresignDownload(ctx context.Context, key, filename string) (*Presigned, error) {
+	return l.presign(http.MethodGet, key, filename), nil
+}
+
+func (l *LocalStore) PresignDelete(ctx context.Context, key string) (*Presigned, error) {
+	return l.presign(http.MethodDelete, key, ""), nil
+}
+
+func (l *LocalStore) presign(method, key, filename string) *Presigned {
+	expires := time.Now().Add(l.ttl).Truncate(time.Second)
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	if filename != "" {
+		q.Set("filename", filename)
+	}
+	q.Set("sig", l.sign(method, key, q.Get("expires"), filename))
+	return &Presigned{
+		Method:    method,
+		URL:       l.baseURL + LocalPathPrefix + escapeKey(key) + "?" + q.Encode(),
+		ExpiresAt: expires,
+	}
+}
+
+func (l *LocalStore) sign(method, key, expires, filename string) string {
+	mac := hmac.New(sha256.New, l.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s", method, key, expires, filename)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Handler 处理预签名的 PUT（上传）、GET（下载）和 DELETE 请求。
+func (l *LocalStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, LocalPathPrefix)
+		if r.Method != http.MethodPut && r.Method != http.MethodGet && r.Method != http.MethodDelete {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		q := r.URL.Query()
+		expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+		if err != nil || time.Now().Unix() > expires {
+			http.Error(w, "url expired", http.StatusForbidden)
+			return
+		}
+		want := l.sign(r.Method, key, q.Get("expires"), q.Get("filename"))
+		if !hmac.Equal([]byte(want), []byte(q.Get("sig"))) {
+			http.Error(w, "invalid signature", http.StatusForbidden)
+			return
+		}
+		file, err := l.path(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == http.MethodGet {
+			if name := q.Get("filename"); name != "" {
+				w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+			}
+			http.ServeFile(w, r, file)
+			return
+		}
+		if err := writeFile(file, r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// path 把 key 映射为 dir 下的文件路径，拒绝跳出 dir 的 key。
+func (l *LocalStore) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if key == "" || clean != "/"+key {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(clean)), nil
+}
+
+// writeFile 先写临时文件再 rename，避免下载到写了一半的文件。
+func writeFile(file string, body io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+// escapeKey 按段转义 key，保留 "/"。
+func escapeKey(key string) string {
+	segs := strings.Split(key, "/")
+	for i, s := range segs {
+		segs[i] = url.PathEscape(s)
+	}
+	return strings.Join(segs, "/")
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/solat/lowcode-database/internal/config"
+)
+
+// New 根据配置创建 Store；STORAGE_PROVIDER 为空时返回 nil（不启用附件）。
+func New(cfg *config.Config) (Store, error) {
+	ttl := time.Duration(cfg.StorageURLTTL) * time.Second
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+	switch cfg.StorageProvider {
+	case "":
+		return nil, nil
+	case "local":
+		return NewLocalStore(cfg.StorageLocalDir, cfg.StoragePublicURL, []byte(cfg.StorageSigningKey), ttl)
+	case "s3":
+		return NewS3Store(S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			TTL:       ttl,
+		})
+	case "minio":
+		// MinIO 兼容 S3 签名，但通常只支持 path-style 访问
+		return NewS3Store(S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: true,
+			TTL:       ttl,
+		})
+	default:
+		return nil, fmt.Errorf("invalid STORAGE_PROVIDER %q", cfg.StorageProvider)
+	}
+}
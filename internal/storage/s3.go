@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Options 配置 S3 / MinIO 存储。
+type S3Options struct {
+	Endpoint  string // 为空时使用 https://s3.<region>.amazonaws.com
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PathStyle 使用 <endpoint>/<bucket>/<key>，否则使用 <bucket>.<endpoint>/<key>
+	PathStyle bool
+	TTL       time.Duration
+}
+
+// S3Store 用 AWS Signature V4 生成预签名 URL（query 签名），不依赖 AWS SDK。
+type S3Store struct {
+	opts   S3Options
+	scheme string
+	host   string
+}
+
+// NewS3Store creates a Store backed by an S3-compatible bucket.
+func NewS3Store(opts S3Options) (*S3Store, error) {
+	if opts.Bucket == "" || opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, fmt.Errorf("s3 storage requires S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	if opts.Endpoint == "" {
+		opts.Endpoint = "https://s3." + opts.Region + ".amazonaws.com"
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = defaultTTL
+	}
+	u, err := url.Parse(opts.Endpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid S3_ENDPOINT %q", opts.Endpoint)
+	}
+	return &S3Store{opts: opts, scheme: u.Scheme, host: u.Host}, nil
+}
+
+func (s *S3Store) PresignUpload(ctx context.Context, key, contentType string) (*Presigned, error) {
+	p := s.presign(http.MethodPut, key, nil, time.Now())
+	if contentType != "" {
+		p.Headers = map[string]string{"Content-Type": contentType}
+	}
+	return p, nil
+}
+
+func (s *S3Store) PresignDownload(ctx context.Context, key, filename string) (*Presigned, error) {
+	extra := url.Values{}
+	if filename != "" {
+		extra.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	}
+	return s.presign(http.MethodGet, key, extra, time.Now()), nil
+}
+
+func (s *S3Store) PresignDelete(ctx context.Context, key string) (*Presigned, error) {
+	return s.presign(http.MethodDelete, key, nil, time.Now()), nil
+}
+
+// presign 按 SigV4 规则签名：只签 host 头，payload 为 UNSIGNED-PAYLOAD。
+func (s *S3Store) presign(method, key string, extra url.Values, now time.Time) *Presigned {
+	host, uri := s.host, "/"+key
+	if s.opts.PathStyle {
+		uri = "/" + s.opts.Bucket + "/" + key
+	} else {
+		host = s.opts.Bucket + "." + s.host
+	}
+
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	scope := day + "/" + s.opts.Region + "/s3/aws4_request"
+
+	q := url.Values{}
+	for k, v := range extra {
+		q[k] = v
+	}
+	q.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	q.Set("X-Amz-Credential", s.opts.AccessKey+"/"+scope)
+	q.Set("X-Amz-Date", amzDate)
+	q.Set("X-Amz-Expires", strconv.Itoa(int(s.opts.TTL/time.Second)))
+	q.Set("X-Amz-SignedHeaders", "host")
+	query := canonicalQuery(q)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		awsEscape(uri, false),
+		query,
+		"host:" + host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hexSHA256(canonicalRequest)}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.opts.SecretKey), day)
+	for _, part := range []string{s.opts.Region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return &Presigned{
+		Method:    method,
+		URL:       s.scheme + "://" + host + awsEscape(uri, false) + "?" + query + "&X-Amz-Signature=" + signature,
+		ExpiresAt: now.Add(s.opts.TTL),
+	}
+}
+
+// canonicalQuery 按 key 排序并用 SigV4 规则转义。
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range q[k] {
+			parts = append(parts, awsEscape(k, true)+"="+awsEscape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsEscape 转义除 A-Z a-z 0-9 - _ . ~ 以外的字符；encodeSlash 为 false 时保留 "/"。
+func awsEscape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,29 @@
+// Package storage 为 attachment 列提供对象存储：服务只负责生成预签名 URL，
+// 文件内容由客户端直接上传到 / 下载自存储后端，不经过 gRPC 服务。
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// Store 是可插拔的存储后端（本地磁盘 / S3 / MinIO）。
+type Store interface {
+	// PresignUpload 返回上传 key 的预签名请求，contentType 为空时不限制。
+	PresignUpload(ctx context.Context, key, contentType string) (*Presigned, error)
+	// PresignDownload 返回下载 key 的预签名请求，filename 非空时作为下载文件名。
+	PresignDownload(ctx context.Context, key, filename string) (*Presigned, error)
+	// PresignDelete 返回删除 key 的预签名请求（用于服务自己清理的文件，如过期的备份）。
+	PresignDelete(ctx context.Context, key string) (*Presigned, error)
+}
+
+// Presigned 是一个带签名的 HTTP 请求：客户端用 Method 请求 URL，并带上 Headers。
+type Presigned struct {
+	Method    string
+	URL       string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+// defaultTTL 是未配置 STORAGE_URL_TTL 时预签名 URL 的有效期。
+const defaultTTL = 15 * time.Minute
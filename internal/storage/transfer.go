@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Put 由服务端通过预签名 PUT 把 body 上传到 key（用于服务自己生成的文件，如 tenant 归档）。
+// S3 不接受分块传输的 PUT，所以需要预先知道 size。
+func Put(ctx context.Context, s Store, key, contentType string, body io.Reader, size int64) error {
+	p, err := s.PresignUpload(ctx, key, contentType)
+	if err != nil {
+		return err
+	}
+	req, err := newRequest(ctx, p, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("upload %s: %w", key, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("upload %s: %s", key, res.Status)
+	}
+	return nil
+}
+
+// Get 通过预签名 GET 下载 key，调用方负责关闭返回的 body。
+func Get(ctx context.Context, s Store, key string) (io.ReadCloser, error) {
+	p, err := s.PresignDownload(ctx, key, "")
+	if err != nil {
+		return nil, err
+	}
+	req, err := newRequest(ctx, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", key, err)
+	}
+	if res.StatusCode/100 != 2 {
+		res.Body.Close()
+		return nil, fmt.Errorf("download %s: %s", key, res.Status)
+	}
+	return res.Body, nil
+}
+
+// Delete 通过预签名 DELETE 删除 key，key 不存在时不报错。
+func Delete(ctx context.Context, s Store, key string) error {
+	p, err := s.PresignDelete(ctx, key)
+	if err != nil {
+		return err
+	}
+	req, err := newRequest(ctx, p, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete %s: %w", key, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete %s: %s", key, res.Status)
+	}
+	return nil
+}
+
+func newRequest(ctx context.Context, p *Presigned, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, p.Method, p.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.Headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
@@ -0,0 +1,22 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrInvalidID is returned when a tenant id is not a valid database name.
+var ErrInvalidID = errors.New("invalid tenant id")
+
+// idPattern 限制 tenant id 的字符集和长度：multi 模式下它是数据库名（Postgres 标识符最长 63 字节）并被拼进 DSN，
+// 只允许小写字母、数字、下划线和连字符，不需要任何转义。
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Validate 检查 tenant id 是否合法。来自请求头的 id 在连接数据库前都要经过检查。
+func Validate(id string) error {
+	if !idPattern.MatchString(id) {
+		return fmt.Errorf("%w %q: must be 1-63 characters of lowercase letters, digits, '_' or '-', starting with a letter or digit", ErrInvalidID, id)
+	}
+	return nil
+}